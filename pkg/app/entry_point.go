@@ -27,6 +27,7 @@ type cliArgs struct {
 	RepoPath           string
 	FilterPath         string
 	GitArg             string
+	RepoPicker         bool
 	PrintVersionInfo   bool
 	Debug              bool
 	TailLogs           bool
@@ -143,7 +144,7 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 
 	parsedGitArg := parseGitArg(cliArgs.GitArg)
 
-	Run(appConfig, common, appTypes.NewStartArgs(cliArgs.FilterPath, parsedGitArg, integrationTest))
+	Run(appConfig, common, appTypes.NewStartArgs(cliArgs.FilterPath, parsedGitArg, cliArgs.RepoPicker, integrationTest))
 }
 
 func parseCliArgsAndEnvVars() *cliArgs {
@@ -153,11 +154,14 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	flaggy.String(&repoPath, "p", "path", "Path of git repo. (equivalent to --work-tree=<path> --git-dir=<path>/.git/)")
 
 	filterPath := ""
-	flaggy.String(&filterPath, "f", "filter", "Path to filter on in `git log -- <path>`. When in filter mode, the commits, reflog, and stash are filtered based on the given path, and some operations are restricted")
+	flaggy.String(&filterPath, "f", "filter", "Comma-separated paths and glob patterns to filter on in `git log -- <path>`. When in filter mode, the commits, reflog, and stash are filtered based on the given paths, and some operations are restricted")
 
 	gitArg := ""
 	flaggy.AddPositionalValue(&gitArg, "git-arg", 1, false, "Panel to focus upon opening lazygit. Accepted values (based on git terminology): status, branch, log, stash. Ignored if --filter arg is passed.")
 
+	repoPicker := false
+	flaggy.Bool(&repoPicker, "rp", "repo-picker", "Open the list of recent repos to pick which repo to switch to")
+
 	printVersionInfo := false
 	flaggy.Bool(&printVersionInfo, "v", "version", "Print the current version")
 
@@ -195,6 +199,7 @@ func parseCliArgsAndEnvVars() *cliArgs {
 		RepoPath:           repoPath,
 		FilterPath:         filterPath,
 		GitArg:             gitArg,
+		RepoPicker:         repoPicker,
 		PrintVersionInfo:   printVersionInfo,
 		Debug:              debug,
 		TailLogs:           tailLogs,
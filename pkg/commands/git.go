@@ -20,6 +20,7 @@ import (
 
 // GitCommand is our main git interface
 type GitCommand struct {
+	Blame       *git_commands.BlameCommands
 	Branch      *git_commands.BranchCommands
 	Commit      *git_commands.CommitCommands
 	Config      *git_commands.ConfigCommands
@@ -35,12 +36,14 @@ type GitCommand struct {
 	Sync        *git_commands.SyncCommands
 	Tag         *git_commands.TagCommands
 	WorkingTree *git_commands.WorkingTreeCommands
+	Worktree    *git_commands.WorktreeCommands
 	Bisect      *git_commands.BisectCommands
 
 	Loaders Loaders
 }
 
 type Loaders struct {
+	BlameLoader        *git_commands.BlameLoader
 	BranchLoader       *git_commands.BranchLoader
 	CommitFileLoader   *git_commands.CommitFileLoader
 	CommitLoader       *git_commands.CommitLoader
@@ -101,7 +104,7 @@ func NewGitCommandAux(
 	// common ones are: cmn, osCommand, dotGitDir, configCommands
 	configCommands := git_commands.NewConfigCommands(cmn, gitConfig, repo)
 
-	fileLoader := git_commands.NewFileLoader(cmn, cmd, configCommands)
+	fileLoader := git_commands.NewFileLoader(cmn, cmd, configCommands, dotGitDir)
 
 	gitCommon := git_commands.NewGitCommon(cmn, version, cmd, osCommand, dotGitDir, repo, configCommands, syncMutex)
 	statusCommands := git_commands.NewStatusCommands(gitCommon)
@@ -124,9 +127,12 @@ func NewGitCommandAux(
 			// account. For now we just pass false.
 			return workingTreeCommands.ShowFileDiff(from, to, reverse, filename, plain, false)
 		})
-	patchCommands := git_commands.NewPatchCommands(gitCommon, rebaseCommands, commitCommands, statusCommands, stashCommands, patchManager)
+	patchCommands := git_commands.NewPatchCommands(gitCommon, rebaseCommands, commitCommands, statusCommands, stashCommands, workingTreeCommands, patchManager)
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
+	blameCommands := git_commands.NewBlameCommands(gitCommon)
+	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
 
+	blameLoader := git_commands.NewBlameLoader(cmn, cmd)
 	branchLoader := git_commands.NewBranchLoader(cmn, branchCommands.GetRawBranches, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
 	commitLoader := git_commands.NewCommitLoader(cmn, cmd, dotGitDir, branchCommands.CurrentBranchInfo, statusCommands.RebaseMode)
@@ -136,6 +142,7 @@ func NewGitCommandAux(
 	tagLoader := git_commands.NewTagLoader(cmn, cmd)
 
 	return &GitCommand{
+		Blame:       blameCommands,
 		Branch:      branchCommands,
 		Commit:      commitCommands,
 		Config:      configCommands,
@@ -152,7 +159,9 @@ func NewGitCommandAux(
 		Tag:         tagCommands,
 		Bisect:      bisectCommands,
 		WorkingTree: workingTreeCommands,
+		Worktree:    worktreeCommands,
 		Loaders: Loaders{
+			BlameLoader:        blameLoader,
 			BranchLoader:       branchLoader,
 			CommitFileLoader:   commitFileLoader,
 			CommitLoader:       commitLoader,
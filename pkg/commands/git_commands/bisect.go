@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
 type BisectCommands struct {
@@ -118,6 +120,15 @@ func (self *BisectCommands) Start() error {
 	return self.cmd.New("git bisect start").StreamOutput().Run()
 }
 
+// RunCmdObj returns a command which has git check out commits and run the given
+// shell command against each of them until it has found the first new commit.
+// As with 'git bisect run', the command exiting with 125 skips the commit.
+func (self *BisectCommands) RunCmdObj(command string) oscommands.ICmdObj {
+	shellArgs := self.cmd.NewShell(command).GetCmd().Args
+
+	return self.cmd.NewFromArgs(append([]string{"git", "bisect", "run"}, shellArgs...)).StreamOutput()
+}
+
 // tells us whether we've found our problem commit(s). We return a string slice of
 // commit sha's if we're done, and that slice may have more that one item if
 // skipped commits are involved.
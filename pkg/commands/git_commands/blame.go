@@ -0,0 +1,74 @@
+package git_commands
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// matches the first line of each entry in the output of `git blame --porcelain`,
+// which is of the form '<sha> <original line> <final line> [<line count>]'
+var blameEntryRegexp = regexp.MustCompile(`^([0-9a-f]{40}) (\d+) (\d+)`)
+
+type BlameCommands struct {
+	*GitCommon
+}
+
+func NewBlameCommands(gitCommon *GitCommon) *BlameCommands {
+	return &BlameCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// GetCommitsForLines returns the shas of the commits which last changed the
+// given lines of the file as it is at HEAD, without duplicates
+func (self *BlameCommands) GetCommitsForLines(path string, lineNumbers []int) ([]string, error) {
+	if len(lineNumbers) == 0 {
+		return []string{}, nil
+	}
+
+	rangeArgs := lo.Map(lineRanges(lineNumbers), func(lineRange [2]int, _ int) string {
+		return fmt.Sprintf(" -L %d,%d", lineRange[0], lineRange[1])
+	})
+	cmdStr := fmt.Sprintf("git blame --porcelain%s HEAD -- %s", strings.Join(rangeArgs, ""), self.cmd.Quote(path))
+	output, err := self.cmd.New(cmdStr).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	shas := []string{}
+	for _, line := range utils.SplitLines(output) {
+		match := blameEntryRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		// the ranges we blame can include lines we weren't asked about
+		if lo.Contains(lineNumbers, utils.MustConvertToInt(match[3])) && !lo.Contains(shas, match[1]) {
+			shas = append(shas, match[1])
+		}
+	}
+
+	return shas, nil
+}
+
+// groups the given line numbers into ranges of consecutive lines
+func lineRanges(lineNumbers []int) [][2]int {
+	sorted := append([]int{}, lineNumbers...)
+	sort.Ints(sorted)
+
+	result := [][2]int{}
+	for _, lineNumber := range sorted {
+		if len(result) > 0 && lineNumber <= result[len(result)-1][1]+1 {
+			result[len(result)-1][1] = utils.Max(result[len(result)-1][1], lineNumber)
+		} else {
+			result = append(result, [2]int{lineNumber, lineNumber})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,108 @@
+package git_commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameLoader struct {
+	*common.Common
+	cmd oscommands.ICmdObjBuilder
+}
+
+func NewBlameLoader(common *common.Common, cmd oscommands.ICmdObjBuilder) *BlameLoader {
+	return &BlameLoader{
+		Common: common,
+		cmd:    cmd,
+	}
+}
+
+// GetBlameLines blames every line of the file as of the given ref, or as it is
+// in the working tree if the ref is empty
+func (self *BlameLoader) GetBlameLines(ref string, path string) ([]*models.BlameLine, error) {
+	refArg := ""
+	if ref != "" {
+		refArg = " " + self.cmd.Quote(ref)
+	}
+
+	output, err := self.cmd.New(fmt.Sprintf("git blame --porcelain%s -- %s", refArg, self.cmd.Quote(path))).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlameLines(output), nil
+}
+
+// what the porcelain format tells us about a commit, which it only does the
+// first time the commit comes up
+type blameCommit struct {
+	authorName    string
+	unixTimestamp int64
+	summary       string
+	previousSha   string
+	previousPath  string
+}
+
+// each line of the file comes as a header line, optionally followed by
+// information about its commit, followed by the line itself prefixed with a tab
+func parseBlameLines(output string) []*models.BlameLine {
+	commits := map[string]*blameCommit{}
+	blameLines := []*models.BlameLine{}
+
+	var current *models.BlameLine
+	var commit *blameCommit
+	for _, line := range utils.SplitLines(output) {
+		if strings.HasPrefix(line, "\t") {
+			if current == nil {
+				continue
+			}
+
+			current.AuthorName = commit.authorName
+			current.UnixTimestamp = commit.unixTimestamp
+			current.Summary = commit.summary
+			current.PreviousSha = commit.previousSha
+			current.PreviousPath = commit.previousPath
+			current.Content = line[1:]
+			blameLines = append(blameLines, current)
+			current = nil
+			continue
+		}
+
+		if match := blameEntryRegexp.FindStringSubmatch(line); match != nil {
+			sha := match[1]
+			if _, ok := commits[sha]; !ok {
+				commits[sha] = &blameCommit{}
+			}
+			commit = commits[sha]
+			current = &models.BlameLine{
+				Sha:                sha,
+				OriginalLineNumber: utils.MustConvertToInt(match[2]),
+				LineNumber:         utils.MustConvertToInt(match[3]),
+			}
+			continue
+		}
+
+		if commit == nil {
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "author":
+			commit.authorName = value
+		case "author-time":
+			commit.unixTimestamp = int64(utils.MustConvertToInt(value))
+		case "summary":
+			commit.summary = value
+		case "previous":
+			commit.previousSha, commit.previousPath, _ = strings.Cut(value, " ")
+		}
+	}
+
+	return blameLines
+}
@@ -0,0 +1,125 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// the second commit's information only comes with its first line, and the
+// first commit added the file so has no previous commit
+const blameLinesOutput = `2222222222222222222222222222222222222222 1 1 2
+author Jesse Duffield
+author-mail <jesse@example.com>
+author-time 1700000000
+author-tz +1100
+committer Jesse Duffield
+committer-mail <jesse@example.com>
+committer-time 1700000000
+committer-tz +1100
+summary refactor everything
+previous 1111111111111111111111111111111111111111 old name.go
+filename new name.go
+	package main
+2222222222222222222222222222222222222222 2 2
+	
+1111111111111111111111111111111111111111 2 3 1
+author Someone Else
+author-mail <someone@example.com>
+author-time 1600000000
+author-tz +0000
+committer Someone Else
+committer-mail <someone@example.com>
+committer-time 1600000000
+committer-tz +0000
+summary initial commit
+boundary
+filename old name.go
+	func main() {}
+2222222222222222222222222222222222222222 4 4 1
+filename new name.go
+	// the end
+`
+
+func TestGetBlameLines(t *testing.T) {
+	type scenario struct {
+		testName      string
+		ref           string
+		runner        *oscommands.FakeCmdObjRunner
+		expected      []*models.BlameLine
+		expectedError error
+	}
+
+	refactor := func(lineNumber int, content string) *models.BlameLine {
+		return &models.BlameLine{
+			Sha:                "2222222222222222222222222222222222222222",
+			AuthorName:         "Jesse Duffield",
+			UnixTimestamp:      1700000000,
+			Summary:            "refactor everything",
+			PreviousSha:        "1111111111111111111111111111111111111111",
+			PreviousPath:       "old name.go",
+			LineNumber:         lineNumber,
+			OriginalLineNumber: lineNumber,
+			Content:            content,
+		}
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "file at a commit",
+			ref:      "abc123",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git blame --porcelain "abc123" -- "new name.go"`, blameLinesOutput, nil),
+			expected: []*models.BlameLine{
+				refactor(1, "package main"),
+				refactor(2, ""),
+				{
+					Sha:                "1111111111111111111111111111111111111111",
+					AuthorName:         "Someone Else",
+					UnixTimestamp:      1600000000,
+					Summary:            "initial commit",
+					LineNumber:         3,
+					OriginalLineNumber: 2,
+					Content:            "func main() {}",
+				},
+				refactor(4, "// the end"),
+			},
+		},
+		{
+			testName: "file in the working tree",
+			ref:      "",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git blame --porcelain -- "new name.go"`, "", nil),
+			expected: []*models.BlameLine{},
+		},
+		{
+			testName: "error",
+			ref:      "",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git blame --porcelain -- "new name.go"`, "", errors.New("no such path")),
+			expected:      nil,
+			expectedError: errors.New("no such path"),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			loader := &BlameLoader{
+				Common: utils.NewDummyCommon(),
+				cmd:    oscommands.NewDummyCmdObjBuilder(s.runner),
+			}
+
+			blameLines, err := loader.GetBlameLines(s.ref, "new name.go")
+
+			assert.Equal(t, s.expected, blameLines)
+			assert.Equal(t, s.expectedError, err)
+
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+const blameOutput = `1111111111111111111111111111111111111111 3 3 2
+author Jesse
+filename file.txt
+	three
+1111111111111111111111111111111111111111 4 4
+	four
+2222222222222222222222222222222222222222 5 5 1
+author Jesse
+filename file.txt
+	five
+3333333333333333333333333333333333333333 9 9 1
+author Jesse
+filename file.txt
+	nine
+`
+
+func TestBlameGetCommitsForLines(t *testing.T) {
+	type scenario struct {
+		testName      string
+		lineNumbers   []int
+		runner        *oscommands.FakeCmdObjRunner
+		expected      []string
+		expectedError string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "no lines",
+			lineNumbers: []int{},
+			runner:      oscommands.NewFakeRunner(t),
+			expected:    []string{},
+		},
+		{
+			testName:    "lines in several ranges",
+			lineNumbers: []int{9, 3, 4, 5},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git blame --porcelain -L 3,5 -L 9,9 HEAD -- "file.txt"`, blameOutput, nil),
+			expected: []string{
+				"1111111111111111111111111111111111111111",
+				"2222222222222222222222222222222222222222",
+				"3333333333333333333333333333333333333333",
+			},
+		},
+		{
+			testName:    "lines which weren't asked about are ignored",
+			lineNumbers: []int{4, 9},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git blame --porcelain -L 4,4 -L 9,9 HEAD -- "file.txt"`, blameOutput, nil),
+			expected: []string{
+				"1111111111111111111111111111111111111111",
+				"3333333333333333333333333333333333333333",
+			},
+		},
+		{
+			testName:    "file not in HEAD",
+			lineNumbers: []int{1},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git blame --porcelain -L 1,1 HEAD -- "file.txt"`, "", errors.New("fatal: no such path 'file.txt' in HEAD")),
+			expectedError: "fatal: no such path 'file.txt' in HEAD",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBlameCommands(commonDeps{runner: s.runner})
+
+			result, err := instance.GetCommitsForLines("file.txt", s.lineNumbers)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, result)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
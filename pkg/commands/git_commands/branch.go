@@ -2,10 +2,12 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type BranchCommands struct {
@@ -70,8 +72,9 @@ func (self *BranchCommands) Delete(branch string, force bool) error {
 
 // Checkout checks out a branch (or commit), with --force if you set the force arg to true
 type CheckoutOptions struct {
-	Force   bool
-	EnvVars []string
+	Force                bool
+	IgnoreOtherWorktrees bool
+	EnvVars              []string
 }
 
 func (self *BranchCommands) Checkout(branch string, options CheckoutOptions) error {
@@ -79,6 +82,9 @@ func (self *BranchCommands) Checkout(branch string, options CheckoutOptions) err
 	if options.Force {
 		forceArg = " --force"
 	}
+	if options.IgnoreOtherWorktrees {
+		forceArg += " --ignore-other-worktrees"
+	}
 
 	return self.cmd.New(fmt.Sprintf("git checkout%s %s", forceArg, self.cmd.Quote(branch))).
 		// prevents git from prompting us for input which would freeze the program
@@ -143,16 +149,229 @@ func (self *BranchCommands) IsHeadDetached() bool {
 	return err != nil
 }
 
+// IsHeadOnAnyBranch tells us whether any local or remote branch contains HEAD.
+// If not, the commits made on a detached HEAD become hard to find once we move
+// away from it.
+func (self *BranchCommands) IsHeadOnAnyBranch() (bool, error) {
+	output, err := self.cmd.New(`git branch --all --contains HEAD --format="%(refname)"`).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	// a detached HEAD lists itself as e.g. '(HEAD detached from 1234567)'
+	return lo.SomeBy(utils.SplitLines(output), func(line string) bool {
+		return strings.HasPrefix(line, "refs/")
+	}), nil
+}
+
+// CreateAt creates a branch at the given ref without checking it out
+func (self *BranchCommands) CreateAt(name string, ref string) error {
+	return self.cmd.New(fmt.Sprintf("git branch %s %s", self.cmd.Quote(name), self.cmd.Quote(ref))).Run()
+}
+
+// UpdateRef moves the given branch to newSha, as long as it's still at oldSha,
+// so that we don't throw away commits that someone else has put on it since we
+// looked
+func (self *BranchCommands) UpdateRef(branchName string, newSha string, oldSha string) error {
+	return self.cmd.New(
+		fmt.Sprintf("git update-ref %s %s %s", self.cmd.Quote("refs/heads/"+branchName), newSha, oldSha),
+	).Run()
+}
+
 func (self *BranchCommands) Rename(oldName string, newName string) error {
 	return self.cmd.New(fmt.Sprintf("git branch --move %s %s", self.cmd.Quote(oldName), self.cmd.Quote(newName))).Run()
 }
 
 func (self *BranchCommands) GetRawBranches() (string, error) {
-	return self.cmd.New(`git for-each-ref --sort=-committerdate --format="%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(upstream:track)" refs/heads`).DontLog().RunWithOutput()
+	// older versions of git don't know which worktree a branch is checked out
+	// in, so we leave that field empty for them
+	worktreePathField := "%(worktreepath)"
+	if self.version.IsOlderThan(2, 23, 0) {
+		worktreePathField = ""
+	}
+
+	return self.cmd.New(fmt.Sprintf(`git for-each-ref --sort=-committerdate --format="%%(HEAD)%%00%%(refname:short)%%00%%(upstream:short)%%00%%(upstream:track)%%00%s" refs/heads`, worktreePathField)).DontLog().RunWithOutput()
+}
+
+// GetPushedMainBranchesContaining returns those of the remote branches named in
+// the git.mainBranches config which contain the given commit, e.g. 'origin/main'
+func (self *BranchCommands) GetPushedMainBranchesContaining(sha string) ([]string, error) {
+	output, err := self.cmd.New(fmt.Sprintf(`git for-each-ref --contains %s --format="%%(refname:lstrip=2)" refs/remotes`, sha)).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(utils.SplitLines(output), func(remoteBranch string, _ int) bool {
+		_, branchName, found := strings.Cut(remoteBranch, "/")
+		return found && lo.Contains(self.UserConfig.Git.MainBranches, branchName)
+	}), nil
+}
+
+// GetRemoteHeadBranch returns the name of the branch that the remote's HEAD
+// points to, e.g. 'main' for origin/HEAD -> origin/main. This is set when
+// cloning, and is typically the branch that pull requests go into.
+func (self *BranchCommands) GetRemoteHeadBranch(remoteName string) (string, error) {
+	output, err := self.cmd.New("git symbolic-ref --short " + self.cmd.Quote("refs/remotes/"+remoteName+"/HEAD")).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(strings.TrimSpace(output), remoteName+"/"), nil
+}
+
+// GetCommitsNotOnMainBranches returns the shas of the commits in HEAD's history
+// which aren't on any of the branches named in the git.mainBranches config. If
+// we're on one of those branches, only the commits which haven't been pushed to
+// its upstream are returned.
+func (self *BranchCommands) GetCommitsNotOnMainBranches(currentBranchName string) ([]string, error) {
+	refs := []string{}
+	for _, branchName := range self.UserConfig.Git.MainBranches {
+		ref := branchName
+		if branchName == currentBranchName {
+			ref = branchName + "@{u}"
+		}
+		if err := self.cmd.New("git rev-parse --verify --quiet " + self.cmd.Quote(ref)).DontLog().Run(); err == nil {
+			refs = append(refs, self.cmd.Quote(ref))
+		}
+	}
+
+	cmdStr := "git rev-list HEAD"
+	if len(refs) > 0 {
+		cmdStr += " --not " + strings.Join(refs, " ")
+	}
+	output, err := self.cmd.New(cmdStr).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// Divergence describes how far a branch has moved away from its base branch
+type Divergence struct {
+	BaseBranch string
+	// the number of commits on the branch that aren't on the base branch
+	Ahead int
+	// the number of commits on the base branch that aren't on the branch
+	Behind int
+}
+
+// GetDivergence counts the commits on either side of baseBranch...branchName
+func (self *BranchCommands) GetDivergence(baseBranch string, branchName string) (*Divergence, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git rev-list --left-right --count %s", self.cmd.Quote(baseBranch+"..."+branchName)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := strings.Fields(output)
+	if len(counts) != 2 {
+		return nil, fmt.Errorf("unexpected output from git rev-list: %s", output)
+	}
+	behind, err := strconv.Atoi(counts[0])
+	if err != nil {
+		return nil, err
+	}
+	ahead, err := strconv.Atoi(counts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Divergence{BaseBranch: baseBranch, Ahead: ahead, Behind: behind}, nil
+}
+
+// GetDivergenceFromBaseBranch works out how far the branch has diverged from
+// its base branch. If no base branch has been set by hand, we use whichever of
+// the branches named in the git.mainBranches config the branch is the fewest
+// commits ahead of. Returns nil if there is nothing to compare against, e.g.
+// because the branch is itself a main branch.
+func (self *BranchCommands) GetDivergenceFromBaseBranch(branchName string, baseBranchOverride string) (*Divergence, error) {
+	if baseBranchOverride != "" {
+		return self.GetDivergence(baseBranchOverride, branchName)
+	}
+
+	if lo.Contains(self.UserConfig.Git.MainBranches, branchName) {
+		return nil, nil
+	}
+
+	var nearest *Divergence
+	for _, mainBranch := range self.UserConfig.Git.MainBranches {
+		// main branches that don't exist in this repo are skipped
+		divergence, err := self.GetDivergence(mainBranch, branchName)
+		if err != nil {
+			continue
+		}
+		if nearest == nil || divergence.Ahead < nearest.Ahead {
+			nearest = divergence
+		}
+	}
+
+	return nearest, nil
+}
+
+// GetRefShas returns the sha that each local and remote branch points to, keyed
+// by its short name, e.g. 'main' or 'origin/main'
+func (self *BranchCommands) GetRefShas() (map[string]string, error) {
+	output, err := self.cmd.New(`git for-each-ref --format="%(refname:short) %(objectname)" refs/heads refs/remotes`).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, line := range utils.SplitLines(output) {
+		name, sha, found := strings.Cut(line, " ")
+		if !found {
+			continue
+		}
+		result[name] = sha
+	}
+
+	return result, nil
+}
+
+// GetBaseBranchOverrides returns the base branches that have been set by hand,
+// keyed by the name of the branch they were set for
+func (self *BranchCommands) GetBaseBranchOverrides() (map[string]string, error) {
+	result := map[string]string{}
+
+	// git exits with status 1 when no keys match, so we can't tell that apart
+	// from a real failure and just treat it as there being no overrides
+	output, err := self.cmd.New(`git config --get-regexp "^branch\..*\.lazygitbasebranch$"`).DontLog().RunWithOutput()
+	if err != nil {
+		return result, nil
+	}
+
+	for _, line := range utils.SplitLines(output) {
+		key, value, found := strings.Cut(line, " ")
+		if !found {
+			continue
+		}
+		// git lowercases the variable name but not the subsection, so the
+		// branch name keeps its case
+		branchName := strings.TrimSuffix(strings.TrimPrefix(key, "branch."), ".lazygitbasebranch")
+		result[branchName] = value
+	}
+
+	return result, nil
+}
+
+// SetBaseBranch sets the branch that the given branch's divergence is counted
+// against. Passing an empty base branch goes back to detecting it automatically.
+func (self *BranchCommands) SetBaseBranch(branchName string, baseBranch string) error {
+	key := self.cmd.Quote("branch." + branchName + ".lazygitBaseBranch")
+	if baseBranch == "" {
+		return self.cmd.New(fmt.Sprintf("git config --unset %s", key)).Run()
+	}
+
+	return self.cmd.New(fmt.Sprintf("git config %s %s", key, self.cmd.Quote(baseBranch))).Run()
 }
 
 type MergeOpts struct {
 	FastForwardOnly bool
+	NoFastForward   bool
+	// squashing leaves the merged changes staged rather than committing them
+	Squash bool
 }
 
 func (self *BranchCommands) Merge(branchName string, opts MergeOpts) error {
@@ -165,6 +384,12 @@ func (self *BranchCommands) Merge(branchName string, opts MergeOpts) error {
 	if opts.FastForwardOnly {
 		command = fmt.Sprintf("%s --ff-only", command)
 	}
+	if opts.NoFastForward {
+		command = fmt.Sprintf("%s --no-ff", command)
+	}
+	if opts.Squash {
+		command = fmt.Sprintf("%s --squash", command)
+	}
 
 	return self.cmd.New(command).Run()
 }
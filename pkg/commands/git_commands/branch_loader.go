@@ -128,8 +128,8 @@ func (self *BranchLoader) obtainBranches() []*models.Branch {
 		}
 
 		split := strings.Split(line, "\x00")
-		if len(split) != 4 {
-			// Ignore line if it isn't separated into 4 parts
+		if len(split) != 5 {
+			// Ignore line if it isn't separated into 5 parts
 			// This is probably a warning message, for more info see:
 			// https://github.com/jesseduffield/lazygit/issues/1385#issuecomment-885580439
 			return nil, false
@@ -150,6 +150,10 @@ func obtainBranch(split []string) *models.Branch {
 		Head:      split[0] == "*",
 	}
 
+	if !branch.Head {
+		branch.WorktreePath = split[4]
+	}
+
 	upstreamName := split[2]
 	if upstreamName == "" {
 		// if we're here then it means we do not have a local version of the remote.
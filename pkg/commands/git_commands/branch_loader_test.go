@@ -18,29 +18,39 @@ func TestObtainBanch(t *testing.T) {
 	scenarios := []scenario{
 		{
 			testName:       "TrimHeads",
-			input:          []string{"", "heads/a_branch", "", ""},
+			input:          []string{"", "heads/a_branch", "", "", ""},
 			expectedBranch: &models.Branch{Name: "a_branch", Pushables: "?", Pullables: "?", Head: false},
 		},
 		{
 			testName:       "NoUpstream",
-			input:          []string{"", "a_branch", "", ""},
+			input:          []string{"", "a_branch", "", "", ""},
 			expectedBranch: &models.Branch{Name: "a_branch", Pushables: "?", Pullables: "?", Head: false},
 		},
 		{
 			testName:       "IsHead",
-			input:          []string{"*", "a_branch", "", ""},
+			input:          []string{"*", "a_branch", "", "", ""},
 			expectedBranch: &models.Branch{Name: "a_branch", Pushables: "?", Pullables: "?", Head: true},
 		},
 		{
 			testName:       "IsBehindAndAhead",
-			input:          []string{"", "a_branch", "a_remote/a_branch", "[behind 2, ahead 3]"},
+			input:          []string{"", "a_branch", "a_remote/a_branch", "[behind 2, ahead 3]", ""},
 			expectedBranch: &models.Branch{Name: "a_branch", Pushables: "3", Pullables: "2", Head: false},
 		},
 		{
 			testName:       "RemoteBranchIsGone",
-			input:          []string{"", "a_branch", "a_remote/a_branch", "[gone]"},
+			input:          []string{"", "a_branch", "a_remote/a_branch", "[gone]", ""},
 			expectedBranch: &models.Branch{Name: "a_branch", UpstreamGone: true, Pushables: "?", Pullables: "?", Head: false},
 		},
+		{
+			testName:       "CheckedOutInOtherWorktree",
+			input:          []string{"", "a_branch", "", "", "/path/to/worktree"},
+			expectedBranch: &models.Branch{Name: "a_branch", Pushables: "?", Pullables: "?", Head: false, WorktreePath: "/path/to/worktree"},
+		},
+		{
+			testName:       "CheckedOutInThisWorktree",
+			input:          []string{"*", "a_branch", "", "", "/path/to/repo"},
+			expectedBranch: &models.Branch{Name: "a_branch", Pushables: "?", Pullables: "?", Head: true},
+		},
 	}
 
 	for _, s := range scenarios {
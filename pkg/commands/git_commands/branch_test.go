@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -99,12 +100,46 @@ func TestBranchDeleteBranch(t *testing.T) {
 }
 
 func TestBranchMerge(t *testing.T) {
-	runner := oscommands.NewFakeRunner(t).
-		Expect(`git merge --no-edit "test"`, "", nil)
-	instance := buildBranchCommands(commonDeps{runner: runner})
+	type scenario struct {
+		testName string
+		opts     MergeOpts
+		expected string
+	}
 
-	assert.NoError(t, instance.Merge("test", MergeOpts{}))
-	runner.CheckForMissingCalls()
+	scenarios := []scenario{
+		{
+			testName: "basic",
+			opts:     MergeOpts{},
+			expected: `git merge --no-edit "test"`,
+		},
+		{
+			testName: "fast-forward only",
+			opts:     MergeOpts{FastForwardOnly: true},
+			expected: `git merge --no-edit "test" --ff-only`,
+		},
+		{
+			testName: "no fast-forward",
+			opts:     MergeOpts{NoFastForward: true},
+			expected: `git merge --no-edit "test" --no-ff`,
+		},
+		{
+			testName: "squash",
+			opts:     MergeOpts{Squash: true},
+			expected: `git merge --no-edit "test" --squash`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				Expect(s.expected, "", nil)
+			instance := buildBranchCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Merge("test", s.opts))
+			runner.CheckForMissingCalls()
+		})
+	}
 }
 
 func TestBranchCheckout(t *testing.T) {
@@ -144,6 +179,224 @@ func TestBranchCheckout(t *testing.T) {
 	}
 }
 
+func TestBranchCheckoutIgnoringOtherWorktrees(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git checkout --ignore-other-worktrees "test"`, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Checkout("test", CheckoutOptions{IgnoreOtherWorktrees: true}))
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetRawBranches(t *testing.T) {
+	scenarios := []struct {
+		testName    string
+		gitVersion  *GitVersion
+		expectedCmd string
+	}{
+		{
+			testName:    "with worktree paths",
+			gitVersion:  &GitVersion{2, 23, 0, ""},
+			expectedCmd: `git for-each-ref --sort=-committerdate --format="%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(upstream:track)%00%(worktreepath)" refs/heads`,
+		},
+		{
+			testName:    "git too old for worktree paths",
+			gitVersion:  &GitVersion{2, 22, 0, ""},
+			expectedCmd: `git for-each-ref --sort=-committerdate --format="%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(upstream:track)%00" refs/heads`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).Expect(s.expectedCmd, "", nil)
+			instance := buildBranchCommands(commonDeps{runner: runner, gitVersion: s.gitVersion})
+
+			_, err := instance.GetRawBranches()
+			assert.NoError(t, err)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchGetPushedMainBranchesContaining(t *testing.T) {
+	type scenario struct {
+		testName     string
+		mainBranches []string
+		output       string
+		expected     []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "main branches of several remotes",
+			mainBranches: []string{"master", "main"},
+			output:       "origin/HEAD\norigin/main\norigin/feature\nupstream/master\n",
+			expected:     []string{"origin/main", "upstream/master"},
+		},
+		{
+			testName:     "branch whose name contains a slash",
+			mainBranches: []string{"release/1.0"},
+			output:       "origin/release/1.0\norigin/release/2.0\n",
+			expected:     []string{"origin/release/1.0"},
+		},
+		{
+			testName:     "not pushed to any main branch",
+			mainBranches: []string{"master", "main"},
+			output:       "origin/feature\n",
+			expected:     []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.MainBranches = s.mainBranches
+			runner := oscommands.NewFakeRunner(t).
+				Expect(`git for-each-ref --contains 1234567 --format="%(refname:lstrip=2)" refs/remotes`, s.output, nil)
+			instance := buildBranchCommands(commonDeps{runner: runner, userConfig: userConfig})
+
+			result, err := instance.GetPushedMainBranchesContaining("1234567")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchIsHeadOnAnyBranch(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "detached at a commit of a remote branch",
+			output:   "(HEAD detached at 1234567)\nrefs/remotes/origin/master\n",
+			expected: true,
+		},
+		{
+			testName: "detached with commits on top",
+			output:   "(HEAD detached from 1234567)\n",
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				Expect(`git branch --all --contains HEAD --format="%(refname)"`, s.output, nil)
+			instance := buildBranchCommands(commonDeps{runner: runner})
+
+			result, err := instance.IsHeadOnAnyBranch()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchGetRemoteHeadBranch(t *testing.T) {
+	type scenario struct {
+		testName       string
+		output         string
+		err            error
+		expected       string
+		expectingError bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "remote HEAD is set",
+			output:   "origin/main\n",
+			expected: "main",
+		},
+		{
+			testName: "remote HEAD points to a branch with slashes",
+			output:   "origin/release/2.0\n",
+			expected: "release/2.0",
+		},
+		{
+			testName:       "remote HEAD is not set",
+			err:            errors.New("fatal: ref refs/remotes/origin/HEAD is not a symbolic ref"),
+			expectingError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				Expect(`git symbolic-ref --short "refs/remotes/origin/HEAD"`, s.output, s.err)
+			instance := buildBranchCommands(commonDeps{runner: runner})
+
+			result, err := instance.GetRemoteHeadBranch("origin")
+			if s.expectingError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, result)
+			}
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchGetCommitsNotOnMainBranches(t *testing.T) {
+	type scenario struct {
+		testName          string
+		currentBranchName string
+		runner            *oscommands.FakeCmdObjRunner
+		expected          []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:          "on a feature branch",
+			currentBranchName: "feature",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify --quiet "master"`, "", errors.New("error")).
+				Expect(`git rev-parse --verify --quiet "main"`, "1234567\n", nil).
+				Expect(`git rev-list HEAD --not "main"`, "aaa\nbbb\n", nil),
+			expected: []string{"aaa", "bbb"},
+		},
+		{
+			testName:          "on a main branch",
+			currentBranchName: "master",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify --quiet "master@{u}"`, "1234567\n", nil).
+				Expect(`git rev-parse --verify --quiet "main"`, "", errors.New("error")).
+				Expect(`git rev-list HEAD --not "master@{u}"`, "aaa\n", nil),
+			expected: []string{"aaa"},
+		},
+		{
+			testName:          "no main branches",
+			currentBranchName: "feature",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify --quiet "master"`, "", errors.New("error")).
+				Expect(`git rev-parse --verify --quiet "main"`, "", errors.New("error")).
+				Expect(`git rev-list HEAD`, "aaa\nbbb\nccc\n", nil),
+			expected: []string{"aaa", "bbb", "ccc"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner})
+
+			result, err := instance.GetCommitsNotOnMainBranches(s.currentBranchName)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestBranchGetBranchGraph(t *testing.T) {
 	runner := oscommands.NewFakeRunner(t).ExpectGitArgs([]string{
 		"log", "--graph", "--color=always", "--abbrev-commit", "--decorate", "--date=relative", "--pretty=medium", "test", "--",
@@ -231,3 +484,100 @@ func TestBranchCurrentBranchInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchGetDivergenceFromBaseBranch(t *testing.T) {
+	type scenario struct {
+		testName           string
+		branchName         string
+		baseBranchOverride string
+		runner             *oscommands.FakeCmdObjRunner
+		expected           *Divergence
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "picks the main branch the branch is fewest commits ahead of",
+			branchName: "feature",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-list --left-right --count "master...feature"`, "4\t5\n", nil).
+				Expect(`git rev-list --left-right --count "main...feature"`, "1\t2\n", nil),
+			expected: &Divergence{BaseBranch: "main", Ahead: 2, Behind: 1},
+		},
+		{
+			testName:   "skips main branches that don't exist",
+			branchName: "feature",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-list --left-right --count "master...feature"`, "", errors.New("error")).
+				Expect(`git rev-list --left-right --count "main...feature"`, "0\t3\n", nil),
+			expected: &Divergence{BaseBranch: "main", Ahead: 3, Behind: 0},
+		},
+		{
+			testName:   "no main branches exist",
+			branchName: "feature",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-list --left-right --count "master...feature"`, "", errors.New("error")).
+				Expect(`git rev-list --left-right --count "main...feature"`, "", errors.New("error")),
+			expected: nil,
+		},
+		{
+			testName:   "branch is a main branch",
+			branchName: "main",
+			runner:     oscommands.NewFakeRunner(t),
+			expected:   nil,
+		},
+		{
+			testName:           "base branch set by hand",
+			branchName:         "main",
+			baseBranchOverride: "develop",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-list --left-right --count "develop...main"`, "7\t0\n", nil),
+			expected: &Divergence{BaseBranch: "develop", Ahead: 0, Behind: 7},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner, userConfig: config.GetDefaultConfig()})
+			result, err := instance.GetDivergenceFromBaseBranch(s.branchName, s.baseBranchOverride)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchGetRefShas(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git for-each-ref --format="%(refname:short) %(objectname)" refs/heads refs/remotes`,
+			"feature 1234567\nmain abcdef0\norigin/main 7654321\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	result, err := instance.GetRefShas()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"feature": "1234567", "main": "abcdef0", "origin/main": "7654321"}, result)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetBaseBranchOverrides(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git config --get-regexp "^branch\..*\.lazygitbasebranch$"`,
+			"branch.feature.lazygitbasebranch develop\nbranch.Fix/Thing.lazygitbasebranch release/1.0\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	result, err := instance.GetBaseBranchOverrides()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"feature": "develop", "Fix/Thing": "release/1.0"}, result)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchSetBaseBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git config "branch.feature.lazygitBaseBranch" "develop"`, "", nil).
+		Expect(`git config --unset "branch.feature.lazygitBaseBranch"`, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetBaseBranch("feature", "develop"))
+	assert.NoError(t, instance.SetBaseBranch("feature", ""))
+	runner.CheckForMissingCalls()
+}
@@ -2,10 +2,16 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type CommitCommands struct {
@@ -60,6 +66,12 @@ func (self *CommitCommands) CommitCmdObj(message string) oscommands.ICmdObj {
 	return self.cmd.New(fmt.Sprintf("git commit%s%s%s", noVerifyFlag, self.signoffFlag(), lineArgs))
 }
 
+// CommitReusingMessage commits the index using the message and authorship of
+// the given commit
+func (self *CommitCommands) CommitReusingMessage(sha string) error {
+	return self.cmd.New(fmt.Sprintf("git commit --allow-empty --reuse-message=%s", sha)).Run()
+}
+
 // runs git commit without the -m argument meaning it will invoke the user's editor
 func (self *CommitCommands) CommitEditorCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(fmt.Sprintf("git commit%s%s", self.signoffFlag(), self.verboseFlag()))
@@ -124,6 +136,46 @@ func (self *CommitCommands) GetCommitAuthor(commitSha string) (Author, error) {
 	return author, err
 }
 
+// GetAuthors returns the 'Name <email>' of the author of each of the last 1000
+// commits reachable from HEAD, newest first, given that going through the whole
+// history of a big repo takes a while. Authors with several commits appear
+// several times.
+func (self *CommitCommands) GetAuthors() ([]string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git log -n 1000 --format=%s", self.cmd.Quote("%an <%ae>")),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// GetSubjectsToMerge returns the subjects of the commits that merging the given
+// ref would bring into HEAD, oldest first.
+func (self *CommitCommands) GetSubjectsToMerge(refName string) ([]string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git log --reverse --format=%%s %s", self.cmd.Quote("HEAD.."+refName)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// GetMergeBase returns the sha of the best common ancestor of the two given refs
+func (self *CommitCommands) GetMergeBase(refName string, otherRefName string) (string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git merge-base %s %s", self.cmd.Quote(refName), self.cmd.Quote(otherRefName)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
 func (self *CommitCommands) GetCommitMessageFirstLine(sha string) (string, error) {
 	return self.GetCommitMessagesFirstLine([]string{sha})
 }
@@ -149,12 +201,9 @@ func (self *CommitCommands) AmendHeadCmdObj() oscommands.ICmdObj {
 	return self.cmd.New("git commit --amend --no-edit --allow-empty")
 }
 
-func (self *CommitCommands) ShowCmdObj(sha string, filterPath string, ignoreWhitespace bool) oscommands.ICmdObj {
+func (self *CommitCommands) ShowCmdObj(sha string, filterPaths []string, ignoreWhitespace bool) oscommands.ICmdObj {
 	contextSize := self.UserConfig.Git.DiffContextSize
-	filterPathArg := ""
-	if filterPath != "" {
-		filterPathArg = fmt.Sprintf(" -- %s", self.cmd.Quote(filterPath))
-	}
+	filterPathArg := filterPathArgs(self.cmd, filterPaths, false)
 	ignoreWhitespaceArg := ""
 	if ignoreWhitespace {
 		ignoreWhitespaceArg = " --ignore-all-space"
@@ -165,16 +214,269 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string, ignoreWhit
 	return self.cmd.New(cmdStr).DontLog()
 }
 
+// GetFileHistoryPaths follows the given file back through renames from the
+// given ref and returns what it was called in each of the commits that touched
+// it, keyed by sha
+func (self *CommitCommands) GetFileHistoryPaths(refName string, path string) (map[string]string, error) {
+	entries, err := self.getFileHistory(refName, path)
+	if err != nil {
+		return nil, err
+	}
+
+	pathsBySha := map[string]string{}
+	for _, entry := range entries {
+		if entry.path != "" {
+			pathsBySha[entry.sha] = entry.path
+		}
+	}
+
+	return pathsBySha, nil
+}
+
+// GetFilePathInCommit follows the given file back through renames from the
+// given ref and returns what it was called in the given commit. If the commit
+// doesn't list the file (e.g. because it's a merge) we use the name it had in
+// the closest older commit that does.
+func (self *CommitCommands) GetFilePathInCommit(refName string, path string, sha string) (string, error) {
+	entries, err := self.getFileHistory(refName, path)
+	if err != nil {
+		return "", err
+	}
+
+	result := path
+	found := false
+	for _, entry := range entries {
+		found = found || entry.sha == sha
+		if entry.path != "" {
+			result = entry.path
+			if found {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+type fileHistoryEntry struct {
+	sha string
+	// empty if the commit doesn't list the file, e.g. because it's a merge
+	path string
+}
+
+// getFileHistory returns the commits in the file's history, newest first
+func (self *CommitCommands) getFileHistory(refName string, path string) ([]fileHistoryEntry, error) {
+	output, err := self.cmd.
+		New(fmt.Sprintf("git log --follow --name-only --format=%%x00%%H %s -- %s", self.cmd.Quote(refName), self.cmd.Quote(path))).
+		DontLog().
+		RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []fileHistoryEntry{}
+	for _, line := range utils.SplitLines(output) {
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "\x00") {
+			entries = append(entries, fileHistoryEntry{sha: strings.TrimPrefix(line, "\x00")})
+		} else if len(entries) > 0 && entries[len(entries)-1].path == "" {
+			entries[len(entries)-1].path = line
+		}
+	}
+
+	return entries, nil
+}
+
 // Revert reverts the selected commit by sha
 func (self *CommitCommands) Revert(sha string) error {
 	return self.cmd.New(fmt.Sprintf("git revert %s", sha)).Run()
 }
 
+// CherryPickOntoBranch cherry-picks the given commits, oldest first, onto the
+// given branch without checking it out, returning the sha the branch was at and
+// the sha of the new tip. We do the cherry-pick on a detached HEAD in a
+// temporary worktree and leave the branch itself alone, so that the caller can
+// move it to the new tip once it's sure it wants to.
+func (self *CommitCommands) CherryPickOntoBranch(branchName string, shas []string) (string, string, error) {
+	tempDir, err := os.MkdirTemp("", "lazygit-worktree")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.RemoveAll(tempDir)
+
+	worktreePath := filepath.Join(tempDir, "worktree")
+	if err := self.cmd.New(
+		fmt.Sprintf("git worktree add --detach %s %s", self.cmd.Quote(worktreePath), self.cmd.Quote(branchName)),
+	).Run(); err != nil {
+		return "", "", err
+	}
+	defer func() {
+		_ = self.cmd.New(fmt.Sprintf("git worktree remove --force %s", self.cmd.Quote(worktreePath))).Run()
+	}()
+
+	// we pass these explicitly rather than using -C because they take
+	// precedence over GIT_DIR and GIT_WORK_TREE, which may point at the main
+	// worktree
+	inWorktree := fmt.Sprintf(
+		"git --git-dir=%s --work-tree=%s",
+		self.cmd.Quote(filepath.Join(worktreePath, ".git")),
+		self.cmd.Quote(worktreePath),
+	)
+
+	oldSha, err := self.cmd.New(
+		fmt.Sprintf("%s rev-parse HEAD", inWorktree),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := self.cmd.New(
+		fmt.Sprintf("%s cherry-pick %s", inWorktree, strings.Join(shas, " ")),
+	).Run(); err != nil {
+		// so that we don't leave a cherry-pick in progress behind if removing
+		// the worktree fails
+		_ = self.cmd.New(fmt.Sprintf("%s cherry-pick --abort", inWorktree)).Run()
+		return "", "", err
+	}
+
+	newSha, err := self.cmd.New(
+		fmt.Sprintf("%s rev-parse HEAD", inWorktree),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(oldSha), strings.TrimSpace(newSha), nil
+}
+
 func (self *CommitCommands) RevertMerge(sha string, parentNumber int) error {
 	return self.cmd.New(fmt.Sprintf("git revert %s -m %d", sha, parentNumber)).Run()
 }
 
+// IsInFirstParentHistory tells us whether the given commit is reachable from
+// HEAD by following first parents only, i.e. whether it was committed on the
+// current branch rather than brought in by a merge. The commit must have a
+// parent.
+func (self *CommitCommands) IsInFirstParentHistory(sha string) (bool, error) {
+	output, err := self.cmd.New(fmt.Sprintf("git rev-list --first-parent HEAD ^%s^", sha)).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	return lo.Contains(utils.SplitLines(output), sha), nil
+}
+
+// GetSignatureStatuses returns the signature status of each of the given
+// commits, keyed by sha. The statuses are the letters git uses for %G?, e.g. 'G'
+// for a good signature and 'N' for no signature.
+func (self *CommitCommands) GetSignatureStatuses(shas []string) (map[string]string, error) {
+	result := map[string]string{}
+	if len(shas) == 0 {
+		return result, nil
+	}
+
+	output, err := self.cmd.New(
+		fmt.Sprintf("git log --no-walk --format=%s %s", self.cmd.Quote("%H %G?"), strings.Join(shas, " ")),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range utils.SplitLines(output) {
+		if sha, status, ok := strings.Cut(line, " "); ok {
+			result[sha] = status
+		}
+	}
+
+	return result, nil
+}
+
+// DiffStats is what git's --shortstat tells us about a commit
+type DiffStats struct {
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+}
+
+var (
+	filesChangedRegex = regexp.MustCompile(`(\d+) files? changed`)
+	insertionsRegex   = regexp.MustCompile(`(\d+) insertions?\(\+\)`)
+	deletionsRegex    = regexp.MustCompile(`(\d+) deletions?\(-\)`)
+)
+
+// GetDiffStats returns the diff stats of each of the given commits, keyed by
+// sha. Commits which don't change anything (e.g. empty commits) are left out.
+func (self *CommitCommands) GetDiffStats(shas []string) (map[string]*DiffStats, error) {
+	result := map[string]*DiffStats{}
+	if len(shas) == 0 {
+		return result, nil
+	}
+
+	output, err := self.cmd.New(
+		fmt.Sprintf("git log --no-walk --format=%%H --shortstat %s", strings.Join(shas, " ")),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// the output has a line with each commit's sha, followed by a blank line
+	// and an indented stat line if the commit changed anything
+	sha := ""
+	for _, line := range utils.SplitLines(output) {
+		if line == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(line, " ") {
+			sha = line
+			continue
+		}
+
+		result[sha] = &DiffStats{
+			FilesChanged: parseStatCount(filesChangedRegex, line),
+			Insertions:   parseStatCount(insertionsRegex, line),
+			Deletions:    parseStatCount(deletionsRegex, line),
+		}
+	}
+
+	return result, nil
+}
+
+func parseStatCount(regex *regexp.Regexp, line string) int {
+	match := regex.FindStringSubmatch(line)
+	if match == nil {
+		return 0
+	}
+
+	count, _ := strconv.Atoi(match[1])
+	return count
+}
+
+// GetLastCommitForPath returns the sha of the most recent commit reachable from
+// HEAD which changed the given path, or an empty string if there isn't one.
+func (self *CommitCommands) GetLastCommitForPath(path string) (string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git log -1 --format=%%H -- %s", self.cmd.Quote(path)),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+func (self *CommitCommands) VerifyCommitCmdObj(sha string) oscommands.ICmdObj {
+	return self.cmd.New(fmt.Sprintf("git verify-commit %s", sha)).DontLog()
+}
+
 // CreateFixupCommit creates a commit that fixes up a previous commit
 func (self *CommitCommands) CreateFixupCommit(sha string) error {
-	return self.cmd.New(fmt.Sprintf("git commit --fixup=%s", sha)).Run()
+	return self.CreateFixupCommitCmdObj(sha).Run()
+}
+
+func (self *CommitCommands) CreateFixupCommitCmdObj(sha string) oscommands.ICmdObj {
+	return self.cmd.New(fmt.Sprintf("git commit --fixup=%s", sha))
 }
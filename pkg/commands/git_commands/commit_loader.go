@@ -15,6 +15,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // context:
@@ -56,11 +57,25 @@ func NewCommitLoader(
 
 type GetCommitsOptions struct {
 	Limit                bool
-	FilterPath           string
+	FilterPaths          []string
+	FilterAuthor         string
 	IncludeRebaseCommits bool
 	RefName              string // e.g. "HEAD" or "my_branch"
 	// determines if we show the whole git graph i.e. pass the '--all' flag
 	All bool
+	// only shows the commits that added or removed this text, or if
+	// PickaxeIsRegex is set, whose added or removed lines match it
+	Pickaxe        string
+	PickaxeIsRegex bool
+	// loading lots of commits can take a while, so closing this kills the log
+	// command if it's still running
+	Stop chan struct{}
+	// if set, OnChunk is given all the commits loaded so far each time another
+	// ChunkSize of them have come in, so that a long list can be shown before
+	// it's finished loading. A ChunkSize of 0 means we only return them at the
+	// end
+	ChunkSize int
+	OnChunk   func(commits []*models.Commit)
 }
 
 // GetCommits obtains the commits of the current branch
@@ -68,7 +83,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	commits := []*models.Commit{}
 	var rebasingCommits []*models.Commit
 
-	if opts.IncludeRebaseCommits && opts.FilterPath == "" {
+	if opts.IncludeRebaseCommits && len(opts.FilterPaths) == 0 && opts.FilterAuthor == "" && opts.Pickaxe == "" {
 		var err error
 		rebasingCommits, err = self.MergeRebasingCommits(commits)
 		if err != nil {
@@ -84,13 +99,50 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		passedFirstPushedCommit = true
 	}
 
-	err = self.getLogCmd(opts).RunAndProcessLines(func(line string) (bool, error) {
+	logCmdObj := self.getLogCmd(opts)
+	if opts.Stop != nil {
+		done := make(chan struct{})
+		defer close(done)
+		go utils.Safe(func() {
+			select {
+			case <-opts.Stop:
+				_ = oscommands.Kill(logCmdObj.GetCmd())
+			case <-done:
+			}
+		})
+	}
+
+	// we only look up the merge base once there are commits to mark as merged
+	var ancestor *string
+	passedAncestor := false
+	markedCount := 0
+	markMergedCommits := func() error {
+		if ancestor == nil {
+			mergeBase, err := self.getMergeBase(opts.RefName)
+			if err != nil {
+				return err
+			}
+			ancestor = &mergeBase
+		}
+		passedAncestor = setCommitMergedStatuses(*ancestor, passedAncestor, commits[markedCount:])
+		markedCount = len(commits)
+		return nil
+	}
+
+	err = logCmdObj.RunAndProcessLines(func(line string) (bool, error) {
 		commit := self.extractCommitFromLine(line)
 		if commit.Sha == firstPushedCommit {
 			passedFirstPushedCommit = true
 		}
 		commit.Status = map[bool]string{true: "unpushed", false: "pushed"}[!passedFirstPushedCommit]
 		commits = append(commits, commit)
+
+		if opts.OnChunk != nil && opts.ChunkSize > 0 && (len(commits)-markedCount) >= opts.ChunkSize {
+			if err := markMergedCommits(); err != nil {
+				return false, err
+			}
+			opts.OnChunk(commits)
+		}
 		return false, nil
 	})
 	if err != nil {
@@ -101,8 +153,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		return commits, nil
 	}
 
-	commits, err = self.setCommitMergedStatuses(opts.RefName, commits)
-	if err != nil {
+	if err := markMergedCommits(); err != nil {
 		return nil, err
 	}
 
@@ -194,9 +245,13 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 		return nil, nil
 	}
 
-	commitShas := slices.Map(commits, func(commit *models.Commit) string {
-		return commit.Sha
+	// todos like 'exec' lines aren't commits, so there's nothing to hydrate
+	commitShas := slices.FilterMap(commits, func(commit *models.Commit) (string, bool) {
+		return commit.Sha, !commit.IsTODOCommand()
 	})
+	if len(commitShas) == 0 {
+		return commits, nil
+	}
 
 	// note that we're not filtering these as we do non-rebasing commits just because
 	// I suspect that will cause some damage
@@ -212,6 +267,10 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 	hydratedCommits := make([]*models.Commit, 0, len(commits))
 	i := 0
 	err = cmdObj.RunAndProcessLines(func(line string) (bool, error) {
+		for commits[i].IsTODOCommand() {
+			hydratedCommits = append(hydratedCommits, commits[i])
+			i++
+		}
 		commit := self.extractCommitFromLine(line)
 		matchingCommit := commits[i]
 		commit.Action = matchingCommit.Action
@@ -223,7 +282,7 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 	if err != nil {
 		return nil, err
 	}
-	return hydratedCommits, nil
+	return append(hydratedCommits, commits[i:]...), nil
 }
 
 // getRebasingCommits obtains the commits that we're in the process of rebasing
@@ -305,13 +364,14 @@ func (self *CommitLoader) getInteractiveRebasingCommits() ([]*models.Commit, err
 	}
 
 	for _, t := range todos {
-		if t.Commit == "" {
-			// Command does not have a commit associated, skip
+		if t.Command == todo.Comment || t.Command == todo.NoOp {
 			continue
 		}
+		// every other line gets an entry, so that the indexes of our commits
+		// line up with the lines of the todo file when we go to edit it
 		commits = slices.Prepend(commits, &models.Commit{
 			Sha:    t.Commit,
-			Name:   t.Msg,
+			Name:   todoDisplayName(t),
 			Status: "rebasing",
 			Action: t.Command.String(),
 		})
@@ -320,6 +380,24 @@ func (self *CommitLoader) getInteractiveRebasingCommits() ([]*models.Commit, err
 	return commits, nil
 }
 
+// todoDisplayName returns the text we show for a todo in place of a commit
+// message
+func todoDisplayName(t todo.Todo) string {
+	switch t.Command {
+	case todo.Exec:
+		return t.ExecCommand
+	case todo.Label, todo.Reset:
+		return t.Label
+	case todo.Merge:
+		if t.Msg != "" {
+			return t.Label + ": " + t.Msg
+		}
+		return t.Label
+	default:
+		return t.Msg
+	}
+}
+
 // assuming the file starts like this:
 // From e93d4193e6dd45ca9cf3a5a273d7ba6cd8b8fb20 Mon Sep 17 00:00:00 2001
 // From: Lazygit Tester <test@example.com>
@@ -336,15 +414,13 @@ func (self *CommitLoader) commitFromPatch(content string) *models.Commit {
 	}
 }
 
-func (self *CommitLoader) setCommitMergedStatuses(refName string, commits []*models.Commit) ([]*models.Commit, error) {
-	ancestor, err := self.getMergeBase(refName)
-	if err != nil {
-		return nil, err
-	}
+// setCommitMergedStatuses marks the pushed commits from the merge base onwards
+// as merged. The commits may come in several chunks, so we're told whether an
+// earlier chunk had the merge base, and return whether we've passed it now.
+func setCommitMergedStatuses(ancestor string, passedAncestor bool, commits []*models.Commit) bool {
 	if ancestor == "" {
-		return commits, nil
+		return false
 	}
-	passedAncestor := false
 	for i, commit := range commits {
 		if strings.HasPrefix(ancestor, commit.Sha) {
 			passedAncestor = true
@@ -356,7 +432,7 @@ func (self *CommitLoader) setCommitMergedStatuses(refName string, commits []*mod
 			commits[i].Status = "merged"
 		}
 	}
-	return commits, nil
+	return passedAncestor
 }
 
 func (self *CommitLoader) getMergeBase(refName string) (string, error) {
@@ -410,9 +486,20 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		limitFlag = " -300"
 	}
 
-	filterFlag := ""
-	if opts.FilterPath != "" {
-		filterFlag = fmt.Sprintf(" --follow -- %s", self.cmd.Quote(opts.FilterPath))
+	filterFlag := filterPathArgs(self.cmd, opts.FilterPaths, true)
+
+	authorFlag := ""
+	if opts.FilterAuthor != "" {
+		authorFlag = " --author=" + self.cmd.Quote(opts.FilterAuthor)
+	}
+
+	pickaxeFlag := ""
+	if opts.Pickaxe != "" {
+		if opts.PickaxeIsRegex {
+			pickaxeFlag = " -G" + self.cmd.Quote(opts.Pickaxe)
+		} else {
+			pickaxeFlag = " -S" + self.cmd.Quote(opts.Pickaxe)
+		}
 	}
 
 	config := self.UserConfig.Git.Log
@@ -424,17 +511,24 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 	allFlag := ""
 	if opts.All {
 		allFlag = " --all"
+		// without an explicit order, commits from different branches get
+		// interleaved by date, which makes the graph hard to follow
+		if orderFlag == "" {
+			orderFlag = " --topo-order"
+		}
 	}
 
 	return self.cmd.New(
 		fmt.Sprintf(
-			"git -c log.showSignature=false log %s%s%s --oneline %s%s --abbrev=%d%s",
+			"git -c log.showSignature=false log %s%s%s --oneline %s%s --abbrev=%d%s%s%s",
 			self.cmd.Quote(opts.RefName),
 			orderFlag,
 			allFlag,
 			prettyFormat,
 			limitFlag,
 			40,
+			authorFlag,
+			pickaxeFlag,
 			filterFlag,
 		),
 	).DontLog()
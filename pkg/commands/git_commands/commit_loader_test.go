@@ -1,6 +1,8 @@
 package git_commands
 
 import (
+	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -203,6 +206,71 @@ func TestGetCommits(t *testing.T) {
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
 		},
+		{
+			testName:          "should use topo order for all branches if `log.order` is `default`",
+			logOrder:          "default",
+			rebaseMode:        enums.REBASE_MODE_NONE,
+			currentBranchName: "master",
+			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false, All: true, Limit: true},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order --all --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" -300 --abbrev=40`, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
+		{
+			testName:          "should not follow renames when filtering by several paths",
+			logOrder:          "topo-order",
+			rebaseMode:        enums.REBASE_MODE_NONE,
+			currentBranchName: "master",
+			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: true, FilterPaths: []string{"pkg/commands/**", "docs/"}},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40 -- ":(glob)pkg/commands/**" "docs/"`, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
+		{
+			testName:          "should search the contents of commits for text",
+			logOrder:          "topo-order",
+			rebaseMode:        enums.REBASE_MODE_NONE,
+			currentBranchName: "master",
+			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false, Pickaxe: "func main", Limit: true},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" -300 --abbrev=40 -S"func main"`, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
+		{
+			testName:          "should search the contents of commits for a regex within a path",
+			logOrder:          "topo-order",
+			rebaseMode:        enums.REBASE_MODE_NONE,
+			currentBranchName: "master",
+			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: false, Pickaxe: "TODO.*", PickaxeIsRegex: true, FilterPaths: []string{"main.go"}},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40 -G"TODO.*" --follow -- "main.go"`, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
+		{
+			testName:          "should filter by author along with a path",
+			logOrder:          "topo-order",
+			rebaseMode:        enums.REBASE_MODE_NONE,
+			currentBranchName: "master",
+			opts:              GetCommitsOptions{RefName: "HEAD", IncludeRebaseCommits: true, FilterAuthor: "Jesse Duffield", FilterPaths: []string{"main.go"}},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				Expect(`git -c log.showSignature=false log "HEAD" --topo-order --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40 --author="Jesse Duffield" --follow -- "main.go"`, "", nil),
+
+			expectedCommits: []*models.Commit{},
+			expectedError:   nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -236,3 +304,120 @@ func TestGetCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCommitsInChunks(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git merge-base "HEAD" "HEAD"@{u}`, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+		Expect(`git -c log.showSignature=false log "HEAD" --topo-order --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40`, commitsOutput, nil).
+		Expect(`git merge-base "HEAD" "master"`, "26c07b1ab33860a1a7591a0638f9925ccf497ffa", nil)
+
+	common := utils.NewDummyCommon()
+	common.UserConfig.Git.Log.Order = "topo-order"
+
+	builder := &CommitLoader{
+		Common: common,
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+		getCurrentBranchInfo: func() (BranchInfo, error) {
+			return BranchInfo{RefName: "master", DisplayName: "master", DetachedHead: false}, nil
+		},
+		getRebaseMode: func() (enums.RebaseMode, error) { return enums.REBASE_MODE_NONE, nil },
+		dotGitDir:     ".git",
+	}
+
+	// the statuses of the commits as of each chunk
+	chunks := [][]string{}
+	commits, err := builder.GetCommits(GetCommitsOptions{
+		RefName:   "HEAD",
+		ChunkSize: 3,
+		OnChunk: func(commits []*models.Commit) {
+			chunks = append(chunks, lo.Map(commits, func(commit *models.Commit, _ int) string {
+				return commit.Status
+			}))
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"unpushed", "pushed", "pushed"},
+		{"unpushed", "pushed", "pushed", "pushed", "pushed", "merged"},
+	}, chunks)
+	assert.Equal(t,
+		[]string{"unpushed", "pushed", "pushed", "pushed", "pushed", "merged", "merged", "merged"},
+		lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Status }),
+	)
+
+	runner.CheckForMissingCalls()
+}
+
+func BenchmarkGetCommitsInChunks(b *testing.B) {
+	lines := make([]string, 0, 100000)
+	for i := 0; i < 100000; i++ {
+		lines = append(lines, strings.Join([]string{
+			fmt.Sprintf("%040x", i), "1640826609", "Jesse Duffield", "jessedduffield@gmail.com", "", fmt.Sprintf("%040x", i+1), fmt.Sprintf("commit %d", i),
+		}, "\x00"))
+	}
+	logOutput := strings.Join(lines, "\n")
+
+	common := utils.NewDummyCommon()
+	common.UserConfig.Git.Log.Order = "topo-order"
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runner := oscommands.NewFakeRunner(b).
+			Expect(`git merge-base "HEAD" "HEAD"@{u}`, "", errors.New("no upstream")).
+			Expect(`git -c log.showSignature=false log "HEAD" --topo-order --oneline --pretty=format:"%H%x00%at%x00%aN%x00%ae%x00%d%x00%p%x00%s" --abbrev=40`, logOutput, nil).
+			Expect(`git merge-base "HEAD" "master"`, "", nil)
+
+		builder := &CommitLoader{
+			Common: common,
+			cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+			getCurrentBranchInfo: func() (BranchInfo, error) {
+				return BranchInfo{RefName: "master", DisplayName: "master", DetachedHead: false}, nil
+			},
+			getRebaseMode: func() (enums.RebaseMode, error) { return enums.REBASE_MODE_NONE, nil },
+			dotGitDir:     ".git",
+		}
+
+		_, err := builder.GetCommits(GetCommitsOptions{
+			RefName:   "HEAD",
+			ChunkSize: 1000,
+			OnChunk:   func(commits []*models.Commit) {},
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestGetInteractiveRebasingCommits(t *testing.T) {
+	todo := `pick 1fc8da0e5bd4e09c2a35b7bf8ba79ddc2aad1cd1 first
+exec make test
+label onto
+reset onto
+merge -C 3d4470a6c072208722e5ae9a54bcb9634959a1c5 feature # Merge branch 'feature'
+break
+# a comment
+drop 0eea75e8c631fba6b58135697835d58ba4c18dbc second
+`
+
+	builder := &CommitLoader{
+		Common:    utils.NewDummyCommon(),
+		dotGitDir: ".git",
+		readFile: func(filename string) ([]byte, error) {
+			return []byte(todo), nil
+		},
+	}
+
+	commits, err := builder.getInteractiveRebasingCommits()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Commit{
+		{Sha: "0eea75e8c631fba6b58135697835d58ba4c18dbc", Name: "second", Status: "rebasing", Action: "drop"},
+		{Sha: "", Name: "", Status: "rebasing", Action: "break"},
+		{Sha: "3d4470a6c072208722e5ae9a54bcb9634959a1c5", Name: "feature: Merge branch 'feature'", Status: "rebasing", Action: "merge"},
+		{Sha: "", Name: "onto", Status: "rebasing", Action: "reset"},
+		{Sha: "", Name: "onto", Status: "rebasing", Action: "label"},
+		{Sha: "", Name: "make test", Status: "rebasing", Action: "exec"},
+		{Sha: "1fc8da0e5bd4e09c2a35b7bf8ba79ddc2aad1cd1", Name: "first", Status: "rebasing", Action: "pick"},
+	}, commits)
+}
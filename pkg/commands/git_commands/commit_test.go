@@ -1,8 +1,10 @@
 package git_commands
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +29,16 @@ func TestCommitResetToCommit(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestCommitCommitReusingMessage(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"commit", "--allow-empty", "--reuse-message=78976bc"}, "", nil)
+
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.CommitReusingMessage("78976bc"))
+	runner.CheckForMissingCalls()
+}
+
 func TestCommitCommitCmdObj(t *testing.T) {
 	type scenario struct {
 		testName             string
@@ -175,10 +187,272 @@ func TestCommitCreateFixupCommit(t *testing.T) {
 	}
 }
 
+func TestCommitIsInFirstParentHistory(t *testing.T) {
+	type scenario struct {
+		testName string
+		sha      string
+		runner   *oscommands.FakeCmdObjRunner
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "commit is on the current branch",
+			sha:      "abc",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-list --first-parent HEAD ^abc^`, "def\nabc\n", nil),
+			expected: true,
+		},
+		{
+			testName: "commit was merged in from another branch",
+			sha:      "abc",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-list --first-parent HEAD ^abc^`, "def\n", nil),
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			result, err := instance.IsInFirstParentHistory(s.sha)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetSignatureStatuses(t *testing.T) {
+	type scenario struct {
+		testName string
+		shas     []string
+		runner   *oscommands.FakeCmdObjRunner
+		expected map[string]string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no commits",
+			shas:     []string{},
+			runner:   oscommands.NewFakeRunner(t),
+			expected: map[string]string{},
+		},
+		{
+			testName: "several commits",
+			shas:     []string{"abc", "def", "ghi"},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git log --no-walk --format="%H %G?" abc def ghi`, "def G\nabc N\nghi B\n", nil),
+			expected: map[string]string{"abc": "N", "def": "G", "ghi": "B"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			result, err := instance.GetSignatureStatuses(s.shas)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetDiffStats(t *testing.T) {
+	type scenario struct {
+		testName string
+		shas     []string
+		runner   *oscommands.FakeCmdObjRunner
+		expected map[string]*DiffStats
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no commits",
+			shas:     []string{},
+			runner:   oscommands.NewFakeRunner(t),
+			expected: map[string]*DiffStats{},
+		},
+		{
+			testName: "several commits",
+			shas:     []string{"abc", "def", "ghi", "jkl"},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(
+					`git log --no-walk --format=%H --shortstat abc def ghi jkl`,
+					"def\n\n 3 files changed, 10 insertions(+), 2 deletions(-)\nabc\n\n 1 file changed, 1 insertion(+)\nghi\njkl\n\n 1 file changed, 4 deletions(-)\n",
+					nil,
+				),
+			expected: map[string]*DiffStats{
+				"abc": {FilesChanged: 1, Insertions: 1, Deletions: 0},
+				"def": {FilesChanged: 3, Insertions: 10, Deletions: 2},
+				"jkl": {FilesChanged: 1, Insertions: 0, Deletions: 4},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			result, err := instance.GetDiffStats(s.shas)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetAuthors(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git log -n 1000 --format="%an <%ae>"`, "Jane <jane@example.com>\nJohn <john@example.com>\nJane <jane@example.com>\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	authors, err := instance.GetAuthors()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Jane <jane@example.com>", "John <john@example.com>", "Jane <jane@example.com>"}, authors)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitCherryPickOntoBranch(t *testing.T) {
+	type scenario struct {
+		testName         string
+		cherryPickErr    error
+		expectedCommands []string
+		expectedOldSha   string
+		expectedNewSha   string
+		expectedErr      string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "cherry-pick succeeds",
+			cherryPickErr: nil,
+			expectedCommands: []string{
+				`^git worktree add --detach ".+worktree" "feature"$`,
+				`^git --git-dir=".+worktree/.git" --work-tree=".+worktree" rev-parse HEAD$`,
+				`^git --git-dir=".+worktree/.git" --work-tree=".+worktree" cherry-pick 111 222$`,
+				`^git --git-dir=".+worktree/.git" --work-tree=".+worktree" rev-parse HEAD$`,
+				`^git worktree remove --force ".+worktree"$`,
+			},
+			expectedOldSha: "old",
+			expectedNewSha: "new",
+			expectedErr:    "",
+		},
+		{
+			testName:      "cherry-pick fails",
+			cherryPickErr: errors.New("conflict"),
+			expectedCommands: []string{
+				`^git worktree add --detach ".+worktree" "feature"$`,
+				`^git --git-dir=".+worktree/.git" --work-tree=".+worktree" rev-parse HEAD$`,
+				`^git --git-dir=".+worktree/.git" --work-tree=".+worktree" cherry-pick 111 222$`,
+				`^git --git-dir=".+worktree/.git" --work-tree=".+worktree" cherry-pick --abort$`,
+				`^git worktree remove --force ".+worktree"$`,
+			},
+			expectedErr: "conflict",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t)
+			revParseOutputs := []string{"old\n", "new\n"}
+			for _, expectedCommand := range s.expectedCommands {
+				expectedCommand := expectedCommand
+				runner.ExpectFunc(func(cmdObj oscommands.ICmdObj) (string, error) {
+					cmdStr := cmdObj.ToString()
+					assert.Regexp(t, expectedCommand, cmdStr)
+					if strings.HasSuffix(cmdStr, " cherry-pick 111 222") {
+						return "", s.cherryPickErr
+					}
+					if strings.Contains(cmdStr, "rev-parse") {
+						output := revParseOutputs[0]
+						revParseOutputs = revParseOutputs[1:]
+						return output, nil
+					}
+					return "", nil
+				})
+			}
+			instance := buildCommitCommands(commonDeps{runner: runner})
+
+			oldSha, newSha, err := instance.CherryPickOntoBranch("feature", []string{"111", "222"})
+			if s.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedOldSha, oldSha)
+				assert.Equal(t, s.expectedNewSha, newSha)
+			} else {
+				assert.EqualError(t, err, s.expectedErr)
+			}
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetSubjectsToMerge(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git log --reverse --format=%s "HEAD..feature"`, "one\ntwo\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	subjects, err := instance.GetSubjectsToMerge("feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, subjects)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitGetMergeBase(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git merge-base "abc123" "def456"`, "0123456789abcdef\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	mergeBase, err := instance.GetMergeBase("abc123", "def456")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", mergeBase)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitGetLastCommitForPath(t *testing.T) {
+	type scenario struct {
+		testName string
+		path     string
+		runner   *oscommands.FakeCmdObjRunner
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "path changed by a commit",
+			path:     "dir/file.txt",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git log -1 --format=%H -- "dir/file.txt"`, "abc123\n", nil),
+			expected: "abc123",
+		},
+		{
+			testName: "path never committed",
+			path:     "new.txt",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git log -1 --format=%H -- "new.txt"`, "", nil),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			result, err := instance.GetLastCommitForPath(s.path)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestCommitShowCmdObj(t *testing.T) {
 	type scenario struct {
 		testName         string
-		filterPath       string
+		filterPaths      []string
 		contextSize      int
 		ignoreWhitespace bool
 		expected         string
@@ -187,21 +461,28 @@ func TestCommitShowCmdObj(t *testing.T) {
 	scenarios := []scenario{
 		{
 			testName:         "Default case without filter path",
-			filterPath:       "",
+			filterPaths:      nil,
 			contextSize:      3,
 			ignoreWhitespace: false,
 			expected:         "git show --submodule --color=always --unified=3 --no-renames --stat -p 1234567890",
 		},
 		{
 			testName:         "Default case with filter path",
-			filterPath:       "file.txt",
+			filterPaths:      []string{"file.txt"},
 			contextSize:      3,
 			ignoreWhitespace: true,
 			expected:         `git show --submodule --color=always --unified=3 --no-renames --stat -p 1234567890 --ignore-all-space -- "file.txt"`,
 		},
+		{
+			testName:         "Default case with filter paths and glob patterns",
+			filterPaths:      []string{"docs/", "*.go", "pkg/commands/**"},
+			contextSize:      3,
+			ignoreWhitespace: false,
+			expected:         `git show --submodule --color=always --unified=3 --no-renames --stat -p 1234567890 -- "docs/" "*.go" ":(glob)pkg/commands/**"`,
+		},
 		{
 			testName:         "Show diff with custom context size",
-			filterPath:       "",
+			filterPaths:      nil,
 			contextSize:      77,
 			ignoreWhitespace: false,
 			expected:         "git show --submodule --color=always --unified=77 --no-renames --stat -p 1234567890",
@@ -216,7 +497,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig})
 
-			cmdStr := instance.ShowCmdObj("1234567890", s.filterPath, s.ignoreWhitespace).ToString()
+			cmdStr := instance.ShowCmdObj("1234567890", s.filterPaths, s.ignoreWhitespace).ToString()
 			assert.Equal(t, s.expected, cmdStr)
 		})
 	}
@@ -267,3 +548,62 @@ Merge pull request #1750 from mark2185/fix-issue-template
 		})
 	}
 }
+
+func TestCommitGetFileHistoryPaths(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git log --follow --name-only --format=%x00%H "refs/heads/master" -- "new/name.txt"`,
+			"\x00"+"abc123\n\nnew/name.txt\n\x00"+"def456\n\x00"+"ghi789\n\nold-name.txt\n",
+			nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	paths, err := instance.GetFileHistoryPaths("refs/heads/master", "new/name.txt")
+	assert.NoError(t, err)
+	// def456 is a merge commit, which doesn't list any files
+	assert.EqualValues(t, map[string]string{
+		"abc123": "new/name.txt",
+		"ghi789": "old-name.txt",
+	}, paths)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitGetFilePathInCommit(t *testing.T) {
+	type scenario struct {
+		testName string
+		sha      string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "commit touching the file",
+			sha:      "ghi789",
+			expected: "old-name.txt",
+		},
+		{
+			testName: "merge commit uses the name from the closest older commit",
+			sha:      "def456",
+			expected: "old-name.txt",
+		},
+		{
+			testName: "commit not in the history",
+			sha:      "zzz000",
+			expected: "older-name.txt",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				Expect(`git log --follow --name-only --format=%x00%H "refs/heads/master" -- "new/name.txt"`,
+					"\x00"+"abc123\n\nnew/name.txt\n\x00"+"def456\n\x00"+"ghi789\n\nold-name.txt\n\x00"+"jkl012\n\nolder-name.txt\n",
+					nil)
+			instance := buildCommitCommands(commonDeps{runner: runner})
+
+			path, err := instance.GetFilePathInCommit("refs/heads/master", "new/name.txt", s.sha)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, path)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
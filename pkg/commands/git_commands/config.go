@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type ConfigCommands struct {
@@ -72,11 +74,41 @@ func (self *ConfigCommands) GetCoreEditor() string {
 	return self.gitConfig.Get("core.editor")
 }
 
+// GetCommitTemplatePath returns the path of the commit message template set
+// with commit.template, or an empty string if there isn't one
+func (self *ConfigCommands) GetCommitTemplatePath() string {
+	return self.gitConfig.Get("commit.template")
+}
+
+// GetCommentChar returns what lines in a commit message start with to be left
+// out of the commit. With core.commentChar set to 'auto' git picks a character
+// that the message doesn't start any lines with, but as our messages don't start
+// off with anything in them that comes down to the default.
+func (self *ConfigCommands) GetCommentChar() string {
+	commentChar := self.gitConfig.Get("core.commentChar")
+	if commentChar == "" || commentChar == "auto" {
+		return "#"
+	}
+
+	return commentChar
+}
+
+// GetMergeToolKeepBackup tells us whether mergetool backup files should be
+// kept. As in git, this defaults to true when mergetool.keepBackup is unset
+func (self *ConfigCommands) GetMergeToolKeepBackup() bool {
+	return self.gitConfig.Get("mergetool.keepBackup") == "" || self.gitConfig.GetBool("mergetool.keepBackup")
+}
+
 // GetRemoteURL returns current repo remote url
 func (self *ConfigCommands) GetRemoteURL() string {
 	return self.gitConfig.Get("remote.origin.url")
 }
 
+// GetUserEmail returns the email that commits are authored with
+func (self *ConfigCommands) GetUserEmail() string {
+	return self.gitConfig.Get("user.email")
+}
+
 func (self *ConfigCommands) GetShowUntrackedFiles() string {
 	return self.gitConfig.Get("status.showUntrackedFiles")
 }
@@ -86,6 +118,73 @@ func (self *ConfigCommands) GetPushToCurrent() bool {
 	return self.gitConfig.Get("push.default") == "current"
 }
 
+// GetPushRemote returns the remote that git pushes the given branch to when no
+// remote is specified, going by branch.<name>.pushRemote and then
+// remote.pushDefault. It returns an empty string if neither is configured, in
+// which case git pushes to the branch's upstream remote
+func (self *ConfigCommands) GetPushRemote(branchName string) string {
+	pushRemote := self.gitConfig.Get(fmt.Sprintf("branch.%s.pushRemote", branchName))
+	if pushRemote == "" {
+		pushRemote = self.gitConfig.Get("remote.pushDefault")
+	}
+
+	return pushRemote
+}
+
+// HasPushUrl tells us whether the remote has a push URL of its own, i.e. it's
+// been set up specifically for pushing to
+func (self *ConfigCommands) HasPushUrl(remoteName string) bool {
+	return self.gitConfig.Get(fmt.Sprintf("remote.%s.pushurl", remoteName)) != ""
+}
+
+// GetPullRebase tells us whether pulling into the given branch rebases rather
+// than merges, going by branch.<name>.rebase and then pull.rebase
+func (self *ConfigCommands) GetPullRebase(branchName string) bool {
+	value := self.gitConfig.Get(fmt.Sprintf("branch.%s.rebase", branchName))
+	if value == "" {
+		value = self.gitConfig.Get("pull.rebase")
+	}
+
+	// besides true, pull.rebase can be 'merges' or 'interactive'
+	return !lo.Contains([]string{"", "false", "no", "off", "0"}, strings.ToLower(value))
+}
+
+// GetPullFastForwardOnly tells us whether pulling refuses to do anything other
+// than fast-forward
+func (self *ConfigCommands) GetPullFastForwardOnly() bool {
+	return self.gitConfig.Get("pull.ff") == "only"
+}
+
+// GetFsMonitor tells us whether git status asks a filesystem monitor which
+// files have changed rather than looking at every file. core.fsmonitor is
+// either a boolean for git's built-in monitor or the path of a hook (e.g. for
+// watchman)
+func (self *ConfigCommands) GetFsMonitor() bool {
+	value := self.gitConfig.Get("core.fsmonitor")
+	return !lo.Contains([]string{"", "false", "no", "off", "0"}, strings.ToLower(value))
+}
+
+// GetFsMonitorHook returns the hook core.fsmonitor is set to, or "" if it's
+// set to a boolean or not at all
+func (self *ConfigCommands) GetFsMonitorHook() string {
+	value := self.gitConfig.Get("core.fsmonitor")
+	if lo.Contains([]string{"", "false", "no", "off", "0", "true", "yes", "on", "1"}, strings.ToLower(value)) {
+		return ""
+	}
+	return value
+}
+
+// GetUntrackedCache tells us whether git caches which directories have
+// untracked files in them, so that git status doesn't have to look at the
+// directories that haven't changed
+func (self *ConfigCommands) GetUntrackedCache() bool {
+	return self.gitConfig.GetBool("core.untrackedCache")
+}
+
+func (self *ConfigCommands) DropCache() {
+	self.gitConfig.DropCache()
+}
+
 // returns the repo's branches as specified in the git config
 func (self *ConfigCommands) Branches() (map[string]*config.Branch, error) {
 	conf, err := self.repo.Config()
@@ -0,0 +1,165 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigGetPullRebase(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		gitConfig map[string]string
+		expected  bool
+	}{
+		{
+			testName:  "not configured",
+			gitConfig: map[string]string{},
+			expected:  false,
+		},
+		{
+			testName:  "pull.rebase is true",
+			gitConfig: map[string]string{"pull.rebase": "true"},
+			expected:  true,
+		},
+		{
+			testName:  "pull.rebase is merges",
+			gitConfig: map[string]string{"pull.rebase": "merges"},
+			expected:  true,
+		},
+		{
+			testName:  "pull.rebase is false",
+			gitConfig: map[string]string{"pull.rebase": "false"},
+			expected:  false,
+		},
+		{
+			testName:  "the branch's config wins",
+			gitConfig: map[string]string{"pull.rebase": "true", "branch.mybranch.rebase": "false"},
+			expected:  false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildGitCommon(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)}).config
+			assert.Equal(t, s.expected, instance.GetPullRebase("mybranch"))
+		})
+	}
+}
+
+func TestConfigGetPushRemote(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		gitConfig map[string]string
+		expected  string
+	}{
+		{
+			testName:  "not configured",
+			gitConfig: map[string]string{},
+			expected:  "",
+		},
+		{
+			testName:  "remote.pushDefault is set",
+			gitConfig: map[string]string{"remote.pushDefault": "fork"},
+			expected:  "fork",
+		},
+		{
+			testName:  "the branch's config wins",
+			gitConfig: map[string]string{"remote.pushDefault": "fork", "branch.mybranch.pushRemote": "backup"},
+			expected:  "backup",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildGitCommon(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)}).config
+			assert.Equal(t, s.expected, instance.GetPushRemote("mybranch"))
+		})
+	}
+}
+
+func TestConfigHasPushUrl(t *testing.T) {
+	instance := buildGitCommon(commonDeps{gitConfig: git_config.NewFakeGitConfig(map[string]string{
+		"remote.mirror.pushurl": "git@example.com:me/mirror.git",
+	})}).config
+
+	assert.True(t, instance.HasPushUrl("mirror"))
+	assert.False(t, instance.HasPushUrl("origin"))
+}
+
+func TestConfigGetFsMonitor(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		gitConfig map[string]string
+		expected  bool
+	}{
+		{
+			testName:  "not configured",
+			gitConfig: map[string]string{},
+			expected:  false,
+		},
+		{
+			testName:  "built-in monitor",
+			gitConfig: map[string]string{"core.fsmonitor": "true"},
+			expected:  true,
+		},
+		{
+			testName:  "hook",
+			gitConfig: map[string]string{"core.fsmonitor": ".git/hooks/query-watchman"},
+			expected:  true,
+		},
+		{
+			testName:  "turned off",
+			gitConfig: map[string]string{"core.fsmonitor": "false"},
+			expected:  false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildGitCommon(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)}).config
+			assert.Equal(t, s.expected, instance.GetFsMonitor())
+		})
+	}
+}
+
+func TestConfigGetFsMonitorHook(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		gitConfig map[string]string
+		expected  string
+	}{
+		{
+			testName:  "not configured",
+			gitConfig: map[string]string{},
+			expected:  "",
+		},
+		{
+			testName:  "built-in monitor",
+			gitConfig: map[string]string{"core.fsmonitor": "true"},
+			expected:  "",
+		},
+		{
+			testName:  "hook",
+			gitConfig: map[string]string{"core.fsmonitor": ".git/hooks/query-watchman"},
+			expected:  ".git/hooks/query-watchman",
+		},
+		{
+			testName:  "turned off",
+			gitConfig: map[string]string{"core.fsmonitor": "false"},
+			expected:  "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildGitCommon(commonDeps{gitConfig: git_config.NewFakeGitConfig(s.gitConfig)}).config
+			assert.Equal(t, s.expected, instance.GetFsMonitorHook())
+		})
+	}
+}
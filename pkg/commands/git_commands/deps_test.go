@@ -95,7 +95,7 @@ func buildRepo() *gogit.Repository {
 }
 
 func buildFileLoader(gitCommon *GitCommon) *FileLoader {
-	return NewFileLoader(gitCommon.Common, gitCommon.cmd, gitCommon.config)
+	return NewFileLoader(gitCommon.Common, gitCommon.cmd, gitCommon.config, gitCommon.dotGitDir)
 }
 
 func buildSubmoduleCommands(deps commonDeps) *SubmoduleCommands {
@@ -117,6 +117,21 @@ func buildWorkingTreeCommands(deps commonDeps) *WorkingTreeCommands {
 	return NewWorkingTreeCommands(gitCommon, submoduleCommands, fileLoader)
 }
 
+func buildRemoteCommands(deps commonDeps) *RemoteCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewRemoteCommands(gitCommon)
+}
+
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewTagCommands(gitCommon)
+}
+
+func buildWorktreeCommands(deps commonDeps) *WorktreeCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewWorktreeCommands(gitCommon)
+}
+
 func buildStashCommands(deps commonDeps) *StashCommands {
 	gitCommon := buildGitCommon(deps)
 	fileLoader := buildFileLoader(gitCommon)
@@ -145,8 +160,20 @@ func buildFileCommands(deps commonDeps) *FileCommands {
 	return NewFileCommands(gitCommon)
 }
 
+func buildBlameCommands(deps commonDeps) *BlameCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewBlameCommands(gitCommon)
+}
+
 func buildBranchCommands(deps commonDeps) *BranchCommands {
 	gitCommon := buildGitCommon(deps)
 
 	return NewBranchCommands(gitCommon)
 }
+
+func buildStatusCommands(deps commonDeps) *StatusCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewStatusCommands(gitCommon)
+}
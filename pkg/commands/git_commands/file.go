@@ -72,3 +72,56 @@ func (self *FileCommands) GetEditCmdStr(filename string, lineNumber int) (string
 	}
 	return utils.ResolvePlaceholderString(editCmdTemplate, templateValues), nil
 }
+
+type DifftoolCmdOptions struct {
+	// From and To are the refs to compare. When From is empty we compare the
+	// working tree against the index (or the index against HEAD if Staged is set)
+	From string
+	To   string
+	// Staged compares the index against HEAD. Ignored if From is set
+	Staged bool
+	// Path limits the diff to the given file or directory. Empty means everything
+	Path string
+	// IsDirectory opens all changed files at once via `git difftool --dir-diff`
+	IsDirectory bool
+}
+
+// GetDifftoolCmdStr returns the command for viewing a diff in an external
+// difftool, using the user's difftoolCommand template if they've set one.
+func (self *FileCommands) GetDifftoolCmdStr(opts DifftoolCmdOptions) string {
+	rangeArg := ""
+	if opts.From != "" {
+		rangeArg = opts.From + ".." + opts.To
+	} else if opts.Staged {
+		rangeArg = "--cached"
+	}
+
+	filename := ""
+	if opts.Path != "" {
+		filename = self.cmd.Quote(opts.Path)
+	}
+
+	difftoolCmdTemplate := self.UserConfig.OS.DifftoolCommand
+	if difftoolCmdTemplate == "" {
+		difftoolCmdTemplate = "git difftool --no-prompt"
+		if opts.IsDirectory {
+			difftoolCmdTemplate = "git difftool --dir-diff"
+		}
+		if rangeArg != "" {
+			difftoolCmdTemplate += " {{range}}"
+		}
+		difftoolCmdTemplate += " --"
+		if filename != "" {
+			difftoolCmdTemplate += " {{filename}}"
+		}
+	}
+
+	templateValues := map[string]string{
+		"range":    rangeArg,
+		"from":     opts.From,
+		"to":       opts.To,
+		"filename": filename,
+	}
+
+	return utils.ResolvePlaceholderString(difftoolCmdTemplate, templateValues)
+}
@@ -2,11 +2,16 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
 )
 
 type FileLoaderConfig interface {
@@ -18,19 +23,30 @@ type FileLoader struct {
 	cmd         oscommands.ICmdObjBuilder
 	config      FileLoaderConfig
 	getFileType func(string) string
+	dotGitDir   string
+
+	// the flagged files as of when the index was last modified at
+	// flaggedFilesIndexModTime
+	flaggedFiles             []*models.FlaggedFile
+	flaggedFilesIndexModTime time.Time
+	flaggedFilesMutex        deadlock.Mutex
 }
 
-func NewFileLoader(cmn *common.Common, cmd oscommands.ICmdObjBuilder, config FileLoaderConfig) *FileLoader {
+func NewFileLoader(cmn *common.Common, cmd oscommands.ICmdObjBuilder, config FileLoaderConfig, dotGitDir string) *FileLoader {
 	return &FileLoader{
 		Common:      cmn,
 		cmd:         cmd,
 		getFileType: oscommands.FileType,
 		config:      config,
+		dotGitDir:   dotGitDir,
 	}
 }
 
 type GetStatusFileOptions struct {
 	NoRenames bool
+	// the files from the last time we loaded them. Those whose status hasn't
+	// changed are copied as they are, rather than looking at them on disk again
+	PreviousFiles []*models.File
 }
 
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
@@ -47,10 +63,13 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		self.Log.Error(err)
 	}
 	files := []*models.File{}
+	previousFilesByName := lo.KeyBy(opts.PreviousFiles, func(file *models.File) string { return file.Name })
 
 	for _, status := range statuses {
-		if strings.HasPrefix(status.StatusString, "warning") {
-			self.Log.Warningf("warning when calling git status: %s", status.StatusString)
+		if previousFile, ok := previousFilesByName[status.Name]; ok && previousFile.DisplayString == status.StatusString {
+			// a copy, given that the previous files may still be being rendered
+			file := *previousFile
+			files = append(files, &file)
 			continue
 		}
 
@@ -65,9 +84,92 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		files = append(files, file)
 	}
 
+	self.setFlags(files)
+
 	return files
 }
 
+// setFlags marks the given files which are flagged as skip-worktree or
+// assume-unchanged. We go by the whole index rather than passing the files as
+// pathspecs, given that there may be too many of them to fit on the command
+// line, but we only read it again once it has changed.
+func (self *FileLoader) setFlags(files []*models.File) {
+	trackedFiles := lo.Filter(files, func(file *models.File, _ int) bool { return file.Tracked })
+	if len(trackedFiles) == 0 {
+		return
+	}
+
+	flaggedFiles, err := self.GetFlaggedFiles()
+	if err != nil {
+		self.Log.Error(err)
+		return
+	}
+
+	// the files we've reused may have been flagged before and not be any more
+	flaggedFilesByName := lo.KeyBy(flaggedFiles, func(file *models.FlaggedFile) string { return file.Name })
+	for _, file := range trackedFiles {
+		flaggedFile, ok := flaggedFilesByName[file.Name]
+		file.SkipWorktree = ok && flaggedFile.SkipWorktree
+		file.AssumeUnchanged = ok && flaggedFile.AssumeUnchanged
+	}
+}
+
+// GetFlaggedFiles returns the files in the index which are flagged as
+// skip-worktree or assume-unchanged. Setting or clearing a flag rewrites the
+// index, so while the index hasn't been modified we return what we found last
+// time.
+func (self *FileLoader) GetFlaggedFiles() ([]*models.FlaggedFile, error) {
+	self.flaggedFilesMutex.Lock()
+	defer self.flaggedFilesMutex.Unlock()
+
+	var indexModTime time.Time
+	if self.dotGitDir != "" {
+		if info, err := os.Stat(filepath.Join(self.dotGitDir, "index")); err == nil {
+			indexModTime = info.ModTime()
+		}
+	}
+
+	if self.flaggedFiles != nil && !indexModTime.IsZero() && indexModTime.Equal(self.flaggedFilesIndexModTime) {
+		return self.flaggedFiles, nil
+	}
+
+	flaggedFiles, err := self.loadFlaggedFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	self.flaggedFiles = flaggedFiles
+	self.flaggedFilesIndexModTime = indexModTime
+	return flaggedFiles, nil
+}
+
+func (self *FileLoader) loadFlaggedFiles() ([]*models.FlaggedFile, error) {
+	output, err := self.cmd.New("git ls-files -v -z").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	flaggedFiles := []*models.FlaggedFile{}
+	for _, line := range strings.Split(output, "\x00") {
+		if len(line) < 3 {
+			continue
+		}
+
+		// the tag is 'S' for skip-worktree, and lowercase for assume-unchanged
+		tag := line[:1]
+		flaggedFile := &models.FlaggedFile{
+			Name:            line[2:],
+			SkipWorktree:    strings.ToUpper(tag) == "S",
+			AssumeUnchanged: strings.ToUpper(tag) != tag,
+		}
+		if flaggedFile.SkipWorktree || flaggedFile.AssumeUnchanged {
+			flaggedFiles = append(flaggedFiles, flaggedFile)
+		}
+	}
+
+	return flaggedFiles, nil
+}
+
 // GitStatus returns the file status of the repo
 type GitStatusOptions struct {
 	NoRenames         bool
@@ -75,43 +177,68 @@ type GitStatusOptions struct {
 }
 
 type FileStatus struct {
+	// as it would be given by the short format, e.g. 'R  old.txt -> new.txt'
 	StatusString string
 	Change       string // ??, MM, AM, ...
 	Name         string
 	PreviousName string
 }
 
+// the number of space-separated fields before the path in each kind of
+// porcelain v2 entry. The path comes last, so it can have spaces in it.
+var porcelainV2FieldCounts = map[byte]int{
+	'1': 8,  // ordinary change: 1 XY sub mH mI mW hH hI path
+	'2': 9,  // rename or copy: 2 XY sub mH mI mW hH hI Xscore path, followed by the original path
+	'u': 10, // unmerged: u XY sub m1 m2 m3 mW h1 h2 h3 path
+	'?': 1,  // untracked: ? path
+	'!': 1,  // ignored: ! path
+}
+
 func (c *FileLoader) GitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 	noRenamesFlag := ""
 	if opts.NoRenames {
 		noRenamesFlag = " --no-renames"
 	}
 
-	statusLines, _, err := c.cmd.New(fmt.Sprintf("git status %s --porcelain -z%s", opts.UntrackedFilesArg, noRenamesFlag)).DontLog().RunWithOutputs()
+	output, _, err := c.cmd.New(fmt.Sprintf("git status %s --porcelain=v2 -z%s", opts.UntrackedFilesArg, noRenamesFlag)).DontLog().RunWithOutputs()
 	if err != nil {
 		return []FileStatus{}, err
 	}
 
-	splitLines := strings.Split(statusLines, "\x00")
+	entries := strings.Split(output, "\x00")
 	response := []FileStatus{}
 
-	for i := 0; i < len(splitLines); i++ {
-		original := splitLines[i]
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if entry == "" {
+			continue
+		}
 
-		if len(original) < 3 {
+		fieldCount, ok := porcelainV2FieldCounts[entry[0]]
+		if !ok {
+			continue
+		}
+		fields := strings.SplitN(entry, " ", fieldCount+1)
+		if len(fields) <= fieldCount {
 			continue
 		}
 
-		status := FileStatus{
-			StatusString: original,
-			Change:       original[:2],
-			Name:         original[3:],
-			PreviousName: "",
+		status := FileStatus{Name: fields[fieldCount]}
+		switch entry[0] {
+		case '?':
+			status.Change = "??"
+		case '!':
+			status.Change = "!!"
+		default:
+			// unlike the short format, an unchanged side is shown as a '.'
+			status.Change = strings.ReplaceAll(fields[1], ".", " ")
 		}
+		status.StatusString = status.Change + " " + status.Name
 
-		if strings.HasPrefix(status.Change, "R") {
-			// if a line starts with 'R' then the next line is the original file.
-			status.PreviousName = splitLines[i+1]
+		if entry[0] == '2' && i+1 < len(entries) {
+			// renamed either in the index or, for an intent-to-add file, in the
+			// working tree
+			status.PreviousName = entries[i+1]
 			status.StatusString = fmt.Sprintf("%s %s -> %s", status.Change, status.PreviousName, status.Name)
 			i++
 		}
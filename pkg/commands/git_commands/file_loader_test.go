@@ -1,7 +1,10 @@
 package git_commands
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -20,17 +23,22 @@ func TestFileGetStatusFiles(t *testing.T) {
 		{
 			"No files found",
 			oscommands.NewFakeRunner(t).
-				Expect(`git status --untracked-files=yes --porcelain -z`, "", nil),
+				Expect(`git status --untracked-files=yes --porcelain=v2 -z`, "", nil),
 			[]*models.File{},
 		},
 		{
 			"Several files found",
 			oscommands.NewFakeRunner(t).
 				Expect(
-					`git status --untracked-files=yes --porcelain -z`,
-					"MM file1.txt\x00A  file3.txt\x00AM file2.txt\x00?? file4.txt\x00UU file5.txt",
+					`git status --untracked-files=yes --porcelain=v2 -z`,
+					"1 MM N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 file1.txt\x00"+
+						"1 A. N... 000000 100644 100644 0000000000000000000000000000000000000000 1234567890123456789012345678901234567890 file3.txt\x00"+
+						"1 AM N... 000000 100644 100644 0000000000000000000000000000000000000000 1234567890123456789012345678901234567890 file2.txt\x00"+
+						"? file4.txt\x00"+
+						"u UU N... 100644 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 file5.txt\x00",
 					nil,
-				),
+				).
+				Expect(`git ls-files -v -z`, "H file1.txt\x00H file5.txt\x00", nil),
 			[]*models.File{
 				{
 					Name:                    "file1.txt",
@@ -102,7 +110,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 		{
 			"File with new line char",
 			oscommands.NewFakeRunner(t).
-				Expect(`git status --untracked-files=yes --porcelain -z`, "MM a\nb.txt", nil),
+				Expect(`git status --untracked-files=yes --porcelain=v2 -z`, "1 MM N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 a\nb.txt\x00", nil).
+				Expect(`git ls-files -v -z`, "H a\nb.txt\x00", nil),
 			[]*models.File{
 				{
 					Name:                    "a\nb.txt",
@@ -123,10 +132,12 @@ func TestFileGetStatusFiles(t *testing.T) {
 			"Renamed files",
 			oscommands.NewFakeRunner(t).
 				Expect(
-					`git status --untracked-files=yes --porcelain -z`,
-					"R  after1.txt\x00before1.txt\x00RM after2.txt\x00before2.txt",
+					`git status --untracked-files=yes --porcelain=v2 -z`,
+					"2 R. N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 R100 after1.txt\x00before1.txt\x00"+
+						"2 RM N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 R90 after2.txt\x00before2.txt\x00",
 					nil,
-				),
+				).
+				Expect(`git ls-files -v -z`, "H after1.txt\x00H after2.txt\x00", nil),
 			[]*models.File{
 				{
 					Name:                    "after1.txt",
@@ -158,12 +169,84 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			"File renamed in the working tree",
+			oscommands.NewFakeRunner(t).
+				Expect(
+					`git status --untracked-files=yes --porcelain=v2 -z`,
+					"2 .R N... 000000 000000 100644 0000000000000000000000000000000000000000 0000000000000000000000000000000000000000 R100 after.txt\x00before.txt\x00",
+					nil,
+				).
+				Expect(`git ls-files -v -z`, "H after.txt\x00", nil),
+			[]*models.File{
+				{
+					Name:                    "after.txt",
+					PreviousName:            "before.txt",
+					HasStagedChanges:        false,
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					Added:                   false,
+					Deleted:                 false,
+					HasMergeConflicts:       false,
+					HasInlineMergeConflicts: false,
+					DisplayString:           " R before.txt -> after.txt",
+					Type:                    "file",
+					ShortStatus:             " R",
+				},
+			},
+		},
+		{
+			"Files flagged as skip-worktree or assume-unchanged",
+			oscommands.NewFakeRunner(t).
+				Expect(
+					`git status --untracked-files=yes --porcelain=v2 -z`,
+					"1 M. N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 skipped.txt\x00"+
+						"1 M. N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 unchanged.txt\x00"+
+						"1 M. N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 both.txt\x00",
+					nil,
+				).
+				Expect(
+					`git ls-files -v -z`,
+					"S skipped.txt\x00h unchanged.txt\x00s both.txt\x00H other.txt\x00S unchanged-elsewhere.txt\x00",
+					nil,
+				),
+			[]*models.File{
+				{
+					Name:             "skipped.txt",
+					HasStagedChanges: true,
+					Tracked:          true,
+					DisplayString:    "M  skipped.txt",
+					Type:             "file",
+					ShortStatus:      "M ",
+					SkipWorktree:     true,
+				},
+				{
+					Name:             "unchanged.txt",
+					HasStagedChanges: true,
+					Tracked:          true,
+					DisplayString:    "M  unchanged.txt",
+					Type:             "file",
+					ShortStatus:      "M ",
+					AssumeUnchanged:  true,
+				},
+				{
+					Name:             "both.txt",
+					HasStagedChanges: true,
+					Tracked:          true,
+					DisplayString:    "M  both.txt",
+					Type:             "file",
+					ShortStatus:      "M ",
+					SkipWorktree:     true,
+					AssumeUnchanged:  true,
+				},
+			},
+		},
 		{
 			"File with arrow in name",
 			oscommands.NewFakeRunner(t).
 				Expect(
-					`git status --untracked-files=yes --porcelain -z`,
-					`?? a -> b.txt`,
+					`git status --untracked-files=yes --porcelain=v2 -z`,
+					"? a -> b.txt\x00",
 					nil,
 				),
 			[]*models.File{
@@ -201,6 +284,44 @@ func TestFileGetStatusFiles(t *testing.T) {
 	}
 }
 
+func TestFileGetStatusFilesReusingPreviousFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(
+			`git status --untracked-files=yes --porcelain=v2 -z`,
+			"1 .M N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 unchanged.txt\x00"+
+				"1 MM N... 100644 100644 100644 1234567890123456789012345678901234567890 1234567890123456789012345678901234567890 changed.txt\x00",
+			nil,
+		).
+		Expect(`git ls-files -v -z`, "H unchanged.txt\x00H changed.txt\x00", nil)
+
+	unchangedFile := &models.File{Name: "unchanged.txt", DisplayString: " M unchanged.txt", Type: "file", ShortStatus: " M", HasUnstagedChanges: true, Tracked: true, SkipWorktree: true}
+	changedFile := &models.File{Name: "changed.txt", DisplayString: " M changed.txt", Type: "file", ShortStatus: " M", HasUnstagedChanges: true, Tracked: true}
+
+	typedFiles := []string{}
+	loader := &FileLoader{
+		Common: utils.NewDummyCommon(),
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+		config: &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(path string) string {
+			typedFiles = append(typedFiles, path)
+			return "file"
+		},
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{PreviousFiles: []*models.File{unchangedFile, changedFile}})
+
+	assert.Equal(t, 2, len(files))
+	assert.NotSame(t, unchangedFile, files[0])
+	assert.Equal(t, "unchanged.txt", files[0].Name)
+	// no longer flagged, without touching the file we had before
+	assert.False(t, files[0].SkipWorktree)
+	assert.True(t, unchangedFile.SkipWorktree)
+	assert.NotSame(t, changedFile, files[1])
+	assert.Equal(t, "MM", files[1].ShortStatus)
+	// we don't look at the unchanged file on disk again
+	assert.Equal(t, []string{"changed.txt"}, typedFiles)
+}
+
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
 }
@@ -208,3 +329,32 @@ type FakeFileLoaderConfig struct {
 func (self *FakeFileLoaderConfig) GetShowUntrackedFiles() string {
 	return self.showUntrackedFiles
 }
+
+func TestFileGetFlaggedFilesOnlyReadsChangedIndex(t *testing.T) {
+	dotGitDir := t.TempDir()
+	indexPath := filepath.Join(dotGitDir, "index")
+	assert.NoError(t, os.WriteFile(indexPath, []byte{}, 0o644))
+
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git ls-files -v -z`, "S file1.txt\x00H file2.txt\x00", nil).
+		Expect(`git ls-files -v -z`, "H file1.txt\x00H file2.txt\x00", nil)
+
+	loader := NewFileLoader(utils.NewDummyCommon(), oscommands.NewDummyCmdObjBuilder(runner), &FakeFileLoaderConfig{}, dotGitDir)
+
+	expected := []*models.FlaggedFile{{Name: "file1.txt", SkipWorktree: true}}
+	for i := 0; i < 2; i++ {
+		flaggedFiles, err := loader.GetFlaggedFiles()
+		assert.NoError(t, err)
+		assert.EqualValues(t, expected, flaggedFiles)
+	}
+
+	// clearing the flag rewrites the index
+	later := time.Now().Add(time.Second)
+	assert.NoError(t, os.Chtimes(indexPath, later, later))
+
+	flaggedFiles, err := loader.GetFlaggedFiles()
+	assert.NoError(t, err)
+	assert.Empty(t, flaggedFiles)
+
+	runner.CheckForMissingCalls()
+}
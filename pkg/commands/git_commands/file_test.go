@@ -176,3 +176,53 @@ func TestEditFileCmdStr(t *testing.T) {
 		s.runner.CheckForMissingCalls()
 	}
 }
+
+func TestGetDifftoolCmdStr(t *testing.T) {
+	type scenario struct {
+		testName        string
+		opts            DifftoolCmdOptions
+		difftoolCommand string
+		expected        string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "unstaged file",
+			opts:     DifftoolCmdOptions{Path: "file with space"},
+			expected: `git difftool --no-prompt -- "file with space"`,
+		},
+		{
+			testName: "staged directory",
+			opts:     DifftoolCmdOptions{Staged: true, Path: "dir", IsDirectory: true},
+			expected: `git difftool --dir-diff --cached -- "dir"`,
+		},
+		{
+			testName: "whole commit",
+			opts:     DifftoolCmdOptions{From: "abc123^", To: "abc123", IsDirectory: true},
+			expected: `git difftool --dir-diff abc123^..abc123 --`,
+		},
+		{
+			testName: "file in commit",
+			opts:     DifftoolCmdOptions{From: "abc123^", To: "abc123", Staged: true, Path: "file"},
+			expected: `git difftool --no-prompt abc123^..abc123 -- "file"`,
+		},
+		{
+			testName:        "custom command",
+			opts:            DifftoolCmdOptions{From: "abc123^", To: "abc123", Path: "file"},
+			difftoolCommand: "mytool {{from}} {{to}} {{filename}}",
+			expected:        `mytool abc123^ abc123 "file"`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.OS.DifftoolCommand = s.difftoolCommand
+
+			instance := buildFileCommands(commonDeps{userConfig: userConfig})
+
+			assert.Equal(t, s.expected, instance.GetDifftoolCmdStr(s.opts))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package git_commands
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+// FilterPathspecs turns the paths and glob patterns that commits are being
+// filtered by into git pathspecs. Without any magic git already lets '*' match
+// across directories, so '*.go' means go files anywhere, but for '**' to mean
+// any number of directories we need the glob magic.
+func FilterPathspecs(filterPaths []string) []string {
+	return slices.Map(filterPaths, func(path string) string {
+		if strings.Contains(path, "**") {
+			return ":(glob)" + path
+		}
+		return path
+	})
+}
+
+// filterPathArgs limits a log or diff to the given paths. git can only follow
+// renames of a single file, so we only ask it to when there's a single plain
+// path. A path with a trailing slash is a directory, which we don't follow.
+func filterPathArgs(cmd oscommands.ICmdObjBuilder, filterPaths []string, follow bool) string {
+	if len(filterPaths) == 0 {
+		return ""
+	}
+
+	followFlag := ""
+	if follow && len(filterPaths) == 1 && !isGlobPattern(filterPaths[0]) && !strings.HasSuffix(filterPaths[0], "/") {
+		followFlag = " --follow"
+	}
+
+	return fmt.Sprintf("%s -- %s", followFlag, strings.Join(slices.Map(FilterPathspecs(filterPaths), cmd.Quote), " "))
+}
+
+// filterPathsMatch tells us whether git would consider the file to be matched
+// by any of the paths, which it does if it's a file or directory the path
+// names, or one of the glob pattern's matches
+func filterPathsMatch(filterPaths []string, file string) bool {
+	return slices.Some(filterPaths, func(filterPath string) bool {
+		if !isGlobPattern(filterPath) {
+			dir := strings.TrimSuffix(filterPath, "/")
+			return file == dir || strings.HasPrefix(file, dir+"/")
+		}
+
+		pattern, err := globRegexp(filterPath)
+		return err == nil && pattern.MatchString(file)
+	})
+}
+
+// globRegexp matches what git would match for the glob pattern, going by the
+// same rules as FilterPathspecs
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	hasGlobMagic := strings.Contains(pattern, "**")
+
+	var result strings.Builder
+	result.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case hasGlobMagic && strings.HasPrefix(pattern[i:], "**/"):
+			// any number of directories, including none
+			result.WriteString("(?:.*/)?")
+			i += 2
+		case hasGlobMagic && strings.HasPrefix(pattern[i:], "**"):
+			result.WriteString(".*")
+			i++
+		case pattern[i] == '*' && hasGlobMagic:
+			result.WriteString("[^/]*")
+		case pattern[i] == '*':
+			result.WriteString(".*")
+		case pattern[i] == '?' && hasGlobMagic:
+			result.WriteString("[^/]")
+		case pattern[i] == '?':
+			result.WriteString(".")
+		case pattern[i] == '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end == -1 {
+				result.WriteString(regexp.QuoteMeta(pattern[i:]))
+				i = len(pattern)
+				break
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			result.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			result.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	result.WriteString("$")
+
+	return regexp.Compile(result.String())
+}
+
+func isGlobPattern(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
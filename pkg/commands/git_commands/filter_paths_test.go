@@ -0,0 +1,41 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterPathsMatch(t *testing.T) {
+	scenarios := []struct {
+		filterPath string
+		file       string
+		expected   bool
+	}{
+		{"main.go", "main.go", true},
+		{"docs", "docs/Config.md", true},
+		{"docs/", "docs/keybindings/Keybindings_en.md", true},
+		{"docs", "docsite/index.md", false},
+		{"*.go", "pkg/app/app.go", true},
+		{"*.go", "go.mod", false},
+		{"pkg/*.go", "pkg/commands/git.go", true},
+		{"pkg/commands/**", "pkg/commands/git_commands/commit.go", true},
+		{"pkg/commands/**", "pkg/config/app_config.go", false},
+		{"**/*_test.go", "utils_test.go", true},
+		{"**/*_test.go", "pkg/utils/utils_test.go", true},
+		{"pkg/**/*.go", "pkg/app.go", true},
+		{"pkg/**/*.md", "pkg/app.go", false},
+		{"pkg/*/git.go", "pkg/commands/git.go", true},
+		{"pkg/**/[a-c]*.go", "pkg/commands/commit.go", true},
+		{"pkg/**/[!a-c]*.go", "pkg/commands/commit.go", false},
+		{"file?.txt", "file1.txt", true},
+		{"file[.txt", "file[.txt", true},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.filterPath+" "+s.file, func(t *testing.T) {
+			assert.Equal(t, s.expected, filterPathsMatch([]string{s.filterPath}, s.file))
+		})
+	}
+}
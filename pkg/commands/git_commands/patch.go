@@ -2,19 +2,24 @@ package git_commands
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type PatchCommands struct {
 	*GitCommon
-	rebase *RebaseCommands
-	commit *CommitCommands
-	status *StatusCommands
-	stash  *StashCommands
+	rebase      *RebaseCommands
+	commit      *CommitCommands
+	status      *StatusCommands
+	stash       *StashCommands
+	workingTree *WorkingTreeCommands
 
 	PatchManager *patch.PatchManager
 }
@@ -25,6 +30,7 @@ func NewPatchCommands(
 	commit *CommitCommands,
 	status *StatusCommands,
 	stash *StashCommands,
+	workingTree *WorkingTreeCommands,
 	patchManager *patch.PatchManager,
 ) *PatchCommands {
 	return &PatchCommands{
@@ -33,6 +39,7 @@ func NewPatchCommands(
 		commit:       commit,
 		status:       status,
 		stash:        stash,
+		workingTree:  workingTree,
 		PatchManager: patchManager,
 	}
 }
@@ -163,6 +170,10 @@ func (self *PatchCommands) MovePatchToSelectedCommit(commits []*models.Commit, s
 }
 
 func (self *PatchCommands) MovePatchIntoIndex(commits []*models.Commit, commitIdx int, stash bool) error {
+	if self.PatchManager.SpansMultipleCommits() {
+		return self.moveMultiCommitPatchIntoIndex(commits, stash)
+	}
+
 	if stash {
 		if err := self.stash.Save(self.Tr.StashPrefix + commits[commitIdx].Sha); err != nil {
 			return err
@@ -192,8 +203,52 @@ func (self *PatchCommands) MovePatchIntoIndex(commits []*models.Commit, commitId
 	}
 
 	self.rebase.onSuccessfulContinue = func() error {
-		// add patches to index
-		if err := self.PatchManager.ApplyPatches(false); err != nil {
+		return self.addPatchToIndex(stash)
+	}
+
+	return self.rebase.ContinueRebase()
+}
+
+// moveMultiCommitPatchIntoIndex removes each commit's part of the patch from
+// that commit in a single rebase, stopping at the commits from oldest to
+// newest, and then adds the whole patch to the index
+func (self *PatchCommands) moveMultiCommitPatchIntoIndex(commits []*models.Commit, stash bool) error {
+	commitIndexes := []int{}
+	for _, sha := range self.PatchManager.Commits() {
+		_, index, ok := lo.FindIndexOf(commits, func(commit *models.Commit) bool { return commit.Sha == sha })
+		if !ok {
+			return errors.New("commit " + utils.ShortSha(sha) + " is not on the current branch")
+		}
+		commitIndexes = append(commitIndexes, index)
+	}
+	// the rebase stops at the oldest commit first
+	sort.Sort(sort.Reverse(sort.IntSlice(commitIndexes)))
+
+	if self.config.UsingGpg() {
+		return errors.New(self.Tr.DisabledForGPG)
+	}
+
+	if stash {
+		if err := self.stash.Save(self.Tr.StashPrefix + commits[commitIndexes[len(commitIndexes)-1]].Sha); err != nil {
+			return err
+		}
+	}
+
+	baseIndex := commitIndexes[0] + 1
+	todoLines := self.rebase.BuildTodoLines(commits[0:baseIndex], func(commit *models.Commit, i int) string {
+		if lo.Contains(commitIndexes, i) {
+			return "edit"
+		}
+		return "pick"
+	})
+
+	if err := self.rebase.PrepareInteractiveRebaseCommand(getBaseShaOrRoot(commits, baseIndex), todoLines, true).Run(); err != nil {
+		return err
+	}
+
+	var removeFromCommit func(i int) error
+	removeFromCommit = func(i int) error {
+		if err := self.PatchManager.ApplyCommitPatch(commits[commitIndexes[i]].Sha, true); err != nil {
 			if self.status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
 				if err := self.rebase.AbortRebase(); err != nil {
 					return err
@@ -202,17 +257,47 @@ func (self *PatchCommands) MovePatchIntoIndex(commits []*models.Commit, commitId
 			return err
 		}
 
-		if stash {
-			if err := self.stash.Apply(0); err != nil {
+		if err := self.commit.AmendHead(); err != nil {
+			return err
+		}
+
+		if self.rebase.onSuccessfulContinue != nil {
+			return errors.New("You are midway through another rebase operation. Please abort to start again")
+		}
+
+		self.rebase.onSuccessfulContinue = func() error {
+			if i+1 < len(commitIndexes) {
+				return removeFromCommit(i + 1)
+			}
+			return self.addPatchToIndex(stash)
+		}
+
+		return self.rebase.ContinueRebase()
+	}
+
+	return removeFromCommit(0)
+}
+
+// addPatchToIndex is the last step of moving a patch into the index, once the
+// patch has been removed from its commits and the rebase is done
+func (self *PatchCommands) addPatchToIndex(stash bool) error {
+	if err := self.PatchManager.ApplyPatches(false); err != nil {
+		if self.status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
+			if err := self.rebase.AbortRebase(); err != nil {
 				return err
 			}
 		}
+		return err
+	}
 
-		self.PatchManager.Reset()
-		return nil
+	if stash {
+		if err := self.stash.Apply(0); err != nil {
+			return err
+		}
 	}
 
-	return self.rebase.ContinueRebase()
+	self.PatchManager.Reset()
+	return nil
 }
 
 func (self *PatchCommands) PullPatchIntoNewCommit(commits []*models.Commit, commitIdx int) error {
@@ -254,3 +339,92 @@ func (self *PatchCommands) PullPatchIntoNewCommit(commits []*models.Commit, comm
 	self.PatchManager.Reset()
 	return self.rebase.ContinueRebase()
 }
+
+// PullPatchIntoNewCommitBefore moves the patch out of the given commit into a
+// new commit positioned directly before it
+func (self *PatchCommands) PullPatchIntoNewCommitBefore(commits []*models.Commit, commitIdx int) error {
+	if err := self.rebase.BeginInteractiveRebaseForCommit(commits, commitIdx); err != nil {
+		return err
+	}
+
+	sha := commits[commitIdx].Sha
+	headMessage, _ := self.commit.GetHeadCommitMessage()
+
+	// move HEAD back to the parent while keeping the original commit's content
+	// in the index and working tree
+	if err := self.commit.ResetToCommit("HEAD^", "soft", nil); err != nil {
+		if err := self.rebase.AbortRebase(); err != nil {
+			return err
+		}
+		return err
+	}
+
+	// the patch was built against the parent so it will apply cleanly to it
+	newMessage := fmt.Sprintf("Split from \"%s\"", headMessage)
+	patch := self.PatchManager.RenderAggregatedPatchColored(true)
+	if err := self.workingTree.CommitPatch(patch, "", false, self.commit.CommitCmdObj(newMessage)); err != nil {
+		if err := self.rebase.AbortRebase(); err != nil {
+			return err
+		}
+		return err
+	}
+
+	// commit the rest of the original commit on top of the new one
+	if err := self.commit.CommitReusingMessage(sha); err != nil {
+		if err := self.rebase.AbortRebase(); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if self.rebase.onSuccessfulContinue != nil {
+		return errors.New("You are midway through another rebase operation. Please abort to start again")
+	}
+
+	self.PatchManager.Reset()
+	return self.rebase.ContinueRebase()
+}
+
+// SavePatchAsStashEntry creates a stash entry containing the patch, as if it
+// had been applied to the working tree and then stashed. The entry is built
+// using a temporary index, so the working tree, the index and the commit the
+// patch came from are all left untouched.
+func (self *PatchCommands) SavePatchAsStashEntry(message string) error {
+	patchFilepath, err := self.workingTree.SaveTemporaryPatch(self.PatchManager.RenderAggregatedPatchColored(true))
+	if err != nil {
+		return err
+	}
+
+	tmpIndexPath := self.workingTree.temporaryFilePath(".index")
+	defer func() { _ = self.os.Remove(tmpIndexPath) }()
+	indexEnvVar := "GIT_INDEX_FILE=" + tmpIndexPath
+
+	if err := self.cmd.New("git read-tree HEAD").AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+
+	if err := self.cmd.New(fmt.Sprintf("git apply --cached %s", self.cmd.Quote(patchFilepath))).AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+
+	tree, err := self.cmd.New("git write-tree").AddEnvVars(indexEnvVar).RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	// a stash entry is a commit of the working tree whose second parent is a
+	// commit of the index. Nothing is staged in ours, so the index commit
+	// just has HEAD's tree.
+	indexCommit, err := self.cmd.New("git commit-tree HEAD^{tree} -p HEAD -m " + self.cmd.Quote("index on "+message)).RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	stashCommit, err := self.cmd.New(fmt.Sprintf("git commit-tree %s -p HEAD -p %s -m %s",
+		strings.TrimSpace(tree), strings.TrimSpace(indexCommit), self.cmd.Quote(message))).RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	return self.stash.Store(strings.TrimSpace(stashCommit), message)
+}
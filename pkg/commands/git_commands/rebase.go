@@ -11,6 +11,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/app/daemon"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type RebaseCommands struct {
@@ -62,6 +63,21 @@ func (self *RebaseCommands) RewordCommitInEditor(commits []*models.Commit, index
 	return self.PrepareInteractiveRebaseCommand(sha, todo, false), nil
 }
 
+// RewordCommitsInEditor returns the cmd for rewording the commit at the given
+// index and every commit above it in a single interactive rebase, in which git
+// opens the editor for each of their messages in turn
+func (self *RebaseCommands) RewordCommitsInEditor(commits []*models.Commit, index int) oscommands.ICmdObj {
+	todo := self.BuildTodoLines(commits[0:index+1], func(commit *models.Commit, i int) string {
+		if commit.IsMerge() {
+			// as with BuildSingleActionTodo, we don't rebase over merge commits
+			return "drop"
+		}
+		return "reword"
+	})
+
+	return self.PrepareInteractiveRebaseCommand(getBaseShaOrRoot(commits, index+1), todo, false)
+}
+
 func (self *RebaseCommands) ResetCommitAuthor(commits []*models.Commit, index int) error {
 	return self.GenericAmend(commits, index, func() error {
 		return self.commit.ResetAuthor()
@@ -74,6 +90,46 @@ func (self *RebaseCommands) SetCommitAuthor(commits []*models.Commit, index int,
 	})
 }
 
+// SetCommitsAuthorAndDateCmdObj returns the cmd for changing the author, and
+// the author date if one is given, of the commits from the one at startIdx down
+// to the one at endIdx in a single interactive rebase. Leaving either the author
+// or the date empty keeps the commits' own. The commits above the range are
+// replayed with their committer date set to their author date, as they would be
+// by 'git rebase --committer-date-is-author-date'.
+func (self *RebaseCommands) SetCommitsAuthorAndDateCmdObj(commits []*models.Commit, startIdx int, endIdx int, author string, date string) oscommands.ICmdObj {
+	amendCmdStr := "git commit --amend --only --allow-empty --no-edit"
+	if author != "" {
+		amendCmdStr += " --author=" + self.cmd.Quote(author)
+	}
+	if date != "" {
+		amendCmdStr += " --date=" + self.cmd.Quote(date)
+	}
+
+	todoLines := []TodoLine{}
+	for i, commit := range commits[0 : endIdx+1] {
+		if commit.IsMerge() {
+			// as with BuildSingleActionTodo, we don't rebase over merge commits
+			todoLines = append(todoLines, TodoLine{Action: "drop", Commit: commit})
+			continue
+		}
+
+		// the todo lines get reversed, so the exec line goes before the pick
+		// in order to run right after it
+		if i >= startIdx {
+			todoLines = append(todoLines, TodoLine{Action: "exec", Command: amendCmdStr})
+		}
+		todoLines = append(todoLines, TodoLine{Action: "pick", Commit: commit})
+	}
+
+	baseShaOrRoot := getBaseShaOrRoot(commits, endIdx+1)
+	// before git 2.29 this option couldn't be combined with --interactive
+	if !self.version.IsOlderThan(2, 29, 0) {
+		baseShaOrRoot = "--committer-date-is-author-date " + baseShaOrRoot
+	}
+
+	return self.PrepareInteractiveRebaseCommand(baseShaOrRoot, todoLines, false)
+}
+
 func (self *RebaseCommands) GenericAmend(commits []*models.Commit, index int, f func() error) error {
 	if index == 0 {
 		// we've selected the top commit so no rebase is required
@@ -115,6 +171,21 @@ func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, index in
 	return self.PrepareInteractiveRebaseCommand(sha, todo, true).Run()
 }
 
+// DropCommits drops the commits at the given indexes in a single rebase,
+// picking the commits in between them
+func (self *RebaseCommands) DropCommits(commits []*models.Commit, indexes []int) error {
+	baseIndex := lo.Max(indexes) + 1
+
+	todoLines := self.BuildTodoLines(commits[0:baseIndex], func(commit *models.Commit, i int) string {
+		if lo.Contains(indexes, i) {
+			return "drop"
+		}
+		return "pick"
+	})
+
+	return self.PrepareInteractiveRebaseCommand(getBaseShaOrRoot(commits, baseIndex), todoLines, true).Run()
+}
+
 func (self *RebaseCommands) InteractiveRebaseBreakAfter(commits []*models.Commit, index int) error {
 	todo, sha, err := self.BuildSingleActionTodo(commits, index-1, "pick")
 	if err != nil {
@@ -125,6 +196,21 @@ func (self *RebaseCommands) InteractiveRebaseBreakAfter(commits []*models.Commit
 	return self.PrepareInteractiveRebaseCommand(sha, todo, true).Run()
 }
 
+// InteractiveRebaseInsertAfter starts an interactive rebase that picks the
+// commit at the given index and then runs the given todo line (e.g. an 'exec'
+// or a 'break') before going on with the commits above it
+func (self *RebaseCommands) InteractiveRebaseInsertAfter(commits []*models.Commit, index int, todoLine TodoLine) error {
+	todo, sha, err := self.BuildSingleActionTodo(commits, index, "pick")
+	if err != nil {
+		return err
+	}
+
+	// todo lines are listed newest first, so the line that comes right after
+	// the commit goes right before it
+	todo = slices.Insert(todo, index, todoLine)
+	return self.PrepareInteractiveRebaseCommand(sha, todo, true).Run()
+}
+
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
 // we tell git to run lazygit to edit the todo list, and we pass the client
 // lazygit a todo string to write to the todo file
@@ -203,53 +289,97 @@ func (self *RebaseCommands) AmendTo(commit *models.Commit) error {
 
 // EditRebaseTodo sets the action at a given index in the git-rebase-todo file
 func (self *RebaseCommands) EditRebaseTodo(index int, action string) error {
-	fileName := filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo")
-	bytes, err := os.ReadFile(fileName)
+	content, todoLineIndexes, err := self.readRebaseTodo()
 	if err != nil {
 		return err
 	}
+	if index >= len(todoLineIndexes) {
+		return errors.New("index outside of range of rebase todos")
+	}
 
-	content := strings.Split(string(bytes), "\n")
-	commitCount := self.getTodoCommitCount(content)
-
-	// we have the most recent commit at the bottom whereas the todo file has
-	// it at the bottom, so we need to subtract our index from the commit count
-	contentIndex := commitCount - 1 - index
+	contentIndex := todoLineIndexes[index]
 	splitLine := strings.Split(content[contentIndex], " ")
 	content[contentIndex] = action + " " + strings.Join(splitLine[1:], " ")
-	result := strings.Join(content, "\n")
 
-	return os.WriteFile(fileName, []byte(result), 0o644)
+	return self.writeRebaseTodo(content)
 }
 
-func (self *RebaseCommands) getTodoCommitCount(content []string) int {
-	// count lines that are not blank and are not comments
-	commitCount := 0
-	for _, line := range content {
-		if line != "" && !strings.HasPrefix(line, "#") {
-			commitCount++
-		}
+// InsertRebaseTodoAfter adds a line to the git-rebase-todo file so that it's
+// run right after the todo at the given index
+func (self *RebaseCommands) InsertRebaseTodoAfter(index int, todoLine TodoLine) error {
+	content, todoLineIndexes, err := self.readRebaseTodo()
+	if err != nil {
+		return err
 	}
-	return commitCount
+	if index >= len(todoLineIndexes) {
+		return errors.New("index outside of range of rebase todos")
+	}
+
+	content = slices.Insert(content, todoLineIndexes[index]+1, strings.TrimSuffix(todoLine.ToString(), "\n"))
+
+	return self.writeRebaseTodo(content)
+}
+
+// DeleteRebaseTodo removes the line at a given index from the git-rebase-todo
+// file. Unlike dropping a commit, this works for any kind of todo.
+func (self *RebaseCommands) DeleteRebaseTodo(index int) error {
+	content, todoLineIndexes, err := self.readRebaseTodo()
+	if err != nil {
+		return err
+	}
+	if index >= len(todoLineIndexes) {
+		return errors.New("index outside of range of rebase todos")
+	}
+
+	return self.writeRebaseTodo(slices.Remove(content, todoLineIndexes[index]))
 }
 
 // MoveTodoDown moves a rebase todo item down by one position
 func (self *RebaseCommands) MoveTodoDown(index int) error {
-	fileName := filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo")
-	bytes, err := os.ReadFile(fileName)
+	content, todoLineIndexes, err := self.readRebaseTodo()
 	if err != nil {
 		return err
 	}
+	if index+1 >= len(todoLineIndexes) {
+		return errors.New("index outside of range of rebase todos")
+	}
+
+	// swapping with the todo before it, rather than with whatever line is
+	// before it, so that any blank lines or comments stay where they are
+	a, b := todoLineIndexes[index], todoLineIndexes[index+1]
+	content[a], content[b] = content[b], content[a]
+
+	return self.writeRebaseTodo(content)
+}
+
+// readRebaseTodo returns the lines of the git-rebase-todo file, along with the
+// indexes of the lines that are actual todos (as opposed to blank lines or
+// comments). We list the most recent commit at the top whereas the todo file
+// has it at the bottom, so the indexes are reversed to line up with our list.
+func (self *RebaseCommands) readRebaseTodo() ([]string, []int, error) {
+	bytes, err := os.ReadFile(self.rebaseTodoPath())
+	if err != nil {
+		return nil, nil, err
+	}
 
 	content := strings.Split(string(bytes), "\n")
-	commitCount := self.getTodoCommitCount(content)
-	contentIndex := commitCount - 1 - index
+	todoLineIndexes := []int{}
+	for i, line := range content {
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine != "" && trimmedLine != "noop" && !strings.HasPrefix(trimmedLine, "#") {
+			todoLineIndexes = slices.Prepend(todoLineIndexes, i)
+		}
+	}
 
-	rearrangedContent := append(content[0:contentIndex-1], content[contentIndex], content[contentIndex-1])
-	rearrangedContent = append(rearrangedContent, content[contentIndex+1:]...)
-	result := strings.Join(rearrangedContent, "\n")
+	return content, todoLineIndexes, nil
+}
 
-	return os.WriteFile(fileName, []byte(result), 0o644)
+func (self *RebaseCommands) writeRebaseTodo(content []string) error {
+	return os.WriteFile(self.rebaseTodoPath(), []byte(strings.Join(content, "\n")), 0o644)
+}
+
+func (self *RebaseCommands) rebaseTodoPath() string {
+	return filepath.Join(self.dotGitDir, "rebase-merge/git-rebase-todo")
 }
 
 // SquashAllAboveFixupCommits squashes all fixup! commits above the given one
@@ -296,6 +426,18 @@ func (self *RebaseCommands) RebaseBranch(branchName string) error {
 	return self.PrepareInteractiveRebaseCommand(branchName, nil, false).Run()
 }
 
+// RebaseOnto interactive rebases the commits after upstream onto newBase
+func (self *RebaseCommands) RebaseOnto(newBase string, upstream string) error {
+	return self.PrepareInteractiveRebaseCommand(
+		fmt.Sprintf("--onto %s %s", self.cmd.Quote(newBase), self.cmd.Quote(upstream)), nil, false,
+	).Run()
+}
+
+// VerifyRef returns git's error if the given ref doesn't resolve to a commit
+func (self *RebaseCommands) VerifyRef(ref string) error {
+	return self.cmd.New("git rev-parse --verify " + self.cmd.Quote(ref+"^{commit}")).DontLog().Run()
+}
+
 func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string, command string) oscommands.ICmdObj {
 	return self.cmd.New("git " + commandType + " --" + command)
 }
@@ -346,22 +488,25 @@ func (self *RebaseCommands) runSkipEditorCommand(cmdObj oscommands.ICmdObj) erro
 		Run()
 }
 
-// DiscardOldFileChanges discards changes to a file from an old commit
-func (self *RebaseCommands) DiscardOldFileChanges(commits []*models.Commit, commitIndex int, fileName string) error {
+// DiscardOldFileChanges discards changes to the given files from an old commit.
+// Files that were added in the commit are deleted.
+func (self *RebaseCommands) DiscardOldFileChanges(commits []*models.Commit, commitIndex int, fileNames []string) error {
 	if err := self.BeginInteractiveRebaseForCommit(commits, commitIndex); err != nil {
 		return err
 	}
 
-	// check if file exists in previous commit (this command returns an error if the file doesn't exist)
-	if err := self.cmd.New("git cat-file -e HEAD^:" + self.cmd.Quote(fileName)).Run(); err != nil {
-		if err := self.os.Remove(fileName); err != nil {
-			return err
-		}
-		if err := self.workingTree.StageFile(fileName); err != nil {
+	for _, fileName := range fileNames {
+		// check if file exists in previous commit (this command returns an error if the file doesn't exist)
+		if err := self.cmd.New("git cat-file -e HEAD^:" + self.cmd.Quote(fileName)).Run(); err != nil {
+			if err := self.os.Remove(fileName); err != nil {
+				return err
+			}
+			if err := self.workingTree.StageFile(fileName); err != nil {
+				return err
+			}
+		} else if err := self.workingTree.CheckoutFile("HEAD^", fileName); err != nil {
 			return err
 		}
-	} else if err := self.workingTree.CheckoutFile("HEAD^", fileName); err != nil {
-		return err
 	}
 
 	// amend the commit
@@ -404,11 +549,15 @@ func (self *RebaseCommands) BuildTodoLinesSingleAction(commits []*models.Commit,
 type TodoLine struct {
 	Action string
 	Commit *models.Commit
+	// only used for 'exec' lines
+	Command string
 }
 
 func (self *TodoLine) ToString() string {
 	if self.Action == "break" {
 		return self.Action + "\n"
+	} else if self.Action == "exec" {
+		return self.Action + " " + self.Command + "\n"
 	} else {
 		return self.Action + " " + self.Commit.Sha + " " + self.Commit.Name + "\n"
 	}
@@ -51,6 +51,271 @@ func TestRebaseRebaseBranch(t *testing.T) {
 	}
 }
 
+func TestRebaseRebaseOnto(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git rebase --interactive --autostash --keep-empty --no-autosquash --onto "origin/main~3" "v1.0"`, "", nil)
+	instance := buildRebaseCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RebaseOnto("origin/main~3", "v1.0"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRebaseVerifyRef(t *testing.T) {
+	type scenario struct {
+		testName string
+		ref      string
+		runner   *oscommands.FakeCmdObjRunner
+		test     func(error)
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "valid ref",
+			ref:      "origin/main~3",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify "origin/main~3^{commit}"`, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "invalid ref",
+			ref:      "nope",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rev-parse --verify "nope^{commit}"`, "", errors.New("fatal: Needed a single revision")),
+			test: func(err error) {
+				assert.EqualError(t, err, "fatal: Needed a single revision")
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRebaseCommands(commonDeps{runner: s.runner})
+			s.test(instance.VerifyRef(s.ref))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRebaseRewordCommitsInEditor(t *testing.T) {
+	type scenario struct {
+		testName     string
+		commits      []*models.Commit
+		index        int
+		expectedBase string
+		expectedTodo string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "rewording some of the commits",
+			commits: []*models.Commit{
+				{Name: "three", Sha: "333"},
+				{Name: "two", Sha: "222"},
+				{Name: "one", Sha: "111"},
+			},
+			index:        1,
+			expectedBase: "111",
+			expectedTodo: "reword 222 two\nreword 333 three\n",
+		},
+		{
+			testName: "rewording down to the root commit",
+			commits: []*models.Commit{
+				{Name: "two", Sha: "222"},
+				{Name: "one", Sha: "111"},
+			},
+			index:        1,
+			expectedBase: "--root",
+			expectedTodo: "reword 111 one\nreword 222 two\n",
+		},
+		{
+			testName: "merge commits are dropped",
+			commits: []*models.Commit{
+				{Name: "three", Sha: "333"},
+				{Name: "merge", Sha: "222", Parents: []string{"111", "aaa"}},
+				{Name: "one", Sha: "111"},
+			},
+			index:        1,
+			expectedBase: "111",
+			expectedTodo: "drop 222 merge\nreword 333 three\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRebaseCommands(commonDeps{runner: oscommands.NewFakeRunner(t)})
+			cmdObj := instance.RewordCommitsInEditor(s.commits, s.index)
+
+			assert.Equal(t, "git rebase --interactive --autostash --keep-empty --no-autosquash "+s.expectedBase, cmdObj.ToString())
+			assert.Contains(t, cmdObj.GetEnvVars(), daemon.RebaseTODOEnvKey+"="+s.expectedTodo)
+			// git opens the user's editor for each of the commit messages
+			assert.NotContains(t, cmdObj.GetEnvVars(), "GIT_EDITOR="+oscommands.GetLazygitPath())
+		})
+	}
+}
+
+func TestRebaseDropCommits(t *testing.T) {
+	type scenario struct {
+		testName     string
+		indexes      []int
+		expectedBase string
+		expectedTodo string
+	}
+
+	commits := []*models.Commit{
+		{Name: "four", Sha: "444"},
+		{Name: "three", Sha: "333"},
+		{Name: "two", Sha: "222"},
+		{Name: "one", Sha: "111"},
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "dropping commits that aren't next to each other",
+			indexes:      []int{0, 2},
+			expectedBase: "111",
+			expectedTodo: "drop 222 two\npick 333 three\ndrop 444 four\n",
+		},
+		{
+			testName:     "dropping the root commit",
+			indexes:      []int{3, 1},
+			expectedBase: "--root",
+			expectedTodo: "drop 111 one\npick 222 two\ndrop 333 three\npick 444 four\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectFunc(func(cmdObj oscommands.ICmdObj) (string, error) {
+				assert.Equal(t, "git rebase --interactive --autostash --keep-empty --no-autosquash "+s.expectedBase, cmdObj.ToString())
+				assert.Contains(t, cmdObj.GetEnvVars(), daemon.RebaseTODOEnvKey+"="+s.expectedTodo)
+				return "", nil
+			})
+			instance := buildRebaseCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.DropCommits(commits, s.indexes))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRebaseInteractiveRebaseInsertAfter(t *testing.T) {
+	type scenario struct {
+		testName     string
+		index        int
+		todoLine     TodoLine
+		expectedBase string
+		expectedTodo string
+	}
+
+	commits := []*models.Commit{
+		{Name: "three", Sha: "333"},
+		{Name: "two", Sha: "222"},
+		{Name: "one", Sha: "111"},
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "exec after a commit in the middle",
+			index:        1,
+			todoLine:     TodoLine{Action: "exec", Command: "make test"},
+			expectedBase: "111",
+			expectedTodo: "pick 222 two\nexec make test\npick 333 three\n",
+		},
+		{
+			testName:     "break after the root commit",
+			index:        2,
+			todoLine:     TodoLine{Action: "break"},
+			expectedBase: "--root",
+			expectedTodo: "pick 111 one\nbreak\npick 222 two\npick 333 three\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectFunc(func(cmdObj oscommands.ICmdObj) (string, error) {
+				assert.Equal(t, "git rebase --interactive --autostash --keep-empty --no-autosquash "+s.expectedBase, cmdObj.ToString())
+				assert.Contains(t, cmdObj.GetEnvVars(), daemon.RebaseTODOEnvKey+"="+s.expectedTodo)
+				return "", nil
+			})
+			instance := buildRebaseCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.InteractiveRebaseInsertAfter(commits, s.index, s.todoLine))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRebaseSetCommitsAuthorAndDateCmdObj(t *testing.T) {
+	type scenario struct {
+		testName     string
+		gitVersion   *GitVersion
+		commits      []*models.Commit
+		startIdx     int
+		endIdx       int
+		author       string
+		date         string
+		expectedCmd  string
+		expectedTodo string
+	}
+
+	commits := []*models.Commit{
+		{Name: "three", Sha: "333"},
+		{Name: "two", Sha: "222"},
+		{Name: "one", Sha: "111"},
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "changing the author of one commit",
+			gitVersion:   &GitVersion{2, 39, 0, ""},
+			commits:      commits,
+			startIdx:     1,
+			endIdx:       1,
+			author:       "Jane <jane@example.com>",
+			expectedCmd:  "git rebase --interactive --autostash --keep-empty --no-autosquash --committer-date-is-author-date 111",
+			expectedTodo: "pick 222 two\nexec git commit --amend --only --allow-empty --no-edit --author=\"Jane <jane@example.com>\"\npick 333 three\n",
+		},
+		{
+			testName:     "changing the author and date of several commits",
+			gitVersion:   &GitVersion{2, 39, 0, ""},
+			commits:      commits,
+			startIdx:     0,
+			endIdx:       2,
+			author:       "Jane <jane@example.com>",
+			date:         "2020-01-01",
+			expectedCmd:  "git rebase --interactive --autostash --keep-empty --no-autosquash --committer-date-is-author-date --root",
+			expectedTodo: "pick 111 one\nexec git commit --amend --only --allow-empty --no-edit --author=\"Jane <jane@example.com>\" --date=\"2020-01-01\"\npick 222 two\nexec git commit --amend --only --allow-empty --no-edit --author=\"Jane <jane@example.com>\" --date=\"2020-01-01\"\npick 333 three\nexec git commit --amend --only --allow-empty --no-edit --author=\"Jane <jane@example.com>\" --date=\"2020-01-01\"\n",
+		},
+		{
+			testName:     "changing only the date on an old git version",
+			gitVersion:   &GitVersion{2, 28, 0, ""},
+			commits:      commits,
+			startIdx:     1,
+			endIdx:       1,
+			date:         "2020-01-01",
+			expectedCmd:  "git rebase --interactive --autostash --keep-empty --no-autosquash 111",
+			expectedTodo: "pick 222 two\nexec git commit --amend --only --allow-empty --no-edit --date=\"2020-01-01\"\npick 333 three\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRebaseCommands(commonDeps{runner: oscommands.NewFakeRunner(t), gitVersion: s.gitVersion})
+			cmdObj := instance.SetCommitsAuthorAndDateCmdObj(s.commits, s.startIdx, s.endIdx, s.author, s.date)
+
+			assert.Equal(t, s.expectedCmd, cmdObj.ToString())
+			assert.Contains(t, cmdObj.GetEnvVars(), daemon.RebaseTODOEnvKey+"="+s.expectedTodo)
+		})
+	}
+}
+
 // TestRebaseSkipEditorCommand confirms that SkipEditorCommand injects
 // environment variables that suppress an interactive editor
 func TestRebaseSkipEditorCommand(t *testing.T) {
@@ -87,7 +352,7 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 		gitConfigMockResponses map[string]string
 		commits                []*models.Commit
 		commitIndex            int
-		fileName               string
+		fileNames              []string
 		runner                 *oscommands.FakeCmdObjRunner
 		test                   func(error)
 	}
@@ -98,7 +363,7 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 			gitConfigMockResponses: nil,
 			commits:                []*models.Commit{},
 			commitIndex:            0,
-			fileName:               "test999.txt",
+			fileNames:              []string{"test999.txt"},
 			runner:                 oscommands.NewFakeRunner(t),
 			test: func(err error) {
 				assert.Error(t, err)
@@ -109,7 +374,7 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 			gitConfigMockResponses: map[string]string{"commit.gpgsign": "true"},
 			commits:                []*models.Commit{{Name: "commit", Sha: "123456"}},
 			commitIndex:            0,
-			fileName:               "test999.txt",
+			fileNames:              []string{"test999.txt"},
 			runner:                 oscommands.NewFakeRunner(t),
 			test: func(err error) {
 				assert.Error(t, err)
@@ -123,7 +388,7 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 				{Name: "commit2", Sha: "abcdef"},
 			},
 			commitIndex: 0,
-			fileName:    "test999.txt",
+			fileNames:   []string{"test999.txt"},
 			runner: oscommands.NewFakeRunner(t).
 				Expect(`git rebase --interactive --autostash --keep-empty --no-autosquash abcdef`, "", nil).
 				Expect(`git cat-file -e HEAD^:"test999.txt"`, "", nil).
@@ -134,6 +399,27 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			testName:               "checks out each of the given files",
+			gitConfigMockResponses: nil,
+			commits: []*models.Commit{
+				{Name: "commit", Sha: "123456"},
+				{Name: "commit2", Sha: "abcdef"},
+			},
+			commitIndex: 0,
+			fileNames:   []string{"dir/file1.txt", "dir/file2.txt"},
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git rebase --interactive --autostash --keep-empty --no-autosquash abcdef`, "", nil).
+				Expect(`git cat-file -e HEAD^:"dir/file1.txt"`, "", nil).
+				Expect(`git checkout HEAD^ -- "dir/file1.txt"`, "", nil).
+				Expect(`git cat-file -e HEAD^:"dir/file2.txt"`, "", nil).
+				Expect(`git checkout HEAD^ -- "dir/file2.txt"`, "", nil).
+				Expect(`git commit --amend --no-edit --allow-empty`, "", nil).
+				Expect(`git rebase --continue`, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
 		// test for when the file was created within the commit requires a refactor to support proper mocks
 		// currently we'd need to mock out the os.Remove function and that's gonna introduce tech debt
 	}
@@ -146,7 +432,7 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 				gitConfig: git_config.NewFakeGitConfig(s.gitConfigMockResponses),
 			})
 
-			s.test(instance.DiscardOldFileChanges(s.commits, s.commitIndex, s.fileName))
+			s.test(instance.DiscardOldFileChanges(s.commits, s.commitIndex, s.fileNames))
 			s.runner.CheckForMissingCalls()
 		})
 	}
@@ -23,16 +23,19 @@ func NewReflogCommitLoader(common *common.Common, cmd oscommands.ICmdObjBuilder)
 }
 
 // GetReflogCommits only returns the new reflog commits since the given lastReflogCommit
-// if none is passed (i.e. it's value is nil) then we get all the reflog commits
-func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit, filterPath string) ([]*models.Commit, bool, error) {
+// if none is passed (i.e. it's value is nil) then we get all the reflog commits.
+// filterAuthor is matched against the commits' authors as with git log --author
+func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit, filterPaths []string, filterAuthor string) ([]*models.Commit, bool, error) {
 	commits := make([]*models.Commit, 0)
 
-	filterPathArg := ""
-	if filterPath != "" {
-		filterPathArg = fmt.Sprintf(" --follow -- %s", self.cmd.Quote(filterPath))
+	filterPathArg := filterPathArgs(self.cmd, filterPaths, true)
+
+	authorArg := ""
+	if filterAuthor != "" {
+		authorArg = " --author=" + self.cmd.Quote(filterAuthor)
 	}
 
-	cmdObj := self.cmd.New(fmt.Sprintf(`git -c log.showSignature=false log -g --abbrev=40 --format="%s"%s`, "%h%x00%ct%x00%gs%x00%p", filterPathArg)).DontLog()
+	cmdObj := self.cmd.New(fmt.Sprintf(`git -c log.showSignature=false log -g --abbrev=40 --format="%s"%s%s`, "%h%x00%ct%x00%gs%x00%p", authorArg, filterPathArg)).DontLog()
 	onlyObtainedNewReflogCommits := false
 	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
 		fields := strings.SplitN(line, "\x00", 4)
@@ -24,7 +24,8 @@ func TestGetReflogCommits(t *testing.T) {
 		testName                string
 		runner                  *oscommands.FakeCmdObjRunner
 		lastReflogCommit        *models.Commit
-		filterPath              string
+		filterPaths             []string
+		filterAuthor            string
 		expectedCommits         []*models.Commit
 		expectedOnlyObtainedNew bool
 		expectedError           error
@@ -123,7 +124,32 @@ func TestGetReflogCommits(t *testing.T) {
 				UnixTimestamp: 1643150483,
 				Parents:       []string{"51baa8c1"},
 			},
-			filterPath: "path",
+			filterPaths: []string{"path"},
+			expectedCommits: []*models.Commit{
+				{
+					Sha:           "c3c4b66b64c97ffeecde",
+					Name:          "checkout: moving from A to B",
+					Status:        "reflog",
+					UnixTimestamp: 1643150483,
+					Parents:       []string{"51baa8c1"},
+				},
+			},
+			expectedOnlyObtainedNew: true,
+			expectedError:           nil,
+		},
+		{
+			testName: "when passing filterAuthor",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git -c log.showSignature=false log -g --abbrev=40 --format="%h%x00%ct%x00%gs%x00%p" --author="John Smith"`, reflogOutput, nil),
+
+			lastReflogCommit: &models.Commit{
+				Sha:           "c3c4b66b64c97ffeecde",
+				Name:          "checkout: moving from B to A",
+				Status:        "reflog",
+				UnixTimestamp: 1643150483,
+				Parents:       []string{"51baa8c1"},
+			},
+			filterAuthor: "John Smith",
 			expectedCommits: []*models.Commit{
 				{
 					Sha:           "c3c4b66b64c97ffeecde",
@@ -142,7 +168,7 @@ func TestGetReflogCommits(t *testing.T) {
 				Expect(`git -c log.showSignature=false log -g --abbrev=40 --format="%h%x00%ct%x00%gs%x00%p"`, "", errors.New("haha")),
 
 			lastReflogCommit:        nil,
-			filterPath:              "",
+			filterPaths:             nil,
 			expectedCommits:         nil,
 			expectedOnlyObtainedNew: false,
 			expectedError:           errors.New("haha"),
@@ -157,7 +183,7 @@ func TestGetReflogCommits(t *testing.T) {
 				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
 			}
 
-			commits, onlyObtainednew, err := builder.GetReflogCommits(scenario.lastReflogCommit, scenario.filterPath)
+			commits, onlyObtainednew, err := builder.GetReflogCommits(scenario.lastReflogCommit, scenario.filterPaths, scenario.filterAuthor)
 			assert.Equal(t, scenario.expectedOnlyObtainedNew, onlyObtainednew)
 			assert.Equal(t, scenario.expectedError, err)
 			t.Logf("actual commits: \n%s", litter.Sdump(commits))
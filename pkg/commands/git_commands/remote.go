@@ -38,6 +38,28 @@ func (self *RemoteCommands) UpdateRemoteUrl(remoteName string, updatedUrl string
 		Run()
 }
 
+// SetPushUrl makes us push to the given url instead of the fetch url
+func (self *RemoteCommands) SetPushUrl(remoteName string, pushUrl string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git remote set-url --push %s %s", self.cmd.Quote(remoteName), self.cmd.Quote(pushUrl))).
+		Run()
+}
+
+// RemovePushUrl makes us push to the fetch url again
+func (self *RemoteCommands) RemovePushUrl(remoteName string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git config --unset-all %s", self.cmd.Quote("remote."+remoteName+".pushurl"))).
+		Run()
+}
+
+// SetPrune sets whether fetching from the remote also removes the remote
+// branches that no longer exist on it
+func (self *RemoteCommands) SetPrune(remoteName string, prune bool) error {
+	return self.cmd.
+		New(fmt.Sprintf("git config %s %t", self.cmd.Quote("remote."+remoteName+".prune"), prune)).
+		Run()
+}
+
 func (self *RemoteCommands) DeleteRemoteBranch(remoteName string, branchName string) error {
 	command := fmt.Sprintf("git push %s --delete %s", self.cmd.Quote(remoteName), self.cmd.Quote(branchName))
 	return self.cmd.New(command).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
@@ -7,9 +7,11 @@ import (
 
 	"github.com/jesseduffield/generics/slices"
 	gogit "github.com/jesseduffield/go-git/v5"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type RemoteLoader struct {
@@ -41,6 +43,8 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		return nil, err
 	}
 
+	pushUrls, prune := self.getPushUrlsAndPrune()
+
 	// first step is to get our remotes from go-git
 	remotes := slices.Map(goGitRemotes, func(goGitRemote *gogit.Remote) *models.Remote {
 		remoteName := goGitRemote.Config().Name
@@ -57,6 +61,8 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 		return &models.Remote{
 			Name:     goGitRemote.Config().Name,
 			Urls:     goGitRemote.Config().URLs,
+			PushUrls: pushUrls[remoteName],
+			Prune:    prune[remoteName],
 			Branches: branches,
 		}
 	})
@@ -75,3 +81,40 @@ func (self *RemoteLoader) GetRemotes() ([]*models.Remote, error) {
 
 	return remotes, nil
 }
+
+var remoteConfigRegex = regexp.MustCompile(`^remote\.(.+)\.(pushurl|prune)( (.*))?$`)
+
+// getPushUrlsAndPrune reads the push urls and prune settings of all remotes
+// from the git config, since go-git doesn't give us those
+func (self *RemoteLoader) getPushUrlsAndPrune() (map[string][]string, map[string]bool) {
+	// git exits with status 1 when no keys match, so we can't tell that apart
+	// from a real failure and just treat it as there being no such settings
+	output, err := self.cmd.New(`git config --get-regexp "^remote\..*\.(pushurl|prune)$"`).DontLog().RunWithOutput()
+	if err != nil {
+		return map[string][]string{}, map[string]bool{}
+	}
+
+	return parseRemoteConfig(output)
+}
+
+func parseRemoteConfig(output string) (map[string][]string, map[string]bool) {
+	pushUrls := map[string][]string{}
+	prune := map[string]bool{}
+
+	for _, line := range utils.SplitLines(output) {
+		match := remoteConfigRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		remoteName, key, hasValue, value := match[1], match[2], match[3] != "", match[4]
+		if key == "pushurl" {
+			pushUrls[remoteName] = append(pushUrls[remoteName], value)
+		} else {
+			// a key without a value counts as true
+			prune[remoteName] = !hasValue || git_config.IsTruthy(value)
+		}
+	}
+
+	return pushUrls, prune
+}
@@ -0,0 +1,28 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRemoteConfig(t *testing.T) {
+	output := "remote.origin.pushurl git@github.com:me/repo.git\n" +
+		"remote.origin.prune yes\n" +
+		"remote.fork.prune off\n" +
+		"remote.upstream.prune\n" +
+		"remote.other.prune 1\n" +
+		"remote.origin.pushurl git@gitlab.com:me/repo.git\n"
+
+	pushUrls, prune := parseRemoteConfig(output)
+
+	assert.EqualValues(t, map[string][]string{
+		"origin": {"git@github.com:me/repo.git", "git@gitlab.com:me/repo.git"},
+	}, pushUrls)
+	assert.EqualValues(t, map[string]bool{
+		"origin":   true,
+		"fork":     false,
+		"upstream": true,
+		"other":    true,
+	}, prune)
+}
@@ -0,0 +1,37 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteSetPushUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"remote", "set-url", "--push", "origin", "git@github.com:me/repo.git"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetPushUrl("origin", "git@github.com:me/repo.git"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteRemovePushUrl(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "--unset-all", "remote.origin.pushurl"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.RemovePushUrl("origin"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteSetPrune(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "remote.origin.prune", "true"}, "", nil).
+		ExpectGitArgs([]string{"config", "remote.origin.prune", "false"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetPrune("origin", true))
+	assert.NoError(t, instance.SetPrune("origin", false))
+	runner.CheckForMissingCalls()
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
@@ -41,6 +43,12 @@ func (self *StashCommands) Apply(index int) error {
 	return self.cmd.New(fmt.Sprintf("git stash apply stash@{%d}", index)).Run()
 }
 
+// Branch checks out a new branch at the commit the stash entry was made on,
+// then pops the stash entry onto it
+func (self *StashCommands) Branch(index int, branchName string) error {
+	return self.cmd.New(fmt.Sprintf("git stash branch %s stash@{%d}", self.cmd.Quote(branchName), index)).Run()
+}
+
 // Save save stash
 func (self *StashCommands) Save(message string) error {
 	return self.cmd.New("git stash save " + self.cmd.Quote(message)).Run()
@@ -60,11 +68,43 @@ func (self *StashCommands) Sha(index int) (string, error) {
 }
 
 func (self *StashCommands) ShowStashEntryCmdObj(index int) oscommands.ICmdObj {
-	cmdStr := fmt.Sprintf("git stash show -p --stat --color=%s --unified=%d stash@{%d}", self.UserConfig.Git.Paging.ColorArg, self.UserConfig.Git.DiffContextSize, index)
+	// older versions of git leave out any untracked files that were stashed
+	includeUntrackedFlag := ""
+	if !self.version.IsOlderThan(2, 32, 0) {
+		includeUntrackedFlag = " --include-untracked"
+	}
+	cmdStr := fmt.Sprintf("git stash show -p --stat%s --color=%s --unified=%d stash@{%d}", includeUntrackedFlag, self.UserConfig.Git.Paging.ColorArg, self.UserConfig.Git.DiffContextSize, index)
 
 	return self.cmd.New(cmdStr).DontLog()
 }
 
+// TreeIncludingUntracked returns a tree with the changes of the given stash
+// entry plus the untracked files that were stashed along with them, which git
+// keeps in the entry's third parent. If there are none, it returns an empty
+// string.
+func (self *StashCommands) TreeIncludingUntracked(index int) (string, error) {
+	ref := fmt.Sprintf("stash@{%d}", index)
+	if err := self.cmd.New(fmt.Sprintf("git rev-parse --verify --quiet %s^3", ref)).DontLog().Run(); err != nil {
+		return "", nil
+	}
+
+	tmpIndexPath := self.workingTree.temporaryFilePath(".index")
+	defer func() { _ = self.os.Remove(tmpIndexPath) }()
+	indexEnvVar := "GIT_INDEX_FILE=" + tmpIndexPath
+
+	// untracked files can't clash with the tracked ones, so we can just overlay
+	// the two trees
+	if err := self.cmd.New(fmt.Sprintf("git read-tree %s %s^3", ref, ref)).AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+		return "", err
+	}
+	tree, err := self.cmd.New("git write-tree").AddEnvVars(indexEnvVar).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(tree), nil
+}
+
 func (self *StashCommands) StashAndKeepIndex(message string) error {
 	return self.cmd.New(fmt.Sprintf("git stash save %s --keep-index", self.cmd.Quote(message))).Run()
 }
@@ -123,6 +163,84 @@ func (self *StashCommands) SaveStagedChanges(message string) error {
 	return nil
 }
 
+// StashPaths stashes only the changes to the given paths, including any of
+// them that are untracked
+func (self *StashCommands) StashPaths(message string, paths []string) error {
+	quotedPaths := slices.Map(paths, self.cmd.Quote)
+	return self.cmd.New(
+		fmt.Sprintf("git stash push --include-untracked -m %s -- %s", self.cmd.Quote(message), strings.Join(quotedPaths, " ")),
+	).Run()
+}
+
+// StashPatch stashes only the changes in the given patch, which must be
+// relative to HEAD, and then takes them out of the working tree (and out of the
+// index too if removeFromIndex is set) by applying removalPatch in reverse. We
+// can't get 'git stash' to do this, so we build the stash commit ourselves on
+// top of a temporary index and store it.
+func (self *StashCommands) StashPatch(patch string, removalPatch string, removeFromIndex bool, message string) error {
+	patchFilepath, err := self.workingTree.SaveTemporaryPatch(patch)
+	if err != nil {
+		return err
+	}
+	removalPatchFilepath, err := self.workingTree.SaveTemporaryPatch(removalPatch)
+	if err != nil {
+		return err
+	}
+
+	// check that the changes can be taken back out before we stash anything
+	removals := [][]string{{"reverse"}}
+	if removeFromIndex {
+		removals = append(removals, []string{"reverse", "cached"})
+	}
+	for _, flags := range removals {
+		if err := self.workingTree.ApplyPatchFile(removalPatchFilepath, append(flags, "check")...); err != nil {
+			return err
+		}
+	}
+
+	tmpIndexPath := self.workingTree.temporaryFilePath(".index")
+	defer func() { _ = self.os.Remove(tmpIndexPath) }()
+	indexEnvVar := "GIT_INDEX_FILE=" + tmpIndexPath
+
+	if err := self.cmd.New("git read-tree HEAD").AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+	if err := self.cmd.New(fmt.Sprintf("git apply --cached %s", self.cmd.Quote(patchFilepath))).AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+	tree, err := self.cmd.New("git write-tree").AddEnvVars(indexEnvVar).RunWithOutput()
+	if err != nil {
+		return err
+	}
+	tree = strings.TrimSpace(tree)
+
+	// a stash commit's second parent records the index, which here is the
+	// same as the stashed working tree
+	indexCommit, err := self.cmd.New(
+		fmt.Sprintf("git commit-tree %s -p HEAD -m %s", tree, self.cmd.Quote("index on "+message)),
+	).RunWithOutput()
+	if err != nil {
+		return err
+	}
+	stashCommit, err := self.cmd.New(
+		fmt.Sprintf("git commit-tree %s -p HEAD -p %s -m %s", tree, strings.TrimSpace(indexCommit), self.cmd.Quote(message)),
+	).RunWithOutput()
+	if err != nil {
+		return err
+	}
+	if err := self.Store(strings.TrimSpace(stashCommit), message); err != nil {
+		return err
+	}
+
+	for _, flags := range removals {
+		if err := self.workingTree.ApplyPatchFile(removalPatchFilepath, flags...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (self *StashCommands) StashIncludeUntrackedChanges(message string) error {
 	return self.cmd.New(fmt.Sprintf("git stash save %s --include-untracked", self.cmd.Quote(message))).Run()
 }
@@ -27,8 +27,8 @@ func NewStashLoader(
 	}
 }
 
-func (self *StashLoader) GetStashEntries(filterPath string) []*models.StashEntry {
-	if filterPath == "" {
+func (self *StashLoader) GetStashEntries(filterPaths []string) []*models.StashEntry {
+	if len(filterPaths) == 0 {
 		return self.getUnfilteredStashEntries()
 	}
 
@@ -55,7 +55,7 @@ outer:
 		currentStashEntry = self.stashEntryFromLine(lines[i], idx)
 		for i+1 < len(lines) && !isAStash(lines[i+1]) {
 			i++
-			if lines[i] == filterPath {
+			if filterPathsMatch(filterPaths, lines[i]) {
 				stashEntries = append(stashEntries, currentStashEntry)
 				continue outer
 			}
@@ -12,7 +12,7 @@ import (
 func TestGetStashEntries(t *testing.T) {
 	type scenario struct {
 		testName             string
-		filterPath           string
+		filterPaths          []string
 		runner               oscommands.ICmdObjRunner
 		expectedStashEntries []*models.StashEntry
 	}
@@ -20,14 +20,14 @@ func TestGetStashEntries(t *testing.T) {
 	scenarios := []scenario{
 		{
 			"No stash entries found",
-			"",
+			nil,
 			oscommands.NewFakeRunner(t).
 				Expect(`git stash list -z --pretty='%gs'`, "", nil),
 			[]*models.StashEntry{},
 		},
 		{
 			"Several stash entries found",
-			"",
+			nil,
 			oscommands.NewFakeRunner(t).
 				Expect(
 					`git stash list -z --pretty='%gs'`,
@@ -45,6 +45,26 @@ func TestGetStashEntries(t *testing.T) {
 				},
 			},
 		},
+		{
+			"Stash entries filtered by paths and glob patterns",
+			[]string{"docs", "pkg/**/*_test.go"},
+			oscommands.NewFakeRunner(t).
+				Expect(
+					`git stash list --name-only`,
+					"stash@{0}: WIP on master: bb86a3f update readme\nREADME.md\nstash@{1}: WIP on master: bb86a3f update docs\ndocs/Config.md\nstash@{2}: WIP on master: bb86a3f add test\npkg/utils/utils.go\npkg/utils/utils_test.go\nstash@{3}: WIP on master: bb86a3f add other test\nutils_test.go\n",
+					nil,
+				),
+			[]*models.StashEntry{
+				{
+					Index: 1,
+					Name:  "stash@{1}: WIP on master: bb86a3f update docs",
+				},
+				{
+					Index: 2,
+					Name:  "stash@{2}: WIP on master: bb86a3f add test",
+				},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -54,7 +74,7 @@ func TestGetStashEntries(t *testing.T) {
 
 			loader := NewStashLoader(utils.NewDummyCommon(), cmd)
 
-			assert.EqualValues(t, s.expectedStashEntries, loader.GetStashEntries(s.filterPath))
+			assert.EqualValues(t, s.expectedStashEntries, loader.GetStashEntries(s.filterPaths))
 		})
 	}
 }
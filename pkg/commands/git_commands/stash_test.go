@@ -1,10 +1,13 @@
 package git_commands
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,6 +38,15 @@ func TestStashPop(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "branch", "new-branch", "stash@{1}"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Branch(1, "new-branch"))
+	runner.CheckForMissingCalls()
+}
+
 func TestStashSave(t *testing.T) {
 	runner := oscommands.NewFakeRunner(t).
 		ExpectGitArgs([]string{"stash", "save", "A stash message"}, "", nil)
@@ -102,6 +114,7 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 		testName    string
 		index       int
 		contextSize int
+		gitVersion  *GitVersion
 		expected    string
 	}
 
@@ -118,6 +131,13 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			contextSize: 77,
 			expected:    "git stash show -p --stat --color=always --unified=77 stash@{5}",
 		},
+		{
+			testName:    "Include untracked files on newer versions of git",
+			index:       5,
+			contextSize: 3,
+			gitVersion:  &GitVersion{2, 32, 0, ""},
+			expected:    "git stash show -p --stat --include-untracked --color=always --unified=3 stash@{5}",
+		},
 	}
 
 	for _, s := range scenarios {
@@ -125,7 +145,7 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 		t.Run(s.testName, func(t *testing.T) {
 			userConfig := config.GetDefaultConfig()
 			userConfig.Git.DiffContextSize = s.contextSize
-			instance := buildStashCommands(commonDeps{userConfig: userConfig})
+			instance := buildStashCommands(commonDeps{userConfig: userConfig, gitVersion: s.gitVersion})
 
 			cmdStr := instance.ShowStashEntryCmdObj(s.index).ToString()
 			assert.Equal(t, s.expected, cmdStr)
@@ -179,3 +199,109 @@ func TestStashRename(t *testing.T) {
 		})
 	}
 }
+
+func TestStashStashPaths(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "push", "--include-untracked", "-m", "A stash message", "--", "dir", "file.txt"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashPaths("A stash message", []string{"dir", "file.txt"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestStashStashPatch(t *testing.T) {
+	type scenario struct {
+		testName        string
+		removeFromIndex bool
+		runner          *oscommands.FakeCmdObjRunner
+	}
+
+	expect := func(regexStr string, output string) func(cmdObj oscommands.ICmdObj) (string, error) {
+		return func(cmdObj oscommands.ICmdObj) (string, error) {
+			assert.Regexp(t, regexStr, cmdObj.ToString())
+			return output, nil
+		}
+	}
+
+	stashCommits := func(runner *oscommands.FakeCmdObjRunner) *oscommands.FakeCmdObjRunner {
+		return runner.
+			ExpectFunc(expect(`^git read-tree HEAD$`, "")).
+			ExpectFunc(expect(`^git apply --cached ".*\.patch"$`, "")).
+			ExpectFunc(expect(`^git write-tree$`, "tree123\n")).
+			ExpectFunc(expect(`^git commit-tree tree123 -p HEAD -m "index on message"$`, "index123\n")).
+			ExpectFunc(expect(`^git commit-tree tree123 -p HEAD -p index123 -m "message"$`, "stash123\n")).
+			ExpectFunc(expect(`^git stash store "stash123" -m "message"$`, ""))
+	}
+
+	scenarios := []scenario{
+		{
+			testName:        "unstaged lines",
+			removeFromIndex: false,
+			runner: stashCommits(oscommands.NewFakeRunner(t).
+				ExpectFunc(expect(`^git apply --reverse --check ".*\.patch"$`, ""))).
+				ExpectFunc(expect(`^git apply --reverse ".*\.patch"$`, "")),
+		},
+		{
+			testName:        "staged lines",
+			removeFromIndex: true,
+			runner: stashCommits(oscommands.NewFakeRunner(t).
+				ExpectFunc(expect(`^git apply --reverse --check ".*\.patch"$`, "")).
+				ExpectFunc(expect(`^git apply --reverse --cached --check ".*\.patch"$`, ""))).
+				ExpectFunc(expect(`^git apply --reverse ".*\.patch"$`, "")).
+				ExpectFunc(expect(`^git apply --reverse --cached ".*\.patch"$`, "")),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildStashCommands(commonDeps{runner: s.runner})
+
+			assert.NoError(t, instance.StashPatch("test", "removal test", s.removeFromIndex, "message"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestStashTreeIncludingUntracked(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "stash without untracked files",
+			runner: oscommands.NewFakeRunner(t).
+				Expect("git rev-parse --verify --quiet stash@{1}^3", "", errors.New("error")),
+			expected: "",
+		},
+		{
+			testName: "stash with untracked files",
+			runner: oscommands.NewFakeRunner(t).
+				Expect("git rev-parse --verify --quiet stash@{1}^3", "abc123\n", nil).
+				ExpectFunc(func(cmdObj oscommands.ICmdObj) (string, error) {
+					assert.Equal(t, "git read-tree stash@{1} stash@{1}^3", cmdObj.ToString())
+					assert.True(t, lo.SomeBy(cmdObj.GetEnvVars(), func(envVar string) bool {
+						return strings.HasPrefix(envVar, "GIT_INDEX_FILE=")
+					}))
+					return "", nil
+				}).
+				Expect("git write-tree", "tree123\n", nil),
+			expected: "tree123",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildStashCommands(commonDeps{runner: s.runner})
+
+			tree, err := instance.TreeIncludingUntracked(1)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, tree)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package git_commands
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
@@ -69,3 +72,44 @@ func IsBareRepo(osCommand *oscommands.OSCommand) (bool, error) {
 func (self *StatusCommands) IsInMergeState() (bool, error) {
 	return self.os.FileExists(filepath.Join(self.dotGitDir, "MERGE_HEAD"))
 }
+
+// LastFetchTime returns when the repo was last fetched, going by when git last
+// wrote FETCH_HEAD. The second return value is false if it was never fetched.
+func (self *StatusCommands) LastFetchTime() (time.Time, bool) {
+	info, err := os.Stat(filepath.Join(self.dotGitDir, "FETCH_HEAD"))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return info.ModTime(), true
+}
+
+// SetFsMonitor turns git's built-in filesystem monitor on or off for this repo.
+// Turning it off unsets the repo's setting rather than setting it to false, so
+// that we don't override the global config.
+func (self *StatusCommands) SetFsMonitor(value bool) error {
+	defer self.config.DropCache()
+
+	if !value {
+		return self.cmd.New("git config --unset core.fsmonitor").Run()
+	}
+
+	return self.cmd.New("git config core.fsmonitor true").Run()
+}
+
+// SetUntrackedCache turns the untracked cache on or off for this repo. Setting
+// the config keeps git from changing it back, and updating the index means we
+// don't have to wait for the next git status for it to take effect.
+func (self *StatusCommands) SetUntrackedCache(value bool) error {
+	defer self.config.DropCache()
+
+	if err := self.cmd.New(fmt.Sprintf("git config core.untrackedCache %t", value)).Run(); err != nil {
+		return err
+	}
+
+	flag := "--untracked-cache"
+	if !value {
+		flag = "--no-untracked-cache"
+	}
+	return self.cmd.New("git update-index " + flag).Run()
+}
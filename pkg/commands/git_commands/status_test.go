@@ -0,0 +1,34 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusSetFsMonitor(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git config core.fsmonitor true`, "", nil).
+		Expect(`git config --unset core.fsmonitor`, "", nil)
+
+	instance := buildStatusCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetFsMonitor(true))
+	assert.NoError(t, instance.SetFsMonitor(false))
+	runner.CheckForMissingCalls()
+}
+
+func TestStatusSetUntrackedCache(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git config core.untrackedCache true`, "", nil).
+		Expect(`git update-index --untracked-cache`, "", nil).
+		Expect(`git config core.untrackedCache false`, "", nil).
+		Expect(`git update-index --no-untracked-cache`, "", nil)
+
+	instance := buildStatusCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetUntrackedCache(true))
+	assert.NoError(t, instance.SetUntrackedCache(false))
+	runner.CheckForMissingCalls()
+}
@@ -6,10 +6,12 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // .gitmodules looks like this:
@@ -27,8 +29,19 @@ func NewSubmoduleCommands(gitCommon *GitCommon) *SubmoduleCommands {
 	}
 }
 
+// GetConfigs returns the submodules of the repo, each followed by the
+// submodules nested in it (if it's been checked out)
 func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
-	file, err := os.Open(".gitmodules")
+	return self.getConfigs(nil)
+}
+
+func (self *SubmoduleCommands) getConfigs(parentModule *models.SubmoduleConfig) ([]*models.SubmoduleConfig, error) {
+	dir := ""
+	if parentModule != nil {
+		dir = parentModule.Path
+	}
+
+	file, err := os.Open(filepath.Join(dir, ".gitmodules"))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -56,7 +69,7 @@ func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
 		line := scanner.Text()
 
 		if name, ok := firstMatch(line, `\[submodule "(.*)"\]`); ok {
-			configs = append(configs, &models.SubmoduleConfig{Name: name})
+			configs = append(configs, &models.SubmoduleConfig{Name: name, ParentModule: parentModule})
 			continue
 		}
 
@@ -64,14 +77,110 @@ func (self *SubmoduleCommands) GetConfigs() ([]*models.SubmoduleConfig, error) {
 			lastConfig := configs[len(configs)-1]
 
 			if path, ok := firstMatch(line, `\s*path\s*=\s*(.*)\s*`); ok {
-				lastConfig.Path = path
+				// the paths of nested submodules are relative to their parent
+				// module, but we want them relative to our repo
+				lastConfig.Path = filepath.ToSlash(filepath.Join(dir, path))
 			} else if url, ok := firstMatch(line, `\s*url\s*=\s*(.*)\s*`); ok {
 				lastConfig.Url = url
 			}
 		}
 	}
 
-	return configs, nil
+	result := []*models.SubmoduleConfig{}
+	for _, config := range configs {
+		result = append(result, config)
+
+		nestedConfigs, err := self.getConfigs(config)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nestedConfigs...)
+	}
+
+	return result, nil
+}
+
+// SubmoduleStatus is what we know about the checkout of a submodule
+type SubmoduleStatus struct {
+	Initialized bool
+	// the checked out commit isn't the one the parent repo has recorded
+	OutOfSync    bool
+	HasConflicts bool
+	// has uncommitted changes
+	Dirty bool
+	// how far the checked out branch is ahead of and behind its upstream. Both
+	// are zero if there is no upstream, e.g. because HEAD is detached
+	Ahead  int
+	Behind int
+}
+
+var submoduleStatusRegex = regexp.MustCompile(`^([ +\-U])[0-9a-f]+ (.*?)(?: \(.*\))?$`)
+
+// GetStatuses returns the statuses of all submodules, including nested ones,
+// keyed by their path relative to our repo. Closing stop makes us give up on
+// the submodules we haven't looked at yet.
+func (self *SubmoduleCommands) GetStatuses(stop chan struct{}) (map[string]*SubmoduleStatus, error) {
+	output, err := self.cmd.New("git submodule status --recursive").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := map[string]*SubmoduleStatus{}
+	for _, line := range utils.SplitLines(output) {
+		match := submoduleStatusRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		path := match[2]
+		status := &SubmoduleStatus{
+			Initialized:  match[1] != "-",
+			OutOfSync:    match[1] == "+",
+			HasConflicts: match[1] == "U",
+		}
+
+		select {
+		case <-stop:
+			return statuses, nil
+		default:
+		}
+
+		if status.Initialized {
+			status.Dirty = self.isDirty(path)
+			status.Ahead, status.Behind = self.getAheadBehind(path)
+		}
+
+		statuses[path] = status
+	}
+
+	return statuses, nil
+}
+
+func (self *SubmoduleCommands) isDirty(path string) bool {
+	output, err := self.cmd.New(self.inRepoOf(path) + " status --porcelain").DontLog().RunWithOutput()
+	if err != nil {
+		self.Log.Error(err)
+		return false
+	}
+
+	return strings.TrimSpace(output) != ""
+}
+
+func (self *SubmoduleCommands) getAheadBehind(path string) (int, int) {
+	// this fails if there's no upstream, in which case there's nothing to show
+	output, err := self.cmd.New(self.inRepoOf(path) + " rev-list --left-right --count HEAD...@{upstream}").DontLog().RunWithOutput()
+	if err != nil {
+		return 0, 0
+	}
+
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return 0, 0
+	}
+
+	ahead, _ := strconv.Atoi(fields[0])
+	behind, _ := strconv.Atoi(fields[1])
+	return ahead, behind
 }
 
 func (self *SubmoduleCommands) Stash(submodule *models.SubmoduleConfig) error {
@@ -86,7 +195,7 @@ func (self *SubmoduleCommands) Stash(submodule *models.SubmoduleConfig) error {
 }
 
 func (self *SubmoduleCommands) Reset(submodule *models.SubmoduleConfig) error {
-	return self.cmd.New("git submodule update --init --force -- " + self.cmd.Quote(submodule.Path)).Run()
+	return self.cmd.New(self.submoduleCmdPrefix(submodule) + "update --init --force -- " + self.cmd.Quote(submodule.PathInParent())).Run()
 }
 
 func (self *SubmoduleCommands) UpdateAll() error {
@@ -146,12 +255,79 @@ func (self *SubmoduleCommands) UpdateUrl(name string, path string, newUrl string
 	return nil
 }
 
-func (self *SubmoduleCommands) Init(path string) error {
-	return self.cmd.New("git submodule init -- " + self.cmd.Quote(path)).Run()
+func (self *SubmoduleCommands) Init(submodule *models.SubmoduleConfig) error {
+	return self.cmd.New(self.submoduleCmdPrefix(submodule) + "init -- " + self.cmd.Quote(submodule.PathInParent())).Run()
+}
+
+func (self *SubmoduleCommands) Update(submodule *models.SubmoduleConfig) error {
+	return self.cmd.New(self.submoduleCmdPrefix(submodule) + "update --init -- " + self.cmd.Quote(submodule.PathInParent())).Run()
+}
+
+// GetTrackedBranch returns the branch that the submodule follows when updating
+// it from its remote, or an empty string if it hasn't got one
+func (self *SubmoduleCommands) GetTrackedBranch(submodule *models.SubmoduleConfig) string {
+	// git exits with status 1 if the key isn't set
+	output, err := self.cmd.New(
+		self.inParentRepo(submodule) + " config --file .gitmodules --get " + self.cmd.Quote("submodule."+submodule.Name+".branch"),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
+func (self *SubmoduleCommands) SetTrackedBranch(submodule *models.SubmoduleConfig, branchName string) error {
+	// set-branch is only for later git versions so we're doing it manually here
+	return self.cmd.New(
+		self.inParentRepo(submodule) + " config --file .gitmodules " + self.cmd.Quote("submodule."+submodule.Name+".branch") + " " + self.cmd.Quote(branchName),
+	).Run()
+}
+
+// UpdateToTrackedBranch fetches the submodule's tracked branch and merges it
+// into the submodule's checkout
+func (self *SubmoduleCommands) UpdateToTrackedBranch(submodule *models.SubmoduleConfig) error {
+	return self.cmd.New(self.submoduleCmdPrefix(submodule) + "update --remote --merge -- " + self.cmd.Quote(submodule.PathInParent())).
+		PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
+}
+
+func (self *SubmoduleCommands) BulkUpdateToTrackedBranchesCmdObj() oscommands.ICmdObj {
+	return self.cmd.New("git submodule update --remote --merge").PromptOnCredentialRequest().WithMutex(self.syncMutex)
+}
+
+// GetCheckedOutSha returns the sha of the submodule's HEAD, or an empty string
+// if it hasn't been checked out
+func (self *SubmoduleCommands) GetCheckedOutSha(submodule *models.SubmoduleConfig) string {
+	output, err := self.cmd.New(self.inRepoOf(submodule.Path) + " rev-parse HEAD").DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
+// inParentRepo returns the start of a git command that runs in the repo the
+// given submodule belongs to
+func (self *SubmoduleCommands) inParentRepo(submodule *models.SubmoduleConfig) string {
+	if submodule.ParentModule == nil {
+		return "git"
+	}
+
+	return self.inRepoOf(submodule.ParentModule.Path)
+}
+
+// submoduleCmdPrefix returns the start of a `git submodule` command for the
+// given submodule. Nested submodules belong to their parent module, so that's
+// where we need to run it for them.
+func (self *SubmoduleCommands) submoduleCmdPrefix(submodule *models.SubmoduleConfig) string {
+	return self.inParentRepo(submodule) + " submodule "
 }
 
-func (self *SubmoduleCommands) Update(path string) error {
-	return self.cmd.New("git submodule update --init -- " + self.cmd.Quote(path)).Run()
+// inRepoOf returns the start of a git command that runs in the submodule at
+// the given path. -C alone isn't enough, because GIT_DIR and GIT_WORK_TREE may
+// point at our repo, so we also pass the submodule's own, which take precedence.
+func (self *SubmoduleCommands) inRepoOf(path string) string {
+	return fmt.Sprintf("git -C %s --git-dir=.git --work-tree=.", self.cmd.Quote(path))
 }
 
 func (self *SubmoduleCommands) BulkInitCmdObj() oscommands.ICmdObj {
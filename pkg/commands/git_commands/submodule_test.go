@@ -0,0 +1,116 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmoduleGetStatuses(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect("git submodule status --recursive",
+			" 1234567890abcdef1234567890abcdef12345678 lib (heads/master)\n"+
+				"+abcdef1234567890abcdef1234567890abcdef12 lib/nested (v1.0-2-gabcdef1)\n"+
+				"-0000000000000000000000000000000000000000 vendor/other\n",
+			nil).
+		Expect(`git -C "lib" --git-dir=.git --work-tree=. status --porcelain`, "", nil).
+		Expect(`git -C "lib" --git-dir=.git --work-tree=. rev-list --left-right --count HEAD...@{upstream}`, "2\t1\n", nil).
+		Expect(`git -C "lib/nested" --git-dir=.git --work-tree=. status --porcelain`, " M file\n", nil).
+		Expect(`git -C "lib/nested" --git-dir=.git --work-tree=. rev-list --left-right --count HEAD...@{upstream}`, "", errors.New("no upstream configured"))
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	statuses, err := instance.GetStatuses(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*SubmoduleStatus{
+		"lib":          {Initialized: true, Ahead: 2, Behind: 1},
+		"lib/nested":   {Initialized: true, OutOfSync: true, Dirty: true},
+		"vendor/other": {Initialized: false},
+	}, statuses)
+	runner.CheckForMissingCalls()
+}
+
+func TestSubmoduleUpdate(t *testing.T) {
+	parent := &models.SubmoduleConfig{Name: "lib", Path: "lib"}
+
+	type scenario struct {
+		testName  string
+		submodule *models.SubmoduleConfig
+		expected  []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "submodule of our repo",
+			submodule: parent,
+			expected:  []string{"submodule", "update", "--init", "--", "lib"},
+		},
+		{
+			testName:  "nested submodule",
+			submodule: &models.SubmoduleConfig{Name: "nested", Path: "lib/deps/nested", ParentModule: parent},
+			expected:  []string{"-C", "lib", "--git-dir=.git", "--work-tree=.", "submodule", "update", "--init", "--", "deps/nested"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expected, "", nil)
+			instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Update(s.submodule))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSubmoduleGetTrackedBranch(t *testing.T) {
+	submodule := &models.SubmoduleConfig{Name: "lib", Path: "lib"}
+
+	type scenario struct {
+		testName string
+		output   string
+		err      error
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "branch is set",
+			output:   "main\n",
+			expected: "main",
+		},
+		{
+			testName: "branch is not set",
+			err:      errors.New("exit status 1"),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--file", ".gitmodules", "--get", "submodule.lib.branch"}, s.output, s.err)
+			instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+			assert.Equal(t, s.expected, instance.GetTrackedBranch(submodule))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSubmoduleSetTrackedBranch(t *testing.T) {
+	parent := &models.SubmoduleConfig{Name: "lib", Path: "lib"}
+	submodule := &models.SubmoduleConfig{Name: "nested", Path: "lib/nested", ParentModule: parent}
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-C", "lib", "--git-dir=.git", "--work-tree=.", "config", "--file", ".gitmodules", "submodule.nested.branch", "main"}, "", nil)
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetTrackedBranch(submodule, "main"))
+	runner.CheckForMissingCalls()
+}
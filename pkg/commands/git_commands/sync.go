@@ -2,9 +2,11 @@ package git_commands
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type SyncCommands struct {
@@ -19,20 +21,28 @@ func NewSyncCommands(gitCommon *GitCommon) *SyncCommands {
 
 // Push pushes to a branch
 type PushOpts struct {
-	Force          bool
-	UpstreamRemote string
-	UpstreamBranch string
-	SetUpstream    bool
+	Force bool
+	// only force with lease, leaving out --force-if-includes even when git
+	// supports it
+	NoForceIfIncludes bool
+	UpstreamRemote    string
+	UpstreamBranch    string
+	SetUpstream       bool
+}
+
+// SupportsForceIfIncludes tells us whether git knows about --force-if-includes,
+// which was added in git 2.30
+func (self *SyncCommands) SupportsForceIfIncludes() bool {
+	return !self.version.IsOlderThan(2, 30, 0)
 }
 
 func (self *SyncCommands) PushCmdObj(opts PushOpts) (oscommands.ICmdObj, error) {
 	cmdStr := "git push"
 
 	if opts.Force {
-		if self.version.IsOlderThan(2, 30, 0) {
-			cmdStr += " --force-with-lease"
-		} else {
-			cmdStr += " --force-with-lease --force-if-includes"
+		cmdStr += " --force-with-lease"
+		if !opts.NoForceIfIncludes && self.SupportsForceIfIncludes() {
+			cmdStr += " --force-if-includes"
 		}
 	}
 
@@ -94,15 +104,24 @@ type PullOptions struct {
 	RemoteName      string
 	BranchName      string
 	FastForwardOnly bool
+	// Rebase and NoRebase override pull.rebase for this pull
+	Rebase   bool
+	NoRebase bool
 }
 
-func (self *SyncCommands) Pull(opts PullOptions) error {
+func (self *SyncCommands) PullCmdObj(opts PullOptions) oscommands.ICmdObj {
 	cmdStr := "git pull --no-edit"
 
 	if opts.FastForwardOnly {
 		cmdStr += " --ff-only"
 	}
 
+	if opts.Rebase {
+		cmdStr += " --rebase"
+	} else if opts.NoRebase {
+		cmdStr += " --no-rebase"
+	}
+
 	if opts.RemoteName != "" {
 		cmdStr = fmt.Sprintf("%s %s", cmdStr, self.cmd.Quote(opts.RemoteName))
 	}
@@ -112,7 +131,11 @@ func (self *SyncCommands) Pull(opts PullOptions) error {
 
 	// setting GIT_SEQUENCE_EDITOR to ':' as a way of skipping it, in case the user
 	// has 'pull.rebase = interactive' configured.
-	return self.cmd.New(cmdStr).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
+	return self.cmd.New(cmdStr).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest().WithMutex(self.syncMutex)
+}
+
+func (self *SyncCommands) Pull(opts PullOptions) error {
+	return self.PullCmdObj(opts).Run()
 }
 
 func (self *SyncCommands) FastForward(branchName string, remoteName string, remoteBranchName string) error {
@@ -124,3 +147,28 @@ func (self *SyncCommands) FetchRemote(remoteName string) error {
 	cmdStr := fmt.Sprintf("git fetch %s", self.cmd.Quote(remoteName))
 	return self.cmd.New(cmdStr).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
 }
+
+// FetchRemotes fetches the given remotes concurrently, calling onFetched as
+// each one finishes. We can't have several credential prompts open at once, so
+// a remote that asks for credentials fails to fetch.
+func (self *SyncCommands) FetchRemotes(remoteNames []string, background bool, onFetched func(remoteName string, err error)) {
+	self.syncMutex.Lock()
+	defer self.syncMutex.Unlock()
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(remoteNames))
+	for _, remoteName := range remoteNames {
+		remoteName := remoteName
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			cmdStr := fmt.Sprintf("git fetch %s", self.cmd.Quote(remoteName))
+			cmdObj := self.cmd.New(cmdStr).FailOnCredentialRequest()
+			if background {
+				cmdObj.DontLog()
+			}
+			onFetched(remoteName, cmdObj.Run())
+		})
+	}
+	wg.Wait()
+}
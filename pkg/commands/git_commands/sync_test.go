@@ -43,6 +43,15 @@ func TestSyncPush(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			testName: "Push with force enabled, without --force-if-includes",
+			version:  &GitVersion{2, 30, 0, ""},
+			opts:     PushOpts{Force: true, NoForceIfIncludes: true},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.ToString(), "git push --force-with-lease")
+				assert.NoError(t, err)
+			},
+		},
 		{
 			testName: "Push with force disabled, upstream supplied",
 			version:  &GitVersion{2, 29, 3, ""},
@@ -122,3 +131,44 @@ func TestSyncPush(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncPull(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		opts     PullOptions
+		expected string
+	}{
+		{
+			testName: "default",
+			opts:     PullOptions{},
+			expected: "git pull --no-edit",
+		},
+		{
+			testName: "fast-forward only, from upstream",
+			opts: PullOptions{
+				FastForwardOnly: true,
+				RemoteName:      "origin",
+				BranchName:      "master",
+			},
+			expected: `git pull --no-edit --ff-only "origin" "master"`,
+		},
+		{
+			testName: "rebase",
+			opts:     PullOptions{Rebase: true},
+			expected: "git pull --no-edit --rebase",
+		},
+		{
+			testName: "merge",
+			opts:     PullOptions{NoRebase: true},
+			expected: "git pull --no-edit --no-rebase",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSyncCommands(commonDeps{})
+			assert.Equal(t, s.expected, instance.PullCmdObj(s.opts).ToString())
+		})
+	}
+}
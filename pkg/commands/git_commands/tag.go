@@ -2,6 +2,9 @@ package git_commands
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
 type TagCommands struct {
@@ -37,3 +40,47 @@ func (self *TagCommands) Delete(tagName string) error {
 func (self *TagCommands) Push(remoteName string, tagName string) error {
 	return self.cmd.New(fmt.Sprintf("git push %s %s", self.cmd.Quote(remoteName), self.cmd.Quote(tagName))).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
 }
+
+// ShowCmdObj shows the tagger and full message of an annotated tag, followed by
+// the commit it points to
+func (self *TagCommands) ShowCmdObj(tagName string) oscommands.ICmdObj {
+	return self.cmd.New(fmt.Sprintf("git show --no-patch --color=%s %s", self.UserConfig.Git.Paging.ColorArg, self.cmd.Quote("refs/tags/"+tagName))).DontLog()
+}
+
+// GetMessage returns the message of an annotated tag, leaving out its signature
+func (self *TagCommands) GetMessage(tagName string) (string, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf(`git for-each-ref --format="%%(contents:subject)%%0a%%0a%%(contents:body)" %s`, self.cmd.Quote("refs/tags/"+tagName)),
+	).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// EditMessage re-creates an annotated tag with the given message, keeping it
+// pointed at the same object
+func (self *TagCommands) EditMessage(tagName string, msg string) error {
+	return self.cmd.New(
+		fmt.Sprintf("git tag --force --annotate %s %s -m %s", self.cmd.Quote(tagName), self.cmd.Quote("refs/tags/"+tagName+"^{}"), self.cmd.Quote(msg)),
+	).Run()
+}
+
+// ExistsOnRemote asks the remote whether it has the given tag
+func (self *TagCommands) ExistsOnRemote(remoteName string, tagName string) (bool, error) {
+	output, err := self.cmd.New(
+		fmt.Sprintf("git ls-remote --tags %s %s", self.cmd.Quote(remoteName), self.cmd.Quote("refs/tags/"+tagName)),
+	).
+		// we need the output, which we don't get when handling credential
+		// requests, so we'd rather fail than have git prompt for input
+		AddEnvVars("GIT_TERMINAL_PROMPT=0").
+		WithMutex(self.syncMutex).
+		DontLog().
+		RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(output) != "", nil
+}
+
+func (self *TagCommands) ForcePush(remoteName string, tagName string) error {
+	return self.cmd.New(fmt.Sprintf("git push --force %s %s", self.cmd.Quote(remoteName), self.cmd.Quote("refs/tags/"+tagName))).PromptOnCredentialRequest().WithMutex(self.syncMutex).Run()
+}
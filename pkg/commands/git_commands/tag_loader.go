@@ -1,18 +1,25 @@
 package git_commands
 
 import (
-	"regexp"
+	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
 )
 
 type TagLoader struct {
 	*common.Common
 	cmd oscommands.ICmdObjBuilder
+
+	// verifying a signature is slow, so we remember the result for each tag
+	// object. Re-signing a tag gives it a new sha, so these never go stale.
+	signatureStatusesBySha map[string]string
+	signatureStatusesMutex deadlock.Mutex
 }
 
 func NewTagLoader(
@@ -20,36 +27,78 @@ func NewTagLoader(
 	cmd oscommands.ICmdObjBuilder,
 ) *TagLoader {
 	return &TagLoader{
-		Common: common,
-		cmd:    cmd,
+		Common:                 common,
+		cmd:                    cmd,
+		signatureStatusesBySha: map[string]string{},
 	}
 }
 
 func (self *TagLoader) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
+	// get tags, sorted by creation date (descending)
 	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	tagsOutput, err := self.cmd.New(`git tag --list -n --sort=-creatordate`).DontLog().RunWithOutput()
+	tagsOutput, err := self.cmd.New(`git for-each-ref --sort=-creatordate --format="%(refname:lstrip=2)%00%(objectname)%00%(objecttype)%00%(if)%(contents:signature)%(then)signed%(end)%00%(contents:subject)" refs/tags`).DontLog().RunWithOutput()
 	if err != nil {
 		return nil, err
 	}
 
 	split := utils.SplitLines(tagsOutput)
 
-	lineRegex := regexp.MustCompile(`^([^\s]+)(\s+)?(.*)$`)
-
 	tags := slices.Map(split, func(line string) *models.Tag {
-		matches := lineRegex.FindStringSubmatch(line)
-		tagName := matches[1]
-		message := ""
-		if len(matches) > 3 {
-			message = matches[3]
+		fields := strings.SplitN(line, "\x00", 5)
+		for len(fields) < 5 {
+			fields = append(fields, "")
+		}
+
+		tag := &models.Tag{
+			Name:        fields[0],
+			IsAnnotated: fields[2] == "tag",
+			IsSigned:    fields[3] == "signed",
+			Message:     fields[4],
 		}
 
-		return &models.Tag{
-			Name:    tagName,
-			Message: message,
+		if tag.IsSigned && self.UserConfig.Gui.ShowSignatureStatus {
+			tag.SignatureStatus = self.getCachedSignatureStatus(tag.Name, fields[1])
 		}
+
+		return tag
 	})
 
 	return tags, nil
 }
+
+func (self *TagLoader) getCachedSignatureStatus(tagName string, sha string) string {
+	self.signatureStatusesMutex.Lock()
+	defer self.signatureStatusesMutex.Unlock()
+
+	if status, ok := self.signatureStatusesBySha[sha]; ok {
+		return status
+	}
+
+	status := self.getSignatureStatus(tagName)
+	self.signatureStatusesBySha[sha] = status
+	return status
+}
+
+// getSignatureStatus verifies the given signed tag, returning the letter git
+// would use for %G? if it were a commit
+func (self *TagLoader) getSignatureStatus(tagName string) string {
+	// verify-tag exits non-zero for anything but a good signature, so we just
+	// go by what it prints
+	output, _ := self.cmd.New(fmt.Sprintf("git verify-tag --raw %s", self.cmd.Quote(tagName))).DontLog().RunWithOutput()
+
+	switch {
+	case strings.Contains(output, "[GNUPG:] GOODSIG"), strings.Contains(output, `Good "git" signature`):
+		return "G"
+	case strings.Contains(output, "[GNUPG:] BADSIG"):
+		return "B"
+	case strings.Contains(output, "[GNUPG:] EXPSIG"):
+		return "X"
+	case strings.Contains(output, "[GNUPG:] EXPKEYSIG"):
+		return "Y"
+	case strings.Contains(output, "[GNUPG:] REVKEYSIG"):
+		return "R"
+	default:
+		// we couldn't check the signature, e.g. because the key is missing
+		return "E"
+	}
+}
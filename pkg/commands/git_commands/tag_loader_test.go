@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -9,35 +10,53 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `tag1 this is my message
-tag2
-tag3 this is my other message
-`
+const tagsOutput = "tag1\x00aaa111\x00tag\x00\x00this is my message\n" +
+	"tag2\x00bbb222\x00commit\x00\x00\n" +
+	"tag3\x00ccc333\x00commit\x00\x00this is my other message\n" +
+	"tag4\x00ddd444\x00tag\x00signed\x00this is a signed tag\n"
+
+const tagsCmd = `git for-each-ref --sort=-creatordate --format="%(refname:lstrip=2)%00%(objectname)%00%(objecttype)%00%(if)%(contents:signature)%(then)signed%(end)%00%(contents:subject)" refs/tags`
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
-		testName      string
-		runner        *oscommands.FakeCmdObjRunner
-		expectedTags  []*models.Tag
-		expectedError error
+		testName            string
+		showSignatureStatus bool
+		runner              *oscommands.FakeCmdObjRunner
+		expectedTags        []*models.Tag
+		expectedError       error
 	}
 
 	scenarios := []scenario{
 		{
 			testName: "should return no tags if there are none",
 			runner: oscommands.NewFakeRunner(t).
-				Expect(`git tag --list -n --sort=-creatordate`, "", nil),
+				Expect(tagsCmd, "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
 			testName: "should return tags if present",
 			runner: oscommands.NewFakeRunner(t).
-				Expect(`git tag --list -n --sort=-creatordate`, tagsOutput, nil),
+				Expect(tagsCmd, tagsOutput, nil),
+			expectedTags: []*models.Tag{
+				{Name: "tag1", Message: "this is my message", IsAnnotated: true},
+				{Name: "tag2", Message: ""},
+				{Name: "tag3", Message: "this is my other message"},
+				{Name: "tag4", Message: "this is a signed tag", IsAnnotated: true, IsSigned: true},
+			},
+			expectedError: nil,
+		},
+		{
+			testName:            "should verify signed tags if showing signature statuses",
+			showSignatureStatus: true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(tagsCmd, tagsOutput, nil).
+				Expect(`git verify-tag --raw "tag4"`, "[GNUPG:] NEWSIG\n[GNUPG:] BADSIG 1234 someone\n", errors.New("error")),
 			expectedTags: []*models.Tag{
-				{Name: "tag1", Message: "this is my message"},
+				{Name: "tag1", Message: "this is my message", IsAnnotated: true},
 				{Name: "tag2", Message: ""},
 				{Name: "tag3", Message: "this is my other message"},
+				{Name: "tag4", Message: "this is a signed tag", IsAnnotated: true, IsSigned: true, SignatureStatus: "B"},
 			},
 			expectedError: nil,
 		},
@@ -46,10 +65,9 @@ func TestGetTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		scenario := scenario
 		t.Run(scenario.testName, func(t *testing.T) {
-			loader := &TagLoader{
-				Common: utils.NewDummyCommon(),
-				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
-			}
+			common := utils.NewDummyCommon()
+			common.UserConfig.Gui.ShowSignatureStatus = scenario.showSignatureStatus
+			loader := NewTagLoader(common, oscommands.NewDummyCmdObjBuilder(scenario.runner))
 
 			tags, err := loader.GetTags()
 
@@ -60,3 +78,26 @@ func TestGetTags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTagsOnlyVerifiesEachTagObjectOnce(t *testing.T) {
+	resignedTagsOutput := "tag4\x00eee555\x00tag\x00signed\x00this is a signed tag\n"
+
+	runner := oscommands.NewFakeRunner(t).
+		Expect(tagsCmd, tagsOutput, nil).
+		Expect(`git verify-tag --raw "tag4"`, "[GNUPG:] NEWSIG\n[GNUPG:] GOODSIG 1234 someone\n", nil).
+		Expect(tagsCmd, tagsOutput, nil).
+		Expect(tagsCmd, resignedTagsOutput, nil).
+		Expect(`git verify-tag --raw "tag4"`, "[GNUPG:] NEWSIG\n[GNUPG:] BADSIG 1234 someone\n", errors.New("error"))
+
+	common := utils.NewDummyCommon()
+	common.UserConfig.Gui.ShowSignatureStatus = true
+	loader := NewTagLoader(common, oscommands.NewDummyCmdObjBuilder(runner))
+
+	for _, expectedStatus := range []string{"G", "G", "B"} {
+		tags, err := loader.GetTags()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStatus, tags[len(tags)-1].SignatureStatus)
+	}
+
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,63 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagGetMessage(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git for-each-ref --format="%(contents:subject)%0a%0a%(contents:body)" "refs/tags/v1.0"`, "release 1.0\n\nlots of fixes\n\n", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	message, err := instance.GetMessage("v1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "release 1.0\n\nlots of fixes", message)
+	runner.CheckForMissingCalls()
+}
+
+func TestTagEditMessage(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"tag", "--force", "--annotate", "v1.0", "refs/tags/v1.0^{}", "-m", "new message"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.EditMessage("v1.0", "new message"))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagExistsOnRemote(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "tag on remote",
+			output:   "1234567890abcdef\trefs/tags/v1.0\n",
+			expected: true,
+		},
+		{
+			testName: "tag not on remote",
+			output:   "",
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"ls-remote", "--tags", "origin", "refs/tags/v1.0"}, s.output, nil)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			exists, err := instance.ExistsOnRemote("origin", "v1.0")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, exists)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
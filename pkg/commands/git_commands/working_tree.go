@@ -12,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type WorkingTreeCommands struct {
@@ -40,6 +41,37 @@ func (self *WorkingTreeCommands) OpenMergeTool() error {
 	return self.OpenMergeToolCmdObj().Run()
 }
 
+// OpenMergeToolForFileCmdObj opens a mergetool on a single conflicted file,
+// using the user's mergetoolCommand template if they've set one
+func (self *WorkingTreeCommands) OpenMergeToolForFileCmdObj(path string) oscommands.ICmdObj {
+	mergetoolCmdTemplate := self.UserConfig.OS.MergetoolCommand
+	if mergetoolCmdTemplate == "" {
+		return self.cmd.New("git mergetool -- " + self.cmd.Quote(path))
+	}
+
+	templateValues := map[string]string{
+		"filename": self.cmd.Quote(path),
+	}
+
+	return self.cmd.NewShell(utils.ResolvePlaceholderString(mergetoolCmdTemplate, templateValues))
+}
+
+// RemoveMergeToolBackup removes the .orig file a mergetool leaves next to the
+// given file, unless the user has set mergetool.keepBackup
+func (self *WorkingTreeCommands) RemoveMergeToolBackup(path string) error {
+	if self.config.GetMergeToolKeepBackup() {
+		return nil
+	}
+
+	backupPath := path + ".orig"
+	exists, err := self.os.FileExists(backupPath)
+	if err != nil || !exists {
+		return err
+	}
+
+	return self.os.RemoveFile(backupPath)
+}
+
 // StageFile stages a file
 func (self *WorkingTreeCommands) StageFile(path string) error {
 	return self.StageFiles([]string{path})
@@ -52,6 +84,48 @@ func (self *WorkingTreeCommands) StageFiles(paths []string) error {
 	return self.cmd.New(fmt.Sprintf("git add -- %s", strings.Join(quotedPaths, " "))).Run()
 }
 
+// SetSkipWorktree sets or clears the skip-worktree flag of the given files, so
+// that git ignores (or stops ignoring) local changes to them
+func (self *WorkingTreeCommands) SetSkipWorktree(paths []string, value bool) error {
+	return self.setIndexFlag("skip-worktree", paths, value)
+}
+
+// SetAssumeUnchanged sets or clears the assume-unchanged flag of the given files
+func (self *WorkingTreeCommands) SetAssumeUnchanged(paths []string, value bool) error {
+	return self.setIndexFlag("assume-unchanged", paths, value)
+}
+
+func (self *WorkingTreeCommands) setIndexFlag(flag string, paths []string, value bool) error {
+	if !value {
+		flag = "no-" + flag
+	}
+
+	quotedPaths := slices.Map(paths, func(path string) string {
+		return self.cmd.Quote(path)
+	})
+	return self.cmd.New(fmt.Sprintf("git update-index --%s -- %s", flag, strings.Join(quotedPaths, " "))).Run()
+}
+
+// TrackedFilesInPath returns the paths of the tracked files at or below the given path
+func (self *WorkingTreeCommands) TrackedFilesInPath(path string) ([]string, error) {
+	output, err := self.cmd.New("git ls-files -z -- " + self.cmd.Quote(path)).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(output, "\x00")), nil
+}
+
+// UntrackedFiles returns the paths of all untracked files that aren't ignored
+func (self *WorkingTreeCommands) UntrackedFiles() ([]string, error) {
+	output, err := self.cmd.New("git ls-files -z --others --exclude-standard").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(output, "\x00")), nil
+}
+
 // StageAll stages all files
 func (self *WorkingTreeCommands) StageAll() error {
 	return self.cmd.New("git add -A").Run()
@@ -217,6 +291,12 @@ func (self *WorkingTreeCommands) Ignore(filename string) error {
 	return self.os.AppendLineToFile(".gitignore", filename)
 }
 
+// IgnoreInDirectory adds a pattern to the .gitignore in the given directory,
+// creating the .gitignore if it doesn't exist yet
+func (self *WorkingTreeCommands) IgnoreInDirectory(dir string, pattern string) error {
+	return self.os.AppendLineToFile(filepath.Join(dir, ".gitignore"), pattern)
+}
+
 // Exclude adds a file to the .git/info/exclude for the repo
 func (self *WorkingTreeCommands) Exclude(filename string) error {
 	return self.os.AppendLineToFile(".git/info/exclude", filename)
@@ -272,12 +352,76 @@ func (self *WorkingTreeCommands) ApplyPatchFile(filepath string, flags ...string
 	for _, flag := range flags {
 		flagStr += " --" + flag
 	}
+	// without any context lines git can't tell where a hunk belongs unless we
+	// tell it to trust the line numbers in the hunk headers
+	if self.UserConfig.Git.DiffContextSize == 0 {
+		flagStr += " --unidiff-zero"
+	}
 
 	return self.cmd.New(fmt.Sprintf("git apply%s %s", flagStr, self.cmd.Quote(filepath))).Run()
 }
 
+// CommitPatch creates a new commit containing only the given patch, using a
+// temporary index so that neither the working tree nor any other staged
+// changes are touched. If the patch is relative to the index (i.e. it was built
+// from unstaged changes), the changes already staged for the patch's file are
+// included in the commit too, and the patch is then also applied to the real
+// index so that it matches the new HEAD.
+func (self *WorkingTreeCommands) CommitPatch(patch string, path string, patchIsRelativeToIndex bool, commitCmdObj oscommands.ICmdObj) error {
+	patchFilepath, err := self.SaveTemporaryPatch(patch)
+	if err != nil {
+		return err
+	}
+
+	tmpIndexPath := self.temporaryFilePath(".index")
+	defer func() { _ = self.os.Remove(tmpIndexPath) }()
+	indexEnvVar := "GIT_INDEX_FILE=" + tmpIndexPath
+
+	if err := self.cmd.New("git read-tree HEAD").AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+
+	if patchIsRelativeToIndex {
+		output, err := self.cmd.New("git ls-files --stage -- " + self.cmd.Quote(path)).DontLog().RunWithOutput()
+		if err != nil {
+			return err
+		}
+		// output is of the form '<mode> <sha> <stage>\t<path>'
+		if fields := strings.Fields(output); len(fields) >= 2 {
+			cacheInfo := fmt.Sprintf("%s,%s,%s", fields[0], fields[1], path)
+			if err := self.cmd.New("git update-index --add --cacheinfo " + self.cmd.Quote(cacheInfo)).AddEnvVars(indexEnvVar).Run(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := self.cmd.New(fmt.Sprintf("git apply --cached %s", self.cmd.Quote(patchFilepath))).AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+
+	if err := commitCmdObj.AddEnvVars(indexEnvVar).Run(); err != nil {
+		return err
+	}
+
+	if patchIsRelativeToIndex {
+		return self.ApplyPatchFile(patchFilepath, "cached")
+	}
+
+	return nil
+}
+
+// temporaryFilePath returns a unique path in lazygit's temp directory for a
+// file with the given extension, making sure that the directory exists
+func (self *WorkingTreeCommands) temporaryFilePath(extension string) string {
+	dir := filepath.Join(self.os.GetTempDir(), utils.GetCurrentRepoName())
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		self.Log.Error(err)
+	}
+	return filepath.Join(dir, time.Now().Format("Jan _2 15.04.05.000000000")+extension)
+}
+
 func (self *WorkingTreeCommands) SaveTemporaryPatch(patch string) (string, error) {
-	filepath := filepath.Join(self.os.GetTempDir(), utils.GetCurrentRepoName(), time.Now().Format("Jan _2 15.04.05.000000000")+".patch")
+	filepath := self.temporaryFilePath(".patch")
 	self.Log.Infof("saving temporary patch to %s", filepath)
 	if err := self.os.CreateFileWithContent(filepath, patch); err != nil {
 		return "", err
@@ -341,6 +485,71 @@ func (self *WorkingTreeCommands) RemoveUntrackedFiles() error {
 	return self.cmd.New("git clean -fd").Run()
 }
 
+// CleanCandidate is something `git clean -d` would remove
+type CleanCandidate struct {
+	// directories end in a slash
+	Path string
+	// whether removing it would remove a nested git repository, which git
+	// only does when forced twice
+	ContainsRepo bool
+}
+
+// CleanCandidates lists what `git clean -d` would remove, including nested
+// repositories, along with ignored files if includeIgnored is true
+func (self *WorkingTreeCommands) CleanCandidates(includeIgnored bool) ([]*CleanCandidate, error) {
+	// git clean leaves nested repositories out of its dry run unless forced
+	// twice, so we ask both ways to find out which paths contain one
+	withoutRepos, err := self.cleanDryRun(includeIgnored, false)
+	if err != nil {
+		return nil, err
+	}
+
+	withRepos, err := self.cleanDryRun(includeIgnored, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Map(withRepos, func(path string) *CleanCandidate {
+		return &CleanCandidate{Path: path, ContainsRepo: !lo.Contains(withoutRepos, path)}
+	}), nil
+}
+
+func (self *WorkingTreeCommands) cleanDryRun(includeIgnored bool, includeRepos bool) ([]string, error) {
+	cmdStr := "git -c core.quotePath=false clean -nd"
+	if includeRepos {
+		cmdStr += " -ff"
+	}
+	if includeIgnored {
+		cmdStr += " -x"
+	}
+
+	output, err := self.cmd.New(cmdStr).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := "Would remove "
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (string, bool) {
+		return strings.TrimPrefix(line, prefix), strings.HasPrefix(line, prefix)
+	}), nil
+}
+
+// CleanPaths removes the given untracked paths. Nested repositories are only
+// removed if includeRepos is true.
+func (self *WorkingTreeCommands) CleanPaths(paths []string, includeIgnored bool, includeRepos bool) error {
+	cmdStr := "git clean -fd"
+	if includeRepos {
+		cmdStr = "git clean -ffd"
+	}
+	if includeIgnored {
+		cmdStr += "x"
+	}
+
+	quotedPaths := slices.Map(paths, self.cmd.Quote)
+	cmdStr = fmt.Sprintf("%s -- %s", cmdStr, strings.Join(quotedPaths, " "))
+	return self.cmd.New(cmdStr).Run()
+}
+
 // ResetAndClean removes all unstaged changes and removes all untracked files
 func (self *WorkingTreeCommands) ResetAndClean() error {
 	submoduleConfigs, err := self.submodule.GetConfigs()
@@ -3,10 +3,14 @@ package git_commands
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
@@ -33,6 +37,54 @@ func TestWorkingTreeStageFiles(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestWorkingTreeSetSkipWorktree(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git update-index --skip-worktree -- "a.txt" "dir/b.txt"`, "", nil).
+		Expect(`git update-index --no-skip-worktree -- "a.txt"`, "", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetSkipWorktree([]string{"a.txt", "dir/b.txt"}, true))
+	assert.NoError(t, instance.SetSkipWorktree([]string{"a.txt"}, false))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeSetAssumeUnchanged(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git update-index --assume-unchanged -- "a.txt"`, "", nil).
+		Expect(`git update-index --no-assume-unchanged -- "a.txt"`, "", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetAssumeUnchanged([]string{"a.txt"}, true))
+	assert.NoError(t, instance.SetAssumeUnchanged([]string{"a.txt"}, false))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeTrackedFilesInPath(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git ls-files -z -- "dir"`, "dir/a.txt\x00dir/sub/b.txt\x00", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	paths, err := instance.TrackedFilesInPath("dir")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dir/a.txt", "dir/sub/b.txt"}, paths)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeUntrackedFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		Expect(`git ls-files -z --others --exclude-standard`, "a.log\x00dir/b.txt\x00", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	paths, err := instance.UntrackedFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.log", "dir/b.txt"}, paths)
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeUnstageFile(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -484,6 +536,20 @@ func TestWorkingTreeApplyPatch(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeApplyPatchWithZeroContext(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectFunc(func(cmdObj oscommands.ICmdObj) (string, error) {
+			assert.Regexp(t, `^git apply --cached --unidiff-zero ".*"$`, cmdObj.ToString())
+			return "", nil
+		})
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.DiffContextSize = 0
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner, userConfig: userConfig})
+	assert.NoError(t, instance.ApplyPatch("test", "cached"))
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeDiscardUnstagedFileChanges(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -570,6 +636,166 @@ func TestWorkingTreeRemoveUntrackedFiles(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeCleanCandidates(t *testing.T) {
+	type scenario struct {
+		testName       string
+		includeIgnored bool
+		runner         *oscommands.FakeCmdObjRunner
+		expected       []*CleanCandidate
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "nested repo",
+			includeIgnored: false,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git -c core.quotePath=false clean -nd`, "Would remove file\nWould remove dir/\n", nil).
+				Expect(`git -c core.quotePath=false clean -nd -ff`, "Would remove file\nWould remove dir/\nWould remove repo/\n", nil),
+			expected: []*CleanCandidate{
+				{Path: "file", ContainsRepo: false},
+				{Path: "dir/", ContainsRepo: false},
+				{Path: "repo/", ContainsRepo: true},
+			},
+		},
+		{
+			testName:       "including ignored files",
+			includeIgnored: true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git -c core.quotePath=false clean -nd -x`, "Would remove file with space\n", nil).
+				Expect(`git -c core.quotePath=false clean -nd -ff -x`, "Would remove file with space\n", nil),
+			expected: []*CleanCandidate{
+				{Path: "file with space", ContainsRepo: false},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			candidates, err := instance.CleanCandidates(s.includeIgnored)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, candidates)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeCleanPaths(t *testing.T) {
+	type scenario struct {
+		testName       string
+		includeIgnored bool
+		includeRepos   bool
+		runner         *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "untracked files",
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git clean -fd -- "file with space" "dir/"`, "", nil),
+		},
+		{
+			testName:       "ignored files and repos",
+			includeIgnored: true,
+			includeRepos:   true,
+			runner: oscommands.NewFakeRunner(t).
+				Expect(`git clean -ffdx -- "file with space" "dir/"`, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.CleanPaths([]string{"file with space", "dir/"}, s.includeIgnored, s.includeRepos))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeOpenMergeToolForFileCmdObj(t *testing.T) {
+	type scenario struct {
+		testName         string
+		mergetoolCommand string
+		expected         string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:         "git mergetool by default",
+			mergetoolCommand: "",
+			expected:         `git mergetool -- "dir/file with space"`,
+		},
+		{
+			testName:         "custom mergetool command",
+			mergetoolCommand: "meld --auto-merge {{filename}}",
+			expected:         `bash -c "meld --auto-merge \"dir/file with space\""`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.OS.MergetoolCommand = s.mergetoolCommand
+
+			instance := buildWorkingTreeCommands(commonDeps{userConfig: userConfig})
+			assert.Equal(t, s.expected, instance.OpenMergeToolForFileCmdObj("dir/file with space").ToString())
+		})
+	}
+}
+
+func TestWorkingTreeRemoveMergeToolBackup(t *testing.T) {
+	type scenario struct {
+		testName       string
+		gitConfig      map[string]string
+		expectedRemove bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "keepBackup unset",
+			gitConfig:      map[string]string{},
+			expectedRemove: false,
+		},
+		{
+			testName:       "keepBackup true",
+			gitConfig:      map[string]string{"mergetool.keepBackup": "true"},
+			expectedRemove: false,
+		},
+		{
+			testName:       "keepBackup false",
+			gitConfig:      map[string]string{"mergetool.keepBackup": "false"},
+			expectedRemove: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+			assert.NoError(t, os.WriteFile(path+".orig", []byte("backup"), 0o644))
+
+			removed := []string{}
+			instance := buildWorkingTreeCommands(commonDeps{
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfig),
+				removeFile: func(path string) error {
+					removed = append(removed, path)
+					return nil
+				},
+			})
+
+			assert.NoError(t, instance.RemoveMergeToolBackup(path))
+			if s.expectedRemove {
+				assert.Equal(t, []string{path + ".orig"}, removed)
+			} else {
+				assert.Empty(t, removed)
+			}
+		})
+	}
+}
+
 func TestWorkingTreeResetHard(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -598,3 +824,64 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeCommitPatch(t *testing.T) {
+	type scenario struct {
+		testName               string
+		patchIsRelativeToIndex bool
+		runner                 *oscommands.FakeCmdObjRunner
+	}
+
+	expectWithTmpIndex := func(regexStr string, output string) func(cmdObj oscommands.ICmdObj) (string, error) {
+		return func(cmdObj oscommands.ICmdObj) (string, error) {
+			assert.Regexp(t, regexStr, cmdObj.ToString())
+			assert.True(t, slices.Some(cmdObj.GetEnvVars(), func(envVar string) bool {
+				return strings.HasPrefix(envVar, "GIT_INDEX_FILE=")
+			}), "expected command to use a temporary index: "+cmdObj.ToString())
+			return output, nil
+		}
+	}
+
+	expectWithRealIndex := func(regexStr string, output string) func(cmdObj oscommands.ICmdObj) (string, error) {
+		return func(cmdObj oscommands.ICmdObj) (string, error) {
+			assert.Regexp(t, regexStr, cmdObj.ToString())
+			assert.False(t, slices.Some(cmdObj.GetEnvVars(), func(envVar string) bool {
+				return strings.HasPrefix(envVar, "GIT_INDEX_FILE=")
+			}), "expected command to use the real index: "+cmdObj.ToString())
+			return output, nil
+		}
+	}
+
+	scenarios := []scenario{
+		{
+			testName:               "patch relative to the index",
+			patchIsRelativeToIndex: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectFunc(expectWithTmpIndex(`^git read-tree HEAD$`, "")).
+				ExpectFunc(expectWithRealIndex(`^git ls-files --stage -- "test.txt"$`, "100644 e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 0\ttest.txt\n")).
+				ExpectFunc(expectWithTmpIndex(`^git update-index --add --cacheinfo "100644,e69de29bb2d1d6434b8b29ae775ad8c2e48c5391,test.txt"$`, "")).
+				ExpectFunc(expectWithTmpIndex(`^git apply --cached ".*\.patch"$`, "")).
+				ExpectFunc(expectWithTmpIndex(`^git commit -m "message"$`, "")).
+				ExpectFunc(expectWithRealIndex(`^git apply --cached ".*\.patch"$`, "")),
+		},
+		{
+			testName:               "patch relative to HEAD",
+			patchIsRelativeToIndex: false,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectFunc(expectWithTmpIndex(`^git read-tree HEAD$`, "")).
+				ExpectFunc(expectWithTmpIndex(`^git apply --cached ".*\.patch"$`, "")).
+				ExpectFunc(expectWithTmpIndex(`^git commit -m "message"$`, "")),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			commitCmdObj := buildCommitCommands(commonDeps{runner: s.runner}).CommitCmdObj("message")
+
+			assert.NoError(t, instance.CommitPatch("test", "test.txt", s.patchIsRelativeToIndex, commitCmdObj))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package git_commands
+
+import (
+	"fmt"
+)
+
+type WorktreeCommands struct {
+	*GitCommon
+}
+
+func NewWorktreeCommands(gitCommon *GitCommon) *WorktreeCommands {
+	return &WorktreeCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// New checks out an existing local branch in a new worktree at the given path
+func (self *WorktreeCommands) New(path string, branchName string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git worktree add %s %s", self.cmd.Quote(path), self.cmd.Quote(branchName))).
+		Run()
+}
+
+// NewFromRemoteBranch creates a local branch tracking the given remote branch
+// and checks it out in a new worktree at the given path
+func (self *WorktreeCommands) NewFromRemoteBranch(path string, branchName string, remoteBranchName string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git worktree add -b %s %s %s", self.cmd.Quote(branchName), self.cmd.Quote(path), self.cmd.Quote(remoteBranchName))).
+		Run()
+}
+
+// NewDetached checks out the given ref with a detached HEAD in a new worktree
+// at the given path
+func (self *WorktreeCommands) NewDetached(path string, ref string) error {
+	return self.cmd.
+		New(fmt.Sprintf("git worktree add --detach %s %s", self.cmd.Quote(path), self.cmd.Quote(ref))).
+		Run()
+}
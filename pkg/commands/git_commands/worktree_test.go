@@ -0,0 +1,35 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorktreeNew(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"worktree", "add", "../feature", "feature"}, "", nil)
+	instance := buildWorktreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.New("../feature", "feature"))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorktreeNewFromRemoteBranch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"worktree", "add", "-b", "feature/one", "../feature-one", "origin/feature/one"}, "", nil)
+	instance := buildWorktreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.NewFromRemoteBranch("../feature-one", "feature/one", "origin/feature/one"))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorktreeNewDetached(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"worktree", "add", "--detach", "../v1.0", "v1.0"}, "", nil)
+	instance := buildWorktreeCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.NewDetached("../v1.0", "v1.0"))
+	runner.CheckForMissingCalls()
+}
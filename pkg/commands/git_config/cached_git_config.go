@@ -4,6 +4,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"github.com/sasha-s/go-deadlock"
 	"github.com/sirupsen/logrus"
 )
 
@@ -14,12 +15,16 @@ type IGitConfig interface {
 	GetGeneral(string) string
 	// this is for when you want to pass 'mykey' and check if the result is truthy
 	GetBool(string) bool
+	// forgets what we've looked up, for when we've changed the config ourselves
+	DropCache()
 }
 
 type CachedGitConfig struct {
 	cache           map[string]string
 	runGitConfigCmd func(*exec.Cmd) (string, error)
 	log             *logrus.Entry
+	// we're read from background goroutines as well as the UI thread
+	mutex deadlock.Mutex
 }
 
 func NewStdCachedGitConfig(log *logrus.Entry) *CachedGitConfig {
@@ -35,6 +40,9 @@ func NewCachedGitConfig(runGitConfigCmd func(*exec.Cmd) (string, error), log *lo
 }
 
 func (self *CachedGitConfig) Get(key string) string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
 	if value, ok := self.cache[key]; ok {
 		self.log.Debugf("using cache for key " + key)
 		return value
@@ -46,6 +54,9 @@ func (self *CachedGitConfig) Get(key string) string {
 }
 
 func (self *CachedGitConfig) GetGeneral(args string) string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
 	if value, ok := self.cache[args]; ok {
 		self.log.Debugf("using cache for args " + args)
 		return value
@@ -77,10 +88,19 @@ func (self *CachedGitConfig) getAux(key string) string {
 }
 
 func (self *CachedGitConfig) GetBool(key string) bool {
-	return isTruthy(self.Get(key))
+	return IsTruthy(self.Get(key))
+}
+
+func (self *CachedGitConfig) DropCache() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.cache = make(map[string]string)
 }
 
-func isTruthy(value string) bool {
+// IsTruthy tells us whether the value of a git config key means true, going by
+// what git itself accepts
+func IsTruthy(value string) bool {
 	lcValue := strings.ToLower(value)
 	return lcValue == "true" || lcValue == "1" || lcValue == "yes" || lcValue == "on"
 }
@@ -25,5 +25,8 @@ func (self *FakeGitConfig) GetGeneral(args string) string {
 }
 
 func (self *FakeGitConfig) GetBool(key string) bool {
-	return isTruthy(self.Get(key))
+	return IsTruthy(self.Get(key))
+}
+
+func (self *FakeGitConfig) DropCache() {
 }
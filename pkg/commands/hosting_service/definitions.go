@@ -6,7 +6,7 @@ var defaultUrlRegexStrings = []string{
 	`^(?:https?|ssh)://[^/]+/(?P<owner>.*)/(?P<repo>.*?)(?:\.git)?$`,
 	`^git@.*:(?P<owner>.*)/(?P<repo>.*?)(?:\.git)?$`,
 }
-var defaultRepoURLTemplate = "https://{{.webDomain}}/{{.owner}}/{{.repo}}"
+var defaultRepoURLTemplate = "{{.webURL}}/{{.owner}}/{{.repo}}"
 
 // we've got less type safety using go templates but this lends itself better to
 // users adding custom service definitions in their config
@@ -15,6 +15,8 @@ var githubServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}?expand=1",
 	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}?expand=1",
 	commitURL:                       "/commit/{{.CommitSha}}",
+	fileURL:                         "/blob/{{.CommitSha}}/{{.FilePath}}",
+	fileAtLinesURL:                  "/blob/{{.CommitSha}}/{{.FilePath}}#L{{.FirstLine}}-L{{.LastLine}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
 }
@@ -24,6 +26,8 @@ var bitbucketServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/pull-requests/new?source={{.From}}&t=1",
 	pullRequestURLIntoTargetBranch:  "/pull-requests/new?source={{.From}}&dest={{.To}}&t=1",
 	commitURL:                       "/commits/{{.CommitSha}}",
+	fileURL:                         "/src/{{.CommitSha}}/{{.FilePath}}",
+	fileAtLinesURL:                  "/src/{{.CommitSha}}/{{.FilePath}}#lines-{{.FirstLine}}:{{.LastLine}}",
 	regexStrings: []string{
 		`^(?:https?|ssh)://.*/(?P<owner>.*)/(?P<repo>.*?)(?:\.git)?$`,
 		`^.*@.*:(?P<owner>.*)/(?P<repo>.*?)(?:\.git)?$`,
@@ -36,6 +40,8 @@ var gitLabServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/merge_requests/new?merge_request[source_branch]={{.From}}",
 	pullRequestURLIntoTargetBranch:  "/merge_requests/new?merge_request[source_branch]={{.From}}&merge_request[target_branch]={{.To}}",
 	commitURL:                       "/commit/{{.CommitSha}}",
+	fileURL:                         "/-/blob/{{.CommitSha}}/{{.FilePath}}",
+	fileAtLinesURL:                  "/-/blob/{{.CommitSha}}/{{.FilePath}}#L{{.FirstLine}}-{{.LastLine}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
 }
@@ -45,11 +51,13 @@ var azdoServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/pullrequestcreate?sourceRef={{.From}}",
 	pullRequestURLIntoTargetBranch:  "/pullrequestcreate?sourceRef={{.From}}&targetRef={{.To}}",
 	commitURL:                       "/commit/{{.CommitSha}}",
+	fileURL:                         "?path=/{{.FilePath}}&version=GC{{.CommitSha}}",
+	fileAtLinesURL:                  "?path=/{{.FilePath}}&version=GC{{.CommitSha}}&line={{.FirstLine}}&lineEnd={{.LastLine}}",
 	regexStrings: []string{
 		`^git@ssh.dev.azure.com.*/(?P<org>.*)/(?P<project>.*)/(?P<repo>.*?)(?:\.git)?$`,
 		`^https://.*@dev.azure.com/(?P<org>.*?)/(?P<project>.*?)/_git/(?P<repo>.*?)(?:\.git)?$`,
 	},
-	repoURLTemplate: "https://{{.webDomain}}/{{.org}}/{{.project}}/_git/{{.repo}}",
+	repoURLTemplate: "{{.webURL}}/{{.org}}/{{.project}}/_git/{{.repo}}",
 }
 
 var bitbucketServerServiceDef = ServiceDefinition{
@@ -57,11 +65,36 @@ var bitbucketServerServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/pull-requests?create&sourceBranch={{.From}}",
 	pullRequestURLIntoTargetBranch:  "/pull-requests?create&targetBranch={{.To}}&sourceBranch={{.From}}",
 	commitURL:                       "/commits/{{.CommitSha}}",
+	fileURL:                         "/browse/{{.FilePath}}?at={{.CommitSha}}",
+	fileAtLinesURL:                  "/browse/{{.FilePath}}?at={{.CommitSha}}#{{.FirstLine}}-{{.LastLine}}",
 	regexStrings: []string{
 		`^ssh://git@.*/(?P<project>.*)/(?P<repo>.*?)(?:\.git)?$`,
 		`^https://.*/scm/(?P<project>.*)/(?P<repo>.*?)(?:\.git)?$`,
 	},
-	repoURLTemplate: "https://{{.webDomain}}/projects/{{.project}}/repos/{{.repo}}",
+	repoURLTemplate: "{{.webURL}}/projects/{{.project}}/repos/{{.repo}}",
+}
+
+var giteaServiceDef = ServiceDefinition{
+	provider:                        "gitea",
+	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}",
+	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}",
+	commitURL:                       "/commit/{{.CommitSha}}",
+	fileURL:                         "/src/commit/{{.CommitSha}}/{{.FilePath}}",
+	fileAtLinesURL:                  "/src/commit/{{.CommitSha}}/{{.FilePath}}#L{{.FirstLine}}-L{{.LastLine}}",
+	regexStrings:                    defaultUrlRegexStrings,
+	repoURLTemplate:                 defaultRepoURLTemplate,
+}
+
+// forgejo is a fork of gitea and has kept its URLs
+var forgejoServiceDef = ServiceDefinition{
+	provider:                        "forgejo",
+	pullRequestURLIntoDefaultBranch: giteaServiceDef.pullRequestURLIntoDefaultBranch,
+	pullRequestURLIntoTargetBranch:  giteaServiceDef.pullRequestURLIntoTargetBranch,
+	commitURL:                       giteaServiceDef.commitURL,
+	fileURL:                         giteaServiceDef.fileURL,
+	fileAtLinesURL:                  giteaServiceDef.fileAtLinesURL,
+	regexStrings:                    giteaServiceDef.regexStrings,
+	repoURLTemplate:                 giteaServiceDef.repoURLTemplate,
 }
 
 var serviceDefinitions = []ServiceDefinition{
@@ -70,6 +103,8 @@ var serviceDefinitions = []ServiceDefinition{
 	gitLabServiceDef,
 	azdoServiceDef,
 	bitbucketServerServiceDef,
+	giteaServiceDef,
+	forgejoServiceDef,
 }
 
 var defaultServiceDomains = []ServiceDomain{
@@ -1,9 +1,12 @@
 package hosting_service
 
 import (
+	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
@@ -25,6 +28,9 @@ type HostingServiceMgr struct {
 
 	// see https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	configServiceDomains map[string]string
+
+	// for talking to the service's API
+	httpClient *http.Client
 }
 
 // NewHostingServiceMgr creates new instance of PullRequest
@@ -34,6 +40,7 @@ func NewHostingServiceMgr(log logrus.FieldLogger, tr *i18n.TranslationSet, remot
 		tr:                   tr,
 		remoteURL:            remoteURL,
 		configServiceDomains: configServiceDomains,
+		httpClient:           &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
@@ -44,9 +51,9 @@ func (self *HostingServiceMgr) GetPullRequestURL(from string, to string) (string
 	}
 
 	if to == "" {
-		return gitService.getPullRequestURLIntoDefaultBranch(url.QueryEscape(from)), nil
+		return gitService.getPullRequestURLIntoDefaultBranch(from), nil
 	} else {
-		return gitService.getPullRequestURLIntoTargetBranch(url.QueryEscape(from), url.QueryEscape(to)), nil
+		return gitService.getPullRequestURLIntoTargetBranch(from, to), nil
 	}
 }
 
@@ -61,19 +68,31 @@ func (self *HostingServiceMgr) GetCommitURL(commitSha string) (string, error) {
 	return pullRequestURL, nil
 }
 
+// GetFileURL returns the URL of the file as of the given commit, highlighting
+// the lines from firstLine to lastLine unless firstLine is 0
+func (self *HostingServiceMgr) GetFileURL(commitSha string, path string, firstLine int, lastLine int) (string, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return "", err
+	}
+
+	return gitService.getFileURL(commitSha, path, firstLine, lastLine), nil
+}
+
 func (self *HostingServiceMgr) getService() (*Service, error) {
 	serviceDomain, err := self.getServiceDomain(self.remoteURL)
 	if err != nil {
 		return nil, err
 	}
 
-	repoURL, err := serviceDomain.serviceDefinition.getRepoURLFromRemoteURL(self.remoteURL, serviceDomain.webDomain)
+	repoInfo, err := serviceDomain.serviceDefinition.parseRemoteURL(self.remoteURL, serviceDomain.webDomain)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Service{
-		repoURL:           repoURL,
+		repoURL:           utils.ResolvePlaceholderString(serviceDomain.serviceDefinition.repoURLTemplate, repoInfo),
+		repoInfo:          repoInfo,
 		ServiceDefinition: serviceDomain.serviceDefinition,
 	}, nil
 }
@@ -100,15 +119,13 @@ func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
 
 	if len(self.configServiceDomains) > 0 {
 		for gitDomain, typeAndDomain := range self.configServiceDomains {
-			splitData := strings.Split(typeAndDomain, ":")
-			if len(splitData) != 2 {
+			// the web domain may come with a scheme, e.g. 'gitea:http://git.work.com'
+			provider, webDomain, found := strings.Cut(typeAndDomain, ":")
+			if !found || webDomain == "" {
 				self.log.Errorf("Unexpected format for git service: '%s'. Expected something like 'github.com:github.com'", typeAndDomain)
 				continue
 			}
 
-			provider := splitData[0]
-			webDomain := splitData[1]
-
 			serviceDefinition, ok := serviceDefinitionByProvider[provider]
 			if !ok {
 				providerNames := slices.Map(serviceDefinitions, func(serviceDefinition ServiceDefinition) string {
@@ -135,7 +152,7 @@ func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
 // the github service definition, it'll actually be served from e.g. my-custom-github.com
 type ServiceDomain struct {
 	gitDomain         string // the one that appears in the git remote url
-	webDomain         string // the one that appears in the web url
+	webDomain         string // the one that appears in the web url, optionally with a scheme
 	serviceDefinition ServiceDefinition
 }
 
@@ -144,27 +161,34 @@ type ServiceDefinition struct {
 	pullRequestURLIntoDefaultBranch string
 	pullRequestURLIntoTargetBranch  string
 	commitURL                       string
+	fileURL                         string
+	fileAtLinesURL                  string
 	regexStrings                    []string
 
-	// can expect 'webdomain' to be passed in. Otherwise, you get to pick what we match in the regex
+	// can expect 'webURL' (the web domain along with its scheme) to be passed
+	// in. Otherwise, you get to pick what we match in the regex
 	repoURLTemplate string
 }
 
-func (self ServiceDefinition) getRepoURLFromRemoteURL(url string, webDomain string) (string, error) {
+// parseRemoteURL returns the groups matched in the remote url, along with the
+// webURL
+func (self ServiceDefinition) parseRemoteURL(url string, webDomain string) (map[string]string, error) {
 	for _, regexStr := range self.regexStrings {
 		re := regexp.MustCompile(regexStr)
 		input := utils.FindNamedMatches(re, url)
 		if input != nil {
-			input["webDomain"] = webDomain
-			return utils.ResolvePlaceholderString(self.repoURLTemplate, input), nil
+			input["webURL"] = webURL(webDomain)
+			return input, nil
 		}
 	}
 
-	return "", errors.New("Failed to parse repo information from url")
+	return nil, errors.New("Failed to parse repo information from url")
 }
 
 type Service struct {
 	repoURL string
+	// what we parsed out of the remote url, e.g. the owner and repo
+	repoInfo map[string]string
 	ServiceDefinition
 }
 
@@ -180,6 +204,47 @@ func (self *Service) getCommitURL(commitSha string) string {
 	return self.resolveUrl(self.commitURL, map[string]string{"CommitSha": commitSha})
 }
 
+func (self *Service) getFileURL(commitSha string, path string, firstLine int, lastLine int) string {
+	args := map[string]string{"CommitSha": commitSha, "FilePath": path}
+	if firstLine == 0 {
+		return self.resolveUrl(self.fileURL, args)
+	}
+
+	args["FirstLine"] = strconv.Itoa(firstLine)
+	args["LastLine"] = strconv.Itoa(lastLine)
+	return self.resolveUrl(self.fileAtLinesURL, args)
+}
+
+// Placeholders in the path of the URL are escaped as path segments, so that
+// slashes in branch names stay as they are, whereas those in the query are
+// query-escaped, slashes included.
 func (self *Service) resolveUrl(templateString string, args map[string]string) string {
-	return self.repoURL + utils.ResolvePlaceholderString(templateString, args)
+	pathTemplate, queryTemplate, hasQuery := strings.Cut(templateString, "?")
+
+	result := self.repoURL + utils.ResolvePlaceholderString(pathTemplate, escapeArgs(args, escapePath))
+	if hasQuery {
+		result += "?" + utils.ResolvePlaceholderString(queryTemplate, escapeArgs(args, url.QueryEscape))
+	}
+
+	return result
+}
+
+func escapeArgs(args map[string]string, escape func(string) string) map[string]string {
+	escaped := make(map[string]string, len(args))
+	for key, value := range args {
+		escaped[key] = escape(value)
+	}
+	return escaped
+}
+
+func escapePath(path string) string {
+	return strings.Join(slices.Map(strings.Split(path, "/"), url.PathEscape), "/")
+}
+
+// we assume https unless the configured web domain says otherwise
+func webURL(webDomain string) string {
+	if strings.HasPrefix(webDomain, "http://") || strings.HasPrefix(webDomain, "https://") {
+		return strings.TrimSuffix(webDomain, "/")
+	}
+	return "https://" + webDomain
 }
@@ -44,7 +44,7 @@ func TestGetPullRequestURL(t *testing.T) {
 			remoteUrl: "git@github.com:peter/calculator.git",
 			test: func(url string, err error) {
 				assert.NoError(t, err)
-				assert.Equal(t, "https://github.com/peter/calculator/compare/feature%2Fsum-operation?expand=1", url)
+				assert.Equal(t, "https://github.com/peter/calculator/compare/feature/sum-operation?expand=1", url)
 			},
 		},
 		{
@@ -53,7 +53,7 @@ func TestGetPullRequestURL(t *testing.T) {
 			remoteUrl: "https://github.com/peter/calculator.git",
 			test: func(url string, err error) {
 				assert.NoError(t, err)
-				assert.Equal(t, "https://github.com/peter/calculator/compare/feature%2Fsum-operation?expand=1", url)
+				assert.Equal(t, "https://github.com/peter/calculator/compare/feature/sum-operation?expand=1", url)
 			},
 		},
 		{
@@ -83,7 +83,7 @@ func TestGetPullRequestURL(t *testing.T) {
 			remoteUrl: "git@github.com:peter/calculator.git",
 			test: func(url string, err error) {
 				assert.NoError(t, err)
-				assert.Equal(t, "https://github.com/peter/calculator/compare/feature%2Foperations...feature%2Fsum-operation?expand=1", url)
+				assert.Equal(t, "https://github.com/peter/calculator/compare/feature/operations...feature/sum-operation?expand=1", url)
 			},
 		},
 		{
@@ -93,7 +93,7 @@ func TestGetPullRequestURL(t *testing.T) {
 			remoteUrl: "https://github.com/peter/calculator.git",
 			test: func(url string, err error) {
 				assert.NoError(t, err)
-				assert.Equal(t, "https://github.com/peter/calculator/compare/feature%2Foperations...feature%2Fsum-operation?expand=1", url)
+				assert.Equal(t, "https://github.com/peter/calculator/compare/feature/operations...feature/sum-operation?expand=1", url)
 			},
 		},
 		{
@@ -300,7 +300,7 @@ func TestGetPullRequestURL(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, "https://bitbucket.org/johndoe/social_network/pull-requests/new?source=feature%2Fprofile-page&t=1", url)
 			},
-			expectedLoggedErrors: []string{"Unknown git service type: 'noservice'. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer"},
+			expectedLoggedErrors: []string{"Unknown git service type: 'noservice'. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer, gitea, forgejo"},
 		},
 		{
 			testName:  "Escapes reserved URL characters in from branch name",
@@ -322,6 +322,67 @@ func TestGetPullRequestURL(t *testing.T) {
 				assert.Equal(t, "https://gitlab.com/me/public/repo-with-issues/merge_requests/new?merge_request[source_branch]=yolo&merge_request[target_branch]=archive%2Fnever-ending-feature%23666", url)
 			},
 		},
+		{
+			testName:  "Keeps slashes but escapes other reserved URL characters in branch names in the path",
+			from:      "feature/someIssue#123",
+			to:        "release/2.0",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://github.com/peter/calculator/compare/release/2.0...feature/someIssue%23123?expand=1", url)
+			},
+		},
+		{
+			testName:  "Opens a link to new pull request on a self-hosted gitea with a scheme in the web domain",
+			from:      "feature/new",
+			to:        "main",
+			remoteUrl: "git@git.mycompany.com:myteam/myrepo.git",
+			configServiceDomains: map[string]string{
+				"git.mycompany.com": "gitea:https://git.mycompany.com",
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://git.mycompany.com/myteam/myrepo/compare/main...feature/new", url)
+			},
+		},
+		{
+			testName:  "Opens a link to new pull request on a self-hosted gitea into the default branch",
+			from:      "feature/new",
+			remoteUrl: "https://git.mycompany.com/myteam/myrepo.git",
+			configServiceDomains: map[string]string{
+				"git.mycompany.com": "gitea:git.mycompany.com",
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://git.mycompany.com/myteam/myrepo/compare/feature/new", url)
+			},
+		},
+		{
+			testName:  "Opens a link to new pull request on a self-hosted forgejo served over http",
+			from:      "feature/new",
+			to:        "dev",
+			remoteUrl: "ssh://git@forge.lan:2222/myteam/myrepo.git",
+			configServiceDomains: map[string]string{
+				"forge.lan": "forgejo:http://forge.lan:3000/",
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "http://forge.lan:3000/myteam/myrepo/compare/dev...feature/new", url)
+			},
+		},
+		{
+			testName:  "Opens a link to new merge request on a self-hosted gitlab with specific target branch",
+			from:      "feature/commit-ui",
+			to:        "epic/ui",
+			remoteUrl: "git@gitlab.mycompany.com:peter/calculator.git",
+			configServiceDomains: map[string]string{
+				"gitlab.mycompany.com": "gitlab:https://gitlab.mycompany.com",
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://gitlab.mycompany.com/peter/calculator/merge_requests/new?merge_request[source_branch]=feature%2Fcommit-ui&merge_request[target_branch]=epic%2Fui", url)
+			},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -335,3 +396,90 @@ func TestGetPullRequestURL(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFileURL(t *testing.T) {
+	type scenario struct {
+		testName             string
+		path                 string
+		firstLine            int
+		lastLine             int
+		remoteUrl            string
+		configServiceDomains map[string]string
+		expected             string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "file on github",
+			path:      "pkg/calculator/sum.go",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			expected:  "https://github.com/peter/calculator/blob/c83a8c4/pkg/calculator/sum.go",
+		},
+		{
+			testName:  "lines of a file on github",
+			path:      "pkg/calculator/sum.go",
+			firstLine: 12,
+			lastLine:  15,
+			remoteUrl: "git@github.com:peter/calculator.git",
+			expected:  "https://github.com/peter/calculator/blob/c83a8c4/pkg/calculator/sum.go#L12-L15",
+		},
+		{
+			testName:  "line of a file on gitlab, with a path needing escaping",
+			path:      "docs/my notes#1.md",
+			firstLine: 3,
+			lastLine:  3,
+			remoteUrl: "git@gitlab.com:peter/calculator.git",
+			expected:  "https://gitlab.com/peter/calculator/-/blob/c83a8c4/docs/my%20notes%231.md#L3-3",
+		},
+		{
+			testName:  "lines of a file on bitbucket",
+			path:      "README.md",
+			firstLine: 1,
+			lastLine:  2,
+			remoteUrl: "git@bitbucket.org:johndoe/social_network.git",
+			expected:  "https://bitbucket.org/johndoe/social_network/src/c83a8c4/README.md#lines-1:2",
+		},
+		{
+			testName:  "lines of a file on azure devops",
+			path:      "src/app.ts",
+			firstLine: 4,
+			lastLine:  8,
+			remoteUrl: "git@ssh.dev.azure.com:v3/myorg/myproject/myrepo",
+			expected:  "https://dev.azure.com/myorg/myproject/_git/myrepo?path=/src%2Fapp.ts&version=GCc83a8c4&line=4&lineEnd=8",
+		},
+		{
+			testName:  "lines of a file on a self-hosted bitbucket server",
+			path:      "src/app.ts",
+			firstLine: 4,
+			lastLine:  8,
+			remoteUrl: "ssh://git@mycompany.bitbucket.com/myproject/myrepo.git",
+			configServiceDomains: map[string]string{
+				"mycompany.bitbucket.com": "bitbucketServer:mycompany.bitbucket.com",
+			},
+			expected: "https://mycompany.bitbucket.com/projects/myproject/repos/myrepo/browse/src/app.ts?at=c83a8c4#4-8",
+		},
+		{
+			testName:  "lines of a file on a self-hosted gitea",
+			path:      "main.go",
+			firstLine: 7,
+			lastLine:  9,
+			remoteUrl: "git@git.mycompany.com:team/repo.git",
+			configServiceDomains: map[string]string{
+				"git.mycompany.com": "gitea:http://git.mycompany.com:3000",
+			},
+			expected: "http://git.mycompany.com:3000/team/repo/src/commit/c83a8c4/main.go#L7-L9",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, &tr, s.remoteUrl, s.configServiceDomains)
+			url, err := hostingServiceMgr.GetFileURL("c83a8c4", s.path, s.firstLine, s.lastLine)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, url)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package hosting_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// GetPullRequests returns the pull requests opened from the given branches of
+// the repo itself (as opposed to those of forks), keyed by branch name.
+// getToken is given the provider and host of the service and returns the token
+// to authenticate with, or "" if there isn't one.
+func (self *HostingServiceMgr) GetPullRequests(branchNames []string, getToken func(provider string, host string) string) (map[string]*models.PullRequest, error) {
+	if len(branchNames) == 0 {
+		return map[string]*models.PullRequest{}, nil
+	}
+
+	service, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	webURL, err := url.Parse(service.repoInfo["webURL"])
+	if err != nil {
+		return nil, err
+	}
+	token := getToken(service.provider, webURL.Host)
+
+	switch service.provider {
+	case githubServiceDef.provider:
+		if token == "" {
+			return nil, errors.New(fmt.Sprintf(self.tr.NoHostingServiceToken, webURL.Host))
+		}
+		return self.getGithubPullRequests(service, webURL, token, branchNames)
+	case gitLabServiceDef.provider:
+		return self.getGitlabMergeRequests(service, token)
+	default:
+		return nil, errors.New(fmt.Sprintf(self.tr.PullRequestStatusNotSupported, service.provider))
+	}
+}
+
+// the graphql API gives us the review decision along with the pull requests,
+// which the REST API would need a request per pull request for. It can only
+// filter pull requests by a single branch name, so we ask for each branch's
+// under an alias of its own, a batch of branches at a time.
+const githubBranchesPerQuery = 50
+
+func githubPullRequestsQuery(branchCount int) string {
+	var query strings.Builder
+	query.WriteString("query($owner: String!, $repo: String!")
+	for i := 0; i < branchCount; i++ {
+		fmt.Fprintf(&query, ", $branch%d: String!", i)
+	}
+	query.WriteString(") {\n  repository(owner: $owner, name: $repo) {\n")
+	for i := 0; i < branchCount; i++ {
+		fmt.Fprintf(&query, "    branch%d: pullRequests(headRefName: $branch%d, first: 10, orderBy: {field: CREATED_AT, direction: DESC}) {\n", i, i)
+		query.WriteString("      nodes { number url state headRefName isCrossRepository reviewDecision }\n")
+		query.WriteString("    }\n")
+	}
+	query.WriteString("  }\n}")
+
+	return query.String()
+}
+
+type githubPullRequestNode struct {
+	Number            int    `json:"number"`
+	URL               string `json:"url"`
+	State             string `json:"state"`
+	HeadRefName       string `json:"headRefName"`
+	IsCrossRepository bool   `json:"isCrossRepository"`
+	ReviewDecision    string `json:"reviewDecision"`
+}
+
+type githubPullRequestsResponse struct {
+	Data struct {
+		// keyed by the alias of each branch
+		Repository map[string]struct {
+			Nodes []githubPullRequestNode `json:"nodes"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (self *HostingServiceMgr) getGithubPullRequests(service *Service, webURL *url.URL, token string, branchNames []string) (map[string]*models.PullRequest, error) {
+	result := map[string]*models.PullRequest{}
+	for _, batch := range lo.Chunk(branchNames, githubBranchesPerQuery) {
+		if err := self.getGithubPullRequestsBatch(service, webURL, token, batch, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (self *HostingServiceMgr) getGithubPullRequestsBatch(service *Service, webURL *url.URL, token string, branchNames []string, result map[string]*models.PullRequest) error {
+	variables := map[string]string{
+		"owner": service.repoInfo["owner"],
+		"repo":  service.repoInfo["repo"],
+	}
+	for i, branchName := range branchNames {
+		variables[fmt.Sprintf("branch%d", i)] = branchName
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     githubPullRequestsQuery(len(branchNames)),
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, githubGraphqlURL(webURL), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	var response githubPullRequestsResponse
+	if err := self.requestJSON(req, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return errors.New(response.Errors[0].Message)
+	}
+
+	for i := range branchNames {
+		for _, node := range response.Data.Repository[fmt.Sprintf("branch%d", i)].Nodes {
+			if node.IsCrossRepository {
+				continue
+			}
+
+			state := models.PullRequestOpen
+			switch node.State {
+			case "MERGED":
+				state = models.PullRequestMerged
+			case "CLOSED":
+				state = models.PullRequestClosed
+			}
+
+			addPullRequest(result, node.HeadRefName, &models.PullRequest{
+				Number:   node.Number,
+				State:    state,
+				Approved: node.ReviewDecision == "APPROVED",
+				URL:      node.URL,
+			})
+		}
+	}
+
+	return nil
+}
+
+// github enterprise serves its API from the same domain as the web interface
+func githubGraphqlURL(webURL *url.URL) string {
+	if webURL.Host == "github.com" {
+		return "https://api.github.com/graphql"
+	}
+	return webURL.String() + "/api/graphql"
+}
+
+type gitlabMergeRequest struct {
+	IID int `json:"iid"`
+	// one of opened, closed, locked or merged
+	State           string `json:"state"`
+	WebURL          string `json:"web_url"`
+	SourceBranch    string `json:"source_branch"`
+	SourceProjectID int    `json:"source_project_id"`
+	ProjectID       int    `json:"project_id"`
+}
+
+func (self *HostingServiceMgr) getGitlabMergeRequests(service *Service, token string) (map[string]*models.PullRequest, error) {
+	// the owner includes any subgroups, and the whole path has to be escaped
+	// as a single segment
+	projectID := url.PathEscape(service.repoInfo["owner"] + "/" + service.repoInfo["repo"])
+	requestURL := fmt.Sprintf("%s/api/v4/projects/%s/merge_requests?state=all&order_by=created_at&sort=desc&per_page=100", service.repoInfo["webURL"], projectID)
+
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// public projects can be read without a token
+	if token != "" {
+		req.Header.Set("PRIVATE-TOKEN", token)
+	}
+
+	var mergeRequests []gitlabMergeRequest
+	if err := self.requestJSON(req, &mergeRequests); err != nil {
+		return nil, err
+	}
+
+	result := map[string]*models.PullRequest{}
+	for _, mergeRequest := range mergeRequests {
+		if mergeRequest.SourceProjectID != mergeRequest.ProjectID {
+			continue
+		}
+
+		state := models.PullRequestOpen
+		switch mergeRequest.State {
+		case "merged":
+			state = models.PullRequestMerged
+		case "closed", "locked":
+			state = models.PullRequestClosed
+		}
+
+		addPullRequest(result, mergeRequest.SourceBranch, &models.PullRequest{
+			Number: mergeRequest.IID,
+			State:  state,
+			URL:    mergeRequest.WebURL,
+		})
+	}
+
+	return result, nil
+}
+
+// where a branch has had several pull requests we show the open one, failing
+// that the newest. Expects to be given the newest first.
+func addPullRequest(pullRequests map[string]*models.PullRequest, branchName string, pullRequest *models.PullRequest) {
+	existing, ok := pullRequests[branchName]
+	if !ok || (existing.State != models.PullRequestOpen && pullRequest.State == models.PullRequestOpen) {
+		pullRequests[branchName] = pullRequest
+	}
+}
+
+func (self *HostingServiceMgr) requestJSON(req *http.Request, result interface{}) error {
+	response, err := self.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return errors.New(fmt.Sprintf("%s %s: %s", req.Method, req.URL.String(), response.Status))
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
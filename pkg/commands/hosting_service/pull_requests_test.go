@@ -0,0 +1,179 @@
+package hosting_service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/fakes"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPullRequests(t *testing.T) {
+	type scenario struct {
+		testName  string
+		provider  string
+		remoteUrl string
+		token     string
+		// the branches we're asking about
+		branchNames []string
+		// given the request, returns the status code and body to respond with
+		handler        func(t *testing.T, r *http.Request) (int, string)
+		expected       map[string]*models.PullRequest
+		expectedErrMsg string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "github enterprise",
+			provider:    "github",
+			remoteUrl:   "git@git.mycompany.com:peter/calculator.git",
+			token:       "secret",
+			branchNames: []string{"feature/add", "feature/sub", "fix", "docs"},
+			handler: func(t *testing.T, r *http.Request) (int, string) {
+				assert.Equal(t, "/api/graphql", r.URL.Path)
+				assert.Equal(t, "bearer secret", r.Header.Get("Authorization"))
+
+				var body struct {
+					Query     string            `json:"query"`
+					Variables map[string]string `json:"variables"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{
+					"owner":   "peter",
+					"repo":    "calculator",
+					"branch0": "feature/add",
+					"branch1": "feature/sub",
+					"branch2": "fix",
+					"branch3": "docs",
+				}, body.Variables)
+				assert.Contains(t, body.Query, "branch3: pullRequests(headRefName: $branch3,")
+
+				return http.StatusOK, `{"data": {"repository": {
+					"branch0": {"nodes": [
+						{"number": 5, "url": "https://git.mycompany.com/peter/calculator/pull/5", "state": "OPEN", "headRefName": "feature/add", "isCrossRepository": false, "reviewDecision": "APPROVED"},
+						{"number": 3, "url": "https://git.mycompany.com/peter/calculator/pull/3", "state": "CLOSED", "headRefName": "feature/add", "isCrossRepository": false, "reviewDecision": null}
+					]},
+					"branch1": {"nodes": [
+						{"number": 4, "url": "https://git.mycompany.com/peter/calculator/pull/4", "state": "OPEN", "headRefName": "feature/sub", "isCrossRepository": true, "reviewDecision": null}
+					]},
+					"branch2": {"nodes": [
+						{"number": 2, "url": "https://git.mycompany.com/peter/calculator/pull/2", "state": "MERGED", "headRefName": "fix", "isCrossRepository": false, "reviewDecision": null}
+					]},
+					"branch3": {"nodes": [
+						{"number": 1, "url": "https://git.mycompany.com/peter/calculator/pull/1", "state": "OPEN", "headRefName": "docs", "isCrossRepository": false, "reviewDecision": "REVIEW_REQUIRED"}
+					]}
+				}}}`
+			},
+			expected: map[string]*models.PullRequest{
+				"feature/add": {Number: 5, State: models.PullRequestOpen, Approved: true, URL: "https://git.mycompany.com/peter/calculator/pull/5"},
+				"fix":         {Number: 2, State: models.PullRequestMerged, URL: "https://git.mycompany.com/peter/calculator/pull/2"},
+				"docs":        {Number: 1, State: models.PullRequestOpen, URL: "https://git.mycompany.com/peter/calculator/pull/1"},
+			},
+		},
+		{
+			testName:       "github without a token",
+			provider:       "github",
+			remoteUrl:      "git@git.mycompany.com:peter/calculator.git",
+			branchNames:    []string{"main"},
+			handler:        nil,
+			expectedErrMsg: "No API token for",
+		},
+		{
+			testName:    "github API error",
+			provider:    "github",
+			remoteUrl:   "git@git.mycompany.com:peter/calculator.git",
+			token:       "secret",
+			branchNames: []string{"main"},
+			handler: func(t *testing.T, r *http.Request) (int, string) {
+				return http.StatusOK, `{"data": null, "errors": [{"message": "Could not resolve to a Repository"}]}`
+			},
+			expectedErrMsg: "Could not resolve to a Repository",
+		},
+		{
+			testName:    "self-hosted gitlab in nested groups",
+			provider:    "gitlab",
+			remoteUrl:   "git@git.mycompany.com:peter/public/calculator.git",
+			token:       "secret",
+			branchNames: []string{"feature/ui", "spike"},
+			handler: func(t *testing.T, r *http.Request) (int, string) {
+				assert.Equal(t, "/api/v4/projects/peter%2Fpublic%2Fcalculator/merge_requests", r.URL.EscapedPath())
+				assert.Equal(t, "secret", r.Header.Get("PRIVATE-TOKEN"))
+
+				return http.StatusOK, `[
+					{"iid": 7, "state": "merged", "web_url": "https://git.mycompany.com/peter/public/calculator/-/merge_requests/7", "source_branch": "feature/ui", "source_project_id": 10, "project_id": 10},
+					{"iid": 6, "state": "opened", "web_url": "https://git.mycompany.com/peter/public/calculator/-/merge_requests/6", "source_branch": "fork-branch", "source_project_id": 11, "project_id": 10},
+					{"iid": 5, "state": "closed", "web_url": "https://git.mycompany.com/peter/public/calculator/-/merge_requests/5", "source_branch": "spike", "source_project_id": 10, "project_id": 10}
+				]`
+			},
+			expected: map[string]*models.PullRequest{
+				"feature/ui": {Number: 7, State: models.PullRequestMerged, URL: "https://git.mycompany.com/peter/public/calculator/-/merge_requests/7"},
+				"spike":      {Number: 5, State: models.PullRequestClosed, URL: "https://git.mycompany.com/peter/public/calculator/-/merge_requests/5"},
+			},
+		},
+		{
+			testName:    "gitlab request failure",
+			provider:    "gitlab",
+			remoteUrl:   "git@git.mycompany.com:peter/calculator.git",
+			branchNames: []string{"main"},
+			handler: func(t *testing.T, r *http.Request) (int, string) {
+				assert.Equal(t, "", r.Header.Get("PRIVATE-TOKEN"))
+				return http.StatusNotFound, `{"message": "404 Project Not Found"}`
+			},
+			expectedErrMsg: "404 Not Found",
+		},
+		{
+			testName:  "no branches to ask about",
+			provider:  "github",
+			remoteUrl: "git@git.mycompany.com:peter/calculator.git",
+			token:     "secret",
+			handler:   nil,
+			expected:  map[string]*models.PullRequest{},
+		},
+		{
+			testName:       "unsupported service",
+			provider:       "bitbucket",
+			remoteUrl:      "git@git.mycompany.com:peter/calculator.git",
+			branchNames:    []string{"main"},
+			expectedErrMsg: "Pull request status isn't supported for bitbucket",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if s.handler == nil {
+					t.Errorf("unexpected request to %s", r.URL)
+					return
+				}
+				status, body := s.handler(t, r)
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, &tr, s.remoteUrl, map[string]string{
+				"git.mycompany.com": s.provider + ":" + server.URL,
+			})
+
+			pullRequests, err := hostingServiceMgr.GetPullRequests(s.branchNames, func(provider string, host string) string {
+				assert.Equal(t, s.provider, provider)
+				assert.Equal(t, server.Listener.Addr().String(), host)
+				return s.token
+			})
+
+			if s.expectedErrMsg != "" {
+				assert.ErrorContains(t, err, s.expectedErrMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, pullRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import "github.com/jesseduffield/lazygit/pkg/utils"
+
+// git blame uses this sha for lines which have yet to be committed
+const UncommittedSha = "0000000000000000000000000000000000000000"
+
+// BlameLine is a line of a file along with the commit which last changed it
+type BlameLine struct {
+	Sha           string
+	AuthorName    string
+	UnixTimestamp int64
+	Summary       string
+
+	// the commit which last touched the file before Sha did, and the file's
+	// path there, for digging past Sha. Empty if Sha added the file.
+	PreviousSha  string
+	PreviousPath string
+
+	LineNumber int
+	// the number of the line in Sha, which may differ from LineNumber
+	OriginalLineNumber int
+	Content            string
+}
+
+func (self *BlameLine) ShortSha() string {
+	return utils.ShortSha(self.Sha)
+}
+
+func (self *BlameLine) IsUncommitted() bool {
+	return self.Sha == UncommittedSha
+}
+
+func (self *BlameLine) ID() string {
+	return self.Sha
+}
+
+func (self *BlameLine) Description() string {
+	return self.ShortSha() + " " + self.Summary
+}
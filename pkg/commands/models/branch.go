@@ -17,6 +17,8 @@ type Branch struct {
 	// 'git@github.com:tiwood/lazygit.git'
 	UpstreamRemote string
 	UpstreamBranch string
+	// the path of the other worktree that this branch is checked out in, if any
+	WorktreePath string
 }
 
 func (b *Branch) FullRefName() string {
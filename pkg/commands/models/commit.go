@@ -14,7 +14,7 @@ type Commit struct {
 	Sha           string
 	Name          string
 	Status        string // one of "unpushed", "pushed", "merged", "rebasing" or "selected"
-	Action        string // one of "", "pick", "edit", "squash", "reword", "drop", "fixup"
+	Action        string // one of "", "pick", "edit", "squash", "reword", "drop", "fixup", "exec", "break", "label", "reset", "merge"
 	Tags          []string
 	ExtraInfo     string // something like 'HEAD -> master, tag: v0.15.2'
 	AuthorName    string // something like 'Jesse Duffield'
@@ -53,7 +53,7 @@ func (c *Commit) ID() string {
 }
 
 func (c *Commit) Description() string {
-	return fmt.Sprintf("%s %s", c.Sha[:7], c.Name)
+	return fmt.Sprintf("%s %s", utils.ShortSha(c.Sha), c.Name)
 }
 
 func (c *Commit) IsMerge() bool {
@@ -65,3 +65,25 @@ func (c *Commit) IsMerge() bool {
 func (c *Commit) IsTODO() bool {
 	return c.Action != ""
 }
+
+// returns true if this TODO is a command of its own (e.g. 'exec' or 'label')
+// rather than an action to apply to a commit. These have no sha, except for
+// 'merge' entries that reuse the message of an existing merge commit.
+func (c *Commit) IsTODOCommand() bool {
+	switch c.Action {
+	case "exec", "break", "label", "reset", "merge":
+		return true
+	}
+	return false
+}
+
+// returns true if this TODO is one of the entries that
+// 'git rebase --rebase-merges' uses to recreate merges. Moving a commit past
+// one of these would change which branch the commit ends up on.
+func (c *Commit) IsRebaseMergesTODO() bool {
+	switch c.Action {
+	case "label", "reset", "merge":
+		return true
+	}
+	return false
+}
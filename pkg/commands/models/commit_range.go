@@ -0,0 +1,38 @@
+package models
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// CommitRange is the difference between two arbitrary commits, which we can
+// show the files of just like we do for the changes of a single commit
+type CommitRange struct {
+	From string
+	To   string
+	// if set, we're showing the changes on To since it diverged from From (as
+	// in 'git diff From...To') and this is the commit it diverged at
+	MergeBase string
+}
+
+func (r *CommitRange) FullRefName() string {
+	return r.To
+}
+
+func (r *CommitRange) RefName() string {
+	return r.To
+}
+
+func (r *CommitRange) ParentRefName() string {
+	if r.MergeBase != "" {
+		return r.MergeBase
+	}
+	return r.From
+}
+
+func (r *CommitRange) Description() string {
+	separator := ".."
+	if r.MergeBase != "" {
+		separator = "..."
+	}
+	return utils.ShortSha(r.From) + separator + utils.ShortSha(r.To)
+}
@@ -20,6 +20,16 @@ type File struct {
 	DisplayString           string
 	Type                    string // one of 'file', 'directory', and 'other'
 	ShortStatus             string // e.g. 'AD', ' A', 'M ', '??'
+	SkipWorktree            bool
+	AssumeUnchanged         bool
+}
+
+// FlaggedFile is a tracked file that git has been told to ignore changes to,
+// via `git update-index --skip-worktree` or `--assume-unchanged`
+type FlaggedFile struct {
+	Name            string
+	SkipWorktree    bool
+	AssumeUnchanged bool
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
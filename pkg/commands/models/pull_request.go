@@ -0,0 +1,19 @@
+package models
+
+type PullRequestState string
+
+const (
+	PullRequestOpen   PullRequestState = "open"
+	PullRequestMerged PullRequestState = "merged"
+	PullRequestClosed PullRequestState = "closed"
+)
+
+// PullRequest : A pull request (or merge request) on the hosting service
+type PullRequest struct {
+	Number int
+	State  PullRequestState
+	// only known for github, where it means the review requirements are met
+	Approved bool
+	// the page of the pull request itself, as opposed to the URL for creating one
+	URL string
+}
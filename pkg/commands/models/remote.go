@@ -1,12 +1,24 @@
 package models
 
+import "strings"
+
 // Remote : A git remote
 type Remote struct {
-	Name     string
-	Urls     []string
+	Name string
+	Urls []string
+	// only set if we push somewhere other than where we fetch from
+	PushUrls []string
+	// whether fetching removes the remote branches that are gone from the remote
+	Prune    bool
 	Branches []*RemoteBranch
 }
 
+// HasSeparatePushUrls tells us whether we push somewhere other than where we
+// fetch from
+func (r *Remote) HasSeparatePushUrls() bool {
+	return len(r.PushUrls) > 0 && strings.Join(r.PushUrls, "\n") != strings.Join(r.Urls, "\n")
+}
+
 func (r *Remote) RefName() string {
 	return r.Name
 }
@@ -6,6 +6,11 @@ import "fmt"
 type StashEntry struct {
 	Index int
 	Name  string
+
+	// TreeIncludingUntracked is set when viewing the entry's files, to a tree
+	// that also has the untracked files stashed along with the changes, which
+	// git keeps in a separate parent. We then use it in place of the entry.
+	TreeIncludingUntracked string
 }
 
 func (s *StashEntry) FullRefName() string {
@@ -13,17 +18,24 @@ func (s *StashEntry) FullRefName() string {
 }
 
 func (s *StashEntry) RefName() string {
-	return fmt.Sprintf("stash@{%d}", s.Index)
+	if s.TreeIncludingUntracked != "" {
+		return s.TreeIncludingUntracked
+	}
+	return s.stashRef()
 }
 
 func (s *StashEntry) ParentRefName() string {
-	return s.RefName() + "^"
+	return s.stashRef() + "^"
 }
 
 func (s *StashEntry) ID() string {
-	return s.RefName()
+	return s.stashRef()
 }
 
 func (s *StashEntry) Description() string {
-	return s.RefName() + ": " + s.Name
+	return s.stashRef() + ": " + s.Name
+}
+
+func (s *StashEntry) stashRef() string {
+	return fmt.Sprintf("stash@{%d}", s.Index)
 }
@@ -1,9 +1,16 @@
 package models
 
+import "strings"
+
 type SubmoduleConfig struct {
 	Name string
+	// relative to the root of our repo, also for nested submodules
 	Path string
 	Url  string
+
+	// the submodule this one is nested in, or nil if it's a submodule of our
+	// repo itself
+	ParentModule *SubmoduleConfig
 }
 
 func (r *SubmoduleConfig) RefName() string {
@@ -17,3 +24,22 @@ func (r *SubmoduleConfig) ID() string {
 func (r *SubmoduleConfig) Description() string {
 	return r.RefName()
 }
+
+// PathInParent returns the path of the submodule relative to the repo it's a
+// submodule of, which is what `git submodule` commands expect
+func (r *SubmoduleConfig) PathInParent() string {
+	if r.ParentModule == nil {
+		return r.Path
+	}
+
+	return strings.TrimPrefix(r.Path, r.ParentModule.Path+"/")
+}
+
+// Depth returns how many submodules this one is nested in
+func (r *SubmoduleConfig) Depth() int {
+	depth := 0
+	for parent := r.ParentModule; parent != nil; parent = parent.ParentModule {
+		depth++
+	}
+	return depth
+}
@@ -6,6 +6,13 @@ type Tag struct {
 	// this is either the first line of the message of an annotated tag, or the
 	// first line of a commit message for a lightweight tag
 	Message string
+	// lightweight tags are just refs to a commit, whereas annotated tags are
+	// objects of their own, with a message and a tagger
+	IsAnnotated bool
+	IsSigned    bool
+	// the letter git uses for %G? on commits, e.g. 'G' for a good signature.
+	// Only set for signed tags, and only if we're showing signature statuses
+	SignatureStatus string
 }
 
 func (t *Tag) FullRefName() string {
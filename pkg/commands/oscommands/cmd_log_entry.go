@@ -0,0 +1,29 @@
+package oscommands
+
+import (
+	"time"
+)
+
+// the most output of a command that we hold on to for the command log, given
+// that we keep a lot of entries and some commands, like those loading the
+// commits, have a lot to say
+const maxCmdLogEntryOutputLength = 64 * 1024
+
+// CmdLogEntry describes a command that has finished running
+type CmdLogEntry struct {
+	CmdStr string
+	// what we need to run the command again
+	Args []string
+	Env  []string
+	Dir  string
+
+	Duration time.Duration
+	// -1 if the command couldn't be started, or was killed
+	ExitCode      int
+	FailedToStart bool
+	// stdout and stderr together, cut short if there was too much of it
+	Output string
+	// the user didn't ask for the command to be run, as with those loading the
+	// commits or the files. These are the commands we don't log when they start.
+	Background bool
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"regexp"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -26,8 +28,17 @@ const (
 	Username
 	Passphrase
 	PIN
+	// e.g. a one-time code from an authenticator app
+	TwoFactorCode
+	// ssh asking whether to trust a host it hasn't seen before, which we
+	// answer with 'yes' or 'no'
+	HostKeyConfirmation
 )
 
+// ErrCredentialRequestCancelled is returned when the user cancels a credential
+// request, in which case we kill the command rather than leaving it waiting
+var ErrCredentialRequestCancelled = errors.New("credential request cancelled")
+
 type cmdObjRunner struct {
 	log   *logrus.Entry
 	guiIO *guiIO
@@ -110,7 +121,11 @@ func (self *cmdObjRunner) RunWithOutputAux(cmdObj ICmdObj) (string, error) {
 		self.logCmdObj(cmdObj)
 	}
 
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	startTime := time.Now()
+	rawOutput, err := cmdObj.GetCmd().CombinedOutput()
+	self.reportCmdDone(cmdObj, startTime, func() string { return string(rawOutput) })
+
+	output, err := sanitisedCommandOutput(rawOutput, err)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
 	}
@@ -128,7 +143,9 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj ICmdObj) (string, string, err
 	cmd := cmdObj.GetCmd()
 	cmd.Stdout = &outBuffer
 	cmd.Stderr = &errBuffer
+	startTime := time.Now()
 	err := cmd.Run()
+	self.reportCmdDone(cmdObj, startTime, func() string { return outBuffer.String() + errBuffer.String() })
 
 	stdout := outBuffer.String()
 	stderr, err := sanitisedCommandOutput(errBuffer.Bytes(), err)
@@ -161,14 +178,27 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 
 	scanner := bufio.NewScanner(stdoutPipe)
 	scanner.Split(bufio.ScanLines)
+
+	// we don't want to hold on to much more of the output than we'll keep, or
+	// any of it if nobody wants it
+	var output strings.Builder
+	keepOutput := self.guiIO.wantsCmdDoneFn(!cmdObj.ShouldLog())
+	startTime := time.Now()
+	defer func() { self.reportCmdDone(cmdObj, startTime, output.String) }()
+
 	if err := cmd.Start(); err != nil {
 		return err
 	}
 
 	for scanner.Scan() {
 		line := scanner.Text()
+		if keepOutput && output.Len() < maxCmdLogEntryOutputLength {
+			output.WriteString(line + "\n")
+		}
 		stop, err := onLine(line)
 		if err != nil {
+			_ = Kill(cmd)
+			_ = cmd.Wait()
 			return err
 		}
 		if stop {
@@ -183,11 +213,17 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 }
 
 // Whenever we're asked for a password we just enter a newline, which will
-// eventually cause the command to fail.
-var failPromptFn = func(CredentialType) string { return "\n" }
+// eventually cause the command to fail. We don't trust unknown hosts.
+var failPromptFn = func(credential CredentialType, output string) (string, bool) {
+	if credential == HostKeyConfirmation {
+		return "no\n", true
+	}
+
+	return "\n", true
+}
 
 func (self *cmdObjRunner) runWithCredentialHandling(cmdObj ICmdObj) error {
-	var promptFn func(CredentialType) string
+	var promptFn func(CredentialType, string) (string, bool)
 
 	switch cmdObj.GetCredentialStrategy() {
 	case PROMPT:
@@ -206,6 +242,40 @@ func (self *cmdObjRunner) logCmdObj(cmdObj ICmdObj) {
 	self.guiIO.logCommandFn(cmdObj.ToString(), true)
 }
 
+// reportCmdDone is called once a command has finished, whether or not we
+// logged it when it started. We only get the output if the GUI wants it,
+// because it can be big.
+func (self *cmdObjRunner) reportCmdDone(cmdObj ICmdObj, startTime time.Time, getOutput func() string) {
+	background := !cmdObj.ShouldLog()
+	if !self.guiIO.wantsCmdDoneFn(background) {
+		return
+	}
+
+	cmd := cmdObj.GetCmd()
+
+	exitCode := -1
+	if cmd.ProcessState != nil {
+		exitCode = cmd.ProcessState.ExitCode()
+	}
+
+	output := getOutput()
+	if len(output) > maxCmdLogEntryOutputLength {
+		output = output[:maxCmdLogEntryOutputLength]
+	}
+
+	self.guiIO.onCmdDoneFn(CmdLogEntry{
+		CmdStr:        cmdObj.ToString(),
+		Args:          cmd.Args,
+		Env:           cmd.Env,
+		Dir:           cmd.Dir,
+		Duration:      time.Since(startTime),
+		ExitCode:      exitCode,
+		FailedToStart: cmd.ProcessState == nil,
+		Output:        output,
+		Background:    background,
+	})
+}
+
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
 	outputString := string(output)
 	if err != nil {
@@ -235,21 +305,36 @@ func (self *cmdObjRunner) runAndStream(cmdObj ICmdObj) error {
 
 // runAndDetectCredentialRequest detect a username / password / passphrase question in a command
 // promptUserForCredential is a function that gets executed when this function detect you need to fillin a password or passphrase
-// The promptUserForCredential argument will be "username", "password" or "passphrase" and expects the user's password/passphrase or username back
+// The promptUserForCredential argument will be "username", "password" or "passphrase" and expects the user's password/passphrase or username back,
+// or false if the user cancelled, in which case we kill the command
 func (self *cmdObjRunner) runAndDetectCredentialRequest(
 	cmdObj ICmdObj,
-	promptUserForCredential func(CredentialType) string,
+	promptUserForCredential func(CredentialType, string) (string, bool),
 ) error {
 	// setting the output to english so we can parse it for a username/password request
 	cmdObj.AddEnvVars("LANG=en_US.UTF-8", "LC_ALL=en_US.UTF-8")
 
-	return self.runAndStreamAux(cmdObj, func(handler *cmdHandler, cmdWriter io.Writer) {
+	var cancelled int32
+	cancel := func() {
+		atomic.StoreInt32(&cancelled, 1)
+		if err := cmdObj.GetCmd().Process.Kill(); err != nil {
+			self.log.Error(err)
+		}
+	}
+
+	err := self.runAndStreamAux(cmdObj, func(handler *cmdHandler, cmdWriter io.Writer) {
 		tr := io.TeeReader(handler.stdoutPipe, cmdWriter)
 
 		go utils.Safe(func() {
-			self.processOutput(tr, handler.stdinPipe, promptUserForCredential)
+			self.processOutput(tr, handler.stdinPipe, promptUserForCredential, cancel)
 		})
 	})
+
+	if atomic.LoadInt32(&cancelled) == 1 {
+		return ErrCredentialRequestCancelled
+	}
+
+	return err
 }
 
 func (self *cmdObjRunner) runAndStreamAux(
@@ -266,12 +351,14 @@ func (self *cmdObjRunner) runAndStreamAux(
 	}
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 	cmd := cmdObj.GetCmd()
+	startTime := time.Now()
 
 	var stderr bytes.Buffer
 	cmd.Stderr = io.MultiWriter(cmdWriter, &stderr)
 
 	handler, err := self.getCmdHandler(cmd)
 	if err != nil {
+		self.reportCmdDone(cmdObj, startTime, func() string { return "" })
 		return err
 	}
 
@@ -287,6 +374,7 @@ func (self *cmdObjRunner) runAndStreamAux(
 	onRun(handler, cmdWriter)
 
 	err = cmd.Wait()
+	self.reportCmdDone(cmdObj, startTime, func() string { return stdout.String() + stderr.String() })
 	if err != nil {
 		errStr := stderr.String()
 		if errStr != "" {
@@ -302,16 +390,25 @@ func (self *cmdObjRunner) runAndStreamAux(
 	return nil
 }
 
-func (self *cmdObjRunner) processOutput(reader io.Reader, writer io.Writer, promptUserForCredential func(CredentialType) string) {
+func (self *cmdObjRunner) processOutput(
+	reader io.Reader,
+	writer io.Writer,
+	promptUserForCredential func(CredentialType, string) (string, bool),
+	cancel func(),
+) {
 	checkForCredentialRequest := self.getCheckForCredentialRequestFunc()
 
 	scanner := bufio.NewScanner(reader)
 	scanner.Split(bufio.ScanBytes)
 	for scanner.Scan() {
 		newBytes := scanner.Bytes()
-		askFor, ok := checkForCredentialRequest(newBytes)
+		askFor, output, ok := checkForCredentialRequest(newBytes)
 		if ok {
-			toInput := promptUserForCredential(askFor)
+			toInput, answered := promptUserForCredential(askFor, output)
+			if !answered {
+				cancel()
+				return
+			}
 			// If the return data is empty we don't write anything to stdin
 			if toInput != "" {
 				_, _ = writer.Write([]byte(toInput))
@@ -320,11 +417,13 @@ func (self *cmdObjRunner) processOutput(reader io.Reader, writer io.Writer, prom
 	}
 }
 
-// having a function that returns a function because we need to maintain some state inbetween calls hence the closure
-func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (CredentialType, bool) {
+// having a function that returns a function because we need to maintain some state inbetween calls hence the closure.
+// Along with what we're being asked for, we return the output since the previous request, given that
+// ssh shows the fingerprint of an unknown host before asking whether to trust it
+func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (CredentialType, string, bool) {
 	var ttyText strings.Builder
 	// this function takes each word of output from the command and builds up a string to see if we're being asked for a password
-	return func(newBytes []byte) (CredentialType, bool) {
+	return func(newBytes []byte) (CredentialType, string, bool) {
 		_, err := ttyText.Write(newBytes)
 		if err != nil {
 			self.log.Error(err)
@@ -337,15 +436,19 @@ func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (Crede
 			`Username\s*for\s*'.+':`:                 Username,
 			`Enter\s*passphrase\s*for\s*key\s*'.+':`: Passphrase,
 			`Enter\s*PIN\s*for\s*.+\s*key\s*.+:`:     PIN,
+			`(?i)(verification|authentication|one-time|two-factor|2fa|otp)\s*(code|password|token)[^:\n]*:`: TwoFactorCode,
+			`Are you sure you want to continue connecting \(yes/no[^)]*\)\?`:                                HostKeyConfirmation,
+			`Please type 'yes', 'no' or the fingerprint:`:                                                   HostKeyConfirmation,
 		}
 
 		for pattern, askFor := range prompts {
 			if match, _ := regexp.MatchString(pattern, ttyText.String()); match {
+				output := ttyText.String()
 				ttyText.Reset()
-				return askFor, true
+				return askFor, output, true
 			}
 		}
 
-		return 0, false
+		return 0, "", false
 	}
 }
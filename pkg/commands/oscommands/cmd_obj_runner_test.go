@@ -0,0 +1,197 @@
+package oscommands
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessOutput(t *testing.T) {
+	type prompt struct {
+		askFor CredentialType
+		output string
+	}
+
+	scenarios := []struct {
+		testName        string
+		output          string
+		answers         []string
+		expectedPrompts []prompt
+		expectedInput   string
+		expectCancel    bool
+	}{
+		{
+			testName: "password",
+			output:   "Password for 'https://github.com':",
+			answers:  []string{"hunter2\n"},
+			expectedPrompts: []prompt{
+				{askFor: Password, output: "Password for 'https://github.com':"},
+			},
+			expectedInput: "hunter2\n",
+		},
+		{
+			testName: "two-factor code",
+			output:   "Username for 'https://example.com': Enter your verification code:",
+			answers:  []string{"me\n", "123456\n"},
+			expectedPrompts: []prompt{
+				{askFor: Username, output: "Username for 'https://example.com':"},
+				{askFor: TwoFactorCode, output: " Enter your verification code:"},
+			},
+			expectedInput: "me\n123456\n",
+		},
+		{
+			testName: "unknown host",
+			output: "The authenticity of host 'example.com (1.2.3.4)' can't be established.\n" +
+				"ED25519 key fingerprint is SHA256:abc.\n" +
+				"Are you sure you want to continue connecting (yes/no/[fingerprint])?",
+			answers: []string{"yes\n"},
+			expectedPrompts: []prompt{
+				{
+					askFor: HostKeyConfirmation,
+					output: "The authenticity of host 'example.com (1.2.3.4)' can't be established.\n" +
+						"ED25519 key fingerprint is SHA256:abc.\n" +
+						"Are you sure you want to continue connecting (yes/no/[fingerprint])?",
+				},
+			},
+			expectedInput: "yes\n",
+		},
+		{
+			testName: "cancelled",
+			output:   "Password: Password:",
+			answers:  []string{},
+			expectedPrompts: []prompt{
+				{askFor: Password, output: "Password:"},
+			},
+			expectedInput: "",
+			expectCancel:  true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := &cmdObjRunner{log: utils.NewDummyLog()}
+
+			prompts := []prompt{}
+			promptFn := func(askFor CredentialType, output string) (string, bool) {
+				prompts = append(prompts, prompt{askFor: askFor, output: output})
+				if len(prompts) > len(s.answers) {
+					return "", false
+				}
+				return s.answers[len(prompts)-1], true
+			}
+
+			cancelled := false
+			var input strings.Builder
+			runner.processOutput(strings.NewReader(s.output), &input, promptFn, func() { cancelled = true })
+
+			assert.Equal(t, s.expectedPrompts, prompts)
+			assert.Equal(t, s.expectedInput, input.String())
+			assert.Equal(t, s.expectCancel, cancelled)
+		})
+	}
+}
+
+func TestCmdObjRunnerReportsFinishedCommands(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a unix shell")
+	}
+
+	type scenario struct {
+		testName string
+		run      func(c *OSCommand) error
+		expected CmdLogEntry
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "logged command that fails",
+			run: func(c *OSCommand) error {
+				_, err := c.Cmd.New("sh -c 'echo out; exit 3'").RunWithOutput()
+				return err
+			},
+			expected: CmdLogEntry{CmdStr: "sh -c 'echo out; exit 3'", ExitCode: 3, Output: "out\n", Background: false},
+		},
+		{
+			testName: "background command",
+			run: func(c *OSCommand) error {
+				_, err := c.Cmd.New("echo -n 123").DontLog().RunWithOutput()
+				return err
+			},
+			expected: CmdLogEntry{CmdStr: "echo -n 123", ExitCode: 0, Output: "123", Background: true},
+		},
+		{
+			testName: "processing lines",
+			run: func(c *OSCommand) error {
+				return c.Cmd.New("printf 'a\nb\n'").DontLog().RunAndProcessLines(func(line string) (bool, error) {
+					return false, nil
+				})
+			},
+			expected: CmdLogEntry{CmdStr: "printf 'a\nb\n'", ExitCode: 0, Output: "a\nb\n", Background: true},
+		},
+		{
+			testName: "processing lines until an error",
+			run: func(c *OSCommand) error {
+				return c.Cmd.New("sh -c 'echo a; sleep 10'").DontLog().RunAndProcessLines(func(line string) (bool, error) {
+					return false, errors.New("error")
+				})
+			},
+			// killed, rather than not having been started
+			expected: CmdLogEntry{CmdStr: "sh -c 'echo a; sleep 10'", ExitCode: -1, Output: "a\n", Background: true},
+		},
+		{
+			testName: "command that can't be started",
+			run: func(c *OSCommand) error {
+				_, err := c.Cmd.New("lazygit-no-such-command").RunWithOutput()
+				return err
+			},
+			expected: CmdLogEntry{CmdStr: "lazygit-no-such-command", ExitCode: -1, FailedToStart: true, Background: false},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			entries := []CmdLogEntry{}
+			guiIO := NewNullGuiIO(utils.NewDummyLog())
+			guiIO.onCmdDoneFn = func(entry CmdLogEntry) { entries = append(entries, entry) }
+			guiIO.wantsCmdDoneFn = func(bool) bool { return true }
+			c := NewOSCommand(utils.NewDummyCommon(), config.NewDummyAppConfig(), dummyPlatform, guiIO)
+
+			_ = s.run(c)
+
+			if assert.Len(t, entries, 1) {
+				entry := entries[0]
+				assert.Equal(t, s.expected.CmdStr, entry.CmdStr)
+				assert.Equal(t, s.expected.ExitCode, entry.ExitCode)
+				assert.Equal(t, s.expected.FailedToStart, entry.FailedToStart)
+				assert.Equal(t, s.expected.Output, entry.Output)
+				assert.Equal(t, s.expected.Background, entry.Background)
+			}
+		})
+	}
+}
+
+func TestCmdObjRunnerOnlyReportsWantedCommands(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a unix shell")
+	}
+
+	entries := []CmdLogEntry{}
+	guiIO := NewNullGuiIO(utils.NewDummyLog())
+	guiIO.onCmdDoneFn = func(entry CmdLogEntry) { entries = append(entries, entry) }
+	guiIO.wantsCmdDoneFn = func(background bool) bool { return !background }
+	c := NewOSCommand(utils.NewDummyCommon(), config.NewDummyAppConfig(), dummyPlatform, guiIO)
+
+	_, _ = c.Cmd.New("echo background").DontLog().RunWithOutput()
+	_, _ = c.Cmd.New("echo user").RunWithOutput()
+
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "echo user", entries[0].CmdStr)
+	}
+}
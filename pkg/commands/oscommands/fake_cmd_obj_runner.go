@@ -14,14 +14,14 @@ import (
 // for use in testing
 
 type FakeCmdObjRunner struct {
-	t                *testing.T
+	t                testing.TB
 	expectedCmds     []func(ICmdObj) (string, error)
 	expectedCmdIndex int
 }
 
 var _ ICmdObjRunner = &FakeCmdObjRunner{}
 
-func NewFakeRunner(t *testing.T) *FakeCmdObjRunner { //nolint:thelper
+func NewFakeRunner(t testing.TB) *FakeCmdObjRunner { //nolint:thelper
 	return &FakeCmdObjRunner{t: t}
 }
 
@@ -19,20 +19,29 @@ type guiIO struct {
 	// depending on whether we're directly outputting a command we're about to run that
 	// will be run on the command line, or if we're using something from Go's standard lib.
 	logCommandFn func(str string, isCommandLineCommand bool)
+	// this is for us to tell the GUI how a command went once it's finished,
+	// including those we didn't log when they started.
+	onCmdDoneFn func(entry CmdLogEntry)
+	// this tells us whether the GUI wants to hear about a command finishing, so
+	// that we don't gather up the output of those it doesn't.
+	wantsCmdDoneFn func(background bool) bool
 	// this is for us to directly write the output of a command. We will do this for
 	// certain commands like 'git push'. The GUI will write this to a command output panel.
 	// We need a new cmd writer per command, hence it being a function.
 	newCmdWriterFn func() io.Writer
 	// this allows us to request info from the user like username/password, in the event
 	// that a command requests it.
-	// the 'credential' arg is something like 'username' or 'password'
-	promptForCredentialFn func(credential CredentialType) string
+	// the 'credential' arg is something like 'username' or 'password', and 'output' is
+	// what the command printed leading up to the request. Returning false cancels the command.
+	promptForCredentialFn func(credential CredentialType, output string) (string, bool)
 }
 
-func NewGuiIO(log *logrus.Entry, logCommandFn func(string, bool), newCmdWriterFn func() io.Writer, promptForCredentialFn func(CredentialType) string) *guiIO {
+func NewGuiIO(log *logrus.Entry, logCommandFn func(string, bool), onCmdDoneFn func(CmdLogEntry), wantsCmdDoneFn func(bool) bool, newCmdWriterFn func() io.Writer, promptForCredentialFn func(CredentialType, string) (string, bool)) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
+		onCmdDoneFn:           onCmdDoneFn,
+		wantsCmdDoneFn:        wantsCmdDoneFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
 	}
@@ -44,6 +53,8 @@ func NewNullGuiIO(log *logrus.Entry) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          func(string, bool) {},
+		onCmdDoneFn:           func(CmdLogEntry) {},
+		wantsCmdDoneFn:        func(bool) bool { return false },
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
 	}
@@ -4,7 +4,10 @@
 package oscommands
 
 import (
+	"os"
+	"os/exec"
 	"runtime"
+	"syscall"
 )
 
 func GetPlatform() *Platform {
@@ -16,3 +19,19 @@ func GetPlatform() *Platform {
 		OpenLinkCommand: "open {{link}}",
 	}
 }
+
+// Interrupt sends SIGINT to a running process, giving it the chance to clean up
+// after itself. If the process leads its own process group, as streamed commands
+// do given that we run them in a pty, we interrupt the whole group so that
+// anything the process has spawned gets interrupted too.
+func Interrupt(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	if pgid, err := syscall.Getpgid(cmd.Process.Pid); err == nil && pgid == cmd.Process.Pid {
+		return syscall.Kill(-pgid, syscall.SIGINT)
+	}
+
+	return cmd.Process.Signal(os.Interrupt)
+}
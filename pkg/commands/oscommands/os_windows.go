@@ -1,5 +1,7 @@
 package oscommands
 
+import "os/exec"
+
 func GetPlatform() *Platform {
 	return &Platform{
 		OS:       "windows",
@@ -7,3 +9,13 @@ func GetPlatform() *Platform {
 		ShellArg: "/c",
 	}
 }
+
+// Interrupt stops a running process. Windows has no equivalent of SIGINT that
+// we can send to another process, so we kill it instead.
+func Interrupt(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	return Kill(cmd)
+}
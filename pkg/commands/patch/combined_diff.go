@@ -0,0 +1,189 @@
+package patch
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// the job of this file is to parse combined diffs, which is what git shows for
+// a file with merge conflicts. A combined diff compares the working tree
+// version of the file against each parent of the merge at once: its hunk
+// headers have one more '@' than there are parents (so '@@@' for a regular
+// merge) and each line has one prefix column per parent. We can't stage lines
+// of such a diff, but we can still display it properly.
+
+var (
+	combinedHunkHeaderRegexp = regexp.MustCompile(`^(@{3,}) ((?:-\d+(?:,\d+)? )+)\+(\d+)(?:,\d+)? @{3,}(.*)$`)
+	combinedDiffRegexp       = regexp.MustCompile(`(?m)^@{3,} -`)
+	parentRangeRegexp        = regexp.MustCompile(`-(\d+)`)
+)
+
+type CombinedHunk struct {
+	FirstLineIdx int
+	// the line the hunk starts at in each of the merge's parents
+	ParentStarts []int
+	NewStart     int
+	Heading      string
+	Lines        []*CombinedLine
+}
+
+type CombinedLine struct {
+	// ADDITION or DELETION if the line was added to or deleted from the file
+	// relative to any of the parents, CONTEXT if it's the same in all of them,
+	// and NEWLINE_MESSAGE for a 'no newline at end of file' line
+	Kind PatchLineKind
+	// how the line differs from each parent, in the order of the parents
+	ParentKinds []PatchLineKind
+	// the line without its prefix columns
+	Content string
+}
+
+func (hunk *CombinedHunk) LastLineIdx() int {
+	return hunk.FirstLineIdx + len(hunk.Lines)
+}
+
+// IsCombinedDiff tells us whether the diff is a combined diff of a file with
+// merge conflicts
+func IsCombinedDiff(diff string) bool {
+	return combinedDiffRegexp.MatchString(diff)
+}
+
+// ParseCombinedHunk parses the lines of a combined diff's hunk, starting with
+// its header. firstLineIdx is the index of the header within the diff.
+func ParseCombinedHunk(lines []string, firstLineIdx int) (*CombinedHunk, error) {
+	if len(lines) == 0 {
+		return nil, errors.New("combined hunk has no header")
+	}
+
+	header := strings.TrimSuffix(lines[0], "\n")
+	match := combinedHunkHeaderRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return nil, errors.Errorf("invalid combined hunk header: %s", header)
+	}
+
+	parentCount := len(match[1]) - 1
+	parentStarts := []int{}
+	for _, parentRange := range parentRangeRegexp.FindAllStringSubmatch(match[2], -1) {
+		parentStarts = append(parentStarts, utils.MustConvertToInt(parentRange[1]))
+	}
+	if len(parentStarts) != parentCount {
+		return nil, errors.Errorf("invalid combined hunk header: %s", header)
+	}
+
+	hunk := &CombinedHunk{
+		FirstLineIdx: firstLineIdx,
+		ParentStarts: parentStarts,
+		NewStart:     utils.MustConvertToInt(match[3]),
+		Heading:      match[4],
+		Lines:        make([]*CombinedLine, 0, len(lines)-1),
+	}
+
+	for _, line := range lines[1:] {
+		combinedLine, err := parseCombinedLine(strings.TrimSuffix(line, "\n"), parentCount)
+		if err != nil {
+			return nil, err
+		}
+		hunk.Lines = append(hunk.Lines, combinedLine)
+	}
+
+	return hunk, nil
+}
+
+func parseCombinedLine(line string, parentCount int) (*CombinedLine, error) {
+	if strings.HasPrefix(line, "\\") {
+		return &CombinedLine{Kind: NEWLINE_MESSAGE, Content: line}, nil
+	}
+
+	// git leaves out trailing whitespace, so an empty context line can be
+	// shorter than the prefix
+	prefix := line
+	if len(line) >= parentCount {
+		prefix = line[:parentCount]
+	}
+
+	combinedLine := &CombinedLine{
+		Kind:        CONTEXT,
+		ParentKinds: make([]PatchLineKind, parentCount),
+		Content:     line[len(prefix):],
+	}
+	for i := 0; i < parentCount; i++ {
+		char := byte(' ')
+		if i < len(prefix) {
+			char = prefix[i]
+		}
+
+		switch char {
+		case '+':
+			combinedLine.ParentKinds[i] = ADDITION
+			combinedLine.Kind = ADDITION
+		case '-':
+			combinedLine.ParentKinds[i] = DELETION
+			combinedLine.Kind = DELETION
+		case ' ':
+			combinedLine.ParentKinds[i] = CONTEXT
+		default:
+			return nil, errors.Errorf("invalid line in combined hunk: %s", line)
+		}
+	}
+
+	return combinedLine, nil
+}
+
+// GetCombinedHunksFromDiff returns the hunks of a combined diff
+func GetCombinedHunksFromDiff(diff string) ([]*CombinedHunk, error) {
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+
+	hunks := []*CombinedHunk{}
+	firstLineIdx := -1
+	parseHunk := func(lastLineIdx int) error {
+		if firstLineIdx == -1 {
+			return nil
+		}
+		hunk, err := ParseCombinedHunk(lines[firstLineIdx:lastLineIdx+1], firstLineIdx)
+		if err != nil {
+			return err
+		}
+		hunks = append(hunks, hunk)
+		return nil
+	}
+
+	for lineIdx, line := range lines {
+		if strings.HasPrefix(line, "@@@") {
+			if err := parseHunk(lineIdx - 1); err != nil {
+				return nil, err
+			}
+			firstLineIdx = lineIdx
+		}
+	}
+	if err := parseHunk(len(lines) - 1); err != nil {
+		return nil, err
+	}
+
+	return hunks, nil
+}
+
+// toPatchHunk returns the hunk as a regular hunk relative to the first parent,
+// with a single prefix column, so that we can navigate it like any other hunk
+func (hunk *CombinedHunk) toPatchHunk() *PatchHunk {
+	prefixes := map[PatchLineKind]string{ADDITION: "+", DELETION: "-", CONTEXT: " "}
+
+	bodyLines := make([]string, 0, len(hunk.Lines))
+	for _, line := range hunk.Lines {
+		if line.Kind == NEWLINE_MESSAGE {
+			bodyLines = append(bodyLines, line.Content+"\n")
+		} else {
+			bodyLines = append(bodyLines, prefixes[line.Kind]+line.Content+"\n")
+		}
+	}
+
+	return &PatchHunk{
+		FirstLineIdx: hunk.FirstLineIdx,
+		oldStart:     hunk.ParentStarts[0],
+		newStart:     hunk.NewStart,
+		heading:      hunk.Heading,
+		bodyLines:    bodyLines,
+	}
+}
@@ -0,0 +1,135 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+const combinedDiff = `diff --cc file
+index 3b6f40a,f4ea702..0000000
+--- a/file
++++ b/file
+@@@ -1,3 -1,3 +1,4 @@@ heading
+  a
+- B2
+ -B1
+++B3
+  c
+++d
+`
+
+func TestIsCombinedDiff(t *testing.T) {
+	assert.True(t, IsCombinedDiff(combinedDiff))
+	assert.False(t, IsCombinedDiff(simpleDiff))
+	assert.False(t, IsCombinedDiff(""))
+}
+
+func TestParseCombinedHunk(t *testing.T) {
+	type scenario struct {
+		testName      string
+		lines         string
+		expectedError string
+		expected      *CombinedHunk
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "two parents",
+			lines: `@@@ -1,3 -1,3 +1,4 @@@ heading
+  a
+- B2
+ -B1
+++B3
+  c
+++d
+`,
+			expected: &CombinedHunk{
+				FirstLineIdx: 4,
+				ParentStarts: []int{1, 1},
+				NewStart:     1,
+				Heading:      " heading",
+				Lines: []*CombinedLine{
+					{Kind: CONTEXT, ParentKinds: []PatchLineKind{CONTEXT, CONTEXT}, Content: "a"},
+					{Kind: DELETION, ParentKinds: []PatchLineKind{DELETION, CONTEXT}, Content: "B2"},
+					{Kind: DELETION, ParentKinds: []PatchLineKind{CONTEXT, DELETION}, Content: "B1"},
+					{Kind: ADDITION, ParentKinds: []PatchLineKind{ADDITION, ADDITION}, Content: "B3"},
+					{Kind: CONTEXT, ParentKinds: []PatchLineKind{CONTEXT, CONTEXT}, Content: "c"},
+					{Kind: ADDITION, ParentKinds: []PatchLineKind{ADDITION, ADDITION}, Content: "d"},
+				},
+			},
+		},
+		{
+			testName: "three parents, empty context line and no newline at end of file",
+			lines: `@@@@ -5 -6,2 -7,2 +8,2 @@@@
+
+ + x
+++ y
+\ No newline at end of file
+`,
+			expected: &CombinedHunk{
+				FirstLineIdx: 4,
+				ParentStarts: []int{5, 6, 7},
+				NewStart:     8,
+				Heading:      "",
+				Lines: []*CombinedLine{
+					{Kind: CONTEXT, ParentKinds: []PatchLineKind{CONTEXT, CONTEXT, CONTEXT}, Content: ""},
+					{Kind: ADDITION, ParentKinds: []PatchLineKind{CONTEXT, ADDITION, CONTEXT}, Content: "x"},
+					{Kind: ADDITION, ParentKinds: []PatchLineKind{ADDITION, ADDITION, CONTEXT}, Content: "y"},
+					{Kind: NEWLINE_MESSAGE, Content: `\ No newline at end of file`},
+				},
+			},
+		},
+		{
+			testName:      "regular hunk",
+			lines:         "@@ -1,2 +1,2 @@\n a\n-b\n+c\n",
+			expectedError: "invalid combined hunk header: @@ -1,2 +1,2 @@",
+		},
+		{
+			testName:      "header with the wrong number of parents",
+			lines:         "@@@ -1,2 +1,2 @@@\n  a\n",
+			expectedError: "invalid combined hunk header: @@@ -1,2 +1,2 @@@",
+		},
+		{
+			testName:      "invalid prefix",
+			lines:         "@@@ -1,2 -1,2 +1,2 @@@\n  a\n*-b\n",
+			expectedError: "invalid line in combined hunk: *-b",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			lines := strings.SplitAfter(strings.TrimSuffix(s.lines, "\n"), "\n")
+			hunk, err := ParseCombinedHunk(lines, 4)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, hunk)
+		})
+	}
+}
+
+func TestPatchParserWithCombinedDiff(t *testing.T) {
+	parser := NewPatchParser(nil, combinedDiff)
+
+	assert.True(t, parser.IsCombinedDiff)
+	assert.Equal(t, []int{6, 7, 8, 10}, parser.StageableLines)
+	assert.Equal(t,
+		[]PatchLineKind{PATCH_HEADER, PATCH_HEADER, PATCH_HEADER, PATCH_HEADER, HUNK_HEADER, CONTEXT, DELETION, DELETION, ADDITION, CONTEXT, ADDITION},
+		lo.Map(parser.PatchLines, func(line *PatchLine, _ int) PatchLineKind { return line.Kind }),
+	)
+
+	assert.Equal(t, 1, len(parser.PatchHunks))
+	hunk := parser.PatchHunks[0]
+	assert.Equal(t, 4, hunk.FirstLineIdx)
+	assert.Equal(t, 10, hunk.LastLineIdx())
+	// the line numbers are those of the working tree version of the file
+	assert.Equal(t, 2, hunk.LineNumberOfLine(8))
+	assert.Equal(t, 4, hunk.LineNumberOfLine(10))
+}
@@ -90,9 +90,15 @@ func (hunk *PatchHunk) formatHeader(oldStart int, oldLength int, newStart int, n
 	return fmt.Sprintf("@@ -%d,%d +%d,%d @@%s\n", oldStart, oldLength, newStart, newLength, heading)
 }
 
-func (hunk *PatchHunk) formatWithChanges(lineIndices []int, reverse bool, startOffset int) (int, string) {
-	bodyLines := hunk.updatedLines(lineIndices, reverse)
-	startOffset, header, ok := hunk.updatedHeader(bodyLines, startOffset)
+// formatWithChanges formats the given hunks as a single hunk. It's passed more
+// than one hunk when they overlap, in which case the context lines they share
+// are only included once.
+func formatWithChanges(hunks []*PatchHunk, lineIndices []int, reverse bool, startOffset int) (int, string) {
+	bodyLines := hunks[0].updatedLines(lineIndices, reverse)
+	for i, hunk := range hunks[1:] {
+		bodyLines = append(bodyLines, hunk.updatedLines(lineIndices, reverse)[hunk.overlap(hunks[i]):]...)
+	}
+	startOffset, header, ok := hunks[0].updatedHeader(bodyLines, startOffset)
 	if !ok {
 		return startOffset, ""
 	}
@@ -128,3 +134,163 @@ func (hunk *PatchHunk) updatedHeader(newBodyLines []string, startOffset int) (in
 	formattedHeader := hunk.formatHeader(oldStart, oldLength, newStart, newLength, hunk.heading)
 	return newStartOffset, formattedHeader, true
 }
+
+// OriginalLineNumbers returns the line numbers which the hunk's deleted lines
+// have in the original version of the file. If the hunk only adds lines, we
+// return those of its context lines instead, being the lines around the ones
+// that were added.
+func (hunk *PatchHunk) OriginalLineNumbers() []int {
+	deleted := []int{}
+	context := []int{}
+	lineNumber := hunk.oldStart
+	for _, line := range hunk.bodyLines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			deleted = append(deleted, lineNumber)
+		case strings.HasPrefix(line, " "):
+			context = append(context, lineNumber)
+		default:
+			continue
+		}
+		lineNumber++
+	}
+
+	if len(deleted) > 0 {
+		return deleted
+	}
+	return context
+}
+
+// Body returns the hunk's lines without its header
+func (hunk *PatchHunk) Body() string {
+	return strings.Join(hunk.bodyLines, "")
+}
+
+// CanSplit tells us whether the hunk has more than one group of changes, in
+// which case it can be split into smaller hunks
+func (hunk *PatchHunk) CanSplit() bool {
+	return len(hunk.split()) > 1
+}
+
+// split breaks the hunk up at its context lines, returning one hunk per group
+// of consecutive changes. Like with `git add -p`, the context lines between
+// two groups belong to both of the hunks on either side of them, so that each
+// hunk can be applied by itself.
+func (hunk *PatchHunk) split() []*PatchHunk {
+	isChange := make([]bool, len(hunk.bodyLines))
+	for i, line := range hunk.bodyLines {
+		if strings.HasPrefix(line, "\\") && i > 0 {
+			// a 'no newline at end of file' line belongs to the line before it
+			isChange[i] = isChange[i-1]
+		} else {
+			isChange[i] = strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-")
+		}
+	}
+
+	// the start and end (exclusive) of each group of changes
+	type changeGroup struct{ start, end int }
+	groups := []changeGroup{}
+	for i := range hunk.bodyLines {
+		if !isChange[i] {
+			continue
+		}
+		if i > 0 && isChange[i-1] {
+			groups[len(groups)-1].end = i + 1
+		} else {
+			groups = append(groups, changeGroup{start: i, end: i + 1})
+		}
+	}
+
+	if len(groups) < 2 {
+		return []*PatchHunk{hunk}
+	}
+
+	result := make([]*PatchHunk, 0, len(groups))
+	for i := range groups {
+		start := 0
+		if i > 0 {
+			start = groups[i-1].end
+		}
+		end := len(hunk.bodyLines)
+		if i < len(groups)-1 {
+			end = groups[i+1].start
+		}
+
+		precedingLines := hunk.bodyLines[:start]
+		result = append(result, &PatchHunk{
+			oldStart:  hunk.oldStart + nLinesWithPrefix(precedingLines, []string{" ", "-"}),
+			newStart:  hunk.newStart + nLinesWithPrefix(precedingLines, []string{" ", "+"}),
+			heading:   hunk.heading,
+			bodyLines: hunk.bodyLines[start:end],
+		})
+	}
+
+	return result
+}
+
+// whitespaceOnlyChanges returns the indices of the hunk's changed lines which
+// only differ in whitespace from the lines they replace. Within each group of
+// changes the deleted lines are paired up with the added lines that follow
+// them, so a group with a different number of each is never whitespace-only.
+func (hunk *PatchHunk) whitespaceOnlyChanges() []int {
+	result := []int{}
+
+	// collects the indices of the consecutive lines starting at i which have
+	// the given prefix, skipping 'no newline at end of file' lines
+	collect := func(i int, prefix string) ([]int, int) {
+		indices := []int{}
+		for ; i < len(hunk.bodyLines); i++ {
+			line := hunk.bodyLines[i]
+			if strings.HasPrefix(line, prefix) {
+				indices = append(indices, i)
+			} else if !strings.HasPrefix(line, "\\") {
+				break
+			}
+		}
+		return indices, i
+	}
+
+	withoutWhitespace := func(i int) string {
+		return strings.Join(strings.Fields(hunk.bodyLines[i][1:]), "")
+	}
+
+	for i := 0; i < len(hunk.bodyLines); {
+		if !strings.HasPrefix(hunk.bodyLines[i], "-") {
+			i++
+			continue
+		}
+
+		var deletions, additions []int
+		deletions, i = collect(i, "-")
+		additions, i = collect(i, "+")
+		if len(deletions) != len(additions) {
+			continue
+		}
+
+		for j := range deletions {
+			if withoutWhitespace(deletions[j]) == withoutWhitespace(additions[j]) {
+				result = append(result,
+					hunk.FirstLineIdx+1+deletions[j],
+					hunk.FirstLineIdx+1+additions[j],
+				)
+			}
+		}
+	}
+
+	return result
+}
+
+func (hunk *PatchHunk) oldLength() int {
+	return nLinesWithPrefix(hunk.bodyLines, []string{" ", "-"})
+}
+
+func (hunk *PatchHunk) newLength() int {
+	return nLinesWithPrefix(hunk.bodyLines, []string{" ", "+"})
+}
+
+// overlap returns how many context lines at the start of the hunk are shared
+// with the end of the given preceding hunk, which happens when both came from
+// splitting a bigger hunk
+func (hunk *PatchHunk) overlap(prev *PatchHunk) int {
+	return utils.Max(0, prev.oldStart+prev.oldLength()-hunk.oldStart)
+}
@@ -0,0 +1,169 @@
+package patch
+
+import (
+	"unicode"
+)
+
+// the job of this file is to work out which parts of a changed line actually
+// changed, so that we can highlight just those parts rather than the whole line.
+
+// runeRange is a half-open range of rune offsets into a line's content, not
+// counting the leading '+' or '-'
+type runeRange struct {
+	start int
+	end   int
+}
+
+// if two paired lines have less than this proportion of their content in
+// common, we consider them unrelated and don't highlight anything within them
+const minIntraLineSimilarity = 0.5
+
+// beyond this many token comparisons we don't bother diffing a pair of lines
+const maxIntraLineComparisons = 250000
+
+// intraLineChanges pairs up the deleted and added lines of each block of
+// changes, in order, and returns the changed ranges of each paired line, keyed
+// by line index.
+func intraLineChanges(lines []*PatchLine) map[int][]runeRange {
+	result := map[int][]runeRange{}
+
+	i := 0
+	for i < len(lines) {
+		if lines[i].Kind != DELETION {
+			i++
+			continue
+		}
+
+		deletions := []int{}
+		for ; i < len(lines) && (lines[i].Kind == DELETION || lines[i].Kind == NEWLINE_MESSAGE); i++ {
+			if lines[i].Kind == DELETION {
+				deletions = append(deletions, i)
+			}
+		}
+
+		additions := []int{}
+		for ; i < len(lines) && (lines[i].Kind == ADDITION || lines[i].Kind == NEWLINE_MESSAGE); i++ {
+			if lines[i].Kind == ADDITION {
+				additions = append(additions, i)
+			}
+		}
+
+		for j := 0; j < len(deletions) && j < len(additions); j++ {
+			deletedIdx, addedIdx := deletions[j], additions[j]
+			deletedRanges, addedRanges, ok := diffLineContents(
+				[]rune(lines[deletedIdx].Content)[1:],
+				[]rune(lines[addedIdx].Content)[1:],
+			)
+			if ok {
+				result[deletedIdx] = deletedRanges
+				result[addedIdx] = addedRanges
+			}
+		}
+	}
+
+	return result
+}
+
+// diffLineContents returns the ranges of a and b which aren't part of the
+// longest common subsequence of their tokens. ok is false if the lines are too
+// different (or too long) for highlighting to be useful.
+func diffLineContents(a []rune, b []rune) ([]runeRange, []runeRange, bool) {
+	aTokens := tokenize(a)
+	bTokens := tokenize(b)
+
+	if len(aTokens)*len(bTokens) > maxIntraLineComparisons {
+		return nil, nil, false
+	}
+
+	// lengths[i][j] is the length of the LCS of aTokens[i:] and bTokens[j:]
+	lengths := make([][]int, len(aTokens)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(bTokens)+1)
+	}
+	for i := len(aTokens) - 1; i >= 0; i-- {
+		for j := len(bTokens) - 1; j >= 0; j-- {
+			if aTokens[i].text == bTokens[j].text {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	aCommon := make([]bool, len(aTokens))
+	bCommon := make([]bool, len(bTokens))
+	commonRuneCount := 0
+	for i, j := 0, 0; i < len(aTokens) && j < len(bTokens); {
+		switch {
+		case aTokens[i].text == bTokens[j].text:
+			aCommon[i] = true
+			bCommon[j] = true
+			commonRuneCount += aTokens[i].end - aTokens[i].start
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	totalRuneCount := len(a) + len(b)
+	if totalRuneCount == 0 || float64(commonRuneCount*2)/float64(totalRuneCount) < minIntraLineSimilarity {
+		return nil, nil, false
+	}
+
+	return changedRanges(aTokens, aCommon), changedRanges(bTokens, bCommon), true
+}
+
+// merges adjacent changed tokens into ranges
+func changedRanges(tokens []token, common []bool) []runeRange {
+	ranges := []runeRange{}
+	for i, token := range tokens {
+		if common[i] {
+			continue
+		}
+		if len(ranges) > 0 && ranges[len(ranges)-1].end == token.start {
+			ranges[len(ranges)-1].end = token.end
+		} else {
+			ranges = append(ranges, runeRange{start: token.start, end: token.end})
+		}
+	}
+	return ranges
+}
+
+type token struct {
+	text  string
+	start int
+	end   int
+}
+
+// tokenize splits a line into words, runs of whitespace, and individual
+// punctuation characters, so that e.g. renaming a variable only highlights
+// the variable name.
+func tokenize(runes []rune) []token {
+	tokens := []token{}
+	start := 0
+	for start < len(runes) {
+		end := start + 1
+		switch {
+		case isWordRune(runes[start]):
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+		case unicode.IsSpace(runes[start]):
+			for end < len(runes) && unicode.IsSpace(runes[end]) {
+				end++
+			}
+		}
+		tokens = append(tokens, token{text: string(runes[start:end]), start: start, end: end})
+		start = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,113 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntraLineChanges(t *testing.T) {
+	type scenario struct {
+		testName string
+		diffText string
+		expected map[int][]runeRange
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "changed word within a line",
+			diffText: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,3 +1,3 @@
+ apple
+-the quick brown fox
++the slow brown fox
+ pear
+`,
+			expected: map[int][]runeRange{
+				6: {{start: 4, end: 9}},
+				7: {{start: 4, end: 8}},
+			},
+		},
+		{
+			testName: "unicode content",
+			diffText: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,1 +1,1 @@
+-héllo wörld 😀
++héllo wêrld 😀
+`,
+			expected: map[int][]runeRange{
+				5: {{start: 6, end: 11}},
+				6: {{start: 6, end: 11}},
+			},
+		},
+		{
+			testName: "unequal numbers of deleted and added lines are paired in order",
+			diffText: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,2 +1,1 @@
+-foo(a, b)
+-something else
++foo(a, c)
+`,
+			expected: map[int][]runeRange{
+				5: {{start: 7, end: 8}},
+				7: {{start: 7, end: 8}},
+			},
+		},
+		{
+			testName: "dissimilar lines are not highlighted",
+			diffText: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,1 +1,1 @@
+-apple banana
++totally different
+`,
+			expected: map[int][]runeRange{},
+		},
+		{
+			testName: "lines either side of a no-newline marker are paired",
+			diffText: `diff --git a/filename b/filename
+index e48a11c..80a73f1 100644
+--- a/filename
++++ b/filename
+@@ -1,1 +1,1 @@
+-last line
+\ No newline at end of file
++last line!
+`,
+			expected: map[int][]runeRange{
+				5: {},
+				7: {{start: 9, end: 10}},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			parser := NewPatchParser(nil, s.diffText)
+			assert.Equal(t, s.expected, intraLineChanges(parser.PatchLines))
+		})
+	}
+}
+
+func TestRenderWithIntraLineDiffKeepsLines(t *testing.T) {
+	parser := NewPatchParser(nil, twoChangesInOneHunk)
+
+	plain := parser.Render(false, -1, -1, nil, false)
+	withIntraLineDiff := parser.Render(false, -1, -1, nil, true)
+
+	assert.Equal(t, len(parser.PatchLines), len(strings.Split(withIntraLineDiff, "\n")))
+	assert.Equal(t, len(strings.Split(plain, "\n")), len(strings.Split(withIntraLineDiff, "\n")))
+}
@@ -0,0 +1,69 @@
+package patch
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// returns the line number of each patch line in the old and new versions of
+// the file, with 0 for lines that aren't in that version
+func (p *PatchParser) lineNumbers() ([]int, []int) {
+	oldLineNumbers := make([]int, len(p.PatchLines))
+	newLineNumbers := make([]int, len(p.PatchLines))
+
+	oldLineNumber, newLineNumber := 0, 0
+	for lineIdx, line := range p.PatchLines {
+		switch line.Kind {
+		case HUNK_HEADER:
+			if match := hunkHeaderRegexp.FindStringSubmatch(line.Content); match != nil {
+				oldLineNumber = utils.MustConvertToInt(match[1])
+				newLineNumber = utils.MustConvertToInt(match[2])
+			}
+		case DELETION:
+			oldLineNumbers[lineIdx] = oldLineNumber
+			oldLineNumber++
+		case ADDITION:
+			newLineNumbers[lineIdx] = newLineNumber
+			newLineNumber++
+		case CONTEXT:
+			oldLineNumbers[lineIdx] = oldLineNumber
+			newLineNumbers[lineIdx] = newLineNumber
+			oldLineNumber++
+			newLineNumber++
+		}
+	}
+
+	return oldLineNumbers, newLineNumbers
+}
+
+// LineNumberGutters returns, for each patch line, its line numbers in the old
+// and new versions of the file, padded so that they line up. Lines that
+// aren't part of the file, like headers, get a blank gutter.
+func (p *PatchParser) LineNumberGutters() []string {
+	oldLineNumbers, newLineNumbers := p.lineNumbers()
+	width := len(strconv.Itoa(utils.Max(lo.Max(oldLineNumbers), lo.Max(newLineNumbers))))
+
+	formatLineNumber := func(lineNumber int) string {
+		if lineNumber == 0 {
+			return fmt.Sprintf("%*s", width, "")
+		}
+		return fmt.Sprintf("%*d", width, lineNumber)
+	}
+
+	return slices.MapWithIndex(p.PatchLines, func(_ *PatchLine, lineIdx int) string {
+		return formatLineNumber(oldLineNumbers[lineIdx]) + " " + formatLineNumber(newLineNumbers[lineIdx]) + " "
+	})
+}
+
+// LineIdxOfNewLineNumber returns the index of the patch line which is at the
+// given line number in the new version of the file, or -1 if the line isn't
+// part of the patch
+func (p *PatchParser) LineIdxOfNewLineNumber(lineNumber int) int {
+	_, newLineNumbers := p.lineNumbers()
+
+	return lo.IndexOf(newLineNumbers, lineNumber)
+}
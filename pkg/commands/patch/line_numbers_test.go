@@ -0,0 +1,37 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineNumberGutters(t *testing.T) {
+	parser := NewPatchParser(nil, sideBySideDiff)
+
+	assert.Equal(t, []string{
+		"    ",
+		"    ",
+		"    ",
+		"    ",
+		"    ",
+		"1 1 ",
+		"2   ",
+		"3   ",
+		"  2 ",
+		"4 3 ",
+		"5   ",
+		"  4 ",
+		"  5 ",
+		"  6 ",
+	}, parser.LineNumberGutters())
+}
+
+func TestLineIdxOfNewLineNumber(t *testing.T) {
+	parser := NewPatchParser(nil, sideBySideDiff)
+
+	assert.Equal(t, 5, parser.LineIdxOfNewLineNumber(1))
+	assert.Equal(t, 8, parser.LineIdxOfNewLineNumber(2))
+	assert.Equal(t, 13, parser.LineIdxOfNewLineNumber(6))
+	assert.Equal(t, -1, parser.LineIdxOfNewLineNumber(7))
+}
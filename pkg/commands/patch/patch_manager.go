@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/generics/maps"
 	"github.com/jesseduffield/generics/slices"
 	"github.com/samber/lo"
@@ -26,6 +27,9 @@ type fileInfo struct {
 	mode                PatchStatus
 	includedLineIndices []int
 	diff                string
+	// editedLines maps the index of an added line to the content the user has
+	// replaced it with (excluding the leading '+')
+	editedLines map[int]string
 }
 
 type (
@@ -33,9 +37,20 @@ type (
 	loadFileDiffFunc func(from string, to string, reverse bool, filename string, plain bool) (string, error)
 )
 
-// PatchManager manages the building of a patch for a commit to be applied to another commit (or the working tree, or removed from the current commit). We also support building patches from things like stashes, for which there is less flexibility
+// commitPatch is the part of the patch taken from the diff of a single commit
+type commitPatch struct {
+	from    string
+	reverse bool
+
+	// fileInfoMap starts empty but you add files to it as you go along
+	fileInfoMap map[string]*fileInfo
+}
+
+// PatchManager manages the building of a patch for a commit to be applied to another commit (or the working tree, or removed from the current commit). We also support building patches from things like stashes, for which there is less flexibility.
+// A patch can span several commits, in which case it can only be applied, not moved between commits.
 type PatchManager struct {
-	// To is the commit sha if we're dealing with files of a commit, or a stash ref for a stash
+	// To is the commit sha if we're dealing with files of a commit, or a stash ref for a stash.
+	// If the patch spans several commits, this is the one we're currently adding files from
 	To      string
 	From    string
 	reverse bool
@@ -44,8 +59,11 @@ type PatchManager struct {
 	// TODO: move this out into a proper mode struct in the gui package: it doesn't really belong here
 	CanRebase bool
 
-	// fileInfoMap starts empty but you add files to it as you go along
-	fileInfoMap map[string]*fileInfo
+	// commitPatches maps each commit we've added files from to its part of the patch
+	commitPatches map[string]*commitPatch
+	// commitOrder holds the keys of commitPatches in the order the commits were
+	// added, which is the order their parts of the patch are applied in
+	commitOrder []string
 	Log         *logrus.Entry
 	applyPatch  applyPatchFunc
 
@@ -62,13 +80,42 @@ func NewPatchManager(log *logrus.Entry, applyPatch applyPatchFunc, loadFileDiff
 	}
 }
 
-// NewPatchManager returns a new PatchManager
+// Start discards any existing patch and starts a new one for the given commit
 func (p *PatchManager) Start(from, to string, reverse bool, canRebase bool) {
+	p.Reset()
+	p.CanRebase = canRebase
+	p.AddCommit(from, to, reverse, canRebase)
+}
+
+// AddCommit switches to adding files from the given commit, keeping whatever
+// has already been added from other commits
+func (p *PatchManager) AddCommit(from, to string, reverse bool, canRebase bool) {
 	p.To = to
 	p.From = from
 	p.reverse = reverse
-	p.CanRebase = canRebase
-	p.fileInfoMap = map[string]*fileInfo{}
+	p.CanRebase = p.CanRebase && canRebase
+
+	if _, ok := p.commitPatches[to]; ok {
+		return
+	}
+
+	p.commitPatches[to] = &commitPatch{
+		from:        from,
+		reverse:     reverse,
+		fileInfoMap: map[string]*fileInfo{},
+	}
+	p.commitOrder = append(p.commitOrder, to)
+}
+
+func (p *PatchManager) currentFileInfoMap() map[string]*fileInfo {
+	// the patch may have been reset while files were still being added to it,
+	// in which case whatever gets added is thrown away
+	commitPatch, ok := p.commitPatches[p.To]
+	if !ok {
+		return map[string]*fileInfo{}
+	}
+
+	return commitPatch.fileInfoMap
 }
 
 func (p *PatchManager) addFileWhole(info *fileInfo) {
@@ -85,6 +132,7 @@ func (p *PatchManager) addFileWhole(info *fileInfo) {
 func (p *PatchManager) removeFile(info *fileInfo) {
 	info.mode = UNSELECTED
 	info.includedLineIndices = nil
+	info.editedLines = nil
 }
 
 func (p *PatchManager) AddFileWhole(filename string) error {
@@ -118,7 +166,7 @@ func getIndicesForRange(first, last int) []int {
 }
 
 func (p *PatchManager) getFileInfo(filename string) (*fileInfo, error) {
-	info, ok := p.fileInfoMap[filename]
+	info, ok := p.currentFileInfoMap()[filename]
 	if ok {
 		return info, nil
 	}
@@ -132,7 +180,7 @@ func (p *PatchManager) getFileInfo(filename string) (*fileInfo, error) {
 		diff: diff,
 	}
 
-	p.fileInfoMap[filename] = info
+	p.currentFileInfoMap()[filename] = info
 
 	return info, nil
 }
@@ -155,6 +203,9 @@ func (p *PatchManager) RemoveFileLineRange(filename string, firstLineIdx, lastLi
 	}
 	info.mode = PART
 	info.includedLineIndices, _ = lo.Difference(info.includedLineIndices, getIndicesForRange(firstLineIdx, lastLineIdx))
+	for i := firstLineIdx; i <= lastLineIdx; i++ {
+		delete(info.editedLines, i)
+	}
 	if len(info.includedLineIndices) == 0 {
 		p.removeFile(info)
 	}
@@ -162,21 +213,84 @@ func (p *PatchManager) RemoveFileLineRange(filename string, firstLineIdx, lastLi
 	return nil
 }
 
-func (p *PatchManager) renderPlainPatchForFile(filename string, reverse bool) string {
+// GetEditableLineContent returns the content of the line at the given index
+// without its leading '+', taking into account any edits made so far. Only
+// added lines can be edited so ok will be false for any other kind of line.
+func (p *PatchManager) GetEditableLineContent(filename string, lineIdx int) (string, bool, error) {
 	info, err := p.getFileInfo(filename)
 	if err != nil {
-		p.Log.Error(err)
-		return ""
+		return "", false, err
+	}
+
+	lines := strings.Split(info.diffWithEdits(), "\n")
+	if lineIdx < 0 || lineIdx >= len(lines) || !isAddedLine(info.diff, lineIdx) {
+		return "", false, nil
+	}
+
+	return lines[lineIdx][1:], true, nil
+}
+
+// EditLine replaces the content of an added line in the file's diff and
+// includes that line in the patch. The hunk headers are recomputed when the
+// patch is rendered.
+func (p *PatchManager) EditLine(filename string, lineIdx int, content string) error {
+	info, err := p.getFileInfo(filename)
+	if err != nil {
+		return err
+	}
+
+	if !isAddedLine(info.diff, lineIdx) {
+		return errors.New("only added lines can be edited")
+	}
+
+	if info.editedLines == nil {
+		info.editedLines = map[int]string{}
+	}
+	// an edited line must remain a single line for the hunk header to stay valid
+	info.editedLines[lineIdx] = strings.NewReplacer("\r", "", "\n", "").Replace(content)
+
+	if info.mode != WHOLE {
+		info.mode = PART
+		info.includedLineIndices = lo.Union(info.includedLineIndices, []int{lineIdx})
+	}
+
+	return nil
+}
+
+func isAddedLine(diff string, lineIdx int) bool {
+	parser := NewPatchParser(nil, diff)
+	if lineIdx < 0 || lineIdx >= len(parser.PatchLines) {
+		return false
+	}
+
+	return parser.PatchLines[lineIdx].Kind == ADDITION
+}
+
+// diffWithEdits returns the file's diff with any edited lines substituted in.
+func (info *fileInfo) diffWithEdits() string {
+	if len(info.editedLines) == 0 {
+		return info.diff
+	}
+
+	lines := strings.Split(info.diff, "\n")
+	for lineIdx, content := range info.editedLines {
+		if lineIdx >= 0 && lineIdx < len(lines) {
+			lines[lineIdx] = "+" + content
+		}
 	}
 
+	return strings.Join(lines, "\n")
+}
+
+func (p *PatchManager) renderPlainPatchForFile(filename string, info *fileInfo, reverse bool) string {
 	switch info.mode {
 	case WHOLE:
 		// use the whole diff
 		// the reverse flag is only for part patches so we're ignoring it here
-		return info.diff
+		return info.diffWithEdits()
 	case PART:
 		// generate a new diff with just the selected lines
-		return ModifiedPatchForLines(p.Log, filename, info.diff, info.includedLineIndices,
+		return ModifiedPatchForLines(p.Log, filename, info.diffWithEdits(), info.includedLineIndices,
 			PatchOptions{
 				Reverse:            reverse,
 				KeepOriginalHeader: true,
@@ -186,32 +300,86 @@ func (p *PatchManager) renderPlainPatchForFile(filename string, reverse bool) st
 	}
 }
 
+// RenderPatchForFile renders the part of the patch for the given file of the
+// commit we're currently adding files from
 func (p *PatchManager) RenderPatchForFile(filename string, plain bool, reverse bool) string {
-	patch := p.renderPlainPatchForFile(filename, reverse)
+	info, err := p.getFileInfo(filename)
+	if err != nil {
+		p.Log.Error(err)
+		return ""
+	}
+
+	return p.renderPatchForFileInfo(filename, info, plain, reverse)
+}
+
+func (p *PatchManager) renderPatchForFileInfo(filename string, info *fileInfo, plain bool, reverse bool) string {
+	patch := p.renderPlainPatchForFile(filename, info, reverse)
 	if plain {
 		return patch
 	}
 	parser := NewPatchParser(p.Log, patch)
 
 	// not passing included lines because we don't want to see them in the secondary panel
-	return parser.Render(false, -1, -1, nil)
+	return parser.Render(false, -1, -1, nil, false)
 }
 
 func (p *PatchManager) renderEachFilePatch(plain bool) []string {
-	// sort files by name then iterate through and render each patch
-	filenames := maps.Keys(p.fileInfoMap)
-
-	sort.Strings(filenames)
-	patches := slices.Map(filenames, func(filename string) string {
-		return p.RenderPatchForFile(filename, plain, false)
-	})
-	output := slices.Filter(patches, func(patch string) bool {
-		return patch != ""
-	})
+	output := []string{}
+	for _, to := range p.commitOrder {
+		fileInfoMap := p.commitPatches[to].fileInfoMap
+
+		// sort files by name then iterate through and render each patch
+		filenames := maps.Keys(fileInfoMap)
+
+		sort.Strings(filenames)
+		patches := slices.Map(filenames, func(filename string) string {
+			return p.renderPatchForFileInfo(filename, fileInfoMap[filename], plain, false)
+		})
+		output = append(output, slices.Filter(patches, func(patch string) bool {
+			return patch != ""
+		})...)
+	}
 
 	return output
 }
 
+// IncludedFile is a file which has something included in the patch
+type IncludedFile struct {
+	// Commit is the commit (or stash entry) that the file's changes are from
+	Commit string
+	Name   string
+	Status PatchStatus
+	// Patch is the plain patch for the file
+	Patch string
+}
+
+// IncludedFiles returns the files which have something included in the patch,
+// in the same order as in the aggregated patch
+func (p *PatchManager) IncludedFiles() []*IncludedFile {
+	result := []*IncludedFile{}
+	for _, to := range p.commitOrder {
+		fileInfoMap := p.commitPatches[to].fileInfoMap
+
+		filenames := maps.Keys(fileInfoMap)
+		sort.Strings(filenames)
+		for _, filename := range filenames {
+			info := fileInfoMap[filename]
+			if info.mode == UNSELECTED {
+				continue
+			}
+
+			result = append(result, &IncludedFile{
+				Commit: to,
+				Name:   filename,
+				Status: info.mode,
+				Patch:  p.renderPlainPatchForFile(filename, info, false),
+			})
+		}
+	}
+
+	return result
+}
+
 func (p *PatchManager) RenderAggregatedPatchColored(plain bool) string {
 	result := ""
 	for _, patch := range p.renderEachFilePatch(plain) {
@@ -223,11 +391,12 @@ func (p *PatchManager) RenderAggregatedPatchColored(plain bool) string {
 }
 
 func (p *PatchManager) GetFileStatus(filename string, parent string) PatchStatus {
-	if parent != p.To {
+	commitPatch, ok := p.commitPatches[parent]
+	if !ok {
 		return UNSELECTED
 	}
 
-	info, ok := p.fileInfoMap[filename]
+	info, ok := commitPatch.fileInfoMap[filename]
 	if !ok {
 		return UNSELECTED
 	}
@@ -244,28 +413,75 @@ func (p *PatchManager) GetFileIncLineIndices(filename string) ([]int, error) {
 }
 
 func (p *PatchManager) ApplyPatches(reverse bool) error {
-	patch := ""
+	applyFlags := []string{"index", "3way"}
+	if reverse {
+		applyFlags = append(applyFlags, "reverse")
+	}
+
+	return p.applyPatch(p.renderPatchesForApplying(reverse), applyFlags...)
+}
+
+// ApplyCommitPatch is like ApplyPatches but only applies the part of the patch
+// taken from the given commit
+func (p *PatchManager) ApplyCommitPatch(to string, reverse bool) error {
+	if _, ok := p.commitPatches[to]; !ok {
+		return errors.New("commit is not part of the patch")
+	}
 
 	applyFlags := []string{"index", "3way"}
 	if reverse {
 		applyFlags = append(applyFlags, "reverse")
 	}
 
-	for filename, info := range p.fileInfoMap {
+	return p.applyPatch(p.renderCommitPatchForApplying(to, reverse), applyFlags...)
+}
+
+// ApplyPatchesToWorkingTree applies the patch to the working tree without
+// touching the index. Unlike ApplyPatches we don't fall back to a three-way
+// merge, so if the working tree has conflicting changes nothing is applied
+// and an error is returned.
+func (p *PatchManager) ApplyPatchesToWorkingTree(reverse bool) error {
+	applyFlags := []string{}
+	if reverse {
+		applyFlags = append(applyFlags, "reverse")
+	}
+
+	return p.applyPatch(p.renderPatchesForApplying(reverse), applyFlags...)
+}
+
+func (p *PatchManager) renderPatchesForApplying(reverse bool) string {
+	// when reversing, later commits' changes need to be undone first
+	commitOrder := p.commitOrder
+	if reverse {
+		commitOrder = slices.Reverse(commitOrder)
+	}
+
+	patch := ""
+	for _, to := range commitOrder {
+		patch += p.renderCommitPatchForApplying(to, reverse)
+	}
+
+	return patch
+}
+
+func (p *PatchManager) renderCommitPatchForApplying(to string, reverse bool) string {
+	patch := ""
+	for filename, info := range p.commitPatches[to].fileInfoMap {
 		if info.mode == UNSELECTED {
 			continue
 		}
 
-		patch += p.RenderPatchForFile(filename, true, reverse)
+		patch += p.renderPatchForFileInfo(filename, info, true, reverse)
 	}
 
-	return p.applyPatch(patch, applyFlags...)
+	return patch
 }
 
 // clears the patch
 func (p *PatchManager) Reset() {
 	p.To = ""
-	p.fileInfoMap = map[string]*fileInfo{}
+	p.commitPatches = map[string]*commitPatch{}
+	p.commitOrder = nil
 }
 
 func (p *PatchManager) Active() bool {
@@ -273,7 +489,25 @@ func (p *PatchManager) Active() bool {
 }
 
 func (p *PatchManager) IsEmpty() bool {
-	for _, fileInfo := range p.fileInfoMap {
+	return len(p.Commits()) == 0
+}
+
+// Commits returns the commits which contribute something to the patch, in the
+// order they were added
+func (p *PatchManager) Commits() []string {
+	return slices.Filter(p.commitOrder, func(to string) bool {
+		return !p.commitPatches[to].isEmpty()
+	})
+}
+
+// SpansMultipleCommits tells us whether the patch contains changes from more
+// than one commit, in which case it can't be moved out of its commit
+func (p *PatchManager) SpansMultipleCommits() bool {
+	return len(p.Commits()) > 1
+}
+
+func (c *commitPatch) isEmpty() bool {
+	for _, fileInfo := range c.fileInfoMap {
 		if fileInfo.mode == WHOLE || (fileInfo.mode == PART && len(fileInfo.includedLineIndices) > 0) {
 			return false
 		}
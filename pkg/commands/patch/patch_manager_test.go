@@ -0,0 +1,219 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPatchManager(diff string) *PatchManager {
+	p := NewPatchManager(nil, nil, func(from string, to string, reverse bool, filename string, plain bool) (string, error) {
+		return diff, nil
+	})
+	p.Start("from", "to", false, false)
+	return p
+}
+
+func TestEditLine(t *testing.T) {
+	type scenario struct {
+		testName      string
+		diffText      string
+		lineIdx       int
+		content       string
+		expectedError string
+		expected      string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "editing an added line",
+			diffText: twoChangesInOneHunk,
+			lineIdx:  7,
+			content:  "lime",
+			expected: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,6 @@
+ apple
+ grape
++lime
+ orange
+ pear
+ lemon
+`,
+		},
+		{
+			testName: "editing a line before the no-newline marker",
+			diffText: removeNewlinefromEndOfFile,
+			lineIdx:  9,
+			content:  "edited last line",
+			expected: `diff --git a/filename b/filename
+index e48a11c..80a73f1 100644
+--- a/filename
++++ b/filename
+@@ -60,4 +60,5 @@ grape
+ ...
+ ...
+ ...
+ last line
++edited last line
+\ No newline at end of file
+`,
+		},
+		{
+			testName: "newlines are stripped from the edited content",
+			diffText: twoChangesInOneHunk,
+			lineIdx:  7,
+			content:  "li\nme\r",
+			expected: `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,6 @@
+ apple
+ grape
++lime
+ orange
+ pear
+ lemon
+`,
+		},
+		{
+			testName:      "editing a deleted line",
+			diffText:      twoChangesInOneHunk,
+			lineIdx:       6,
+			content:       "lime",
+			expectedError: "only added lines can be edited",
+			expected:      "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			p := newTestPatchManager(s.diffText)
+			err := p.EditLine("filename", s.lineIdx, s.content)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, s.expected, p.RenderPatchForFile("filename", true, false))
+		})
+	}
+}
+
+func TestGetEditableLineContent(t *testing.T) {
+	p := newTestPatchManager(twoChangesInOneHunk)
+
+	content, ok, err := p.GetEditableLineContent("filename", 7)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "kiwi", content)
+
+	assert.NoError(t, p.EditLine("filename", 7, "lime"))
+	content, ok, err = p.GetEditableLineContent("filename", 7)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "lime", content)
+
+	_, ok, err = p.GetEditableLineContent("filename", 6)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// removing the line from the patch discards the edit
+	assert.NoError(t, p.RemoveFileLineRange("filename", 7, 7))
+	content, _, _ = p.GetEditableLineContent("filename", 7)
+	assert.Equal(t, "kiwi", content)
+}
+
+func TestPatchSpanningMultipleCommits(t *testing.T) {
+	diffs := map[string]string{
+		"commit1": `diff --git a/file1 b/file1
+index 9320895..6d79956 100644
+--- a/file1
++++ b/file1
+@@ -1 +1 @@
+-apple
++grape
+`,
+		"commit2": `diff --git a/file2 b/file2
+index 9320895..6d79956 100644
+--- a/file2
++++ b/file2
+@@ -1 +1 @@
+-lemon
++lime
+`,
+	}
+
+	var appliedPatch string
+	var appliedFlags []string
+	p := NewPatchManager(nil,
+		func(patch string, flags ...string) error {
+			appliedPatch = patch
+			appliedFlags = flags
+			return nil
+		},
+		func(from string, to string, reverse bool, filename string, plain bool) (string, error) {
+			return diffs[to], nil
+		},
+	)
+
+	p.Start("commit1^", "commit1", false, true)
+	assert.NoError(t, p.AddFileWhole("file1"))
+	assert.Equal(t, []string{"commit1"}, p.Commits())
+	assert.False(t, p.SpansMultipleCommits())
+
+	// switching to a commit without adding anything to the patch leaves it
+	// spanning a single commit
+	p.AddCommit("commit2^", "commit2", false, true)
+	assert.Equal(t, []string{"commit1"}, p.Commits())
+	assert.False(t, p.SpansMultipleCommits())
+
+	assert.NoError(t, p.AddFileWhole("file2"))
+	assert.Equal(t, []string{"commit1", "commit2"}, p.Commits())
+	assert.True(t, p.SpansMultipleCommits())
+
+	assert.Equal(t, WHOLE, p.GetFileStatus("file1", "commit1"))
+	assert.Equal(t, UNSELECTED, p.GetFileStatus("file1", "commit2"))
+	assert.Equal(t, WHOLE, p.GetFileStatus("file2", "commit2"))
+
+	assert.Equal(t, diffs["commit1"]+"\n"+diffs["commit2"]+"\n", p.RenderAggregatedPatchColored(true))
+
+	assert.NoError(t, p.ApplyPatchesToWorkingTree(false))
+	assert.Equal(t, diffs["commit1"]+diffs["commit2"], appliedPatch)
+	assert.Empty(t, appliedFlags)
+
+	// the later commit's changes are undone first
+	assert.NoError(t, p.ApplyPatches(true))
+	assert.Equal(t, diffs["commit2"]+diffs["commit1"], appliedPatch)
+	assert.Equal(t, []string{"index", "3way", "reverse"}, appliedFlags)
+
+	// each commit's part can be applied on its own
+	assert.NoError(t, p.ApplyCommitPatch("commit2", true))
+	assert.Equal(t, diffs["commit2"], appliedPatch)
+	assert.Equal(t, []string{"index", "3way", "reverse"}, appliedFlags)
+	assert.Error(t, p.ApplyCommitPatch("commit3", true))
+
+	// going back to an earlier commit keeps what we've added from it
+	p.AddCommit("commit1^", "commit1", false, true)
+	assert.Equal(t, WHOLE, p.GetFileStatus("file1", "commit1"))
+	assert.NoError(t, p.RemoveFile("file1"))
+	assert.Equal(t, []string{"commit2"}, p.Commits())
+	assert.False(t, p.SpansMultipleCommits())
+
+	p.Reset()
+	assert.True(t, p.IsEmpty())
+	assert.False(t, p.Active())
+}
+
+func TestAddingFilesAfterReset(t *testing.T) {
+	p := newTestPatchManager("diff")
+	p.Reset()
+
+	assert.NoError(t, p.AddFileWhole("filename"))
+	assert.False(t, p.Active())
+	assert.True(t, p.IsEmpty())
+}
@@ -5,12 +5,17 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
 var (
 	hunkHeaderRegexp  = regexp.MustCompile(`(?m)^@@ -(\d+)[^\+]+\+(\d+)[^@]+@@(.*)$`)
 	patchHeaderRegexp = regexp.MustCompile(`(?ms)(^diff.*?)^@@`)
+	diffHeaderRegexp  = regexp.MustCompile(`(?ms)(^diff.*?)(^@@|\z)`)
+	modeLineRegexp    = regexp.MustCompile(`(?m)^(old mode|new mode|deleted file mode) (\d+)$`)
+	renameLineRegexp  = regexp.MustCompile(`(?m)^rename (from|to) (.+)$`)
+	binaryLineRegexp  = regexp.MustCompile(`(?m)^Binary files .* differ$`)
 )
 
 type PatchOptions struct {
@@ -24,6 +29,15 @@ type PatchOptions struct {
 	// Whether to keep or discard the original diff header including the
 	// "index deadbeef..fa1afe1 100644" line.
 	KeepOriginalHeader bool
+
+	// Treat the given lines as the ones to leave out: every other line in the
+	// hunks that contain any of the given lines is selected instead.
+	InvertSelection bool
+
+	// Leave out changes which only affect whitespace, i.e. deleted lines which
+	// are replaced by added lines that only differ from them in whitespace.
+	// Hunks with no other changes are dropped from the patch.
+	SkipWhitespaceOnlyChanges bool
 }
 
 func GetHeaderFromDiff(diff string) string {
@@ -34,6 +48,67 @@ func GetHeaderFromDiff(diff string) string {
 	return match[1]
 }
 
+// extendedHeader holds the lines of a diff's header which describe a change to
+// the file itself rather than to its content. Git needs these to apply a
+// patch for such a change correctly, so we keep them even when discarding the
+// rest of the original header.
+type extendedHeader struct {
+	oldMode         string
+	newMode         string
+	deletedFileMode string
+	renameFrom      string
+	renameTo        string
+}
+
+func parseExtendedHeader(diff string) extendedHeader {
+	result := extendedHeader{}
+	match := diffHeaderRegexp.FindStringSubmatch(diff)
+	if len(match) <= 1 {
+		return result
+	}
+
+	for _, modeMatch := range modeLineRegexp.FindAllStringSubmatch(match[1], -1) {
+		switch modeMatch[1] {
+		case "old mode":
+			result.oldMode = modeMatch[2]
+		case "new mode":
+			result.newMode = modeMatch[2]
+		case "deleted file mode":
+			result.deletedFileMode = modeMatch[2]
+		}
+	}
+
+	for _, renameMatch := range renameLineRegexp.FindAllStringSubmatch(match[1], -1) {
+		if renameMatch[1] == "from" {
+			result.renameFrom = renameMatch[2]
+		} else {
+			result.renameTo = renameMatch[2]
+		}
+	}
+
+	return result
+}
+
+func (h extendedHeader) hasModeChange() bool {
+	return h.oldMode != "" && h.newMode != ""
+}
+
+func (h extendedHeader) isRename() bool {
+	return h.renameFrom != "" && h.renameTo != ""
+}
+
+// IsDeletedFileDiff tells us whether the diff is for a file which has been
+// deleted. Such a diff can only be applied as a whole.
+func IsDeletedFileDiff(diff string) bool {
+	return parseExtendedHeader(diff).deletedFileMode != ""
+}
+
+// IsBinaryDiff tells us whether the diff is for a binary file, in which case
+// it has no lines that could be staged individually
+func IsBinaryDiff(diff string) bool {
+	return binaryLineRegexp.MatchString(diff)
+}
+
 func GetHunksFromDiff(diff string) []*PatchHunk {
 	hunks := []*PatchHunk{}
 	firstLineIdx := -1
@@ -73,19 +148,48 @@ func GetHunksFromDiff(diff string) []*PatchHunk {
 	return hunks
 }
 
+// SplitHunks returns the diff with each hunk for which shouldSplit returns
+// true broken up into one hunk per group of changes. Other hunks are left as
+// they are.
+func SplitHunks(diff string, shouldSplit func(hunk *PatchHunk) bool) string {
+	lines := strings.SplitAfter(diff, "\n")
+	hunks := GetHunksFromDiff(diff)
+	if len(hunks) == 0 {
+		return diff
+	}
+
+	var result strings.Builder
+	result.WriteString(strings.Join(lines[:hunks[0].FirstLineIdx], ""))
+	for _, hunk := range hunks {
+		if !shouldSplit(hunk) {
+			result.WriteString(strings.Join(lines[hunk.FirstLineIdx:hunk.LastLineIdx()+1], ""))
+			continue
+		}
+
+		for _, subHunk := range hunk.split() {
+			result.WriteString(subHunk.formatHeader(subHunk.oldStart, subHunk.oldLength(), subHunk.newStart, subHunk.newLength(), subHunk.heading))
+			result.WriteString(subHunk.Body())
+		}
+	}
+
+	return result.String()
+}
+
 type PatchModifier struct {
-	Log      *logrus.Entry
-	filename string
-	hunks    []*PatchHunk
-	header   string
+	Log            *logrus.Entry
+	filename       string
+	hunks          []*PatchHunk
+	header         string
+	extendedHeader extendedHeader
 }
 
 func NewPatchModifier(log *logrus.Entry, filename string, diffText string) *PatchModifier {
 	return &PatchModifier{
-		Log:      log,
-		filename: filename,
-		hunks:    GetHunksFromDiff(diffText),
-		header:   GetHeaderFromDiff(diffText),
+		Log:            log,
+		filename:       filename,
+		hunks:          GetHunksFromDiff(diffText),
+		header:         GetHeaderFromDiff(diffText),
+		extendedHeader: parseExtendedHeader(diffText),
 	}
 }
 
@@ -103,17 +207,35 @@ outer:
 		}
 	}
 
+	if opts.InvertSelection {
+		lineIndices = invertedLineIndices(hunksInRange, lineIndices)
+	}
+
+	if opts.SkipWhitespaceOnlyChanges {
+		lineIndices = withoutWhitespaceOnlyChanges(hunksInRange, lineIndices)
+	}
+
 	// step 2 is collecting all the hunks with new headers
 	startOffset := 0
 	formattedHunks := ""
 	var formattedHunk string
-	for _, hunk := range hunksInRange {
-		startOffset, formattedHunk = hunk.formatWithChanges(
-			lineIndices, opts.Reverse, startOffset)
+	for _, hunks := range groupOverlappingHunks(hunksInRange) {
+		startOffset, formattedHunk = formatWithChanges(
+			hunks, lineIndices, opts.Reverse, startOffset)
 		formattedHunks += formattedHunk
 	}
 
 	if formattedHunks == "" {
+		// a change to the file itself (i.e. a rename or a change of mode) has no
+		// content lines, so if there are no hunks or only the diff's header is
+		// selected, the patch is just that change
+		if !opts.KeepOriginalHeader && d.selectsHeader(lineIndices) {
+			renamed := d.includesRename(opts.Reverse)
+			if renamed || d.extendedHeader.hasModeChange() {
+				return d.gitHeader(false, renamed)
+			}
+		}
+
 		return ""
 	}
 
@@ -125,12 +247,142 @@ outer:
 	if opts.KeepOriginalHeader {
 		fileHeader = d.header
 	} else {
-		fileHeader = fmt.Sprintf("--- a/%s\n+++ b/%s\n", d.filename, d.filename)
+		fileHeader = d.fileHeader(lineIndices, opts.Reverse)
 	}
 
 	return fileHeader + formattedHunks
 }
 
+// fileHeader returns the header to use in place of the original one, keeping
+// only what git needs in order to apply the patch
+func (d *PatchModifier) fileHeader(lineIndices []int, reverse bool) string {
+	if d.deletesWholeFile(lineIndices) {
+		return d.gitHeader(true, false) + fmt.Sprintf("--- a/%s\n+++ /dev/null\n", d.filename)
+	}
+
+	renamed := d.includesRename(reverse)
+	oldPath, newPath := d.paths(renamed)
+	header := ""
+	if renamed || d.extendedHeader.hasModeChange() {
+		header = d.gitHeader(false, renamed)
+	}
+
+	return header + fmt.Sprintf("--- a/%s\n+++ b/%s\n", oldPath, newPath)
+}
+
+// gitHeader returns the 'diff --git' line along with the extended header lines
+// for the file's mode and name. Git only honours those lines in a patch that
+// starts with a 'diff --git' line.
+func (d *PatchModifier) gitHeader(deletesFile bool, renamed bool) string {
+	oldPath, newPath := d.paths(renamed)
+	header := fmt.Sprintf("diff --git a/%s b/%s\n", oldPath, newPath)
+	if deletesFile {
+		return header + fmt.Sprintf("deleted file mode %s\n", d.extendedHeader.deletedFileMode)
+	}
+
+	if d.extendedHeader.hasModeChange() {
+		header += fmt.Sprintf("old mode %s\nnew mode %s\n", d.extendedHeader.oldMode, d.extendedHeader.newMode)
+	}
+
+	if renamed {
+		header += fmt.Sprintf("rename from %s\nrename to %s\n", oldPath, newPath)
+	}
+
+	return header
+}
+
+// includesRename tells us whether the patch should rename the file. When
+// reversing (i.e. unstaging or discarding lines) we're changing the file at
+// its new path and the rename itself should stay as it is.
+func (d *PatchModifier) includesRename(reverse bool) bool {
+	return !reverse && d.extendedHeader.isRename()
+}
+
+func (d *PatchModifier) paths(renamed bool) (string, string) {
+	if renamed {
+		return d.extendedHeader.renameFrom, d.extendedHeader.renameTo
+	}
+
+	return d.filename, d.filename
+}
+
+// selectsHeader tells us whether the given lines include the diff's header, or
+// whether the diff has nothing but a header
+func (d *PatchModifier) selectsHeader(lineIndices []int) bool {
+	if len(d.hunks) == 0 {
+		return true
+	}
+
+	return lo.SomeBy(lineIndices, func(lineIdx int) bool {
+		return lineIdx < d.hunks[0].FirstLineIdx
+	})
+}
+
+// deletesWholeFile tells us whether the diff is for a deleted file and the
+// given lines include all of it. Only then can we tell git to delete the file:
+// if some of its lines were left out, git would reject the patch.
+func (d *PatchModifier) deletesWholeFile(lineIndices []int) bool {
+	if d.extendedHeader.deletedFileMode == "" {
+		return false
+	}
+
+	for _, hunk := range d.hunks {
+		for i, line := range hunk.bodyLines {
+			lineIdx := hunk.FirstLineIdx + 1 + i
+			if strings.HasPrefix(line, "\\") {
+				continue
+			}
+			if !strings.HasPrefix(line, "-") || !lo.Contains(lineIndices, lineIdx) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// groups together consecutive hunks which share context lines. Git won't apply
+// a patch with overlapping hunks, so these need to be joined back into one.
+func groupOverlappingHunks(hunks []*PatchHunk) [][]*PatchHunk {
+	groups := [][]*PatchHunk{}
+	for i, hunk := range hunks {
+		if i > 0 && hunk.overlap(hunks[i-1]) > 0 {
+			groups[len(groups)-1] = append(groups[len(groups)-1], hunk)
+		} else {
+			groups = append(groups, []*PatchHunk{hunk})
+		}
+	}
+	return groups
+}
+
+// returns the lines within the given hunks that are not in lineIndices. We
+// leave out 'no newline at end of file' lines because whether they're kept
+// depends on the line they belong to.
+func invertedLineIndices(hunks []*PatchHunk, lineIndices []int) []int {
+	result := []int{}
+	for _, hunk := range hunks {
+		for i, line := range hunk.bodyLines {
+			lineIdx := hunk.FirstLineIdx + 1 + i
+			if !strings.HasPrefix(line, "\\") && !lo.Contains(lineIndices, lineIdx) {
+				result = append(result, lineIdx)
+			}
+		}
+	}
+	return result
+}
+
+// returns the given lines minus those of any whitespace-only changes in the
+// given hunks. Unselected lines are left out of the patch when it's assembled,
+// so a hunk whose changes are all whitespace-only ends up being dropped.
+func withoutWhitespaceOnlyChanges(hunks []*PatchHunk, lineIndices []int) []int {
+	whitespaceOnlyChanges := []int{}
+	for _, hunk := range hunks {
+		whitespaceOnlyChanges = append(whitespaceOnlyChanges, hunk.whitespaceOnlyChanges()...)
+	}
+
+	return lo.Without(lineIndices, whitespaceOnlyChanges...)
+}
+
 func (d *PatchModifier) ModifiedPatchForRange(firstLineIdx int, lastLineIdx int, opts PatchOptions) string {
 	// generate array of consecutive line indices from our range
 	selectedLines := []int{}
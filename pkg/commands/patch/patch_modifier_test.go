@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,6 +113,184 @@ const exampleHunk = `@@ -1,5 +1,5 @@
 ...
 `
 
+// as produced by git diff --unified=0
+const zeroContextHunks = `diff --git a/filename b/filename
+index f00c965..cec4431 100644
+--- a/filename
++++ b/filename
+@@ -3 +3 @@
+-3
++three
+@@ -9 +9 @@
+-9
++nine
+@@ -10,0 +11 @@
++11
+`
+
+// as produced by git diff --unified=10
+const tenContextHunk = `diff --git a/filename b/filename
+index 5ddf809..f18ea00 100644
+--- a/filename
++++ b/filename
+@@ -2,21 +2,21 @@
+ 2
+ 3
+ 4
+ 5
+ 6
+ 7
+ 8
+ 9
+ 10
+ 11
+-12
++twelve
+ 13
+ 14
+ 15
+ 16
+ 17
+ 18
+ 19
+ 20
+ 21
+ 22
+`
+
+const threeChangeIslands = `diff --git a/filename b/filename
+index 3d4a5e2..b8f4e19 100644
+--- a/filename
++++ b/filename
+@@ -1,11 +1,11 @@ heading
+ 1
+-2
++two
+ 3
+ 4
+-5
++five
+ 6
+ 7
+-8
++eight
+ 9
+ 10
+ 11
+`
+
+// threeChangeIslands after splitting its hunk
+const threeChangeIslandsSplit = `diff --git a/filename b/filename
+index 3d4a5e2..b8f4e19 100644
+--- a/filename
++++ b/filename
+@@ -1,4 +1,4 @@ heading
+ 1
+-2
++two
+ 3
+ 4
+@@ -3,5 +3,5 @@ heading
+ 3
+ 4
+-5
++five
+ 6
+ 7
+@@ -6,6 +6,6 @@ heading
+ 6
+ 7
+-8
++eight
+ 9
+ 10
+ 11
+`
+
+const deletedFile = `diff --git a/deleted b/deleted
+deleted file mode 100644
+index e6c83c5..0000000
+--- a/deleted
++++ /dev/null
+@@ -1,3 +0,0 @@
+-apple
+-grape
+-pear
+`
+
+const modeChange = `diff --git a/script b/script
+old mode 100644
+new mode 100755
+index 4cb29ea..f04eb26
+--- a/script
++++ b/script
+@@ -1,3 +1,3 @@
+ one
+-two
++2
+ three
+`
+
+const pureModeChange = `diff --git a/modeonly b/modeonly
+old mode 100644
+new mode 100755
+`
+
+const renameWithTwoHunks = `diff --git a/oldname b/newname
+similarity index 77%
+rename from oldname
+rename to newname
+index 0ff3bbb..9bebd18 100644
+--- a/oldname
++++ b/newname
+@@ -1,5 +1,5 @@
+ 1
+-2
++two
+ 3
+ 4
+ 5
+@@ -15,6 +15,6 @@
+ 15
+ 16
+ 17
+-18
++eighteen
+ 19
+ 20
+`
+
+const pureRename = `diff --git a/oldname b/newname
+similarity index 100%
+rename from oldname
+rename to newname
+`
+
+const whitespaceChanges = `diff --git a/filename b/filename
+index 3a1b2c4..5d6e7f8 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-grape
++  grape
+ orange
+-pear
++banana
+ lemon
+@@ -10,3 +10,3 @@
+ ...
+-	kiwi
++    kiwi
+ ...
+@@ -20,3 +20,4 @@
+ ...
+-mango
++  mango
++papaya
+ ...
+`
+
 // TestModifyPatchForRange is a function.
 func TestModifyPatchForRange(t *testing.T) {
 	type scenario struct {
@@ -484,6 +663,258 @@ func TestModifyPatchForRange(t *testing.T) {
  orange
  banana
  lemon
+`,
+		},
+		{
+			testName:       "zero context lines, whole hunks selected",
+			filename:       "filename",
+			firstLineIndex: 8,
+			lastLineIndex:  11,
+			reverse:        false,
+			diffText:       zeroContextHunks,
+			expected: `--- a/filename
++++ b/filename
+@@ -9,1 +9,1 @@
+-9
++nine
+@@ -10,0 +11,1 @@
++11
+`,
+		},
+		{
+			testName:       "zero context lines, only a removed line selected",
+			filename:       "filename",
+			firstLineIndex: 5,
+			lastLineIndex:  5,
+			reverse:        false,
+			diffText:       zeroContextHunks,
+			expected: `--- a/filename
++++ b/filename
+@@ -3,1 +2,0 @@
+-3
+`,
+		},
+		{
+			testName:       "zero context lines, only an added line selected",
+			filename:       "filename",
+			firstLineIndex: 9,
+			lastLineIndex:  9,
+			reverse:        false,
+			diffText:       zeroContextHunks,
+			expected: `--- a/filename
++++ b/filename
+@@ -9,1 +9,2 @@
+ 9
++nine
+`,
+		},
+		{
+			testName:       "ten context lines, only an added line selected",
+			filename:       "filename",
+			firstLineIndex: 16,
+			lastLineIndex:  16,
+			reverse:        false,
+			diffText:       tenContextHunk,
+			expected: `--- a/filename
++++ b/filename
+@@ -2,21 +2,22 @@
+ 2
+ 3
+ 4
+ 5
+ 6
+ 7
+ 8
+ 9
+ 10
+ 11
+ 12
++twelve
+ 13
+ 14
+ 15
+ 16
+ 17
+ 18
+ 19
+ 20
+ 21
+ 22
+`,
+		},
+		{
+			testName:       "deleting a whole file",
+			filename:       "deleted",
+			firstLineIndex: 0,
+			lastLineIndex:  8,
+			reverse:        false,
+			diffText:       deletedFile,
+			expected: `diff --git a/deleted b/deleted
+deleted file mode 100644
+--- a/deleted
++++ /dev/null
+@@ -1,3 +0,0 @@
+-apple
+-grape
+-pear
+`,
+		},
+		{
+			testName:       "deleting a whole file, reversed",
+			filename:       "deleted",
+			firstLineIndex: 0,
+			lastLineIndex:  8,
+			reverse:        true,
+			diffText:       deletedFile,
+			expected: `diff --git a/deleted b/deleted
+deleted file mode 100644
+--- a/deleted
++++ /dev/null
+@@ -1,3 +0,0 @@
+-apple
+-grape
+-pear
+`,
+		},
+		{
+			testName:       "deleting part of a file",
+			filename:       "deleted",
+			firstLineIndex: 7,
+			lastLineIndex:  7,
+			reverse:        false,
+			diffText:       deletedFile,
+			expected: `--- a/deleted
++++ b/deleted
+@@ -1,3 +1,2 @@
+ apple
+-grape
+ pear
+`,
+		},
+		{
+			testName:       "changing content along with the mode",
+			filename:       "script",
+			firstLineIndex: 8,
+			lastLineIndex:  9,
+			reverse:        false,
+			diffText:       modeChange,
+			expected: `diff --git a/script b/script
+old mode 100644
+new mode 100755
+--- a/script
++++ b/script
+@@ -1,3 +1,3 @@
+ one
+-two
++2
+ three
+`,
+		},
+		{
+			testName:       "pure mode change",
+			filename:       "modeonly",
+			firstLineIndex: 0,
+			lastLineIndex:  2,
+			reverse:        false,
+			diffText:       pureModeChange,
+			expected: `diff --git a/modeonly b/modeonly
+old mode 100644
+new mode 100755
+`,
+		},
+		{
+			testName:       "staging the first hunk of a renamed and modified file",
+			filename:       "newname",
+			firstLineIndex: 9,
+			lastLineIndex:  10,
+			reverse:        false,
+			diffText:       renameWithTwoHunks,
+			expected: `diff --git a/oldname b/newname
+rename from oldname
+rename to newname
+--- a/oldname
++++ b/newname
+@@ -1,5 +1,5 @@
+ 1
+-2
++two
+ 3
+ 4
+ 5
+`,
+		},
+		{
+			testName:       "staging the second hunk of a renamed and modified file",
+			filename:       "newname",
+			firstLineIndex: 18,
+			lastLineIndex:  19,
+			reverse:        false,
+			diffText:       renameWithTwoHunks,
+			expected: `diff --git a/oldname b/newname
+rename from oldname
+rename to newname
+--- a/oldname
++++ b/newname
+@@ -15,6 +15,6 @@
+ 15
+ 16
+ 17
+-18
++eighteen
+ 19
+ 20
+`,
+		},
+		{
+			testName:       "staging only the rename of a renamed and modified file",
+			filename:       "newname",
+			firstLineIndex: 2,
+			lastLineIndex:  3,
+			reverse:        false,
+			diffText:       renameWithTwoHunks,
+			expected: `diff --git a/oldname b/newname
+rename from oldname
+rename to newname
+`,
+		},
+		{
+			testName:       "unstaging only the rename of a renamed and modified file",
+			filename:       "newname",
+			firstLineIndex: 2,
+			lastLineIndex:  3,
+			reverse:        true,
+			diffText:       renameWithTwoHunks,
+			expected:       "",
+		},
+		{
+			testName:       "pure rename",
+			filename:       "newname",
+			firstLineIndex: 0,
+			lastLineIndex:  3,
+			reverse:        false,
+			diffText:       pureRename,
+			expected: `diff --git a/oldname b/newname
+rename from oldname
+rename to newname
+`,
+		},
+		{
+			testName:       "unstaging one hunk of a renamed and modified file",
+			filename:       "newname",
+			firstLineIndex: 18,
+			lastLineIndex:  19,
+			reverse:        true,
+			diffText:       renameWithTwoHunks,
+			expected: `--- a/newname
++++ b/newname
+@@ -15,6 +15,6 @@
+ 15
+ 16
+ 17
+-18
++eighteen
+ 19
+ 20
 `,
 		},
 	}
@@ -530,3 +961,459 @@ func TestLineNumberOfLine(t *testing.T) {
 		})
 	}
 }
+
+func TestOriginalLineNumbers(t *testing.T) {
+	type scenario struct {
+		testName string
+		hunk     string
+		expected []int
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "deleted lines",
+			hunk:     "@@ -10,6 +10,5 @@\n a\n-b\n+B\n c\n-d\n-e\n f\n",
+			expected: []int{11, 13, 14},
+		},
+		{
+			testName: "only added lines",
+			hunk:     "@@ -10,2 +10,4 @@\n a\n+b\n+c\n d\n",
+			expected: []int{10, 11},
+		},
+		{
+			testName: "no newline at end of file",
+			hunk:     "@@ -10,2 +10,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+			expected: []int{11},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			hunk := newHunk(strings.SplitAfter(strings.TrimSuffix(s.hunk, "\n"), "\n"), 0)
+			assert.Equal(t, s.expected, hunk.OriginalLineNumbers())
+		})
+	}
+}
+
+func TestSplitHunks(t *testing.T) {
+	type scenario struct {
+		testName    string
+		diffText    string
+		shouldSplit bool
+		canSplit    []bool
+		expected    string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "hunk with three groups of changes",
+			diffText:    threeChangeIslands,
+			shouldSplit: true,
+			canSplit:    []bool{true},
+			expected:    threeChangeIslandsSplit,
+		},
+		{
+			testName:    "hunk not chosen for splitting",
+			diffText:    threeChangeIslands,
+			shouldSplit: false,
+			canSplit:    []bool{true},
+			expected:    threeChangeIslands,
+		},
+		{
+			testName:    "hunks which have already been split",
+			diffText:    threeChangeIslandsSplit,
+			shouldSplit: true,
+			canSplit:    []bool{false, false, false},
+			expected:    threeChangeIslandsSplit,
+		},
+		{
+			testName:    "hunk with one group of changes",
+			diffText:    simpleDiff,
+			shouldSplit: true,
+			canSplit:    []bool{false},
+			expected:    simpleDiff,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			canSplit := lo.Map(GetHunksFromDiff(s.diffText), func(hunk *PatchHunk, _ int) bool {
+				return hunk.CanSplit()
+			})
+			assert.Equal(t, s.canSplit, canSplit)
+
+			result := SplitHunks(s.diffText, func(*PatchHunk) bool { return s.shouldSplit })
+			if !assert.Equal(t, s.expected, result) {
+				fmt.Println(result)
+			}
+		})
+	}
+}
+
+func TestModifyPatchForLines(t *testing.T) {
+	type scenario struct {
+		testName       string
+		filename       string
+		diffText       string
+		lineIndices    []int
+		reverse        bool
+		invert         bool
+		skipWhitespace bool
+		expected       string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "disjoint lines within one hunk",
+			filename:    "filename",
+			lineIndices: []int{6, 10},
+			diffText:    twoChangesInOneHunk,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-grape
+ orange
+ pear
++banana
+ lemon
+`,
+		},
+		{
+			testName:    "disjoint lines within one hunk, reverse",
+			filename:    "filename",
+			lineIndices: []int{6, 10},
+			reverse:     true,
+			diffText:    twoChangesInOneHunk,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-grape
+ kiwi
+ orange
++banana
+ lemon
+`,
+		},
+		{
+			testName:    "disjoint lines across hunks",
+			filename:    "filename",
+			lineIndices: []int{7, 16},
+			diffText:    twoHunks,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,6 @@
+ apple
+ grape
++orange
+ ...
+ ...
+ ...
+@@ -8,6 +9,7 @@ grape
+ ...
+ ...
+ ...
++lemon
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:    "unsorted line indices",
+			filename:    "filename",
+			lineIndices: []int{16, 7},
+			diffText:    twoHunks,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,6 @@
+ apple
+ grape
++orange
+ ...
+ ...
+ ...
+@@ -8,6 +9,7 @@ grape
+ ...
+ ...
+ ...
++lemon
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:    "one part of a split hunk",
+			filename:    "filename",
+			lineIndices: []int{13, 14},
+			diffText:    threeChangeIslandsSplit,
+			expected: `--- a/filename
++++ b/filename
+@@ -3,5 +3,5 @@ heading
+ 3
+ 4
+-5
++five
+ 6
+ 7
+`,
+		},
+		{
+			testName:    "non-adjacent parts of a split hunk",
+			filename:    "filename",
+			lineIndices: []int{6, 7, 20, 21},
+			diffText:    threeChangeIslandsSplit,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,4 +1,4 @@ heading
+ 1
+-2
++two
+ 3
+ 4
+@@ -6,6 +6,6 @@ heading
+ 6
+ 7
+-8
++eight
+ 9
+ 10
+ 11
+`,
+		},
+		{
+			testName:    "adjacent parts of a split hunk",
+			filename:    "filename",
+			lineIndices: []int{6, 7, 13, 14, 20, 21},
+			diffText:    threeChangeIslandsSplit,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,11 +1,11 @@ heading
+ 1
+-2
++two
+ 3
+ 4
+-5
++five
+ 6
+ 7
+-8
++eight
+ 9
+ 10
+ 11
+`,
+		},
+		{
+			testName:    "adjacent parts of a split hunk, reverse",
+			filename:    "filename",
+			lineIndices: []int{13, 20},
+			reverse:     true,
+			diffText:    threeChangeIslandsSplit,
+			expected: `--- a/filename
++++ b/filename
+@@ -3,11 +3,9 @@ heading
+ 3
+ 4
+-5
+ five
+ 6
+ 7
+-8
+ eight
+ 9
+ 10
+ 11
+`,
+		},
+		{
+			testName:    "inverted selection of an addition",
+			filename:    "filename",
+			lineIndices: []int{7},
+			invert:      true,
+			diffText:    twoChangesInOneHunk,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,4 @@
+ apple
+-grape
+ orange
+-pear
++banana
+ lemon
+`,
+		},
+		{
+			testName:    "inverted selection of a deletion",
+			filename:    "filename",
+			lineIndices: []int{6},
+			invert:      true,
+			diffText:    twoChangesInOneHunk,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,6 @@
+ apple
+ grape
++kiwi
+ orange
+-pear
++banana
+ lemon
+`,
+		},
+		{
+			testName:    "inverted selection of a deletion, reverse",
+			filename:    "filename",
+			lineIndices: []int{6},
+			invert:      true,
+			reverse:     true,
+			diffText:    twoChangesInOneHunk,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,4 +1,5 @@
+ apple
++kiwi
+ orange
+-pear
++banana
+ lemon
+`,
+		},
+		{
+			testName:    "inverted selection only affects hunks containing the selection",
+			filename:    "filename",
+			lineIndices: []int{15},
+			invert:      true,
+			diffText:    twoHunks,
+			expected: `--- a/filename
++++ b/filename
+@@ -8,6 +8,7 @@ grape
+ ...
+ ...
+ ...
++lemon
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:    "inverted selection of whole hunk",
+			filename:    "filename",
+			lineIndices: []int{5, 6, 7, 8, 9, 10, 11},
+			invert:      true,
+			diffText:    twoChangesInOneHunk,
+			expected:    "",
+		},
+		{
+			testName:    "inverted selection with no-newline marker after deletion",
+			filename:    "filename",
+			lineIndices: []int{10},
+			invert:      true,
+			diffText:    addNewlineToEndOfFile,
+			expected: `--- a/filename
++++ b/filename
+@@ -60,4 +60,3 @@ grape
+ ...
+ ...
+ ...
+-last line
+\ No newline at end of file
+`,
+		},
+		{
+			testName:    "inverted selection with no-newline marker after addition",
+			filename:    "filename",
+			lineIndices: []int{9},
+			invert:      true,
+			diffText:    removeNewlinefromEndOfFile,
+			expected: `--- a/filename
++++ b/filename
+@@ -60,4 +60,3 @@ grape
+ ...
+ ...
+ ...
+-last line
+`,
+		},
+		{
+			testName:       "skipping whitespace-only changes",
+			filename:       "filename",
+			lineIndices:    []int{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22},
+			skipWhitespace: true,
+			diffText:       whitespaceChanges,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+ grape
+ orange
+-pear
++banana
+ lemon
+@@ -20,3 +20,4 @@
+ ...
+-mango
++  mango
++papaya
+ ...
+`,
+		},
+		{
+			testName:       "skipping whitespace-only changes, reverse",
+			filename:       "filename",
+			lineIndices:    []int{6, 7, 9, 10},
+			reverse:        true,
+			skipWhitespace: true,
+			diffText:       whitespaceChanges,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+   grape
+ orange
+-pear
++banana
+ lemon
+`,
+		},
+		{
+			testName:       "skipping whitespace-only changes with only whitespace-only changes selected",
+			filename:       "filename",
+			lineIndices:    []int{14, 15},
+			skipWhitespace: true,
+			diffText:       whitespaceChanges,
+			expected:       "",
+		},
+		{
+			testName:       "skipping whitespace-only changes with inverted selection",
+			filename:       "filename",
+			lineIndices:    []int{9, 10},
+			invert:         true,
+			skipWhitespace: true,
+			diffText:       whitespaceChanges,
+			expected:       "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			result := ModifiedPatchForLines(nil, s.filename, s.diffText, s.lineIndices,
+				PatchOptions{
+					Reverse:                   s.reverse,
+					KeepOriginalHeader:        false,
+					InvertSelection:           s.invert,
+					SkipWhitespaceOnlyChanges: s.skipWhitespace,
+				})
+			if !assert.Equal(t, s.expected, result) {
+				fmt.Println(result)
+			}
+		})
+	}
+}
@@ -37,13 +37,24 @@ type PatchParser struct {
 	PatchHunks     []*PatchHunk
 	HunkStarts     []int
 	StageableLines []int // rename to mention we're talking about indexes
+
+	// true if the patch is a combined diff of a file with merge conflicts (see
+	// combined_diff.go). Its changed lines are still listed in StageableLines so
+	// that we can move between them, but they can't actually be staged.
+	IsCombinedDiff bool
 }
 
 // NewPatchParser builds a new branch list builder
 func NewPatchParser(log *logrus.Entry, patch string) *PatchParser {
 	hunkStarts, stageableLines, patchLines := parsePatch(patch)
 
-	patchHunks := GetHunksFromDiff(patch)
+	isCombinedDiff := IsCombinedDiff(patch)
+	var patchHunks []*PatchHunk
+	if isCombinedDiff {
+		patchHunks, stageableLines = parseCombinedHunks(log, patch, patchLines)
+	} else {
+		patchHunks = GetHunksFromDiff(patch)
+	}
 
 	return &PatchParser{
 		Log:            log,
@@ -51,9 +62,38 @@ func NewPatchParser(log *logrus.Entry, patch string) *PatchParser {
 		StageableLines: stageableLines,
 		PatchLines:     patchLines,
 		PatchHunks:     patchHunks,
+		IsCombinedDiff: isCombinedDiff,
 	}
 }
 
+// parseCombinedHunks returns the hunks of a combined diff along with the
+// indices of its changed lines, correcting the kinds of the given lines which
+// parsePatch works out from the first prefix column only
+func parseCombinedHunks(log *logrus.Entry, patch string, patchLines []*PatchLine) ([]*PatchHunk, []int) {
+	combinedHunks, err := GetCombinedHunksFromDiff(patch)
+	if err != nil {
+		if log != nil {
+			log.Error(err)
+		}
+		return []*PatchHunk{}, []int{}
+	}
+
+	patchHunks := make([]*PatchHunk, 0, len(combinedHunks))
+	changedLines := []int{}
+	for _, combinedHunk := range combinedHunks {
+		patchHunks = append(patchHunks, combinedHunk.toPatchHunk())
+		for i, line := range combinedHunk.Lines {
+			lineIdx := combinedHunk.FirstLineIdx + 1 + i
+			patchLines[lineIdx].Kind = line.Kind
+			if line.Kind == ADDITION || line.Kind == DELETION {
+				changedLines = append(changedLines, lineIdx)
+			}
+		}
+	}
+
+	return patchHunks, changedLines
+}
+
 // GetHunkContainingLine takes a line index and an offset and finds the hunk
 // which contains the line index, then returns the hunk considering the offset.
 // e.g. if the offset is 1 it will return the next hunk.
@@ -86,7 +126,9 @@ func (p *PatchParser) GetHunkContainingLine(lineIndex int, offset int) *PatchHun
 // selected means you've got it highlighted with your cursor
 // included means the line has been included in the patch (only applicable when
 // building a patch)
-func (l *PatchLine) render(selected bool, included bool) string {
+// changedRanges are the parts of the line's content to emphasise because they
+// differ from the line it replaces (or is replaced by)
+func (l *PatchLine) render(selected bool, included bool, changedRanges []runeRange) string {
 	content := l.Content
 	if len(content) == 0 {
 		content = " " // using the space so that we can still highlight if necessary
@@ -94,26 +136,32 @@ func (l *PatchLine) render(selected bool, included bool) string {
 
 	// for hunk headers we need to start off cyan and then use white for the message
 	if l.Kind == HUNK_HEADER {
-		re := regexp.MustCompile("(@@.*?@@)(.*)")
+		re := regexp.MustCompile("(@@+.*?@@+)(.*)")
 		match := re.FindStringSubmatch(content)
 		return coloredString(style.FgCyan, match[1], selected, included) + coloredString(theme.DefaultTextColor, match[2], selected, false)
 	}
 
-	var textStyle style.TextStyle
+	if len(changedRanges) > 0 {
+		return coloredStringWithEmphasis(l.textStyle(), content, selected, included, changedRanges)
+	}
+
+	return coloredString(l.textStyle(), content, selected, included)
+}
+
+func (l *PatchLine) textStyle() style.TextStyle {
 	switch l.Kind {
 	case PATCH_HEADER:
-		textStyle = textStyle.SetBold()
+		var textStyle style.TextStyle
+		return textStyle.SetBold()
 	case ADDITION:
-		textStyle = style.FgGreen
+		return style.FgGreen
 	case DELETION:
-		textStyle = style.FgRed
+		return style.FgRed
 	case COMMIT_SHA:
-		textStyle = style.FgYellow
+		return style.FgYellow
 	default:
-		textStyle = theme.DefaultTextColor
+		return theme.DefaultTextColor
 	}
-
-	return coloredString(textStyle, content, selected, included)
 }
 
 func coloredString(textStyle style.TextStyle, str string, selected bool, included bool) string {
@@ -133,6 +181,40 @@ func coloredString(textStyle style.TextStyle, str string, selected bool, include
 	return firstCharStyle.Sprint(str[:1]) + textStyle.Sprint(str[1:])
 }
 
+// like coloredString, but with the given ranges of everything after the first
+// character rendered in reverse video
+func coloredStringWithEmphasis(textStyle style.TextStyle, str string, selected bool, included bool, changedRanges []runeRange) string {
+	if selected {
+		textStyle = textStyle.MergeStyle(theme.SelectedRangeBgColor)
+	}
+
+	firstCharStyle := textStyle
+	if included {
+		firstCharStyle = firstCharStyle.MergeStyle(style.BgGreen)
+	}
+	emphasisStyle := textStyle.SetReverse()
+
+	runes := []rune(str)
+	content := runes[1:]
+	var builder strings.Builder
+	builder.WriteString(firstCharStyle.Sprint(string(runes[:1])))
+	writeSegment := func(segmentStyle style.TextStyle, start int, end int) {
+		if start < end {
+			builder.WriteString(segmentStyle.Sprint(string(content[start:end])))
+		}
+	}
+
+	pos := 0
+	for _, changedRange := range changedRanges {
+		writeSegment(textStyle, pos, changedRange.start)
+		writeSegment(emphasisStyle, changedRange.start, changedRange.end)
+		pos = changedRange.end
+	}
+	writeSegment(textStyle, pos, len(content))
+
+	return builder.String()
+}
+
 func parsePatch(patch string) ([]int, []int, []*PatchLine) {
 	// ignore trailing newline.
 	lines := strings.Split(strings.TrimSuffix(patch, "\n"), "\n")
@@ -184,8 +266,10 @@ func parsePatch(patch string) ([]int, []int, []*PatchLine) {
 	return hunkStarts, stageableLines, patchLines
 }
 
-// Render returns the coloured string of the diff with any selected lines highlighted
-func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex int, incLineIndices []int) string {
+// Render returns the coloured string of the diff with any selected lines highlighted.
+// If showIntraLineDiff is true, the parts of changed lines which actually
+// differ from their counterparts are emphasised.
+func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex int, incLineIndices []int, showIntraLineDiff bool) string {
 	contentToDisplay := slices.Some(p.PatchLines, func(line *PatchLine) bool {
 		return line.Content != ""
 	})
@@ -193,10 +277,17 @@ func (p *PatchParser) Render(isFocused bool, firstLineIndex int, lastLineIndex i
 		return ""
 	}
 
+	var changedRanges map[int][]runeRange
+	// the lines of a combined diff have more than one prefix column and don't
+	// pair up the way intraLineChanges expects
+	if showIntraLineDiff && !p.IsCombinedDiff {
+		changedRanges = intraLineChanges(p.PatchLines)
+	}
+
 	renderedLines := slices.MapWithIndex(p.PatchLines, func(patchLine *PatchLine, index int) string {
 		selected := isFocused && index >= firstLineIndex && index <= lastLineIndex
 		included := lo.Contains(incLineIndices, index)
-		return patchLine.render(selected, included)
+		return patchLine.render(selected, included, changedRanges[index])
 	})
 
 	result := strings.Join(renderedLines, "\n")
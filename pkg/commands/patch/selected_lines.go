@@ -0,0 +1,109 @@
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// RenderLinesWithoutPrefixes returns the content of the changed and context
+// lines from firstLineIndex to lastLineIndex, without their '+', '-' or ' '
+// prefixes. Headers are left out.
+func (p *PatchParser) RenderLinesWithoutPrefixes(firstLineIndex, lastLineIndex int) string {
+	return p.renderContentOfKinds(firstLineIndex, lastLineIndex, ADDITION, DELETION, CONTEXT)
+}
+
+// RenderNewLinesWithoutPrefixes is like RenderLinesWithoutPrefixes but only
+// includes the lines which are in the new version of the file
+func (p *PatchParser) RenderNewLinesWithoutPrefixes(firstLineIndex, lastLineIndex int) string {
+	return p.renderContentOfKinds(firstLineIndex, lastLineIndex, ADDITION, CONTEXT)
+}
+
+func (p *PatchParser) renderContentOfKinds(firstLineIndex, lastLineIndex int, kinds ...PatchLineKind) string {
+	var result strings.Builder
+	for _, line := range p.PatchLines[firstLineIndex : lastLineIndex+1] {
+		if lo.Contains(kinds, line.Kind) {
+			result.WriteString(line.Content[utils.Min(1, len(line.Content)):] + "\n")
+		}
+	}
+
+	return result.String()
+}
+
+// RenderLinesAsDiff returns the lines from firstLineIndex to lastLineIndex as
+// a diff of their own: each hunk that the selection touches contributes a
+// hunk containing only the selected lines, with a header recomputed to match.
+func (p *PatchParser) RenderLinesAsDiff(firstLineIndex, lastLineIndex int) string {
+	// the lines of a combined diff have more than one prefix column, so we
+	// can't work out new headers for them
+	if p.IsCombinedDiff {
+		return p.RenderLinesPlain(firstLineIndex, lastLineIndex)
+	}
+
+	var result strings.Builder
+	var hunkLines []string
+	heading := ""
+	oldStart, newStart, oldLength, newLength := 0, 0, 0, 0
+
+	writeHunk := func() {
+		if oldLength+newLength > 0 {
+			// git gives an empty side of a hunk the number of the line before it
+			if oldLength == 0 {
+				oldStart--
+			}
+			if newLength == 0 {
+				newStart--
+			}
+			result.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@%s\n", oldStart, oldLength, newStart, newLength, heading))
+			result.WriteString(strings.Join(hunkLines, ""))
+		}
+		hunkLines = nil
+		oldLength, newLength = 0, 0
+	}
+
+	oldLineNumber, newLineNumber := 0, 0
+	for lineIdx, line := range p.PatchLines[:lastLineIndex+1] {
+		if line.Kind == HUNK_HEADER {
+			writeHunk()
+			if match := hunkHeaderRegexp.FindStringSubmatch(line.Content); match != nil {
+				oldLineNumber = utils.MustConvertToInt(match[1])
+				newLineNumber = utils.MustConvertToInt(match[2])
+				heading = match[3]
+			}
+			continue
+		}
+
+		isSelected := lineIdx >= firstLineIndex
+		if isSelected && lo.Contains([]PatchLineKind{ADDITION, DELETION, CONTEXT, NEWLINE_MESSAGE}, line.Kind) {
+			if len(hunkLines) == 0 {
+				oldStart, newStart = oldLineNumber, newLineNumber
+			}
+			hunkLines = append(hunkLines, line.Content+"\n")
+		}
+
+		switch line.Kind {
+		case DELETION:
+			oldLineNumber++
+			if isSelected {
+				oldLength++
+			}
+		case ADDITION:
+			newLineNumber++
+			if isSelected {
+				newLength++
+			}
+		case CONTEXT:
+			oldLineNumber++
+			newLineNumber++
+			if isSelected {
+				oldLength++
+				newLength++
+			}
+		}
+	}
+	writeHunk()
+
+	return result.String()
+}
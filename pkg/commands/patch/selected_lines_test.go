@@ -0,0 +1,80 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const twoHunkDiff = `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,4 +1,4 @@ func main() {
+ one
+-two
++TWO
+ three
+ four
+@@ -10,3 +10,4 @@ func other() {
+ ten
++ten and a half
+ eleven
+-twelve
++TWELVE
+`
+
+func TestRenderLinesWithoutPrefixes(t *testing.T) {
+	parser := NewPatchParser(nil, twoHunkDiff)
+
+	assert.Equal(t, "one\ntwo\nTWO\nthree\n", parser.RenderLinesWithoutPrefixes(4, 8))
+	assert.Equal(t, "one\nTWO\nthree\n", parser.RenderNewLinesWithoutPrefixes(4, 8))
+}
+
+func TestRenderLinesAsDiff(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		firstLineIdx  int
+		lastLineIdx   int
+		expectedPatch string
+	}{
+		{
+			testName:     "part of a hunk",
+			firstLineIdx: 6,
+			lastLineIdx:  8,
+			expectedPatch: `@@ -2,2 +2,2 @@ func main() {
+-two
++TWO
+ three
+`,
+		},
+		{
+			testName:     "only an addition",
+			firstLineIdx: 12,
+			lastLineIdx:  12,
+			expectedPatch: `@@ -10,0 +11,1 @@ func other() {
++ten and a half
+`,
+		},
+		{
+			testName:     "several hunks",
+			firstLineIdx: 8,
+			lastLineIdx:  12,
+			expectedPatch: `@@ -3,2 +3,2 @@ func main() {
+ three
+ four
+@@ -10,1 +10,2 @@ func other() {
+ ten
++ten and a half
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			parser := NewPatchParser(nil, twoHunkDiff)
+			assert.Equal(t, s.expectedPatch, parser.RenderLinesAsDiff(s.firstLineIdx, s.lastLineIdx))
+		})
+	}
+}
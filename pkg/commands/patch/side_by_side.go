@@ -0,0 +1,164 @@
+package patch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
+)
+
+// SideBySideRow is a row of a diff that's shown in two columns, with the old
+// version of the file on the left and the new version on the right. It holds
+// the indices of the patch lines shown in each column, with -1 for an empty
+// column. Lines that are neither additions nor deletions are shown across both
+// columns, in which case Left and Right are the same.
+type SideBySideRow struct {
+	Left  int
+	Right int
+}
+
+func (self SideBySideRow) Contains(lineIdx int) bool {
+	return self.Left == lineIdx || self.Right == lineIdx
+}
+
+// SideBySideRows pairs up each run of deleted lines with the run of added lines
+// that follows it, so that the old and new versions of a change are shown next
+// to each other
+func (p *PatchParser) SideBySideRows() []SideBySideRow {
+	rows := []SideBySideRow{}
+	deletions := []int{}
+	additions := []int{}
+
+	addChangedRows := func() {
+		for i := 0; i < utils.Max(len(deletions), len(additions)); i++ {
+			row := SideBySideRow{Left: -1, Right: -1}
+			if i < len(deletions) {
+				row.Left = deletions[i]
+			}
+			if i < len(additions) {
+				row.Right = additions[i]
+			}
+			rows = append(rows, row)
+		}
+		deletions = []int{}
+		additions = []int{}
+	}
+
+	for lineIdx, line := range p.PatchLines {
+		switch line.Kind {
+		case DELETION:
+			// a deletion coming after additions starts a new change
+			if len(additions) > 0 {
+				addChangedRows()
+			}
+			deletions = append(deletions, lineIdx)
+		case ADDITION:
+			additions = append(additions, lineIdx)
+		default:
+			addChangedRows()
+			rows = append(rows, SideBySideRow{Left: lineIdx, Right: lineIdx})
+		}
+	}
+	addChangedRows()
+
+	return rows
+}
+
+// RenderSideBySide renders the given rows at the given width, with each column
+// showing the line numbers of its version of the file. Headers are shown as is.
+func (p *PatchParser) RenderSideBySide(rows []SideBySideRow, width int, isFocused bool, firstLineIndex int, lastLineIndex int, incLineIndices []int, showIntraLineDiff bool) string {
+	var changedRanges map[int][]runeRange
+	if showIntraLineDiff {
+		changedRanges = intraLineChanges(p.PatchLines)
+	}
+
+	oldLineNumbers, newLineNumbers := p.lineNumbers()
+	lineNumberWidth := len(strconv.Itoa(utils.Max(lo.Max(oldLineNumbers), lo.Max(newLineNumbers))))
+	columnWidth := (width - 1) / 2
+	separator := style.FgBlackLighter.Sprint("│")
+
+	renderCell := func(lineIdx int, lineNumbers []int) string {
+		if lineIdx == -1 {
+			return strings.Repeat(" ", columnWidth)
+		}
+
+		lineNumber := ""
+		if lineNumbers[lineIdx] > 0 {
+			lineNumber = strconv.Itoa(lineNumbers[lineIdx])
+		}
+		selected := isFocused && lineIdx >= firstLineIndex && lineIdx <= lastLineIndex
+		included := lo.Contains(incLineIndices, lineIdx)
+
+		return style.FgBlackLighter.Sprint(fmt.Sprintf("%*s ", lineNumberWidth, lineNumber)) +
+			p.PatchLines[lineIdx].renderCell(columnWidth-lineNumberWidth-1, selected, included, changedRanges[lineIdx])
+	}
+
+	return strings.Join(slices.Map(rows, func(row SideBySideRow) string {
+		if row.Left == row.Right && p.PatchLines[row.Left].Kind != CONTEXT {
+			selected := isFocused && row.Left >= firstLineIndex && row.Left <= lastLineIndex
+			return p.PatchLines[row.Left].render(selected, lo.Contains(incLineIndices, row.Left), nil)
+		}
+
+		return renderCell(row.Left, oldLineNumbers) + separator + renderCell(row.Right, newLineNumbers)
+	}), "\n")
+}
+
+// renderCell renders the line padded or truncated to the given width. Tabs are
+// expanded so that they don't throw off the alignment of the columns.
+func (l *PatchLine) renderCell(width int, selected bool, included bool, changedRanges []runeRange) string {
+	if width <= 0 {
+		return ""
+	}
+
+	content, changedRanges := expandTabs(l.Content, changedRanges)
+	content = runewidth.FillRight(runewidth.Truncate(content, width, ""), width)
+
+	if len(changedRanges) > 0 {
+		// clipping the ranges to what's left of the line after truncating it
+		contentLength := len([]rune(content)) - 1
+		changedRanges = lo.FilterMap(changedRanges, func(changedRange runeRange, _ int) (runeRange, bool) {
+			changedRange.end = utils.Min(changedRange.end, contentLength)
+			return changedRange, changedRange.start < changedRange.end
+		})
+		return coloredStringWithEmphasis(l.textStyle(), content, selected, included, changedRanges)
+	}
+
+	return coloredString(l.textStyle(), content, selected, included)
+}
+
+const tabWidth = 4
+
+// expands the tabs in a line, shifting the given ranges (which skip the line's
+// first character) along with the text
+func expandTabs(content string, changedRanges []runeRange) (string, []runeRange) {
+	if !strings.Contains(content, "\t") {
+		return content, changedRanges
+	}
+
+	runes := []rune(content)
+	// newIndices[i] is the index that the i-th rune after the first one ends up at
+	newIndices := make([]int, len(runes))
+	var builder strings.Builder
+	builder.WriteRune(runes[0])
+	newIdx := 0
+	for i, r := range runes[1:] {
+		newIndices[i] = newIdx
+		if r == '\t' {
+			builder.WriteString(strings.Repeat(" ", tabWidth))
+			newIdx += tabWidth
+		} else {
+			builder.WriteRune(r)
+			newIdx++
+		}
+	}
+	newIndices[len(runes)-1] = newIdx
+
+	return builder.String(), slices.Map(changedRanges, func(changedRange runeRange) runeRange {
+		return runeRange{start: newIndices[changedRange.start], end: newIndices[changedRange.end]}
+	})
+}
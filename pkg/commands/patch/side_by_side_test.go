@@ -0,0 +1,65 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const sideBySideDiff = `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-grape
+-lime
++orange
+ pear
+-plum
++kiwi
++melon
++	fig
+`
+
+func TestSideBySideRows(t *testing.T) {
+	rows := NewPatchParser(nil, sideBySideDiff).SideBySideRows()
+
+	assert.Equal(t, []SideBySideRow{
+		{Left: 0, Right: 0},
+		{Left: 1, Right: 1},
+		{Left: 2, Right: 2},
+		{Left: 3, Right: 3},
+		{Left: 4, Right: 4},
+		{Left: 5, Right: 5},
+		{Left: 6, Right: 8},
+		{Left: 7, Right: -1},
+		{Left: 9, Right: 9},
+		{Left: 10, Right: 11},
+		{Left: -1, Right: 12},
+		{Left: -1, Right: 13},
+	}, rows)
+}
+
+func TestRenderSideBySide(t *testing.T) {
+	parser := NewPatchParser(nil, sideBySideDiff)
+	rendered := parser.RenderSideBySide(parser.SideBySideRows(), 41, false, -1, -1, nil, false)
+
+	lines := strings.Split(utils.Decolorise(rendered), "\n")
+	assert.Equal(t, []string{
+		"diff --git a/filename b/filename",
+		"index 9320895..6d79956 100644",
+		"--- a/filename",
+		"+++ b/filename",
+		"@@ -1,5 +1,5 @@",
+		"1  apple            │1  apple            ",
+		"2 -grape            │2 +orange           ",
+		"3 -lime             │                    ",
+		"4  pear             │3  pear             ",
+		"5 -plum             │4 +kiwi             ",
+		"                    │5 +melon            ",
+		"                    │6 +    fig          ",
+	}, lines)
+}
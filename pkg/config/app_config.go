@@ -38,6 +38,7 @@ type AppConfigurer interface {
 	GetUserConfigPaths() []string
 	GetUserConfigDir() string
 	ReloadUserConfig() error
+	LoadUserConfig() (*UserConfig, error)
 	GetTempDir() string
 
 	GetAppState() *AppState
@@ -210,6 +211,12 @@ func (c *AppConfig) ReloadUserConfig() error {
 	return nil
 }
 
+// LoadUserConfig reads the user's config files afresh. Unlike
+// ReloadUserConfig, it leaves the config we hold on to as it is.
+func (c *AppConfig) LoadUserConfig() (*UserConfig, error) {
+	return loadUserConfigWithDefaults(c.UserConfigPaths)
+}
+
 func (c *AppConfig) GetTempDir() string {
 	return c.TempDir
 }
@@ -287,9 +294,23 @@ type AppState struct {
 	RecentRepos         []string
 	StartupPopupVersion int
 
+	// repos pinned to the top of the recent repos list, in the order they were pinned
+	PinnedRepos []string
+
 	// these are for custom commands typed in directly, not for custom commands in the lazygit config
 	CustomCommandsHistory []string
 	HideCommandLog        bool
+
+	// the last command run with 'git bisect run', keyed by repo path
+	BisectRunCommands map[string]string
+
+	// the messages of the latest commits attempted in each repo, newest first,
+	// keyed by repo path
+	CommitMessageHistory map[string][]string
+
+	// whether the user trusts the options that run commands in a repo's
+	// .lazygit.yml, keyed by repo path. Repos we haven't asked about are absent.
+	TrustedRepoConfigs map[string]bool
 }
 
 func getDefaultAppState() *AppState {
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	yaml "github.com/jesseduffield/yaml"
+)
+
+// RepoConfigFilename is the name of the config file that a repo can check in at
+// its root to override the user config while lazygit is open in that repo
+const RepoConfigFilename = ".lazygit.yml"
+
+// RepoConfig is the content of a repo's config file
+type RepoConfig struct {
+	path    string
+	content []byte
+	// the repo config on its own, which tells us which options it sets
+	parsed *UserConfig
+}
+
+// LoadRepoConfig reads the config file at the root of the given repo,
+// returning nil if the repo doesn't have one
+func LoadRepoConfig(repoPath string) (*RepoConfig, error) {
+	path := filepath.Join(repoPath, RepoConfigFilename)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	parsed := &UserConfig{}
+	if err := yaml.Unmarshal(content, parsed); err != nil {
+		return nil, fmt.Errorf("The config at `%s` couldn't be parsed, please inspect it before opening up an issue.\n%w", path, err)
+	}
+
+	return &RepoConfig{path: path, content: content, parsed: parsed}, nil
+}
+
+func (self *RepoConfig) Path() string {
+	return self.path
+}
+
+// RunsCommands tells us whether the repo config sets options that run shell
+// commands, such as custom commands or the pager. Anyone can check such a
+// config into a repo, so we only apply these options once the repo is trusted.
+func (self *RepoConfig) RunsCommands() bool {
+	return len(self.parsed.CustomCommands) > 0 ||
+		self.parsed.OS != OSConfig{} ||
+		self.parsed.Git.Paging != PagingConfig{} ||
+		self.parsed.Git.BranchLogCmd != "" ||
+		self.parsed.Git.AllBranchesLogCmd != ""
+}
+
+// ApplyTo returns a copy of the given user config with the repo config applied
+// over it. The repo's custom commands are added to the user's. Unless trusted
+// is true, the user config wins for options that run commands.
+func (self *RepoConfig) ApplyTo(userConfig *UserConfig, trusted bool) (*UserConfig, error) {
+	result, err := userConfig.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	userOptions := *result
+	if err := yaml.Unmarshal(self.content, result); err != nil {
+		return nil, err
+	}
+
+	// which repos to trust is up to the user, not the repos themselves
+	result.TrustedRepos = userOptions.TrustedRepos
+
+	if !trusted {
+		result.OS = userOptions.OS
+		result.Git.Paging = userOptions.Git.Paging
+		result.Git.BranchLogCmd = userOptions.Git.BranchLogCmd
+		result.Git.AllBranchesLogCmd = userOptions.Git.AllBranchesLogCmd
+		result.CustomCommands = userOptions.CustomCommands
+		return result, nil
+	}
+
+	result.CustomCommands = append(userOptions.CustomCommands, self.parsed.CustomCommands...)
+
+	return result, nil
+}
+
+// Clone returns a deep copy of the config, so that changing one doesn't
+// change the maps and slices it shares with the other
+func (config *UserConfig) Clone() (*UserConfig, error) {
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserConfig{}
+	if err := yaml.Unmarshal(content, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
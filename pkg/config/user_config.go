@@ -19,39 +19,74 @@ type UserConfig struct {
 	Services                     map[string]string `yaml:"services"`
 	NotARepository               string            `yaml:"notARepository"`
 	PromptToReturnFromSubprocess bool              `yaml:"promptToReturnFromSubprocess"`
+	// paths of repos whose .lazygit.yml may set options that run commands,
+	// without asking first
+	TrustedRepos []string `yaml:"trustedRepos"`
 }
 
 type RefresherConfig struct {
 	RefreshInterval int `yaml:"refreshInterval"`
 	FetchInterval   int `yaml:"fetchInterval"`
+	// in milliseconds
+	DebounceDelay int `yaml:"debounceDelay"`
+	// used instead of RefreshInterval in repos with core.fsmonitor set, where
+	// refreshing is cheap. 0 means always using RefreshInterval
+	FsMonitorRefreshInterval int `yaml:"fsmonitorRefreshInterval"`
 }
 
 type GuiConfig struct {
-	AuthorColors              map[string]string  `yaml:"authorColors"`
-	BranchColors              map[string]string  `yaml:"branchColors"`
-	ScrollHeight              int                `yaml:"scrollHeight"`
-	ScrollPastBottom          bool               `yaml:"scrollPastBottom"`
-	MouseEvents               bool               `yaml:"mouseEvents"`
-	SkipUnstageLineWarning    bool               `yaml:"skipUnstageLineWarning"`
-	SkipStashWarning          bool               `yaml:"skipStashWarning"`
-	SidePanelWidth            float64            `yaml:"sidePanelWidth"`
-	ExpandFocusedSidePanel    bool               `yaml:"expandFocusedSidePanel"`
-	MainPanelSplitMode        string             `yaml:"mainPanelSplitMode"`
-	Language                  string             `yaml:"language"`
-	TimeFormat                string             `yaml:"timeFormat"`
-	Theme                     ThemeConfig        `yaml:"theme"`
-	CommitLength              CommitLengthConfig `yaml:"commitLength"`
-	SkipNoStagedFilesWarning  bool               `yaml:"skipNoStagedFilesWarning"`
-	ShowListFooter            bool               `yaml:"showListFooter"`
-	ShowFileTree              bool               `yaml:"showFileTree"`
-	ShowRandomTip             bool               `yaml:"showRandomTip"`
-	ShowCommandLog            bool               `yaml:"showCommandLog"`
-	ShowBottomLine            bool               `yaml:"showBottomLine"`
-	ShowIcons                 bool               `yaml:"showIcons"`
-	CommandLogSize            int                `yaml:"commandLogSize"`
-	SplitDiff                 string             `yaml:"splitDiff"`
-	SkipRewordInEditorWarning bool               `yaml:"skipRewordInEditorWarning"`
-	WindowSize                string             `yaml:"windowSize"`
+	AuthorColors                       map[string]string  `yaml:"authorColors"`
+	BranchColors                       map[string]string  `yaml:"branchColors"`
+	ScrollHeight                       int                `yaml:"scrollHeight"`
+	ScrollPastBottom                   bool               `yaml:"scrollPastBottom"`
+	MouseEvents                        bool               `yaml:"mouseEvents"`
+	SkipUnstageLineWarning             bool               `yaml:"skipUnstageLineWarning"`
+	SkipStashWarning                   bool               `yaml:"skipStashWarning"`
+	SidePanelWidth                     float64            `yaml:"sidePanelWidth"`
+	ExpandFocusedSidePanel             bool               `yaml:"expandFocusedSidePanel"`
+	MainPanelSplitMode                 string             `yaml:"mainPanelSplitMode"`
+	Language                           string             `yaml:"language"`
+	TimeFormat                         string             `yaml:"timeFormat"`
+	Theme                              ThemeConfig        `yaml:"theme"`
+	CommitLength                       CommitLengthConfig `yaml:"commitLength"`
+	SkipNoStagedFilesWarning           bool               `yaml:"skipNoStagedFilesWarning"`
+	ShowListFooter                     bool               `yaml:"showListFooter"`
+	ShowFileTree                       bool               `yaml:"showFileTree"`
+	DefaultFileTreeDepth               int                `yaml:"defaultFileTreeDepth"`
+	ShowRandomTip                      bool               `yaml:"showRandomTip"`
+	ShowCommandLog                     bool               `yaml:"showCommandLog"`
+	ShowBottomLine                     bool               `yaml:"showBottomLine"`
+	ShowIcons                          bool               `yaml:"showIcons"` // Deprecated: use NerdFontsVersion instead
+	NerdFontsVersion                   string             `yaml:"nerdFontsVersion"`
+	CustomIcons                        CustomIconsConfig  `yaml:"customIcons"`
+	ShowIntraLineDiff                  bool               `yaml:"showIntraLineDiff"`
+	DiffCacheSize                      int                `yaml:"diffCacheSize"`
+	ShowSignatureStatus                bool               `yaml:"showSignatureStatus"`
+	ShowDiffStatsInCommitList          bool               `yaml:"showDiffStatsInCommitList"`
+	ShowDivergenceFromBaseBranch       bool               `yaml:"showDivergenceFromBaseBranch"`
+	CommandLogSize                     int                `yaml:"commandLogSize"`
+	HideBackgroundCommandsInCommandLog bool               `yaml:"hideBackgroundCommandsInCommandLog"`
+	SplitDiff                          string             `yaml:"splitDiff"`
+	DiffLayout                         string             `yaml:"diffLayout"`
+	ShowLineNumbersInDiff              bool               `yaml:"showLineNumbersInDiff"`
+	StatusPanelView                    string             `yaml:"statusPanelView"`
+	SkipRewordInEditorWarning          bool               `yaml:"skipRewordInEditorWarning"`
+	WindowSize                         string             `yaml:"windowSize"`
+	Panels                             PanelsConfig       `yaml:"panels"`
+}
+
+// PanelsConfig sets how the side panels (i.e. the windows on the left) are laid
+// out. The panels are 'status', 'files', 'branches', 'commits' and 'stash'.
+type PanelsConfig struct {
+	// the order of the side panels from top to bottom. Panels left out go at the end
+	Order []string `yaml:"order"`
+	// side panels that are only shown while they're focused
+	Hidden []string `yaml:"hidden"`
+	// the width of the side section as a percentage of the screen. If zero,
+	// gui.sidePanelWidth is used instead
+	SidePanelWidthPercent int `yaml:"sidePanelWidthPercent"`
+	// overrides the width for a mode, one of 'staging' | 'patchBuilding' | 'mergeConflicts'
+	SidePanelWidthPercentByMode map[string]int `yaml:"sidePanelWidthPercentByMode"`
 }
 
 type ThemeConfig struct {
@@ -66,22 +101,41 @@ type ThemeConfig struct {
 	DefaultFgColor            []string `yaml:"defaultFgColor"`
 }
 
+type CustomIconsConfig struct {
+	// keyed by the name of the file or directory, e.g. 'Makefile'
+	Filenames map[string]IconProperties `yaml:"filenames"`
+	// keyed by the extension of the file, e.g. '.go'
+	Extensions map[string]IconProperties `yaml:"extensions"`
+}
+
+type IconProperties struct {
+	Icon string `yaml:"icon"`
+	// a colour name like 'red' or a hex value like '#ff0000'. If empty, the
+	// icon takes on the colour of the file name
+	Color string `yaml:"color"`
+}
+
 type CommitLengthConfig struct {
 	Show bool `yaml:"show"`
 }
 
 type GitConfig struct {
-	Paging              PagingConfig                  `yaml:"paging"`
-	Commit              CommitConfig                  `yaml:"commit"`
-	Merging             MergingConfig                 `yaml:"merging"`
-	SkipHookPrefix      string                        `yaml:"skipHookPrefix"`
-	AutoFetch           bool                          `yaml:"autoFetch"`
-	AutoRefresh         bool                          `yaml:"autoRefresh"`
-	BranchLogCmd        string                        `yaml:"branchLogCmd"`
-	AllBranchesLogCmd   string                        `yaml:"allBranchesLogCmd"`
-	OverrideGpg         bool                          `yaml:"overrideGpg"`
-	DisableForcePushing bool                          `yaml:"disableForcePushing"`
-	CommitPrefixes      map[string]CommitPrefixConfig `yaml:"commitPrefixes"`
+	Paging                PagingConfig                  `yaml:"paging"`
+	Commit                CommitConfig                  `yaml:"commit"`
+	Merging               MergingConfig                 `yaml:"merging"`
+	SkipHookPrefix        string                        `yaml:"skipHookPrefix"`
+	AutoFetch             bool                          `yaml:"autoFetch"`
+	AutoFetchRemotes      RemoteNames                   `yaml:"autoFetchRemotes"`
+	WarnOnDetachedCommits bool                          `yaml:"warnOnDetachedCommits"`
+	AutoRefresh           bool                          `yaml:"autoRefresh"`
+	BranchLogCmd          string                        `yaml:"branchLogCmd"`
+	AllBranchesLogCmd     string                        `yaml:"allBranchesLogCmd"`
+	OverrideGpg           bool                          `yaml:"overrideGpg"`
+	DisableForcePushing   bool                          `yaml:"disableForcePushing"`
+	MainBranches          []string                      `yaml:"mainBranches"`
+	ShowPullRequestStatus bool                          `yaml:"showPullRequestStatus"`
+	HostingServiceTokens  map[string]string             `yaml:"hostingServiceTokens"`
+	CommitPrefixes        map[string]CommitPrefixConfig `yaml:"commitPrefixes"`
 	// this should really be under 'gui', not 'git'
 	ParseEmoji      bool      `yaml:"parseEmoji"`
 	Log             LogConfig `yaml:"log"`
@@ -95,8 +149,26 @@ type PagingConfig struct {
 }
 
 type CommitConfig struct {
-	SignOff bool   `yaml:"signOff"`
-	Verbose string `yaml:"verbose"`
+	SignOff               bool   `yaml:"signOff"`
+	Verbose               string `yaml:"verbose"`
+	AutoWrapCommitMessage bool   `yaml:"autoWrapCommitMessage"`
+	AutoWrapWidth         int    `yaml:"autoWrapWidth"`
+	// maps branch name globs (e.g. 'feature/*') to the path of the commit
+	// message template to use on matching branches
+	TemplateGlobs map[string]string `yaml:"templateGlobs"`
+	// the commit message panel warns about subjects longer than this. 0 means
+	// no limit
+	MaxSubjectLength int `yaml:"maxSubjectLength"`
+	// the commit message panel warns about subjects that don't match this
+	// regex, e.g. '^(feat|fix|docs|refactor|test|chore)(\(.+\))?!?: .+' for
+	// conventional commits
+	SubjectRegex string `yaml:"subjectRegex"`
+	// ask for confirmation before committing a subject that doesn't match
+	// subjectRegex
+	ConfirmOnSubjectRegexMismatch bool `yaml:"confirmOnSubjectRegexMismatch"`
+	// the trailer that adding an issue in the commit message panel appends to
+	// the message. {{issue}} is replaced with the issue number
+	IssueTrailerTemplate string `yaml:"issueTrailerTemplate"`
 }
 
 type MergingConfig struct {
@@ -108,6 +180,7 @@ type LogConfig struct {
 	Order          string `yaml:"order"`     // one of date-order, author-date-order, topo-order
 	ShowGraph      string `yaml:"showGraph"` // one of always, never, when-maximised
 	ShowWholeGraph bool   `yaml:"showWholeGraph"`
+	ChunkSize      int    `yaml:"chunkSize"` // how many commits to add to the list at a time while a long history loads
 }
 
 type CommitPrefixConfig struct {
@@ -115,21 +188,54 @@ type CommitPrefixConfig struct {
 	Replace string `yaml:"replace"`
 }
 
+// RemoteNames is a list of remotes, which can also be given as the single
+// name 'all' to mean every remote
+type RemoteNames []string
+
+const AllRemotes = "all"
+
+func (self *RemoteNames) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		if name != "" {
+			*self = RemoteNames{name}
+		}
+		return nil
+	}
+
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		return err
+	}
+
+	*self = names
+	return nil
+}
+
+func (self RemoteNames) IsAll() bool {
+	return len(self) == 1 && self[0] == AllRemotes
+}
+
 type UpdateConfig struct {
 	Method string `yaml:"method"`
 	Days   int64  `yaml:"days"`
 }
 
 type KeybindingConfig struct {
-	Universal   KeybindingUniversalConfig   `yaml:"universal"`
-	Status      KeybindingStatusConfig      `yaml:"status"`
-	Files       KeybindingFilesConfig       `yaml:"files"`
-	Branches    KeybindingBranchesConfig    `yaml:"branches"`
-	Commits     KeybindingCommitsConfig     `yaml:"commits"`
-	Stash       KeybindingStashConfig       `yaml:"stash"`
-	CommitFiles KeybindingCommitFilesConfig `yaml:"commitFiles"`
-	Main        KeybindingMainConfig        `yaml:"main"`
-	Submodules  KeybindingSubmodulesConfig  `yaml:"submodules"`
+	Universal     KeybindingUniversalConfig     `yaml:"universal"`
+	Status        KeybindingStatusConfig        `yaml:"status"`
+	Files         KeybindingFilesConfig         `yaml:"files"`
+	Branches      KeybindingBranchesConfig      `yaml:"branches"`
+	Remotes       KeybindingRemotesConfig       `yaml:"remotes"`
+	Commits       KeybindingCommitsConfig       `yaml:"commits"`
+	Reflog        KeybindingReflogConfig        `yaml:"reflog"`
+	Stash         KeybindingStashConfig         `yaml:"stash"`
+	CommitFiles   KeybindingCommitFilesConfig   `yaml:"commitFiles"`
+	Main          KeybindingMainConfig          `yaml:"main"`
+	Blame         KeybindingBlameConfig         `yaml:"blame"`
+	Submodules    KeybindingSubmodulesConfig    `yaml:"submodules"`
+	CommitMessage KeybindingCommitMessageConfig `yaml:"commitMessage"`
+	CommandLog    KeybindingCommandLogConfig    `yaml:"commandLog"`
 }
 
 // damn looks like we have some inconsistencies here with -alt and -alt1
@@ -162,6 +268,7 @@ type KeybindingUniversalConfig struct {
 	StartSearch                  string   `yaml:"startSearch"`
 	OptionMenu                   string   `yaml:"optionMenu"`
 	OptionMenuAlt1               string   `yaml:"optionMenu-alt1"`
+	OpenCommandPalette           string   `yaml:"openCommandPalette"`
 	Select                       string   `yaml:"select"`
 	GoInto                       string   `yaml:"goInto"`
 	Confirm                      string   `yaml:"confirm"`
@@ -170,6 +277,7 @@ type KeybindingUniversalConfig struct {
 	New                          string   `yaml:"new"`
 	Edit                         string   `yaml:"edit"`
 	OpenFile                     string   `yaml:"openFile"`
+	OpenDiffTool                 string   `yaml:"openDiffTool"`
 	ScrollUpMain                 string   `yaml:"scrollUpMain"`
 	ScrollDownMain               string   `yaml:"scrollDownMain"`
 	ScrollUpMainAlt1             string   `yaml:"scrollUpMain-alt1"`
@@ -179,7 +287,9 @@ type KeybindingUniversalConfig struct {
 	ExecuteCustomCommand         string   `yaml:"executeCustomCommand"`
 	CreateRebaseOptionsMenu      string   `yaml:"createRebaseOptionsMenu"`
 	Push                         string   `yaml:"pushFiles"` // 'Files' appended for legacy reasons
+	PushMenu                     string   `yaml:"pushMenu"`
 	Pull                         string   `yaml:"pullFiles"` // 'Files' appended for legacy reasons
+	PullMenu                     string   `yaml:"pullMenu"`
 	Refresh                      string   `yaml:"refresh"`
 	CreatePatchOptionsMenu       string   `yaml:"createPatchOptionsMenu"`
 	NextTab                      string   `yaml:"nextTab"`
@@ -204,7 +314,11 @@ type KeybindingUniversalConfig struct {
 type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
+	TogglePinnedRepo    string `yaml:"togglePinnedRepo"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	FlaggedFiles        string `yaml:"flaggedFiles"`
+	StatusSpeedups      string `yaml:"statusSpeedups"`
+	ReloadConfig        string `yaml:"reloadConfig"`
 }
 
 type KeybindingFilesConfig struct {
@@ -212,6 +326,8 @@ type KeybindingFilesConfig struct {
 	CommitChangesWithoutHook string `yaml:"commitChangesWithoutHook"`
 	AmendLastCommit          string `yaml:"amendLastCommit"`
 	CommitChangesWithEditor  string `yaml:"commitChangesWithEditor"`
+	AbsorbStagedChanges      string `yaml:"absorbStagedChanges"`
+	CreateFixupCommitsByFile string `yaml:"createFixupCommitsByFile"`
 	IgnoreFile               string `yaml:"ignoreFile"`
 	RefreshFiles             string `yaml:"refreshFiles"`
 	StashAllChanges          string `yaml:"stashAllChanges"`
@@ -221,7 +337,14 @@ type KeybindingFilesConfig struct {
 	Fetch                    string `yaml:"fetch"`
 	ToggleTreeView           string `yaml:"toggleTreeView"`
 	OpenMergeTool            string `yaml:"openMergeTool"`
+	OpenMergeToolForFile     string `yaml:"openMergeToolForFile"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
+	CollapseAll              string `yaml:"collapseAll"`
+	ExpandAll                string `yaml:"expandAll"`
+	ExpandToDefaultDepth     string `yaml:"expandToDefaultDepth"`
+	ViewFileHistory          string `yaml:"viewFileHistory"`
+	ViewBlame                string `yaml:"viewBlame"`
+	ViewSkipWorktreeOptions  string `yaml:"viewSkipWorktreeOptions"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -231,20 +354,29 @@ type KeybindingBranchesConfig struct {
 	CheckoutBranchByName   string `yaml:"checkoutBranchByName"`
 	ForceCheckoutBranch    string `yaml:"forceCheckoutBranch"`
 	RebaseBranch           string `yaml:"rebaseBranch"`
+	RebaseOntoRef          string `yaml:"rebaseOntoRef"`
 	RenameBranch           string `yaml:"renameBranch"`
 	MergeIntoCurrentBranch string `yaml:"mergeIntoCurrentBranch"`
 	ViewGitFlowOptions     string `yaml:"viewGitFlowOptions"`
 	FastForward            string `yaml:"fastForward"`
 	CreateTag              string `yaml:"createTag"`
 	PushTag                string `yaml:"pushTag"`
+	EditTagMessage         string `yaml:"editTagMessage"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
+	SetBaseBranch          string `yaml:"setBaseBranch"`
+	CreateWorktree         string `yaml:"createWorktree"`
+}
+
+type KeybindingRemotesConfig struct {
+	FetchAllRemotes string `yaml:"fetchAllRemotes"`
 }
 
 type KeybindingCommitsConfig struct {
 	SquashDown                     string `yaml:"squashDown"`
 	RenameCommit                   string `yaml:"renameCommit"`
 	RenameCommitWithEditor         string `yaml:"renameCommitWithEditor"`
+	RenameCommitsWithEditor        string `yaml:"renameCommitsWithEditor"`
 	ViewResetOptions               string `yaml:"viewResetOptions"`
 	MarkCommitAsFixup              string `yaml:"markCommitAsFixup"`
 	CreateFixupCommit              string `yaml:"createFixupCommit"`
@@ -265,29 +397,71 @@ type KeybindingCommitsConfig struct {
 	OpenLogMenu                    string `yaml:"openLogMenu"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
+	RebaseOntoRef                  string `yaml:"rebaseOntoRef"`
+	VerifySignature                string `yaml:"verifySignature"`
+	ToggleMarkCommit               string `yaml:"toggleMarkCommit"`
+	MoveCommitsToBranch            string `yaml:"moveCommitsToBranch"`
+	AddExecTodo                    string `yaml:"addExecTodo"`
+	AddBreakTodo                   string `yaml:"addBreakTodo"`
+	CompareCommits                 string `yaml:"compareCommits"`
+	CheckoutFileVersion            string `yaml:"checkoutFileVersion"`
+}
+
+type KeybindingReflogConfig struct {
+	RestoreToEntry string `yaml:"restoreToEntry"`
 }
 
 type KeybindingStashConfig struct {
-	PopStash    string `yaml:"popStash"`
-	RenameStash string `yaml:"renameStash"`
+	PopStash        string `yaml:"popStash"`
+	RenameStash     string `yaml:"renameStash"`
+	StashBranch     string `yaml:"stashBranch"`
+	ApplyOntoBranch string `yaml:"applyOntoBranch"`
 }
 
 type KeybindingCommitFilesConfig struct {
 	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	CopyFileURL        string `yaml:"copyFileURL"`
 }
 
 type KeybindingMainConfig struct {
-	ToggleDragSelect    string `yaml:"toggleDragSelect"`
-	ToggleDragSelectAlt string `yaml:"toggleDragSelect-alt"`
-	ToggleSelectHunk    string `yaml:"toggleSelectHunk"`
-	PickBothHunks       string `yaml:"pickBothHunks"`
-	EditSelectHunk      string `yaml:"editSelectHunk"`
+	ToggleDragSelect                 string `yaml:"toggleDragSelect"`
+	ToggleDragSelectAlt              string `yaml:"toggleDragSelect-alt"`
+	ToggleSelectHunk                 string `yaml:"toggleSelectHunk"`
+	PickBothHunks                    string `yaml:"pickBothHunks"`
+	PickBothHunksBottomFirst         string `yaml:"pickBothHunksBottomFirst"`
+	EditSelectHunk                   string `yaml:"editSelectHunk"`
+	ToggleMarkLine                   string `yaml:"toggleMarkLine"`
+	ApplyInvertedSelection           string `yaml:"applyInvertedSelection"`
+	ApplySelectionSkippingWhitespace string `yaml:"applySelectionSkippingWhitespace"`
+	CommitSelection                  string `yaml:"commitSelection"`
+	StashSelection                   string `yaml:"stashSelection"`
+	ToggleCollapseHunk               string `yaml:"toggleCollapseHunk"`
+	SplitHunk                        string `yaml:"splitHunk"`
+	ToggleDiffLayout                 string `yaml:"toggleDiffLayout"`
+	GoToLine                         string `yaml:"goToLine"`
+	CopySelectedLines                string `yaml:"copySelectedLines"`
+	GoToPatchFile                    string `yaml:"goToPatchFile"`
+}
+
+type KeybindingBlameConfig struct {
+	ReblameFromParent string `yaml:"reblameFromParent"`
 }
 
 type KeybindingSubmodulesConfig struct {
-	Init     string `yaml:"init"`
-	Update   string `yaml:"update"`
-	BulkMenu string `yaml:"bulkMenu"`
+	Init                  string `yaml:"init"`
+	Update                string `yaml:"update"`
+	UpdateToTrackedBranch string `yaml:"updateToTrackedBranch"`
+	BulkMenu              string `yaml:"bulkMenu"`
+}
+
+type KeybindingCommitMessageConfig struct {
+	TrailersMenu string `yaml:"trailersMenu"`
+}
+
+type KeybindingCommandLogConfig struct {
+	CopyCommand       string `yaml:"copyCommand"`
+	RunCommandAgain   string `yaml:"runCommandAgain"`
+	ShowCommandOutput string `yaml:"showCommandOutput"`
 }
 
 // OSConfig contains config on the level of the os
@@ -303,6 +477,12 @@ type OSConfig struct {
 
 	// OpenCommand is the command for opening a link
 	OpenLinkCommand string `yaml:"openLinkCommand,omitempty"`
+
+	// DifftoolCommand is the command template for viewing a diff in an external tool
+	DifftoolCommand string `yaml:"difftoolCommand,omitempty"`
+
+	// MergetoolCommand is the command template for resolving a file's conflicts in an external tool
+	MergetoolCommand string `yaml:"mergetoolCommand,omitempty"`
 }
 
 type CustomCommand struct {
@@ -314,7 +494,15 @@ type CustomCommand struct {
 	LoadingText string                `yaml:"loadingText"`
 	Description string                `yaml:"description"`
 	Stream      bool                  `yaml:"stream"`
-	ShowOutput  bool                  `yaml:"showOutput"`
+	// deprecated: use `output: popup` instead
+	ShowOutput bool `yaml:"showOutput"`
+	// where to show the command's output: one of 'popup', 'mainView' or 'none'.
+	// Doesn't apply to subprocesses.
+	Output string `yaml:"output"`
+	// template which must resolve to 'true' for the command to be available
+	EnabledIf string `yaml:"enabledIf"`
+	// template for the message shown when the command isn't available
+	DisabledReason string `yaml:"disabledReason"`
 }
 
 type CustomCommandPrompt struct {
@@ -339,6 +527,22 @@ type CustomCommandPrompt struct {
 	Filter      string `yaml:"filter"`
 	ValueFormat string `yaml:"valueFormat"`
 	LabelFormat string `yaml:"labelFormat"`
+	// when set, each line is split into columns instead of being matched
+	// against the filter
+	Columns *CustomCommandMenuColumns `yaml:"columns"`
+	// number of lines (e.g. headers) to drop from the start of the command's output
+	SkipLines int `yaml:"skipLines"`
+	// whether to pick the item from a list that can be narrowed down by typing
+	Filterable bool `yaml:"filterable"`
+}
+
+type CustomCommandMenuColumns struct {
+	// splits on whitespace if empty
+	Delimiter string `yaml:"delimiter"`
+	// 1-based index of the column used as the menu item's value
+	Value int `yaml:"value"`
+	// 1-based index of the column shown on screen, defaulting to the value column
+	Label int `yaml:"label"`
 }
 
 type CustomCommandMenuOption struct {
@@ -371,17 +575,38 @@ func GetDefaultConfig() *UserConfig {
 				UnstagedChangesColor:      []string{"red"},
 				DefaultFgColor:            []string{"default"},
 			},
-			CommitLength:              CommitLengthConfig{Show: true},
-			SkipNoStagedFilesWarning:  false,
-			ShowListFooter:            true,
-			ShowCommandLog:            true,
-			ShowBottomLine:            true,
-			ShowFileTree:              true,
-			ShowRandomTip:             true,
-			ShowIcons:                 false,
-			CommandLogSize:            8,
-			SplitDiff:                 "auto",
-			SkipRewordInEditorWarning: false,
+			CommitLength:             CommitLengthConfig{Show: true},
+			SkipNoStagedFilesWarning: false,
+			ShowListFooter:           true,
+			ShowCommandLog:           true,
+			ShowBottomLine:           true,
+			ShowFileTree:             true,
+			DefaultFileTreeDepth:     0,
+			ShowRandomTip:            true,
+			ShowIcons:                false,
+			NerdFontsVersion:         "",
+			CustomIcons: CustomIconsConfig{
+				Filenames:  map[string]IconProperties{},
+				Extensions: map[string]IconProperties{},
+			},
+			ShowIntraLineDiff:                  false,
+			DiffCacheSize:                      100,
+			ShowSignatureStatus:                false,
+			ShowDiffStatsInCommitList:          false,
+			ShowDivergenceFromBaseBranch:       false,
+			CommandLogSize:                     8,
+			HideBackgroundCommandsInCommandLog: true,
+			SplitDiff:                          "auto",
+			DiffLayout:                         "unified",
+			ShowLineNumbersInDiff:              false,
+			StatusPanelView:                    "default",
+			SkipRewordInEditorWarning:          false,
+			Panels: PanelsConfig{
+				Order:                       []string{"status", "files", "branches", "commits", "stash"},
+				Hidden:                      []string{},
+				SidePanelWidthPercent:       0,
+				SidePanelWidthPercentByMode: map[string]int{},
+			},
 		},
 		Git: GitConfig{
 			Paging: PagingConfig{
@@ -390,8 +615,15 @@ func GetDefaultConfig() *UserConfig {
 				UseConfig: false,
 			},
 			Commit: CommitConfig{
-				SignOff: false,
-				Verbose: "default",
+				SignOff:                       false,
+				Verbose:                       "default",
+				AutoWrapCommitMessage:         false,
+				AutoWrapWidth:                 72,
+				TemplateGlobs:                 map[string]string{},
+				MaxSubjectLength:              72,
+				SubjectRegex:                  "",
+				ConfirmOnSubjectRegexMismatch: false,
+				IssueTrailerTemplate:          "Fixes #{{issue}}",
 			},
 			Merging: MergingConfig{
 				ManualCommit: false,
@@ -401,20 +633,25 @@ func GetDefaultConfig() *UserConfig {
 				Order:          "topo-order",
 				ShowGraph:      "when-maximised",
 				ShowWholeGraph: false,
+				ChunkSize:      1000,
 			},
-			SkipHookPrefix:      "WIP",
-			AutoFetch:           true,
-			AutoRefresh:         true,
-			BranchLogCmd:        "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
-			AllBranchesLogCmd:   "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
-			DisableForcePushing: false,
-			CommitPrefixes:      map[string]CommitPrefixConfig(nil),
-			ParseEmoji:          false,
-			DiffContextSize:     3,
+			SkipHookPrefix:        "WIP",
+			AutoFetch:             true,
+			WarnOnDetachedCommits: true,
+			AutoRefresh:           true,
+			BranchLogCmd:          "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
+			AllBranchesLogCmd:     "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
+			DisableForcePushing:   false,
+			MainBranches:          []string{"master", "main"},
+			CommitPrefixes:        map[string]CommitPrefixConfig(nil),
+			ParseEmoji:            false,
+			DiffContextSize:       3,
 		},
 		Refresher: RefresherConfig{
-			RefreshInterval: 10,
-			FetchInterval:   60,
+			RefreshInterval:          10,
+			FetchInterval:            60,
+			DebounceDelay:            200,
+			FsMonitorRefreshInterval: 2,
 		},
 		Update: UpdateConfig{
 			Method: "prompt",
@@ -452,6 +689,7 @@ func GetDefaultConfig() *UserConfig {
 				StartSearch:                  "/",
 				OptionMenu:                   "",
 				OptionMenuAlt1:               "?",
+				OpenCommandPalette:           "<c-n>",
 				Select:                       "<space>",
 				GoInto:                       "<enter>",
 				Confirm:                      "<enter>",
@@ -460,6 +698,7 @@ func GetDefaultConfig() *UserConfig {
 				New:                          "n",
 				Edit:                         "e",
 				OpenFile:                     "o",
+				OpenDiffTool:                 "<c-x>",
 				OpenRecentRepos:              "<c-r>",
 				ScrollUpMain:                 "<pgup>",
 				ScrollDownMain:               "<pgdown>",
@@ -470,7 +709,9 @@ func GetDefaultConfig() *UserConfig {
 				ExecuteCustomCommand:         ":",
 				CreateRebaseOptionsMenu:      "m",
 				Push:                         "P",
+				PushMenu:                     "<c-q>",
 				Pull:                         "p",
+				PullMenu:                     "<c-a>",
 				Refresh:                      "R",
 				CreatePatchOptionsMenu:       "<c-p>",
 				NextTab:                      "]",
@@ -493,13 +734,19 @@ func GetDefaultConfig() *UserConfig {
 			Status: KeybindingStatusConfig{
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
+				TogglePinnedRepo:    "p",
 				AllBranchesLogGraph: "a",
+				FlaggedFiles:        "f",
+				StatusSpeedups:      "s",
+				ReloadConfig:        "r",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
 				CommitChangesWithoutHook: "w",
 				AmendLastCommit:          "A",
 				CommitChangesWithEditor:  "C",
+				AbsorbStagedChanges:      "<c-f>",
+				CreateFixupCommitsByFile: "F",
 				IgnoreFile:               "i",
 				RefreshFiles:             "r",
 				StashAllChanges:          "s",
@@ -509,7 +756,14 @@ func GetDefaultConfig() *UserConfig {
 				Fetch:                    "f",
 				ToggleTreeView:           "`",
 				OpenMergeTool:            "M",
+				OpenMergeToolForFile:     "<c-t>",
 				OpenStatusFilter:         "<c-b>",
+				CollapseAll:              "-",
+				ExpandAll:                "=",
+				ExpandToDefaultDepth:     "0",
+				ViewFileHistory:          "<c-l>",
+				ViewBlame:                "B",
+				ViewSkipWorktreeOptions:  "U",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -518,19 +772,27 @@ func GetDefaultConfig() *UserConfig {
 				CheckoutBranchByName:   "c",
 				ForceCheckoutBranch:    "F",
 				RebaseBranch:           "r",
+				RebaseOntoRef:          "<c-g>",
 				RenameBranch:           "R",
 				MergeIntoCurrentBranch: "M",
 				ViewGitFlowOptions:     "i",
 				FastForward:            "f",
 				CreateTag:              "T",
 				PushTag:                "P",
+				EditTagMessage:         "r",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
+				SetBaseBranch:          "B",
+				CreateWorktree:         "w",
+			},
+			Remotes: KeybindingRemotesConfig{
+				FetchAllRemotes: "F",
 			},
 			Commits: KeybindingCommitsConfig{
 				SquashDown:                     "s",
 				RenameCommit:                   "r",
 				RenameCommitWithEditor:         "R",
+				RenameCommitsWithEditor:        "<c-t>",
 				ViewResetOptions:               "g",
 				MarkCommitAsFixup:              "f",
 				CreateFixupCommit:              "F",
@@ -551,25 +813,63 @@ func GetDefaultConfig() *UserConfig {
 				OpenLogMenu:                    "<c-l>",
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
+				RebaseOntoRef:                  "<c-g>",
+				VerifySignature:                "V",
+				ToggleMarkCommit:               "M",
+				MoveCommitsToBranch:            "B",
+				AddExecTodo:                    "X",
+				AddBreakTodo:                   "<c-b>",
+				CompareCommits:                 "D",
+				CheckoutFileVersion:            "r",
+			},
+			Reflog: KeybindingReflogConfig{
+				RestoreToEntry: "u",
 			},
 			Stash: KeybindingStashConfig{
-				PopStash:    "g",
-				RenameStash: "r",
+				PopStash:        "g",
+				RenameStash:     "r",
+				StashBranch:     "b",
+				ApplyOntoBranch: "A",
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
 				CheckoutCommitFile: "c",
+				CopyFileURL:        "<c-y>",
 			},
 			Main: KeybindingMainConfig{
-				ToggleDragSelect:    "v",
-				ToggleDragSelectAlt: "V",
-				ToggleSelectHunk:    "a",
-				PickBothHunks:       "b",
-				EditSelectHunk:      "E",
+				ToggleDragSelect:                 "v",
+				ToggleDragSelectAlt:              "V",
+				ToggleSelectHunk:                 "a",
+				PickBothHunks:                    "b",
+				PickBothHunksBottomFirst:         "<c-b>",
+				EditSelectHunk:                   "E",
+				ToggleMarkLine:                   "M",
+				ApplyInvertedSelection:           "I",
+				ApplySelectionSkippingWhitespace: "B",
+				CommitSelection:                  "X",
+				StashSelection:                   "s",
+				ToggleCollapseHunk:               "-",
+				SplitHunk:                        "S",
+				ToggleDiffLayout:                 "|",
+				GoToLine:                         "<c-g>",
+				CopySelectedLines:                "y",
+				GoToPatchFile:                    "f",
+			},
+			Blame: KeybindingBlameConfig{
+				ReblameFromParent: "p",
 			},
 			Submodules: KeybindingSubmodulesConfig{
-				Init:     "i",
-				Update:   "u",
-				BulkMenu: "b",
+				Init:                  "i",
+				Update:                "u",
+				UpdateToTrackedBranch: "r",
+				BulkMenu:              "b",
+			},
+			CommitMessage: KeybindingCommitMessageConfig{
+				TrailersMenu: "<c-t>",
+			},
+			CommandLog: KeybindingCommandLogConfig{
+				CopyCommand:       "<c-o>",
+				RunCommandAgain:   "r",
+				ShowCommandOutput: "o",
 			},
 		},
 		OS:                           GetPlatformDefaultConfig(),
@@ -578,5 +878,6 @@ func GetDefaultConfig() *UserConfig {
 		Services:                     map[string]string(nil),
 		NotARepository:               "prompt",
 		PromptToReturnFromSubprocess: true,
+		TrustedRepos:                 []string{},
 	}
 }
@@ -71,6 +71,24 @@ func (m *statusManager) addToastStatus(message string) int {
 	return id
 }
 
+// addStatus shows a message until it's removed
+func (m *statusManager) addStatus(message string) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.nextId++
+	id := m.nextId
+
+	newStatus := appStatus{
+		message:    message,
+		statusType: "info",
+		id:         id,
+	}
+	m.statuses = append([]appStatus{newStatus}, m.statuses...)
+
+	return id
+}
+
 func (m *statusManager) getStatusString() string {
 	if len(m.statuses) == 0 {
 		return ""
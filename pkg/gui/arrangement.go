@@ -1,11 +1,13 @@
 package gui
 
 import (
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazycore/pkg/boxlayout"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
 )
 
 // In this file we use the boxlayout package, along with knowledge about the app's state,
@@ -120,15 +122,7 @@ func (gui *Gui) mainSectionChildren() []*boxlayout.Box {
 func (gui *Gui) getMidSectionWeights() (int, int) {
 	currentWindow := gui.currentWindow()
 
-	// we originally specified this as a ratio i.e. .20 would correspond to a weight of 1 against 4
-	sidePanelWidthRatio := gui.c.UserConfig.Gui.SidePanelWidth
-	// we could make this better by creating ratios like 2:3 rather than always 1:something
-	mainSectionWeight := int(1/sidePanelWidthRatio) - 1
-	sideSectionWeight := 1
-
-	if gui.splitMainPanelSideBySide() {
-		mainSectionWeight = 5 // need to shrink side panel to make way for main panels if side-by-side
-	}
+	sideSectionWeight, mainSectionWeight := gui.getSidePanelWidthWeights()
 
 	if currentWindow == "main" {
 		if gui.State.ScreenMode == SCREEN_HALF || gui.State.ScreenMode == SCREEN_FULL {
@@ -136,6 +130,7 @@ func (gui *Gui) getMidSectionWeights() (int, int) {
 		}
 	} else {
 		if gui.State.ScreenMode == SCREEN_HALF {
+			sideSectionWeight = 1
 			mainSectionWeight = 1
 		} else if gui.State.ScreenMode == SCREEN_FULL {
 			mainSectionWeight = 0
@@ -145,6 +140,46 @@ func (gui *Gui) getMidSectionWeights() (int, int) {
 	return sideSectionWeight, mainSectionWeight
 }
 
+// a width set as a percentage in gui.panels, either for the current mode or
+// overall, takes precedence over gui.sidePanelWidth
+func (gui *Gui) getSidePanelWidthWeights() (int, int) {
+	panelsConfig := gui.c.UserConfig.Gui.Panels
+	percent := panelsConfig.SidePanelWidthPercent
+	if modePercent, ok := panelsConfig.SidePanelWidthPercentByMode[gui.sidePanelWidthMode()]; ok {
+		percent = modePercent
+	}
+
+	if percent > 0 && percent < 100 {
+		return percent, 100 - percent
+	}
+
+	// we originally specified this as a ratio i.e. .20 would correspond to a weight of 1 against 4
+	sidePanelWidthRatio := gui.c.UserConfig.Gui.SidePanelWidth
+	// we could make this better by creating ratios like 2:3 rather than always 1:something
+	mainSectionWeight := int(1/sidePanelWidthRatio) - 1
+
+	if gui.splitMainPanelSideBySide() {
+		mainSectionWeight = 5 // need to shrink side panel to make way for main panels if side-by-side
+	}
+
+	return 1, mainSectionWeight
+}
+
+// sidePanelWidthMode returns the mode that gui.panels.sidePanelWidthPercentByMode
+// can set a width for, if we're in one
+func (gui *Gui) sidePanelWidthMode() string {
+	switch gui.currentStaticContext().GetKey() {
+	case context.STAGING_MAIN_CONTEXT_KEY, context.STAGING_SECONDARY_CONTEXT_KEY:
+		return "staging"
+	case context.PATCH_BUILDING_MAIN_CONTEXT_KEY, context.PATCH_BUILDING_SECONDARY_CONTEXT_KEY:
+		return "patchBuilding"
+	case context.MERGE_CONFLICTS_CONTEXT_KEY:
+		return "mergeConflicts"
+	default:
+		return ""
+	}
+}
+
 func (gui *Gui) infoSectionChildren(informationStr string, appStatus string) []*boxlayout.Box {
 	if gui.State.Searching.isSearching {
 		return []*boxlayout.Box{
@@ -250,6 +285,7 @@ func (gui *Gui) getDefaultStashWindowBox() *boxlayout.Box {
 
 func (gui *Gui) sidePanelChildren(width int, height int) []*boxlayout.Box {
 	currentWindow := gui.currentSideWindowName()
+	windows := gui.getCyclableWindows()
 
 	if gui.State.ScreenMode == SCREEN_FULL || gui.State.ScreenMode == SCREEN_HALF {
 		fullHeightBox := func(window string) *boxlayout.Box {
@@ -266,13 +302,7 @@ func (gui *Gui) sidePanelChildren(width int, height int) []*boxlayout.Box {
 			}
 		}
 
-		return []*boxlayout.Box{
-			fullHeightBox("status"),
-			fullHeightBox("files"),
-			fullHeightBox("branches"),
-			fullHeightBox("commits"),
-			fullHeightBox("stash"),
-		}
+		return slices.Map(windows, fullHeightBox)
 	} else if height >= 28 {
 		accordionMode := gui.c.UserConfig.Gui.ExpandFocusedSidePanel
 		accordionBox := func(defaultBox *boxlayout.Box) *boxlayout.Box {
@@ -286,16 +316,21 @@ func (gui *Gui) sidePanelChildren(width int, height int) []*boxlayout.Box {
 			return defaultBox
 		}
 
-		return []*boxlayout.Box{
-			{
-				Window: "status",
-				Size:   3,
-			},
-			accordionBox(&boxlayout.Box{Window: "files", Weight: 1}),
-			accordionBox(&boxlayout.Box{Window: "branches", Weight: 1}),
-			accordionBox(&boxlayout.Box{Window: "commits", Weight: 1}),
-			accordionBox(gui.getDefaultStashWindowBox()),
+		defaultBox := func(window string) *boxlayout.Box {
+			switch window {
+			case "status":
+				return &boxlayout.Box{
+					Window: "status",
+					Size:   3,
+				}
+			case "stash":
+				return accordionBox(gui.getDefaultStashWindowBox())
+			default:
+				return accordionBox(&boxlayout.Box{Window: window, Weight: 1})
+			}
 		}
+
+		return slices.Map(windows, defaultBox)
 	} else {
 		squashedHeight := 1
 		if height >= 21 {
@@ -316,18 +351,26 @@ func (gui *Gui) sidePanelChildren(width int, height int) []*boxlayout.Box {
 			}
 		}
 
-		return []*boxlayout.Box{
-			squashedSidePanelBox("status"),
-			squashedSidePanelBox("files"),
-			squashedSidePanelBox("branches"),
-			squashedSidePanelBox("commits"),
-			squashedSidePanelBox("stash"),
-		}
+		return slices.Map(windows, squashedSidePanelBox)
 	}
 }
 
+var sideWindows = []string{"status", "files", "branches", "commits", "stash"}
+
+// getCyclableWindows returns the side windows in the order set by
+// gui.panels.order, leaving out hidden windows unless they're focused
 func (gui *Gui) getCyclableWindows() []string {
-	return []string{"status", "files", "branches", "commits", "stash"}
+	panelsConfig := gui.c.UserConfig.Gui.Panels
+	currentWindow := gui.currentSideWindowName()
+
+	order := lo.Uniq(lo.Filter(panelsConfig.Order, func(window string, _ int) bool {
+		return lo.Contains(sideWindows, window)
+	}))
+	order = append(order, lo.Without(sideWindows, order...)...)
+
+	return lo.Filter(order, func(window string, _ int) bool {
+		return window == currentWindow || !lo.Contains(panelsConfig.Hidden, window)
+	})
 }
 
 func (gui *Gui) currentSideWindowName() string {
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
@@ -24,13 +25,57 @@ func (gui *Gui) startBackgroundRoutines() {
 	if userConfig.Git.AutoRefresh {
 		refreshInterval := userConfig.Refresher.RefreshInterval
 		if refreshInterval > 0 {
-			gui.goEvery(time.Second*time.Duration(refreshInterval), gui.stopChan, gui.refreshFilesAndSubmodules)
+			go utils.Safe(gui.startBackgroundFilesRefresh)
 		} else {
 			gui.c.Log.Errorf(
 				"Value of config option 'refresher.refreshInterval' (%d) is invalid, disabling auto-refresh",
 				refreshInterval)
 		}
 	}
+
+	// the remotes panel says how long ago each remote was fetched, so we need to
+	// re-render it every so often for that to stay accurate
+	gui.goEvery(time.Minute, gui.stopChan, gui.rerenderRemoteFetchTimes)
+}
+
+func (gui *Gui) rerenderRemoteFetchTimes() error {
+	gui.c.OnUIThread(func() error {
+		if len(gui.State.Model.RemoteFetchTimes) == 0 {
+			return nil
+		}
+
+		return gui.c.PostRefreshUpdate(gui.State.Contexts.Remotes)
+	})
+
+	return nil
+}
+
+// unlike the other background routines, the interval can change as we go,
+// because fsmonitor can be turned on or off, or we can switch to another repo
+func (gui *Gui) startBackgroundFilesRefresh() {
+	for {
+		select {
+		case <-time.After(gui.backgroundFilesRefreshInterval()):
+			if gui.PauseBackgroundThreads {
+				continue
+			}
+			// collapsing this with any other refresh of the files that's going on
+			_ = gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Debounce: true})
+		case <-gui.stopChan:
+			return
+		}
+	}
+}
+
+// with fsmonitor, git status doesn't have to look at every file, so we can
+// afford to refresh more often
+func (gui *Gui) backgroundFilesRefreshInterval() time.Duration {
+	refresherConfig := gui.UserConfig.Refresher
+	if refresherConfig.FsMonitorRefreshInterval > 0 && gui.git.Config.GetFsMonitor() {
+		return time.Second * time.Duration(refresherConfig.FsMonitorRefreshInterval)
+	}
+
+	return time.Second * time.Duration(refresherConfig.RefreshInterval)
 }
 
 func (gui *Gui) startBackgroundFetch() {
@@ -0,0 +1,52 @@
+package gui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// backgroundLoader loads something that's too slow to load along with the rest
+// of a refresh, like how far each branch has diverged from its base branch.
+// Refreshes can come quicker than the loads finish, so starting a load gives
+// up on the one before it, which keeps loads from piling up and stops an older
+// load from overwriting what a newer one found.
+type backgroundLoader struct {
+	mutex    deadlock.Mutex
+	stopChan chan struct{}
+}
+
+// run gives up on the load in progress, if any, and starts the given one in
+// the background. The load should stop once its channel is closed, and check
+// that it hasn't been stopped before filling in what it found.
+func (self *backgroundLoader) run(load func(stop chan struct{})) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.stopChan != nil {
+		close(self.stopChan)
+	}
+	stop := make(chan struct{})
+	self.stopChan = stop
+
+	go utils.Safe(func() { load(stop) })
+}
+
+// stop gives up on the load in progress, if any
+func (self *backgroundLoader) stop() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.stopChan != nil {
+		close(self.stopChan)
+		self.stopChan = nil
+	}
+}
+
+func isStopped(stop chan struct{}) bool {
+	select {
+	case <-stop:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,6 +1,15 @@
 package gui
 
-import "github.com/jesseduffield/lazygit/pkg/gui/types"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/sasha-s/go-deadlock"
+)
 
 func (gui *Gui) branchesRenderToMain() error {
 	var task types.UpdateTask
@@ -21,3 +30,151 @@ func (gui *Gui) branchesRenderToMain() error {
 		},
 	})
 }
+
+// loadBranchDivergences works out how far each branch has diverged from its
+// base branch. This takes a git call per branch (or several, if we have to
+// detect the base branch), so we do it in the background and fill in the
+// counts once they're all in. We only make those calls for the branches that
+// have moved, or whose base branch has moved, since the last load.
+func (gui *Gui) loadBranchDivergences(branches []*models.Branch, stop chan struct{}) {
+	overrides, err := gui.git.Branch.GetBaseBranchOverrides()
+	if err != nil {
+		gui.c.Log.Error(err)
+		return
+	}
+
+	shas, err := gui.git.Branch.GetRefShas()
+	if err != nil {
+		// without the shas we can't use the cache, but we can still load
+		gui.c.Log.Error(err)
+		shas = map[string]string{}
+	}
+
+	divergences := map[string]*git_commands.Divergence{}
+	for _, branch := range branches {
+		if isStopped(stop) {
+			return
+		}
+
+		if branch.DetachedHead {
+			continue
+		}
+
+		key, canCache := gui.getDivergenceKey(branch.Name, overrides[branch.Name], shas)
+		divergence, cached := gui.divergenceCache.get(key)
+		if !canCache || !cached {
+			divergence, err = gui.git.Branch.GetDivergenceFromBaseBranch(branch.Name, overrides[branch.Name])
+			if err != nil {
+				gui.c.Log.Error(err)
+				continue
+			}
+			if canCache {
+				gui.divergenceCache.set(key, divergence)
+			}
+		}
+
+		if divergence != nil {
+			divergences[branch.Name] = divergence
+		}
+	}
+
+	gui.c.OnUIThread(func() error {
+		// if the branches have been reloaded in the meantime, that refresh
+		// will have kicked off a load of its own
+		if isStopped(stop) {
+			return nil
+		}
+
+		gui.State.Model.BranchDivergences = divergences
+		return gui.c.PostRefreshUpdate(gui.State.Contexts.Branches)
+	})
+}
+
+// getDivergenceKey returns what we cache the given branch's divergence under:
+// the shas of the branch and of each branch it may be counted against. We
+// can't cache it if we don't know the shas of the branch or of a base branch
+// set by hand (e.g. because it's a tag).
+func (gui *Gui) getDivergenceKey(branchName string, baseBranchOverride string, shas map[string]string) (divergenceKey, bool) {
+	branchSha, ok := shas[branchName]
+	if !ok {
+		return divergenceKey{}, false
+	}
+
+	if baseBranchOverride != "" {
+		baseSha, ok := shas[baseBranchOverride]
+		return divergenceKey{branchSha: branchSha, baseShas: baseSha}, ok
+	}
+
+	// a main branch that doesn't exist has no sha, and if it's created later
+	// the key changes
+	baseShas := slices.Map(gui.c.UserConfig.Git.MainBranches, func(mainBranch string) string {
+		return shas[mainBranch]
+	})
+	return divergenceKey{branchSha: branchSha, baseShas: strings.Join(baseShas, " ")}, true
+}
+
+type divergenceKey struct {
+	branchSha string
+	// the shas of the branches we count the divergence against, of which we
+	// pick the nearest
+	baseShas string
+}
+
+type divergenceCache struct {
+	mutex       deadlock.Mutex
+	divergences map[divergenceKey]*git_commands.Divergence
+}
+
+func newDivergenceCache() *divergenceCache {
+	return &divergenceCache{divergences: map[divergenceKey]*git_commands.Divergence{}}
+}
+
+// get returns the divergence we found for the given key, which is nil if the
+// branch has no base branch, and whether we found one at all
+func (self *divergenceCache) get(key divergenceKey) (*git_commands.Divergence, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	divergence, ok := self.divergences[key]
+	return divergence, ok
+}
+
+func (self *divergenceCache) set(key divergenceKey, divergence *git_commands.Divergence) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.divergences[key] = divergence
+}
+
+// loadPullRequests asks the hosting service for the pull requests of the
+// given branches. Nobody asked for this, so a failure goes to the command log
+// rather than a popup, and only the first time, since it's most likely a
+// missing token that will fail the same way every time.
+func (gui *Gui) loadPullRequests(model *types.Model, branches []*models.Branch, stop chan struct{}) {
+	branchNames := slices.FilterMap(branches, func(branch *models.Branch) (string, bool) {
+		return branch.Name, !branch.DetachedHead
+	})
+	pullRequests, err := gui.helpers.Host.GetPullRequests(branchNames)
+	if isStopped(stop) {
+		return
+	}
+	if err != nil {
+		gui.logPullRequestsFailureOnce.Do(func() {
+			gui.c.LogCommand(fmt.Sprintf(gui.c.Tr.FailedToLoadPullRequests, err.Error()), false)
+		})
+		return
+	}
+
+	gui.c.OnUIThread(func() error {
+		if isStopped(stop) {
+			return nil
+		}
+		model.PullRequests = pullRequests
+
+		// we may have switched to another repo in the meantime
+		if gui.State.Model != model {
+			return nil
+		}
+		return gui.c.PostRefreshUpdate(gui.State.Contexts.Branches)
+	})
+}
@@ -0,0 +1,182 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// how many of the commands we've run we remember. This includes those we run in
+// the background, of which there are a lot
+const COMMAND_HISTORY_SIZE = 200
+
+func (gui *Gui) wantsCmdDone(background bool) bool {
+	return !background || !gui.UserConfig.Gui.HideBackgroundCommandsInCommandLog
+}
+
+// the command history is made up of the commands that have finished, so that we
+// can say how they went. We say so in the command log too.
+func (gui *Gui) onCmdDone(entry oscommands.CmdLogEntry) {
+	gui.cmdHistoryMutex.Lock()
+	gui.cmdHistory = append(gui.cmdHistory, &entry)
+	if len(gui.cmdHistory) > COMMAND_HISTORY_SIZE {
+		gui.cmdHistory = gui.cmdHistory[len(gui.cmdHistory)-COMMAND_HISTORY_SIZE:]
+	}
+	gui.cmdHistoryMutex.Unlock()
+
+	gui.logCmdDone(&entry)
+}
+
+func (gui *Gui) handleCreateCommandHistoryMenu() error {
+	return gui.createCommandHistoryMenu(gui.handleCreateCommandHistoryEntryMenu)
+}
+
+func (gui *Gui) handleCopyCommandFromHistory() error {
+	return gui.createCommandHistoryMenu(gui.copyCommand)
+}
+
+func (gui *Gui) handleRunCommandFromHistoryAgain() error {
+	return gui.createCommandHistoryMenu(gui.runCommandAgain)
+}
+
+func (gui *Gui) handleShowCommandOutputFromHistory() error {
+	return gui.createCommandHistoryMenu(gui.showCommandOutput)
+}
+
+// createCommandHistoryMenu asks which of the commands we've run to do something
+// with
+func (gui *Gui) createCommandHistoryMenu(onPress func(entry *oscommands.CmdLogEntry) error) error {
+	gui.cmdHistoryMutex.Lock()
+	entries := lo.Reverse(append([]*oscommands.CmdLogEntry{}, gui.cmdHistory...))
+	gui.cmdHistoryMutex.Unlock()
+
+	menuItems := lo.Map(entries, func(entry *oscommands.CmdLogEntry, _ int) *types.MenuItem {
+		origin := gui.c.Tr.CommandHistoryUser
+		if entry.Background {
+			origin = style.FgBlue.Sprint(gui.c.Tr.CommandHistoryBackground)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{
+				origin,
+				commandHistoryLabel(entry.CmdStr),
+				durationLabel(entry),
+				gui.exitCodeLabel(entry),
+			},
+			OnPress: func() error {
+				return onPress(entry)
+			},
+		}
+	})
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: gui.c.Tr.CommandHistoryTitle,
+		Items: menuItems,
+	})
+}
+
+// commands can be long, and some are whole scripts
+func commandHistoryLabel(cmdStr string) string {
+	return utils.TruncateWithEllipsis(strings.Replace(cmdStr, "\n", " ", -1), 100)
+}
+
+func durationLabel(entry *oscommands.CmdLogEntry) string {
+	return fmt.Sprintf("%dms", entry.Duration.Milliseconds())
+}
+
+func (gui *Gui) exitCodeLabel(entry *oscommands.CmdLogEntry) string {
+	switch {
+	case entry.FailedToStart:
+		return style.FgRed.Sprint(gui.c.Tr.CommandHistoryFailedToStart)
+	case entry.ExitCode == 0:
+		return fmt.Sprintf(gui.c.Tr.CommandHistoryExitCode, entry.ExitCode)
+	case entry.ExitCode == -1:
+		return style.FgYellow.Sprint(gui.c.Tr.CommandHistoryKilled)
+	default:
+		return style.FgRed.Sprintf(gui.c.Tr.CommandHistoryExitCode, entry.ExitCode)
+	}
+}
+
+func (gui *Gui) handleCreateCommandHistoryEntryMenu(entry *oscommands.CmdLogEntry) error {
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: commandHistoryLabel(entry.CmdStr),
+		Items: []*types.MenuItem{
+			{
+				Label: gui.c.Tr.LcCopyCommandToClipboard,
+				Key:   'c',
+				OnPress: func() error {
+					return gui.copyCommand(entry)
+				},
+			},
+			{
+				Label: gui.c.Tr.LcRunCommandAgain,
+				Key:   'r',
+				OnPress: func() error {
+					return gui.runCommandAgain(entry)
+				},
+			},
+			{
+				Label: gui.c.Tr.LcShowCommandOutput,
+				Key:   'o',
+				OnPress: func() error {
+					return gui.showCommandOutput(entry)
+				},
+			},
+		},
+	})
+}
+
+func (gui *Gui) copyCommand(entry *oscommands.CmdLogEntry) error {
+	gui.c.LogAction(gui.c.Tr.Actions.CopyToClipboard)
+	if err := gui.os.CopyToClipboard(entry.CmdStr); err != nil {
+		return gui.c.Error(err)
+	}
+
+	gui.c.Toast(fmt.Sprintf("'%s' %s", utils.TruncateWithEllipsis(commandHistoryLabel(entry.CmdStr), 50), gui.c.Tr.LcCopiedToClipboard))
+	return nil
+}
+
+func (gui *Gui) runCommandAgain(entry *oscommands.CmdLogEntry) error {
+	return gui.c.Confirm(types.ConfirmOpts{
+		Title:  gui.c.Tr.RunCommandAgainTitle,
+		Prompt: fmt.Sprintf(gui.c.Tr.RunCommandAgainPrompt, commandHistoryLabel(entry.CmdStr)),
+		HandleConfirm: func() error {
+			return gui.c.WithWaitingStatus(gui.c.Tr.LcRunningCommandAgainStatus, func() error {
+				gui.c.LogAction(gui.c.Tr.Actions.RunCommandAgain)
+
+				cmdObj := gui.os.Cmd.NewFromArgs(entry.Args)
+				// the command may have depended on what we set in its
+				// environment, e.g. to tell the rebase what to do
+				cmdObj.GetCmd().Env = entry.Env
+				cmdObj.GetCmd().Dir = entry.Dir
+				if err := cmdObj.Run(); err != nil {
+					return gui.c.Error(err)
+				}
+
+				return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			})
+		},
+	})
+}
+
+func (gui *Gui) showCommandOutput(entry *oscommands.CmdLogEntry) error {
+	output := entry.Output
+	if output == "" {
+		output = gui.c.Tr.NoCommandOutput
+	}
+
+	return gui.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: gui.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: gui.c.Tr.CommandOutputTitle,
+			Task: types.NewRenderStringTask(
+				fmt.Sprintf("%s\n%s, %s\n\n%s", style.FgYellow.Sprint(entry.CmdStr), durationLabel(entry), gui.exitCodeLabel(entry), output),
+			),
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -30,6 +31,7 @@ func (gui *Gui) LogAction(action string) {
 
 	gui.Views.Extras.Autoscroll = true
 
+	gui.lastLoggedCmdStr = ""
 	fmt.Fprint(gui.Views.Extras, "\n"+style.FgYellow.Sprint(action))
 }
 
@@ -47,8 +49,38 @@ func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
 		textStyle = style.FgMagenta
 	}
 	gui.CmdLog = append(gui.CmdLog, cmdStr)
-	indentedCmdStr := "  " + strings.Replace(cmdStr, "\n", "\n  ", -1)
-	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentedCmdStr))
+	gui.lastLoggedCmdStr = cmdStr
+	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentCmdStr(cmdStr)))
+}
+
+// logCmdDone says how a command went. Unless the command is the last thing in
+// the log, we repeat it along with the result so that it's clear which command
+// the result is for. The commands that we run in the background aren't logged
+// when they start, and we don't scroll to them so as not to get in the way of
+// reading the log.
+func (gui *Gui) logCmdDone(entry *oscommands.CmdLogEntry) {
+	if gui.Views.Extras == nil {
+		return
+	}
+
+	if !entry.Background {
+		gui.Views.Extras.Autoscroll = true
+	}
+
+	if entry.Background || gui.lastLoggedCmdStr != entry.CmdStr {
+		textStyle := theme.DefaultTextColor
+		if entry.Background {
+			textStyle = style.FgBlue
+		}
+		fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentCmdStr(entry.CmdStr)))
+	}
+	gui.lastLoggedCmdStr = ""
+
+	fmt.Fprintf(gui.Views.Extras, "\n    %s, %s", durationLabel(entry), gui.exitCodeLabel(entry))
+}
+
+func indentCmdStr(cmdStr string) string {
+	return "  " + strings.Replace(cmdStr, "\n", "\n  ", -1)
 }
 
 func (gui *Gui) printCommandLogHeader() {
@@ -0,0 +1,183 @@
+package gui
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// an action that can be run from the command palette, along with the context
+// it belongs to (nil for global actions)
+type commandPaletteItem struct {
+	binding *types.Binding
+	context types.Context
+}
+
+// tab names are the most recognisable, whereas for other panels we fall back
+// to the view's title. Not using the context's title because some contexts
+// only know theirs once they're showing something.
+func (gui *Gui) commandPaletteContextTitle(item *commandPaletteItem) string {
+	if item.context == nil {
+		return gui.c.Tr.GlobalTitle
+	}
+
+	viewName := item.context.GetViewName()
+	for _, tabs := range gui.viewTabMap() {
+		for _, tab := range tabs {
+			if tab.ViewName == viewName {
+				return tab.Tab
+			}
+		}
+	}
+
+	if view, err := gui.g.View(viewName); err == nil && view.Title != "" {
+		return view.Title
+	}
+	return viewName
+}
+
+// unlike the options menu, this collects the bindings of every side context so
+// that actions can be found without knowing which panel they live in
+func (gui *Gui) getCommandPaletteItems() []*commandPaletteItem {
+	bindings, _ := gui.getKeybindings()
+
+	sideContexts := slices.Filter(gui.State.Contexts.Flatten(), func(context types.Context) bool {
+		return context.GetKind() == types.SIDE_CONTEXT
+	})
+
+	items := []*commandPaletteItem{}
+	for _, binding := range bindings {
+		if keybindings.LabelFromKey(binding.Key) == "" || binding.Description == "" || binding.Tag == "navigation" {
+			continue
+		}
+
+		if binding.ViewName == "" {
+			items = append(items, &commandPaletteItem{binding: binding})
+			continue
+		}
+
+		context, ok := lo.Find(sideContexts, func(context types.Context) bool {
+			return context.GetViewName() == binding.ViewName
+		})
+		if ok {
+			items = append(items, &commandPaletteItem{binding: binding, context: context})
+		}
+	}
+
+	return lo.UniqBy(items, func(item *commandPaletteItem) string {
+		return item.binding.ViewName + "\x00" + item.binding.Description
+	})
+}
+
+// returns why the item can't be run right now, if it can't
+func (gui *Gui) getCommandPaletteDisabledReason(item *commandPaletteItem) string {
+	if item.binding.GetDisabledReason != nil {
+		if reason := item.binding.GetDisabledReason(); reason != "" {
+			return reason
+		}
+	}
+
+	if item.context == nil {
+		return ""
+	}
+
+	// contexts like commit files are only reachable by drilling into another
+	// panel, so we can only switch to them if they're already showing
+	viewName := item.context.GetViewName()
+	if !gui.isTopLevelView(viewName) && gui.getViewNameForWindow(item.context.GetWindowName()) != viewName {
+		return fmt.Sprintf(gui.c.Tr.CommandPaletteContextNotOpen, gui.commandPaletteContextTitle(item))
+	}
+
+	return ""
+}
+
+func (gui *Gui) isTopLevelView(viewName string) bool {
+	if viewName == "status" || viewName == "stash" {
+		return true
+	}
+
+	for _, tabs := range gui.viewTabMap() {
+		for _, tab := range tabs {
+			if tab.ViewName == viewName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (gui *Gui) runCommandPaletteItem(item *commandPaletteItem) error {
+	if reason := gui.getCommandPaletteDisabledReason(item); reason != "" {
+		return gui.c.ErrorMsg(reason)
+	}
+
+	if item.context != nil && gui.currentViewName() != item.context.GetViewName() {
+		if err := gui.c.PushContext(item.context); err != nil {
+			return err
+		}
+	}
+
+	if item.binding.Handler == nil {
+		return nil
+	}
+	return item.binding.Handler()
+}
+
+func (gui *Gui) handleCreateCommandPalette() error {
+	items := gui.getCommandPaletteItems()
+
+	// the suggestions' values are indices into items, so that we can tell apart
+	// actions with the same description in different contexts
+	findSuggestions := func(input string) []*types.Suggestion {
+		matches := items
+		if input != "" {
+			matches = utils.FuzzySearchItems(input, items, func(item *commandPaletteItem) string {
+				return item.binding.Description + " " + gui.commandPaletteContextTitle(item)
+			})
+		}
+
+		return slices.Map(matches, func(item *commandPaletteItem) *types.Suggestion {
+			index := lo.IndexOf(items, item)
+
+			description := item.binding.Description
+			if gui.getCommandPaletteDisabledReason(item) != "" {
+				description = style.FgBlackLighter.Sprint(description)
+			}
+
+			return &types.Suggestion{
+				Value: strconv.Itoa(index),
+				Label: fmt.Sprintf("%s %s %s",
+					description,
+					style.FgCyan.Sprint("("+gui.commandPaletteContextTitle(item)+")"),
+					style.FgMagenta.Sprint(keybindings.LabelFromKey(item.binding.Key)),
+				),
+			}
+		})
+	}
+
+	return gui.c.Prompt(types.PromptOpts{
+		Title:               gui.c.Tr.CommandPaletteTitle,
+		FindSuggestionsFunc: findSuggestions,
+		HandleConfirm: func(str string) error {
+			// a suggestion gives us an index, whereas typed text needs to be
+			// resolved to the best match
+			index, err := strconv.Atoi(str)
+			if err != nil || index < 0 || index >= len(items) {
+				suggestions := findSuggestions(str)
+				if len(suggestions) == 0 {
+					return gui.c.ErrorMsg(gui.c.Tr.NoMatchingMenuItem)
+				}
+				index, _ = strconv.Atoi(suggestions[0].Value)
+			}
+
+			return gui.runCommandPaletteItem(items[index])
+		},
+	})
+}
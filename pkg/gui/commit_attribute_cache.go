@@ -0,0 +1,112 @@
+package gui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// Some of the things we can show in the commits panel (e.g. signature statuses)
+// are too slow to work out for every commit up front, so we only ask git about
+// the commits that are on screen, in the background, and remember the answers
+// for the rest of the session. None of them can change without the commit's
+// sha changing, so there's no need to ever invalidate them.
+type commitAttributeCache[T any] struct {
+	mutex  deadlock.Mutex
+	values map[string]T
+	// shas we've asked git about but haven't heard back on yet
+	loading map[string]bool
+
+	// asks git about the given shas
+	load func(shas []string) (map[string]T, error)
+	// what we store for shas that git didn't tell us about, so that we don't
+	// ask again on every render
+	fallback T
+}
+
+func newCommitAttributeCache[T any](load func(shas []string) (map[string]T, error), fallback T) *commitAttributeCache[T] {
+	return &commitAttributeCache[T]{
+		values:   map[string]T{},
+		loading:  map[string]bool{},
+		load:     load,
+		fallback: fallback,
+	}
+}
+
+// get returns the values we know of for the given commits, and starts loading
+// the others, calling onLoaded once they're in.
+func (self *commitAttributeCache[T]) get(commits []*models.Commit, onLoaded func(err error)) map[string]T {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	result := map[string]T{}
+	shasToLoad := []string{}
+	for _, commit := range commits {
+		if commit.IsTODO() {
+			continue
+		}
+
+		if value, ok := self.values[commit.Sha]; ok {
+			result[commit.Sha] = value
+		} else if !self.loading[commit.Sha] {
+			self.loading[commit.Sha] = true
+			shasToLoad = append(shasToLoad, commit.Sha)
+		}
+	}
+
+	if len(shasToLoad) > 0 {
+		go utils.Safe(func() { onLoaded(self.loadValues(shasToLoad)) })
+	}
+
+	return result
+}
+
+func (self *commitAttributeCache[T]) loadValues(shas []string) error {
+	values, err := self.load(shas)
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, sha := range shas {
+		delete(self.loading, sha)
+		value, ok := values[sha]
+		if !ok {
+			value = self.fallback
+		}
+		self.values[sha] = value
+	}
+
+	return err
+}
+
+func (gui *Gui) onCommitAttributesLoaded(err error) {
+	if err != nil {
+		gui.c.Log.Error(err)
+	}
+
+	gui.c.OnUIThread(func() error {
+		return gui.c.PostRefreshUpdate(gui.State.Contexts.LocalCommits)
+	})
+}
+
+// getSignatureStatuses returns the statuses we know of for the given commits,
+// and starts loading the others. Returns nil if the signature status column is
+// disabled.
+func (gui *Gui) getSignatureStatuses(commits []*models.Commit) map[string]string {
+	if !gui.c.UserConfig.Gui.ShowSignatureStatus {
+		return nil
+	}
+
+	return gui.signatureStatuses.get(commits, gui.onCommitAttributesLoaded)
+}
+
+// getDiffStats returns the diff stats we know of for the given commits, and
+// starts loading the others. Returns nil if the diff stats column is disabled.
+func (gui *Gui) getDiffStats(commits []*models.Commit) map[string]*git_commands.DiffStats {
+	if !gui.c.UserConfig.Gui.ShowDiffStatsInCommitList {
+		return nil
+	}
+
+	return gui.diffStats.get(commits, gui.onCommitAttributesLoaded)
+}
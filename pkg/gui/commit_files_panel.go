@@ -1,6 +1,7 @@
 package gui
 
 import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -18,6 +19,10 @@ func (gui *Gui) commitFilesRenderToMain() error {
 	cmdObj := gui.git.WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false,
 		gui.IgnoreWhitespaceInDiffView)
 	task := types.NewRunPtyTask(cmdObj.GetCmd())
+	// refs like stash@{0} or a branch name can point somewhere else later
+	if _, ok := ref.(*models.Commit); ok && !gui.State.Modes.Diffing.Active() {
+		task = types.NewCacheableRunPtyTask(cmdObj.GetCmd())
+	}
 
 	pair := gui.c.MainViewPairs().Normal
 	if node.File != nil {
@@ -1,12 +1,15 @@
 package gui
 
 import (
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 func (gui *Gui) handleCommitMessageFocused() error {
@@ -20,10 +23,98 @@ func (gui *Gui) handleCommitMessageFocused() error {
 	)
 
 	gui.RenderCommitLength()
+	gui.renderCommitMessageWarnings()
+	gui.State.commitMessageHistoryIdx = 0
 
 	return gui.renderString(gui.Views.Options, message)
 }
 
+const commitMessageHistoryLimit = 50
+
+// rememberCommitMessage adds the message to the history of the current repo,
+// unless it's one that git generates for us
+func (gui *Gui) rememberCommitMessage(message string) {
+	message = strings.TrimSpace(gui.helpers.WorkingTree.StripCommitTemplateComments(message))
+	if message == "" || isAutogeneratedCommitMessage(message) {
+		return
+	}
+
+	repoPath, err := os.Getwd()
+	if err != nil {
+		gui.c.Log.Error(err)
+		return
+	}
+
+	appState := gui.c.GetAppState()
+	if appState.CommitMessageHistory == nil {
+		appState.CommitMessageHistory = map[string][]string{}
+	}
+	appState.CommitMessageHistory[repoPath] = utils.Limit(
+		lo.Uniq(append([]string{message}, appState.CommitMessageHistory[repoPath]...)),
+		commitMessageHistoryLimit,
+	)
+
+	if err := gui.c.SaveAppState(); err != nil {
+		gui.c.Log.Error(err)
+	}
+}
+
+func isAutogeneratedCommitMessage(message string) bool {
+	return lo.SomeBy([]string{"fixup! ", "squash! ", "amend! "}, func(prefix string) bool {
+		return strings.HasPrefix(message, prefix)
+	})
+}
+
+func (gui *Gui) commitMessageHistory() []string {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+
+	return gui.c.GetAppState().CommitMessageHistory[repoPath]
+}
+
+// handleCommitMessageHistoryKeypress goes back through the commit message
+// history when pressing up on the first line, or alt+up anywhere, and forward
+// again when pressing down on the last line, like in a shell
+func (gui *Gui) handleCommitMessageHistoryKeypress(textArea *gocui.TextArea, key gocui.Key, mod gocui.Modifier) bool {
+	content := textArea.GetContent()
+	_, cursorY := textArea.GetCursorXY()
+	isAltPressed := mod&gocui.ModAlt != 0
+
+	switch {
+	case key == gocui.KeyArrowUp && (isAltPressed || cursorY == 0):
+		return gui.moveInCommitMessageHistory(textArea, 1)
+	case key == gocui.KeyArrowDown && (isAltPressed || cursorY == strings.Count(content, "\n")):
+		return gui.moveInCommitMessageHistory(textArea, -1)
+	default:
+		return false
+	}
+}
+
+func (gui *Gui) moveInCommitMessageHistory(textArea *gocui.TextArea, delta int) bool {
+	history := gui.commitMessageHistory()
+	newIdx := gui.State.commitMessageHistoryIdx + delta
+	if newIdx < 0 || newIdx > len(history) {
+		return false
+	}
+
+	if gui.State.commitMessageHistoryIdx == 0 {
+		gui.State.commitMessageDraft = textArea.GetContent()
+	}
+	gui.State.commitMessageHistoryIdx = newIdx
+
+	message := gui.State.commitMessageDraft
+	if newIdx > 0 {
+		message = history[newIdx-1]
+	}
+
+	textArea.Clear()
+	textArea.TypeString(message)
+
+	return true
+}
+
 func (gui *Gui) RenderCommitLength() {
 	if !gui.c.UserConfig.Gui.CommitLength.Show {
 		return
@@ -32,6 +123,37 @@ func (gui *Gui) RenderCommitLength() {
 	gui.Views.CommitMessage.Subtitle = getBufferLength(gui.Views.CommitMessage)
 }
 
+// renderCommitMessageWarnings lists any problems with the message in the title
+// of the panel, and shows the part of the subject past the length limit in red
+func (gui *Gui) renderCommitMessageWarnings() {
+	view := gui.Views.CommitMessage
+	content := view.TextArea.GetContent()
+
+	warnings := gui.helpers.CommitLint.Warnings(content)
+	if len(warnings) == 0 {
+		view.Title = gui.c.Tr.CommitMessage
+	} else {
+		view.Title = utils.ResolvePlaceholderString(gui.c.Tr.CommitMessageWithWarnings, map[string]string{
+			"warnings": strings.Join(warnings, ", "),
+		})
+	}
+
+	maxSubjectLength := gui.c.UserConfig.Git.Commit.MaxSubjectLength
+	subject, rest, _ := strings.Cut(content, "\n")
+	subjectRunes := []rune(subject)
+	if maxSubjectLength <= 0 || len(subjectRunes) <= maxSubjectLength {
+		return
+	}
+
+	highlightedSubject := string(subjectRunes[:maxSubjectLength]) + style.FgRed.Sprint(string(subjectRunes[maxSubjectLength:]))
+	if strings.Contains(content, "\n") {
+		highlightedSubject += "\n" + rest
+	}
+
+	// the cursor and origin stay where rendering the text area put them
+	view.SetContent(highlightedSubject)
+}
+
 func getBufferLength(view *gocui.View) string {
 	return " " + strconv.Itoa(strings.Count(view.TextArea.GetContent(), "")-1) + " "
 }
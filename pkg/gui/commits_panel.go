@@ -1,6 +1,8 @@
 package gui
 
 import (
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -19,8 +21,11 @@ func (gui *Gui) onCommitFocus() error {
 	context := gui.State.Contexts.LocalCommits
 	if context.GetSelectedLineIdx() > COMMIT_THRESHOLD && context.GetLimitCommits() {
 		context.SetLimitCommits(false)
+		// starting the load here rather than in the goroutine means that
+		// anything waiting on all the commits knows to wait for these ones
+		result := gui.startLoadingCommits()
 		go utils.Safe(func() {
-			if err := gui.refreshCommitsWithLimit(); err != nil {
+			if err := <-result; err != nil {
 				_ = gui.c.Error(err)
 			}
 		})
@@ -34,17 +39,26 @@ func (gui *Gui) branchCommitsRenderToMain() error {
 	commit := gui.State.Contexts.LocalCommits.GetSelected()
 	if commit == nil {
 		task = types.NewRenderStringTask(gui.c.Tr.NoCommitsThisBranch)
+	} else if commit.IsTODOCommand() && commit.Sha == "" {
+		task = types.NewRenderStringTask(strings.TrimSpace(commit.Action + " " + commit.Name))
 	} else {
-		cmdObj := gui.git.Commit.ShowCmdObj(commit.Sha, gui.State.Modes.Filtering.GetPath(),
+		cmdObj := gui.git.Commit.ShowCmdObj(commit.Sha, gui.State.Modes.Filtering.GetPaths(),
 			gui.IgnoreWhitespaceInDiffView)
-		task = types.NewRunPtyTask(cmdObj.GetCmd())
+		task = types.NewCacheableRunPtyTask(cmdObj.GetCmd())
+	}
+
+	// there's no picking out what a regex matched, but text we can
+	highlight := ""
+	if gui.State.Modes.Pickaxe.Active() && !gui.State.Modes.Pickaxe.IsRegex {
+		highlight = gui.State.Modes.Pickaxe.Text
 	}
 
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: gui.c.MainViewPairs().Normal,
 		Main: &types.ViewUpdateOpts{
-			Title: "Patch",
-			Task:  task,
+			Title:     "Patch",
+			Task:      task,
+			Highlight: highlight,
 		},
 		Secondary: gui.secondaryPatchPanelUpdateOpts(),
 	})
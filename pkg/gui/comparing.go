@@ -0,0 +1,33 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/comparing"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+func (gui *Gui) exitCompareMode() error {
+	gui.State.Modes.Comparing = comparing.New()
+	return nil
+}
+
+func (gui *Gui) compareStr() string {
+	mode := gui.State.Modes.Comparing
+	if mode.To == "" {
+		return fmt.Sprintf(gui.c.Tr.LcComparingFrom, utils.ShortSha(mode.From))
+	}
+
+	separator := ".."
+	if mode.SinceMergeBase {
+		separator = "..."
+	}
+
+	return fmt.Sprintf(
+		"%s git diff %s%s%s",
+		gui.c.Tr.LcShowingGitDiff,
+		utils.ShortSha(mode.From),
+		separator,
+		utils.ShortSha(mode.To),
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -55,13 +56,21 @@ func (gui *Gui) wrappedPromptConfirmationFunction(cancel context.CancelFunc, fun
 
 func (gui *Gui) deactivateConfirmationPrompt() {
 	gui.Mutexes.PopupMutex.Lock()
+	suggestionActions := currentSuggestionActions(gui.State.CurrentPopupOpts)
 	gui.State.CurrentPopupOpts = nil
 	gui.Mutexes.PopupMutex.Unlock()
 
 	gui.Views.Confirmation.Visible = false
 	gui.Views.Suggestions.Visible = false
 
-	gui.clearConfirmationViewKeyBindings()
+	gui.clearConfirmationViewKeyBindings(suggestionActions)
+}
+
+func currentSuggestionActions(opts *types.CreatePopupPanelOpts) []*types.SuggestionAction {
+	if opts == nil {
+		return nil
+	}
+	return opts.SuggestionActions
 }
 
 func (gui *Gui) getMessageHeight(wrap bool, message string, width int) int {
@@ -168,7 +177,7 @@ func (gui *Gui) createPopupPanel(ctx context.Context, opts types.CreatePopupPane
 	}
 
 	// remove any previous keybindings
-	gui.clearConfirmationViewKeyBindings()
+	gui.clearConfirmationViewKeyBindings(currentSuggestionActions(gui.State.CurrentPopupOpts))
 
 	err := gui.prepareConfirmationPanel(
 		ctx,
@@ -184,6 +193,9 @@ func (gui *Gui) createPopupPanel(ctx context.Context, opts types.CreatePopupPane
 		cancel()
 		return err
 	}
+	if len(opts.SuggestionActions) > 0 {
+		gui.Views.Suggestions.Title += " " + suggestionActionsHint(opts.SuggestionActions)
+	}
 	confirmationView := gui.Views.Confirmation
 	confirmationView.Editable = opts.Editable
 	confirmationView.Editor = gocui.EditorFunc(gui.defaultEditor)
@@ -293,6 +305,32 @@ func (gui *Gui) setKeyBindings(cancel context.CancelFunc, opts types.CreatePopup
 		},
 	}
 
+	// only popups which the user can't type into get this binding, so that it
+	// doesn't get in the way of typing in prompts
+	if !opts.Editable {
+		bindings = append(bindings, &types.Binding{
+			ViewName:    "confirmation",
+			Key:         keybindings.GetKey(keybindingConfig.Universal.CopyToClipboard),
+			Handler:     gui.handleCopyConfirmationPanelToClipboard,
+			Description: gui.c.Tr.LcCopyPopupContentToClipboard,
+		})
+	}
+
+	for _, action := range opts.SuggestionActions {
+		action := action
+		bindings = append(bindings, &types.Binding{
+			ViewName: "suggestions",
+			Key:      action.Key,
+			Handler: func() error {
+				if err := action.Handler(gui.getSelectedSuggestionValue()); err != nil {
+					return gui.c.Error(err)
+				}
+				gui.refreshSuggestions()
+				return nil
+			},
+		})
+	}
+
 	for _, binding := range bindings {
 		if err := gui.SetKeybinding(binding); err != nil {
 			return err
@@ -302,23 +340,34 @@ func (gui *Gui) setKeyBindings(cancel context.CancelFunc, opts types.CreatePopup
 	return nil
 }
 
-func (gui *Gui) clearConfirmationViewKeyBindings() {
+func suggestionActionsHint(actions []*types.SuggestionAction) string {
+	hints := slices.Map(actions, func(action *types.SuggestionAction) string {
+		return keybindings.LabelFromKey(action.Key) + ": " + action.Description
+	})
+	return strings.Join(hints, ", ")
+}
+
+func (gui *Gui) clearConfirmationViewKeyBindings(suggestionActions []*types.SuggestionAction) {
 	keybindingConfig := gui.c.UserConfig.Keybinding
 	_ = gui.g.DeleteKeybinding("confirmation", keybindings.GetKey(keybindingConfig.Universal.Confirm), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("confirmation", keybindings.GetKey(keybindingConfig.Universal.ConfirmAlt1), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("confirmation", keybindings.GetKey(keybindingConfig.Universal.Return), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("confirmation", keybindings.GetKey(keybindingConfig.Universal.ReturnAlt1), gocui.ModNone)
+	_ = gui.g.DeleteKeybinding("confirmation", keybindings.GetKey(keybindingConfig.Universal.CopyToClipboard), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("suggestions", keybindings.GetKey(keybindingConfig.Universal.Confirm), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("suggestions", keybindings.GetKey(keybindingConfig.Universal.ConfirmAlt1), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("suggestions", keybindings.GetKey(keybindingConfig.Universal.Return), gocui.ModNone)
 	_ = gui.g.DeleteKeybinding("suggestions", keybindings.GetKey(keybindingConfig.Universal.ReturnAlt1), gocui.ModNone)
+	for _, action := range suggestionActions {
+		_ = gui.g.DeleteKeybinding("suggestions", action.Key, gocui.ModNone)
+	}
 }
 
 func (gui *Gui) refreshSuggestions() {
 	gui.suggestionsAsyncHandler.Do(func() func() {
 		findSuggestionsFn := gui.findSuggestions
 		if findSuggestionsFn != nil {
-			suggestions := gui.findSuggestions(gui.c.GetPromptInput())
+			suggestions := findSuggestionsFn(gui.c.GetPromptInput())
 			return func() { gui.setSuggestions(suggestions) }
 		} else {
 			return func() {}
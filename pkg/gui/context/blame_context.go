@@ -0,0 +1,91 @@
+package context
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// BlameContext shows the lines of a file, each with the commit which last
+// changed it, in the main window
+type BlameContext struct {
+	*BasicViewModel[*models.BlameLine]
+	*ListContextTrait
+	*DynamicTitleBuilder
+
+	// the ref we're blaming the file as of, or "" for the working tree
+	ref  string
+	path string
+}
+
+var _ types.IListContext = (*BlameContext)(nil)
+
+func NewBlameContext(
+	getModel func() []*models.BlameLine,
+	view *gocui.View,
+	getDisplayStrings func(startIdx int, length int) [][]string,
+
+	onFocus func(types.OnFocusOpts) error,
+	onRenderToMain func() error,
+	onFocusLost func(opts types.OnFocusLostOpts) error,
+
+	c *types.HelperCommon,
+) *BlameContext {
+	viewModel := NewBasicViewModel(getModel)
+
+	return &BlameContext{
+		BasicViewModel:      viewModel,
+		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.BlameDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       view,
+				WindowName: "main",
+				Key:        BLAME_CONTEXT_KEY,
+				Kind:       types.MAIN_CONTEXT,
+				Focusable:  true,
+			}), ContextCallbackOpts{
+				OnFocus:        onFocus,
+				OnFocusLost:    onFocusLost,
+				OnRenderToMain: onRenderToMain,
+			}),
+			list:              viewModel,
+			getDisplayStrings: getDisplayStrings,
+			c:                 c,
+		},
+	}
+}
+
+func (self *BlameContext) GetSelectedItemId() string {
+	item := self.GetSelected()
+	if item == nil {
+		return ""
+	}
+
+	return strconv.Itoa(item.LineNumber)
+}
+
+func (self *BlameContext) SetTarget(ref string, path string) {
+	self.ref = ref
+	self.path = path
+
+	switch len(ref) {
+	case 0:
+		self.SetTitleRef(path)
+	case 40:
+		self.SetTitleRef(path + " @ " + utils.ShortSha(ref))
+	default:
+		// e.g. a stash entry, which we can show as it is
+		self.SetTitleRef(path + " @ " + ref)
+	}
+}
+
+func (self *BlameContext) GetRef() string {
+	return self.ref
+}
+
+func (self *BlameContext) GetPath() string {
+	return self.path
+}
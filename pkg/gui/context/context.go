@@ -25,6 +25,7 @@ const (
 	PATCH_BUILDING_MAIN_CONTEXT_KEY      types.ContextKey = "patchBuilding"
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY types.ContextKey = "patchBuildingSecondary"
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
+	BLAME_CONTEXT_KEY                    types.ContextKey = "blame"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
 	OPTIONS_CONTEXT_KEY       types.ContextKey = "options"
@@ -62,6 +63,7 @@ var AllContextKeys = []types.ContextKey{
 	PATCH_BUILDING_MAIN_CONTEXT_KEY,
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY,
 	MERGE_CONFLICTS_CONTEXT_KEY,
+	BLAME_CONTEXT_KEY,
 
 	MENU_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
@@ -96,6 +98,7 @@ type ContextTree struct {
 	CustomPatchBuilder          *PatchExplorerContext
 	CustomPatchBuilderSecondary types.Context
 	MergeConflicts              *MergeConflictsContext
+	Blame                       *BlameContext
 	Confirmation                types.Context
 	CommitMessage               types.Context
 	CommandLog                  types.Context
@@ -130,6 +133,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Confirmation,
 		self.CommitMessage,
 
+		self.Blame,
 		self.MergeConflicts,
 		self.StagingSecondary,
 		self.Staging,
@@ -13,12 +13,20 @@ type ListContextTrait struct {
 	c                 *types.HelperCommon
 	list              types.IList
 	getDisplayStrings func(startIdx int, length int) [][]string
+	// whether dragging selects a range of items. Only contexts with actions
+	// that act on the whole range should turn this on, or the highlighted
+	// range would suggest a multi-select that isn't there
+	canSelectRange bool
 }
 
 func (self *ListContextTrait) GetList() types.IList {
 	return self.list
 }
 
+func (self *ListContextTrait) CanSelectRange() bool {
+	return self.canSelectRange
+}
+
 func (self *ListContextTrait) FocusLine() {
 	// we need a way of knowing whether we've rendered to the view yet.
 	self.GetViewTrait().FocusPoint(self.list.GetSelectedLineIdx())
@@ -52,12 +60,21 @@ func (self *ListContextTrait) HandleRender() error {
 	self.list.RefreshSelectedIdx()
 	content := utils.RenderDisplayStrings(self.getDisplayStrings(0, self.list.Len()))
 	self.GetViewTrait().SetContent(content)
+	self.highlightSelectedRange()
 	self.c.Render()
 	self.setFooter()
 
 	return nil
 }
 
+// the view only highlights the selected line, so we highlight the rest of the
+// selected range ourselves
+func (self *ListContextTrait) highlightSelectedRange() {
+	if self.list.IsSelectingRange() {
+		self.GetViewTrait().HighlightLines(self.list.GetSelectionRange())
+	}
+}
+
 func (self *ListContextTrait) OnSearchSelect(selectedLineIdx int) error {
 	self.GetList().SetSelectedLineIdx(selectedLineIdx)
 	return self.HandleFocus(types.OnFocusOpts{})
@@ -1,6 +1,7 @@
 package context
 
 import (
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -44,6 +45,7 @@ func NewLocalCommitsContext(
 				list:              viewModel,
 				getDisplayStrings: getDisplayStrings,
 				c:                 c,
+				canSelectRange:    true,
 			},
 		},
 	}
@@ -67,6 +69,10 @@ type LocalCommitsViewModel struct {
 
 	// If this is true we'll use git log --all when fetching the commits.
 	showWholeGitGraph bool
+
+	// The commits the user has marked so that they can act on several commits
+	// at once, even if they aren't next to each other.
+	markedShas *set.Set[string]
 }
 
 func NewLocalCommitsViewModel(getModel func() []*models.Commit, c *types.HelperCommon) *LocalCommitsViewModel {
@@ -74,6 +80,7 @@ func NewLocalCommitsViewModel(getModel func() []*models.Commit, c *types.HelperC
 		BasicViewModel:    NewBasicViewModel(getModel),
 		limitCommits:      true,
 		showWholeGitGraph: c.UserConfig.Git.Log.ShowWholeGraph,
+		markedShas:        set.New[string](),
 	}
 
 	return self
@@ -107,6 +114,34 @@ func (self *LocalCommitsViewModel) GetShowWholeGitGraph() bool {
 	return self.showWholeGitGraph
 }
 
+func (self *LocalCommitsViewModel) ToggleMarked(sha string) {
+	if self.markedShas.Includes(sha) {
+		self.markedShas.Remove(sha)
+	} else {
+		self.markedShas.Add(sha)
+	}
+}
+
+func (self *LocalCommitsViewModel) GetMarkedShaSet() *set.Set[string] {
+	return self.markedShas
+}
+
+// GetMarkedIndexes returns the indexes of the marked commits that are in the
+// list. Marks on commits that have since been rewritten are ignored.
+func (self *LocalCommitsViewModel) GetMarkedIndexes() []int {
+	indexes := []int{}
+	for i, commit := range self.getModel() {
+		if self.markedShas.Includes(commit.Sha) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func (self *LocalCommitsViewModel) ClearMarked() {
+	self.markedShas = set.New[string]()
+}
+
 func (self *LocalCommitsViewModel) GetCommits() []*models.Commit {
 	return self.getModel()
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type MenuContext struct {
@@ -88,6 +89,11 @@ func (self *MenuViewModel) GetDisplayStrings(_startIdx int, _length int) [][]str
 
 	return slices.Map(self.menuItems, func(item *types.MenuItem) []string {
 		displayStrings := item.LabelColumns
+		if item.DisabledReason != "" {
+			displayStrings = slices.Map(displayStrings, func(str string) string {
+				return style.FgBlackLighter.Sprint(utils.Decolorise(str))
+			})
+		}
 		if showKeys {
 			displayStrings = slices.Prepend(displayStrings, style.FgCyan.Sprint(keybindings.LabelFromKey(item.Key)))
 		}
@@ -116,6 +122,10 @@ func (self *MenuContext) GetKeybindings(opts types.KeybindingsOpts) []*types.Bin
 }
 
 func (self *MenuContext) OnMenuPress(selectedItem *types.MenuItem) error {
+	if selectedItem.DisabledReason != "" {
+		return self.c.ErrorMsg(selectedItem.DisabledReason)
+	}
+
 	if err := self.c.PopContext(); err != nil {
 		return err
 	}
@@ -105,7 +105,7 @@ func (self *PatchExplorerContext) FocusSelection() {
 
 	_ = view.SetOriginY(newOriginY)
 
-	view.SetCursorY(state.GetSelectedLineIdx() - newOriginY)
+	view.SetCursorY(state.SelectedViewLineIdx() - newOriginY)
 }
 
 func (self *PatchExplorerContext) GetContentToRender(isFocused bool) string {
@@ -113,12 +113,34 @@ func (self *PatchExplorerContext) GetContentToRender(isFocused bool) string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(isFocused, self.GetIncludedLineIndices())
+	self.GetState().SetSideBySideWidth(self.sideBySideWidth())
+	self.GetState().SetShowLineNumbers(self.c.UserConfig.Gui.ShowLineNumbersInDiff)
+
+	return self.GetState().RenderForLineIndices(isFocused, self.GetIncludedLineIndices(), self.c.UserConfig.Gui.ShowIntraLineDiff)
+}
+
+// below this width the columns of a side-by-side diff would be too narrow to
+// read, so we show a unified diff instead
+const minSideBySideWidth = 60
+
+// returns the width to render the diff side by side at, or 0 if we should
+// render a unified diff
+func (self *PatchExplorerContext) sideBySideWidth() int {
+	if self.c.UserConfig.Gui.DiffLayout != "sideBySide" {
+		return 0
+	}
+
+	width := self.GetView().InnerWidth()
+	if width < minSideBySideWidth {
+		return 0
+	}
+
+	return width
 }
 
 func (self *PatchExplorerContext) NavigateTo(isFocused bool, selectedLineIdx int) error {
 	self.GetState().SetLineSelectMode()
-	self.GetState().SelectLine(selectedLineIdx)
+	self.GetState().SelectLine(self.GetState().PatchLineIdx(selectedLineIdx))
 
 	return self.RenderAndFocus(isFocused)
 }
@@ -65,12 +65,37 @@ type SubCommitsViewModel struct {
 	*BasicViewModel[*models.Commit]
 
 	limitCommits bool
+
+	// when showing the history of a single file, this is its path. We also
+	// keep track of what the file was called in each commit, given that we
+	// follow it through renames
+	filterPath          string
+	filterPathsByCommit map[string]string
 }
 
 func (self *SubCommitsViewModel) SetRef(ref types.Ref) {
 	self.ref = ref
 }
 
+func (self *SubCommitsViewModel) SetFileHistory(path string, pathsByCommit map[string]string) {
+	self.filterPath = path
+	self.filterPathsByCommit = pathsByCommit
+}
+
+func (self *SubCommitsViewModel) GetFilterPath() string {
+	return self.filterPath
+}
+
+// GetFilterPathForCommit returns what the file whose history we're showing was
+// called in the given commit
+func (self *SubCommitsViewModel) GetFilterPathForCommit(sha string) string {
+	if path, ok := self.filterPathsByCommit[sha]; ok {
+		return path
+	}
+
+	return self.filterPath
+}
+
 func (self *SubCommitsViewModel) GetRef() types.Ref {
 	return self.ref
 }
@@ -101,6 +126,10 @@ func (self *SubCommitsContext) GetCommits() []*models.Commit {
 }
 
 func (self *SubCommitsContext) Title() string {
+	if self.filterPath != "" {
+		return fmt.Sprintf(self.c.Tr.FileHistoryDynamicTitle, utils.TruncateWithEllipsis(self.filterPath, 50))
+	}
+
 	return fmt.Sprintf(self.c.Tr.SubCommitsDynamicTitle, utils.TruncateWithEllipsis(self.ref.RefName(), 50))
 }
 
@@ -11,7 +11,11 @@ type HasLength interface {
 
 type ListCursor struct {
 	selectedIdx int
-	list        HasLength
+	// when selecting a range, this is where the range started. The range runs
+	// from here to the selected line
+	rangeStartIdx    int
+	isSelectingRange bool
+	list             HasLength
 }
 
 func NewListCursor(list HasLength) *ListCursor {
@@ -41,6 +45,35 @@ func (self *ListCursor) MoveSelectedLine(delta int) {
 // to be called when the model might have shrunk so that our selection is not not out of bounds
 func (self *ListCursor) RefreshSelectedIdx() {
 	self.SetSelectedLineIdx(self.selectedIdx)
+	if self.isSelectingRange {
+		self.rangeStartIdx = utils.Clamp(self.rangeStartIdx, 0, utils.Max(self.list.Len()-1, 0))
+	}
+}
+
+// starts selecting a range at the given line. Moving the selected line from
+// here on extends the range
+func (self *ListCursor) SetRangeSelectStart(value int) {
+	self.SetSelectedLineIdx(value)
+	self.rangeStartIdx = self.selectedIdx
+	self.isSelectingRange = true
+}
+
+func (self *ListCursor) CancelRangeSelect() {
+	self.isSelectingRange = false
+}
+
+func (self *ListCursor) IsSelectingRange() bool {
+	return self.isSelectingRange
+}
+
+// returns the first and last line of the selected range, which is just the
+// selected line if we're not selecting a range
+func (self *ListCursor) GetSelectionRange() (int, int) {
+	if !self.isSelectingRange {
+		return self.selectedIdx, self.selectedIdx
+	}
+
+	return utils.Min(self.rangeStartIdx, self.selectedIdx), utils.Max(self.rangeStartIdx, self.selectedIdx)
 }
 
 func (self *ListCursor) Len() int {
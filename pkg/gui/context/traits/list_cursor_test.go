@@ -0,0 +1,49 @@
+package traits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeList struct {
+	length int
+}
+
+func (self *fakeList) Len() int {
+	return self.length
+}
+
+func TestListCursorRangeSelect(t *testing.T) {
+	list := &fakeList{length: 10}
+	cursor := NewListCursor(list)
+
+	cursor.SetSelectedLineIdx(3)
+	assert.False(t, cursor.IsSelectingRange())
+	start, end := cursor.GetSelectionRange()
+	assert.Equal(t, []int{3, 3}, []int{start, end})
+
+	// dragging downwards
+	cursor.SetRangeSelectStart(3)
+	cursor.SetSelectedLineIdx(6)
+	assert.True(t, cursor.IsSelectingRange())
+	start, end = cursor.GetSelectionRange()
+	assert.Equal(t, []int{3, 6}, []int{start, end})
+
+	// dragging back up past where the range started
+	cursor.SetSelectedLineIdx(1)
+	start, end = cursor.GetSelectionRange()
+	assert.Equal(t, []int{1, 3}, []int{start, end})
+
+	// the list shrinking
+	cursor.SetSelectedLineIdx(8)
+	list.length = 2
+	cursor.RefreshSelectedIdx()
+	start, end = cursor.GetSelectionRange()
+	assert.Equal(t, []int{1, 1}, []int{start, end})
+
+	cursor.CancelRangeSelect()
+	assert.False(t, cursor.IsSelectingRange())
+	start, end = cursor.GetSelectionRange()
+	assert.Equal(t, []int{1, 1}, []int{start, end})
+}
@@ -34,6 +34,14 @@ func (self *ViewTrait) SetHighlight(highlight bool) {
 	self.view.Highlight = highlight
 }
 
+// highlights the lines from startIdx to endIdx inclusive like the selected
+// line. The highlight stays until the content is next set.
+func (self *ViewTrait) HighlightLines(startIdx int, endIdx int) {
+	for i := startIdx; i <= endIdx; i++ {
+		_ = self.view.SetHighlight(i, true)
+	}
+}
+
 func (self *ViewTrait) SetFooter(value string) {
 	self.view.Footer = value
 }
@@ -19,4 +19,5 @@ func (self *ViewportListContextTrait) FocusLine() {
 	displayStrings := self.ListContextTrait.getDisplayStrings(startIdx, length)
 	content := utils.RenderDisplayStrings(displayStrings)
 	self.GetViewTrait().SetViewPortContent(content)
+	self.highlightSelectedRange()
 }
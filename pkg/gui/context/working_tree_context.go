@@ -25,7 +25,7 @@ func NewWorkingTreeContext(
 
 	c *types.HelperCommon,
 ) *WorkingTreeContext {
-	viewModel := filetree.NewFileTreeViewModel(getModel, c.Log, c.UserConfig.Gui.ShowFileTree)
+	viewModel := filetree.NewFileTreeViewModel(getModel, c.Log, c.UserConfig.Gui.ShowFileTree, c.UserConfig.Gui.DefaultFileTreeDepth)
 
 	return &WorkingTreeContext{
 		FileTreeViewModel: viewModel,
@@ -65,6 +65,7 @@ func (gui *Gui) contextTree() *context.ContextTree {
 		Tags:           gui.tagsListContext(),
 		Stash:          gui.stashListContext(),
 		Suggestions:    gui.suggestionsListContext(),
+		Blame:          gui.blameListContext(),
 		Normal: context.NewSimpleContext(
 			context.NewBaseContext(context.NewBaseContextOpts{
 				Kind:       types.MAIN_CONTEXT,
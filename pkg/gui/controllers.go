@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/snake"
 )
 
@@ -16,27 +17,35 @@ func (gui *Gui) resetControllers() {
 	helperCommon := gui.c
 	osCommand := gui.os
 	model := gui.State.Model
+	detachedHeadHelper := helpers.NewDetachedHeadHelper(helperCommon, gui.git, model)
 	refsHelper := helpers.NewRefsHelper(
 		helperCommon,
 		gui.git,
 		gui.State.Contexts,
 		model,
+		detachedHeadHelper,
+		gui.switchToWorktree,
 	)
 
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, gui.State.Contexts, gui.git, refsHelper)
-	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon, model, gui.refreshSuggestions)
+	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon, gui.git, model, gui.refreshSuggestions)
 	setCommitMessage := gui.getSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
+	setCommitMessageCursor := gui.getSetTextareaCursorFn(func() *gocui.View { return gui.Views.CommitMessage })
+	getCommitMessageContent := func() string {
+		return gui.Views.CommitMessage.TextArea.GetContent()
+	}
 	getSavedCommitMessage := func() string {
 		return gui.State.savedCommitMessage
 	}
+	workingTreeHelper := helpers.NewWorkingTreeHelper(helperCommon, gui.git, gui.State.Contexts, refsHelper, model, setCommitMessage, setCommitMessageCursor, getSavedCommitMessage)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, gui.State.Contexts, gui.git, refsHelper, suggestionsHelper, workingTreeHelper)
 	gui.helpers = &helpers.Helpers{
 		Refs:           refsHelper,
-		Host:           helpers.NewHostHelper(helperCommon, gui.git),
+		Host:           helpers.NewHostHelper(helperCommon, gui.git, gui.os),
 		PatchBuilding:  helpers.NewPatchBuildingHelper(helperCommon, gui.git, gui.State.Contexts),
 		Bisect:         helpers.NewBisectHelper(helperCommon, gui.git),
 		Suggestions:    suggestionsHelper,
 		Files:          helpers.NewFilesHelper(helperCommon, gui.git, osCommand),
-		WorkingTree:    helpers.NewWorkingTreeHelper(helperCommon, gui.git, gui.State.Contexts, refsHelper, model, setCommitMessage, getSavedCommitMessage),
+		WorkingTree:    workingTreeHelper,
 		Tags:           helpers.NewTagsHelper(helperCommon, gui.git),
 		GPG:            helpers.NewGpgHelper(helperCommon, gui.os, gui.git),
 		MergeAndRebase: rebaseHelper,
@@ -48,7 +57,15 @@ func (gui *Gui) resetControllers() {
 			func() *cherrypicking.CherryPicking { return gui.State.Modes.CherryPicking },
 			rebaseHelper,
 		),
-		Upstream: helpers.NewUpstreamHelper(helperCommon, model, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
+		Upstream:       helpers.NewUpstreamHelper(helperCommon, model, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
+		Absorb:         helpers.NewAbsorbHelper(helperCommon, gui.git, refsHelper, rebaseHelper, model),
+		Worktree:       helpers.NewWorktreeHelper(helperCommon, gui.git, model, suggestionsHelper, gui.switchToWorktree),
+		CommitLint:     helpers.NewCommitLintHelper(helperCommon, workingTreeHelper),
+		CommitTrailers: helpers.NewCommitTrailersHelper(helperCommon, gui.git, getCommitMessageContent, setCommitMessage, setCommitMessageCursor),
+		Fetch:          helpers.NewFetchHelper(helperCommon, gui.git, gui.State.Contexts, model),
+		Clean:          helpers.NewCleanHelper(helperCommon, gui.git),
+		DetachedHead:   detachedHeadHelper,
+		Blame:          helpers.NewBlameHelper(helperCommon, gui.git, gui.State.Contexts, model),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -88,6 +105,7 @@ func (gui *Gui) resetControllers() {
 	onCommitAttempt := func(message string) {
 		gui.State.savedCommitMessage = message
 		gui.Views.CommitMessage.ClearTextArea()
+		gui.rememberCommitMessage(message)
 	}
 
 	onCommitSuccess := func() {
@@ -102,10 +120,12 @@ func (gui *Gui) resetControllers() {
 	)
 
 	remoteBranchesController := controllers.NewRemoteBranchesController(common)
+	subCommitsController := controllers.NewSubCommitsController(common)
 
 	menuController := controllers.NewMenuController(common)
 	localCommitsController := controllers.NewLocalCommitsController(common, syncController.HandlePull)
 	tagsController := controllers.NewTagsController(common)
+	reflogController := controllers.NewReflogController(common)
 	filesController := controllers.NewFilesController(
 		common,
 		gui.enterSubmodule,
@@ -121,6 +141,7 @@ func (gui *Gui) resetControllers() {
 	globalController := controllers.NewGlobalController(common)
 	contextLinesController := controllers.NewContextLinesController(common)
 	verticalScrollControllerFactory := controllers.NewVerticalScrollControllerFactory(common, &gui.viewBufferManagerMap)
+	horizontalScrollControllerFactory := controllers.NewHorizontalScrollControllerFactory(common)
 
 	branchesController := controllers.NewBranchesController(common)
 	gitFlowController := controllers.NewGitFlowController(common)
@@ -140,6 +161,11 @@ func (gui *Gui) resetControllers() {
 		gui.State.Model.SubCommits = commits
 	}
 
+	// the commits controller has its own take on going to the bottom, which
+	// loads the commits we haven't got yet, so it needs to take precedence
+	listControllerFactory := controllers.NewListControllerFactory(gui.c)
+	controllers.AttachControllers(gui.State.Contexts.LocalCommits, listControllerFactory.Create(gui.State.Contexts.LocalCommits))
+
 	for _, context := range []controllers.CanSwitchToSubCommits{
 		gui.State.Contexts.Branches,
 		gui.State.Contexts.RemoteBranches,
@@ -151,6 +177,47 @@ func (gui *Gui) resetControllers() {
 		))
 	}
 
+	controllers.AttachControllers(gui.State.Contexts.Files, controllers.NewSwitchToFileHistoryController(
+		common, setSubCommits, gui.State.Contexts.Files,
+		func() types.Ref {
+			// the history of a file in the working tree is that of the checked out branch
+			if branch := gui.helpers.Refs.GetCheckedOutRef(); branch != nil {
+				return branch
+			}
+			return nil
+		},
+	))
+
+	controllers.AttachControllers(gui.State.Contexts.CommitFiles, controllers.NewSwitchToFileHistoryController(
+		common, setSubCommits, gui.State.Contexts.CommitFiles,
+		func() types.Ref { return gui.State.Contexts.CommitFiles.GetRef() },
+	))
+
+	controllers.AttachControllers(gui.State.Contexts.Files, controllers.NewSwitchToBlameController(
+		common, gui.State.Contexts.Files,
+		func() string {
+			node := gui.State.Contexts.Files.GetSelected()
+			if node == nil || !node.IsFile() {
+				return ""
+			}
+			return node.GetPath()
+		},
+		// blaming the working tree shows which lines are yet to be committed
+		func() string { return "" },
+	))
+
+	controllers.AttachControllers(gui.State.Contexts.CommitFiles, controllers.NewSwitchToBlameController(
+		common, gui.State.Contexts.CommitFiles,
+		func() string {
+			node := gui.State.Contexts.CommitFiles.GetSelected()
+			if node == nil || !node.IsFile() {
+				return ""
+			}
+			return node.GetPath()
+		},
+		func() string { return gui.State.Contexts.CommitFiles.GetRef().RefName() },
+	))
+
 	for _, context := range []controllers.CanSwitchToDiffFiles{
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.SubCommits,
@@ -166,7 +233,7 @@ func (gui *Gui) resetControllers() {
 		gui.State.Contexts.ReflogCommits,
 		gui.State.Contexts.SubCommits,
 	} {
-		controllers.AttachControllers(context, controllers.NewBasicCommitsController(common, context))
+		controllers.AttachControllers(context, controllers.NewBasicCommitsController(common, gui.SwitchToCommitFilesContext, context))
 	}
 
 	// TODO: add scroll controllers for main panels (need to bring some more functionality across for that e.g. reading more from the currently displayed git command)
@@ -174,18 +241,21 @@ func (gui *Gui) resetControllers() {
 		stagingController,
 		patchExplorerControllerFactory.Create(gui.State.Contexts.Staging),
 		verticalScrollControllerFactory.Create(gui.State.Contexts.Staging),
+		horizontalScrollControllerFactory.Create(gui.State.Contexts.Staging),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.StagingSecondary,
 		stagingSecondaryController,
 		patchExplorerControllerFactory.Create(gui.State.Contexts.StagingSecondary),
 		verticalScrollControllerFactory.Create(gui.State.Contexts.StagingSecondary),
+		horizontalScrollControllerFactory.Create(gui.State.Contexts.StagingSecondary),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.CustomPatchBuilder,
 		patchBuildingController,
 		patchExplorerControllerFactory.Create(gui.State.Contexts.CustomPatchBuilder),
 		verticalScrollControllerFactory.Create(gui.State.Contexts.CustomPatchBuilder),
+		horizontalScrollControllerFactory.Create(gui.State.Contexts.CustomPatchBuilder),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.CustomPatchBuilderSecondary,
@@ -194,6 +264,11 @@ func (gui *Gui) resetControllers() {
 
 	controllers.AttachControllers(gui.State.Contexts.MergeConflicts,
 		mergeConflictsController,
+		horizontalScrollControllerFactory.Create(gui.State.Contexts.MergeConflicts),
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.Blame,
+		controllers.NewBlameController(common),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.Files,
@@ -205,6 +280,10 @@ func (gui *Gui) resetControllers() {
 		tagsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.ReflogCommits,
+		reflogController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Submodules,
 		submodulesController,
 	)
@@ -248,6 +327,10 @@ func (gui *Gui) resetControllers() {
 		remoteBranchesController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.SubCommits,
+		subCommitsController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Global,
 		syncController,
 		undoController,
@@ -260,8 +343,10 @@ func (gui *Gui) resetControllers() {
 	)
 
 	// this must come last so that we've got our click handlers defined against the context
-	listControllerFactory := controllers.NewListControllerFactory(gui.c)
 	for _, context := range gui.getListContexts() {
+		if context == gui.State.Contexts.LocalCommits {
+			continue
+		}
 		controllers.AttachControllers(context, listControllerFactory.Create(context))
 	}
 }
@@ -3,8 +3,11 @@ package controllers
 import (
 	"fmt"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/comparing"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // This controller is for all contexts that contain a list of commits.
@@ -21,14 +24,20 @@ type ContainsCommits interface {
 type BasicCommitsController struct {
 	baseController
 	*controllerCommon
-	context ContainsCommits
+	context   ContainsCommits
+	viewFiles func(SwitchToCommitFilesContextOpts) error
 }
 
-func NewBasicCommitsController(controllerCommon *controllerCommon, context ContainsCommits) *BasicCommitsController {
+func NewBasicCommitsController(
+	controllerCommon *controllerCommon,
+	viewFiles func(SwitchToCommitFilesContextOpts) error,
+	context ContainsCommits,
+) *BasicCommitsController {
 	return &BasicCommitsController{
 		baseController:   baseController{},
 		controllerCommon: controllerCommon,
 		context:          context,
+		viewFiles:        viewFiles,
 	}
 }
 
@@ -50,6 +59,11 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.checkSelected(self.openInBrowser),
 			Description: self.c.Tr.LcOpenCommitInBrowser,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenDiffTool),
+			Handler:     self.checkSelected(self.openDiffTool),
+			Description: self.c.Tr.LcOpenDiffTool,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.checkSelected(self.newBranch),
@@ -76,6 +90,11 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.helpers.CherryPick.Reset,
 			Description: self.c.Tr.LcResetCherryPick,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.CompareCommits),
+			Handler:     self.checkSelected(self.markForComparison),
+			Description: self.c.Tr.LcCompareCommits,
+		},
 	}
 
 	return bindings
@@ -88,6 +107,10 @@ func (self *BasicCommitsController) checkSelected(callback func(*models.Commit)
 			return nil
 		}
 
+		if commit.IsTODOCommand() {
+			return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.NotAllowedForTodoCommand, commit.Action))
+		}
+
 		return callback(commit)
 	}
 }
@@ -96,6 +119,73 @@ func (self *BasicCommitsController) Context() types.Context {
 	return self.context
 }
 
+// markForComparison marks the first of the two commits to compare, or if one
+// is already marked, compares it with the given commit
+func (self *BasicCommitsController) markForComparison(commit *models.Commit) error {
+	mode := &self.modes.Comparing
+
+	if !mode.Active() || mode.To != "" {
+		*mode = comparing.Comparing{From: commit.Sha}
+		return nil
+	}
+
+	// marking the same commit again unmarks it
+	if commit.Sha == mode.From {
+		*mode = comparing.New()
+		return nil
+	}
+
+	from := mode.From
+	to := commit.Sha
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CompareCommitsMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{
+					fmt.Sprintf("git diff %s..%s", utils.ShortSha(from), utils.ShortSha(to)),
+					self.c.Tr.LcCompareCommitsDirectly,
+				},
+				OnPress: func() error {
+					return self.compare(from, to, false)
+				},
+				Key: 'd',
+			},
+			{
+				LabelColumns: []string{
+					fmt.Sprintf("git diff %s...%s", utils.ShortSha(from), utils.ShortSha(to)),
+					self.c.Tr.LcCompareCommitsSinceMergeBase,
+				},
+				OnPress: func() error {
+					return self.compare(from, to, true)
+				},
+				Key: 'm',
+			},
+		},
+	})
+}
+
+func (self *BasicCommitsController) compare(from string, to string, sinceMergeBase bool) error {
+	commitRange := &models.CommitRange{From: from, To: to}
+	if sinceMergeBase {
+		mergeBase, err := self.git.Commit.GetMergeBase(from, to)
+		if err != nil {
+			return self.c.Error(err)
+		}
+		commitRange.MergeBase = mergeBase
+	}
+
+	self.modes.Comparing = comparing.Comparing{From: from, To: to, SinceMergeBase: sinceMergeBase}
+
+	// the range isn't a commit of its own, so there's nothing to rebase if the
+	// user builds a patch from it
+	return self.viewFiles(SwitchToCommitFilesContextOpts{
+		Ref:       commitRange,
+		CanRebase: false,
+		Context:   self.context,
+	})
+}
+
 func (self *BasicCommitsController) copyCommitAttribute(commit *models.Commit) error {
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Actions.CopyCommitAttributeToClipboard,
@@ -225,6 +315,14 @@ func (self *BasicCommitsController) openInBrowser(commit *models.Commit) error {
 	return nil
 }
 
+func (self *BasicCommitsController) openDiffTool(commit *models.Commit) error {
+	return self.helpers.Files.OpenDiffTool(git_commands.DifftoolCmdOptions{
+		From:        commit.ParentRefName(),
+		To:          commit.RefName(),
+		IsDirectory: true,
+	})
+}
+
 func (self *BasicCommitsController) newBranch(commit *models.Commit) error {
 	return self.helpers.Refs.NewBranch(commit.RefName(), commit.Description(), "")
 }
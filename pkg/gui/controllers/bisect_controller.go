@@ -2,17 +2,48 @@ package controllers
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
 )
 
 type BisectController struct {
 	baseController
 	*controllerCommon
+
+	runMutex deadlock.Mutex
+	// set while a 'git bisect run' is in progress
+	run *bisectRun
+}
+
+type bisectRun struct {
+	cmd       *exec.Cmd
+	cancelled bool
+	// whether to reset the bisect once the cancelled run has stopped
+	resetAfterCancel bool
+	// whether we've sent the interrupt for a cancelled run. If the run was
+	// cancelled just before its process started, there's nothing to interrupt
+	// yet, so we try again once it's running.
+	interrupted bool
+}
+
+// must be called with the run mutex held
+func (self *bisectRun) interruptIfCancelled() error {
+	if !self.cancelled || self.interrupted || self.cmd.Process == nil {
+		return nil
+	}
+
+	self.interrupted = true
+	return oscommands.Interrupt(self.cmd)
 }
 
 var _ types.IController = &BisectController{}
@@ -42,6 +73,10 @@ func (self *BisectController) GetKeybindings(opts types.KeybindingsOpts) []*type
 func (self *BisectController) openMenu(commit *models.Commit) error {
 	// no shame in getting this directly rather than using the cached value
 	// given how cheap it is to obtain
+	if self.isRunning() {
+		return self.openRunningBisectMenu()
+	}
+
 	info := self.git.Bisect.GetInfo()
 	if info.Started() {
 		return self.openMidBisectMenu(info, commit)
@@ -102,6 +137,13 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			},
 			Key: 's',
 		},
+		{
+			Label: fmt.Sprintf(self.c.Tr.Bisect.RunCommand, info.NewTerm()),
+			OnPress: func() error {
+				return self.promptForRunCommand()
+			},
+			Key: 'c',
+		},
 		{
 			Label: self.c.Tr.Bisect.ResetOption,
 			OnPress: func() error {
@@ -157,6 +199,220 @@ func (self *BisectController) openStartBisectMenu(info *git_commands.BisectInfo,
 	})
 }
 
+func (self *BisectController) openRunningBisectMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.Bisect.BisectMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.Bisect.CancelRun,
+				OnPress: func() error {
+					return self.cancelRun(false)
+				},
+				Key: 'c',
+			},
+			{
+				Label: self.c.Tr.Bisect.CancelRunAndReset,
+				OnPress: func() error {
+					return self.cancelRun(true)
+				},
+				Key: 'r',
+			},
+		},
+	})
+}
+
+func (self *BisectController) promptForRunCommand() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.Bisect.RunCommandTitle,
+		InitialContent: self.lastRunCommand(),
+		HandleConfirm: func(command string) error {
+			if strings.TrimSpace(command) == "" {
+				return nil
+			}
+
+			if err := self.rememberRunCommand(command); err != nil {
+				self.c.Log.Error(err)
+			}
+
+			return self.runCommand(command)
+		},
+	})
+}
+
+func (self *BisectController) lastRunCommand() string {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	return self.c.GetAppState().BisectRunCommands[repoPath]
+}
+
+func (self *BisectController) rememberRunCommand(command string) error {
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	appState := self.c.GetAppState()
+	if appState.BisectRunCommands == nil {
+		appState.BisectRunCommands = map[string]string{}
+	}
+	appState.BisectRunCommands[repoPath] = command
+
+	return self.c.SaveAppState()
+}
+
+// runCommand has git run the command against commits until it finds the first
+// new one. The command's output goes to the command log, and we keep refreshing
+// the commits panel so that the user can watch the range narrow down.
+func (self *BisectController) runCommand(command string) error {
+	cmdObj := self.git.Bisect.RunCmdObj(command)
+	run := &bisectRun{cmd: cmdObj.GetCmd()}
+	self.setRun(run)
+
+	return self.c.WithWaitingStatus(self.c.Tr.Bisect.RunningStatus, func() error {
+		stopRefreshing := make(chan struct{})
+		go utils.Safe(func() {
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-stopRefreshing:
+					return
+				case <-ticker.C:
+					self.runMutex.Lock()
+					err := run.interruptIfCancelled()
+					self.runMutex.Unlock()
+					if err != nil {
+						self.c.Log.Error(err)
+					}
+
+					_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+				}
+			}
+		})
+
+		// the user may have cancelled the run before we got the chance to start
+		// it, in which case there'd be no process to interrupt
+		self.runMutex.Lock()
+		cancelledEarly := run.cancelled
+		self.runMutex.Unlock()
+
+		var runErr error
+		if !cancelledEarly {
+			self.c.LogAction(self.c.Tr.Actions.BisectRun)
+			runErr = cmdObj.Run()
+		}
+		close(stopRefreshing)
+
+		self.runMutex.Lock()
+		self.run = nil
+		cancelled, resetAfterCancel := run.cancelled, run.resetAfterCancel
+		self.runMutex.Unlock()
+
+		if cancelled {
+			if resetAfterCancel {
+				self.c.LogAction(self.c.Tr.Actions.ResetBisect)
+				if err := self.git.Bisect.Reset(); err != nil {
+					return self.c.Error(err)
+				}
+			}
+
+			return self.helpers.Bisect.PostBisectCommandRefresh()
+		}
+
+		// git exits with an error if skipped commits stop it from narrowing
+		// things down to a single commit, so we check whether we're done
+		// regardless of how the run went
+		done, candidateShas, err := self.git.Bisect.IsDone()
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{}}); err != nil {
+			return err
+		}
+
+		if done {
+			return self.showRunCompleteMessage(candidateShas)
+		}
+
+		if runErr != nil {
+			return self.c.Error(runErr)
+		}
+
+		return nil
+	})
+}
+
+func (self *BisectController) cancelRun(reset bool) error {
+	self.runMutex.Lock()
+	defer self.runMutex.Unlock()
+
+	// the run may have finished, or already been cancelled, while the menu was
+	// open
+	if self.run == nil || self.run.cancelled {
+		return nil
+	}
+
+	self.run.cancelled = true
+	self.run.resetAfterCancel = reset
+	if err := self.run.interruptIfCancelled(); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
+
+func (self *BisectController) setRun(run *bisectRun) {
+	self.runMutex.Lock()
+	defer self.runMutex.Unlock()
+
+	self.run = run
+}
+
+func (self *BisectController) isRunning() bool {
+	self.runMutex.Lock()
+	defer self.runMutex.Unlock()
+
+	return self.run != nil
+}
+
+// showRunCompleteMessage is like showBisectCompleteMessage, except that rather
+// than offering to reset we offer to select the culprit, given that the user
+// hasn't seen the commits that were tested along the way.
+func (self *BisectController) showRunCompleteMessage(candidateShas []string) error {
+	prompt := self.c.Tr.Bisect.RunCompletePrompt
+	if len(candidateShas) > 1 {
+		prompt = self.c.Tr.Bisect.RunCompleteIndeterminate
+	}
+
+	formattedCommits, err := self.git.Commit.GetCommitsOneline(candidateShas)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.Bisect.CompleteTitle,
+		Prompt: fmt.Sprintf(prompt, strings.TrimSpace(formattedCommits)),
+		HandleConfirm: func() error {
+			return self.selectCommit(candidateShas[0])
+		},
+	})
+}
+
+func (self *BisectController) selectCommit(sha string) error {
+	for i, commit := range self.model.Commits {
+		if commit.Sha == sha {
+			self.context().SetSelectedLineIdx(i)
+			return self.c.PushContext(self.context())
+		}
+	}
+
+	return nil
+}
+
 func (self *BisectController) showBisectCompleteMessage(candidateShas []string) error {
 	prompt := self.c.Tr.Bisect.CompletePrompt
 	if len(candidateShas) > 1 {
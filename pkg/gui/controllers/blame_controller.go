@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+type BlameController struct {
+	baseController
+	*controllerCommon
+}
+
+var _ types.IController = &BlameController{}
+
+func NewBlameController(
+	common *controllerCommon,
+) *BlameController {
+	return &BlameController{
+		baseController:   baseController{},
+		controllerCommon: common,
+	}
+}
+
+func (self *BlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:     self.checkSelected(self.goToCommit),
+			Description: self.c.Tr.LcGoToBlameCommit,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Blame.ReblameFromParent),
+			Handler:     self.checkSelected(self.reblameFromParent),
+			Description: self.c.Tr.LcReblameFromParent,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Return),
+			Handler:     self.escape,
+			Description: self.c.Tr.LcExitBlame,
+		},
+	}
+}
+
+func (self *BlameController) checkSelected(callback func(*models.BlameLine) error) func() error {
+	return func() error {
+		blameLine := self.context().GetSelected()
+		if blameLine == nil {
+			return nil
+		}
+
+		return callback(blameLine)
+	}
+}
+
+func (self *BlameController) Context() types.Context {
+	return self.context()
+}
+
+func (self *BlameController) context() *context.BlameContext {
+	return self.contexts.Blame
+}
+
+// selects the line's commit in the commits panel
+func (self *BlameController) goToCommit(blameLine *models.BlameLine) error {
+	if blameLine.IsUncommitted() {
+		return self.c.ErrorMsg(self.c.Tr.BlameLineNotCommitted)
+	}
+
+	commitsContext := self.contexts.LocalCommits
+	selectCommit := func() error {
+		_, index, found := self.findCommit(blameLine.Sha)
+		if !found {
+			return self.c.ErrorMsg(self.c.Tr.BlameCommitNotFound)
+		}
+
+		commitsContext.SetSelectedLineIdx(index)
+		return self.c.PushContext(commitsContext)
+	}
+
+	if _, _, found := self.findCommit(blameLine.Sha); found {
+		return selectCommit()
+	}
+
+	// the commit may be further down than we've loaded so far
+	if commitsContext.GetLimitCommits() {
+		commitsContext.SetLimitCommits(false)
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}}); err != nil {
+			return err
+		}
+	}
+
+	return self.c.AfterCommitsLoaded(selectCommit)
+}
+
+func (self *BlameController) findCommit(sha string) (*models.Commit, int, bool) {
+	return lo.FindIndexOf(self.model.Commits, func(commit *models.Commit) bool {
+		return commit.Sha == sha
+	})
+}
+
+// blames the file as it was before the line's commit, so that we can see past
+// e.g. a commit which only reformatted the line
+func (self *BlameController) reblameFromParent(blameLine *models.BlameLine) error {
+	if blameLine.IsUncommitted() {
+		return self.c.ErrorMsg(self.c.Tr.BlameLineNotCommitted)
+	}
+
+	if blameLine.PreviousSha == "" {
+		return self.c.ErrorMsg(self.c.Tr.NoEarlierBlame)
+	}
+
+	return self.helpers.Blame.ShowBlame(blameLine.PreviousSha, blameLine.PreviousPath, blameLine.OriginalLineNumber)
+}
+
+func (self *BlameController) escape() error {
+	return self.c.PopContext()
+}
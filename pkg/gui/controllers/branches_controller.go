@@ -76,6 +76,12 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Handler:     opts.Guards.OutsideFilterMode(self.rebase),
 			Description: self.c.Tr.LcRebaseBranch,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.RebaseOntoRef),
+			Handler:     opts.Guards.OutsideFilterMode(self.helpers.MergeAndRebase.CreateRebaseOntoRefMenu),
+			Description: self.c.Tr.LcRebaseOntoRef,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.MergeIntoCurrentBranch),
 			Handler:     opts.Guards.OutsideFilterMode(self.merge),
@@ -108,6 +114,17 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.LcSetUnsetUpstream,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.SetBaseBranch),
+			Handler:     self.checkSelectedAndReal(self.setBaseBranch),
+			Description: self.c.Tr.LcSetBaseBranch,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateWorktree),
+			Handler:     self.checkSelected(self.createWorktree),
+			Description: self.c.Tr.LcCreateWorktree,
+			OpensMenu:   true,
+		},
 	}
 }
 
@@ -164,6 +181,32 @@ func (self *BranchesController) setUpstream(selectedBranch *models.Branch) error
 	})
 }
 
+func (self *BranchesController) setBaseBranch(selectedBranch *models.Branch) error {
+	overrides, err := self.git.Branch.GetBaseBranchOverrides()
+	if err != nil {
+		return self.c.Error(err)
+	}
+	currentOverride := overrides[selectedBranch.Name]
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               fmt.Sprintf(self.c.Tr.SetBaseBranchPrompt, selectedBranch.Name),
+		InitialContent:      currentOverride,
+		FindSuggestionsFunc: self.helpers.Suggestions.GetBranchNameSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			baseBranch := strings.TrimSpace(response)
+			if baseBranch == currentOverride {
+				return nil
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.SetBaseBranch)
+			if err := self.git.Branch.SetBaseBranch(selectedBranch.Name, baseBranch); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+		},
+	})
+}
+
 func (self *BranchesController) Context() types.Context {
 	return self.context()
 }
@@ -182,7 +225,12 @@ func (self *BranchesController) press(selectedBranch *models.Branch) error {
 }
 
 func (self *BranchesController) handleCreatePullRequest(selectedBranch *models.Branch) error {
-	return self.createPullRequest(selectedBranch.Name, "")
+	// no point creating another pull request when there's one open already
+	if pullRequest := self.model.PullRequests[selectedBranch.Name]; pullRequest != nil && pullRequest.State == models.PullRequestOpen {
+		return self.openPullRequest(pullRequest)
+	}
+
+	return self.promptForPullRequestTarget(selectedBranch.Name)
 }
 
 func (self *BranchesController) handleCreatePullRequestMenu(selectedBranch *models.Branch) error {
@@ -204,6 +252,9 @@ func (self *BranchesController) copyPullRequestURL() error {
 	if err != nil {
 		return self.c.Error(err)
 	}
+	if pullRequest := self.model.PullRequests[branch.Name]; pullRequest != nil {
+		url = pullRequest.URL
+	}
 	self.c.LogAction(self.c.Tr.Actions.CopyPullRequestURL)
 	if err := self.os.CopyToClipboard(url); err != nil {
 		return self.c.Error(err)
@@ -372,51 +423,93 @@ func (self *BranchesController) createTag(branch *models.Branch) error {
 	return self.helpers.Tags.CreateTagMenu(branch.FullRefName(), func() {})
 }
 
+func (self *BranchesController) createWorktree(branch *models.Branch) error {
+	return self.helpers.Worktree.CreateWorktreeMenu(branch.Name)
+}
+
 func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) error {
 	return self.helpers.Refs.CreateGitResetMenu(selectedBranch.Name)
 }
 
 func (self *BranchesController) rename(branch *models.Branch) error {
-	promptForNewName := func() error {
-		return self.c.Prompt(types.PromptOpts{
-			Title:          self.c.Tr.NewBranchNamePrompt + " " + branch.Name + ":",
-			InitialContent: branch.Name,
-			HandleConfirm: func(newBranchName string) error {
-				self.c.LogAction(self.c.Tr.Actions.RenameBranch)
-				if err := self.git.Branch.Rename(branch.Name, newBranchName); err != nil {
-					return self.c.Error(err)
-				}
-
-				// need to find where the branch is now so that we can re-select it. That means we need to refetch the branches synchronously and then find our branch
-				_ = self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.NewBranchNamePrompt + " " + branch.Name + ":",
+		InitialContent: branch.Name,
+		HandleConfirm: func(newBranchName string) error {
+			self.c.LogAction(self.c.Tr.Actions.RenameBranch)
+			if err := self.git.Branch.Rename(branch.Name, newBranchName); err != nil {
+				return self.c.Error(err)
+			}
 
-				// now that we've got our stuff again we need to find that branch and reselect it.
-				for i, newBranch := range self.model.Branches {
-					if newBranch.Name == newBranchName {
-						self.context().SetSelectedLineIdx(i)
-						if err := self.context().HandleRender(); err != nil {
-							return err
-						}
-					}
-				}
+			if err := self.refreshAndReselectBranch(newBranchName); err != nil {
+				return err
+			}
 
+			if !branch.IsTrackingRemote() || branch.UpstreamGone {
 				return nil
-			},
+			}
+
+			return self.c.Confirm(types.ConfirmOpts{
+				Title: self.c.Tr.RenameBranchOnRemoteTitle,
+				Prompt: fmt.Sprintf(
+					self.c.Tr.RenameBranchOnRemotePrompt,
+					branch.UpstreamRemote+"/"+branch.UpstreamBranch,
+					branch.UpstreamRemote+"/"+newBranchName,
+				),
+				HandleConfirm: func() error {
+					return self.renameOnRemote(branch.UpstreamRemote, branch.UpstreamBranch, newBranchName)
+				},
+			})
+		},
+	})
+}
+
+// renameOnRemote pushes the (already renamed) local branch under its new name,
+// tracks that, and deletes the old remote branch. The local rename stays
+// regardless of whether this works.
+func (self *BranchesController) renameOnRemote(remoteName string, oldRemoteBranchName string, newBranchName string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.PushWait, func() error {
+		self.c.LogAction(self.c.Tr.Actions.RenameBranchOnRemote)
+
+		err := self.git.Sync.Push(git_commands.PushOpts{
+			UpstreamRemote: remoteName,
+			UpstreamBranch: newBranchName,
 		})
-	}
+		if err == nil {
+			err = self.git.Branch.SetUpstream(remoteName, newBranchName, newBranchName)
+		}
+		if err == nil {
+			err = self.git.Remote.DeleteRemoteBranch(remoteName, oldRemoteBranchName)
+		}
 
-	// I could do an explicit check here for whether the branch is tracking a remote branch
-	// but if we've selected it we'll already know that via Pullables and Pullables.
-	// Bit of a hack but I'm lazy.
-	if !branch.IsTrackingRemote() {
-		return promptForNewName()
+		// the branch keeps its place in the list, so we only need to pick up its
+		// new upstream and the remote branches
+		refreshErr := self.c.Refresh(types.RefreshOptions{
+			Mode:  types.SYNC,
+			Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES},
+		})
+		if err != nil {
+			return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.RenameBranchOnRemoteFailed, err.Error()))
+		}
+		return refreshErr
+	})
+}
+
+// refreshAndReselectBranch refetches the branches synchronously so that we can
+// find where the given branch is now, and selects it
+func (self *BranchesController) refreshAndReselectBranch(branchName string) error {
+	_ = self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+
+	for i, branch := range self.model.Branches {
+		if branch.Name == branchName {
+			self.context().SetSelectedLineIdx(i)
+			if err := self.context().HandleRender(); err != nil {
+				return err
+			}
+		}
 	}
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:         self.c.Tr.LcRenameBranch,
-		Prompt:        self.c.Tr.RenameBranchWarning,
-		HandleConfirm: promptForNewName,
-	})
+	return nil
 }
 
 func (self *BranchesController) newBranch(selectedBranch *models.Branch) error {
@@ -441,13 +534,7 @@ func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Bra
 			{
 				LabelColumns: fromToLabelColumns(branch.Name, self.c.Tr.LcSelectBranch),
 				OnPress: func() error {
-					return self.c.Prompt(types.PromptOpts{
-						Title:               branch.Name + " →",
-						FindSuggestionsFunc: self.helpers.Suggestions.GetBranchNameSuggestionsFunc(),
-						HandleConfirm: func(targetBranchName string) error {
-							return self.createPullRequest(branch.Name, targetBranchName)
-						},
-					})
+					return self.promptForPullRequestTarget(branch.Name)
 				},
 			},
 		}
@@ -467,9 +554,52 @@ func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Bra
 
 	menuItems = append(menuItems, menuItemsForBranch(selectedBranch)...)
 
+	if pullRequest := self.model.PullRequests[selectedBranch.Name]; pullRequest != nil {
+		menuItems = append([]*types.MenuItem{
+			{
+				Label: fmt.Sprintf(self.c.Tr.LcOpenPullRequestInBrowser, pullRequest.Number),
+				OnPress: func() error {
+					return self.openPullRequest(pullRequest)
+				},
+			},
+		}, menuItems...)
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{Title: fmt.Sprintf(self.c.Tr.CreatePullRequestOptions), Items: menuItems})
 }
 
+// promptForPullRequestTarget lets the user pick the branch to open a pull
+// request into, starting them off with the repo's main branch
+func (self *BranchesController) promptForPullRequestTarget(from string) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               from + " →",
+		InitialContent:      self.defaultPullRequestTarget(),
+		FindSuggestionsFunc: self.helpers.Suggestions.GetBranchNameSuggestionsFunc(),
+		HandleConfirm: func(targetBranchName string) error {
+			return self.createPullRequest(from, strings.TrimSpace(targetBranchName))
+		},
+	})
+}
+
+// defaultPullRequestTarget is the branch that origin's HEAD points to, falling
+// back to the first of the branches named in the git.mainBranches config that
+// exists locally
+func (self *BranchesController) defaultPullRequestTarget() string {
+	if branchName, err := self.git.Branch.GetRemoteHeadBranch("origin"); err == nil {
+		return branchName
+	}
+
+	for _, mainBranch := range self.c.UserConfig.Git.MainBranches {
+		for _, branch := range self.model.Branches {
+			if branch.Name == mainBranch {
+				return mainBranch
+			}
+		}
+	}
+
+	return ""
+}
+
 func (self *BranchesController) createPullRequest(from string, to string) error {
 	url, err := self.helpers.Host.GetPullRequestURL(from, to)
 	if err != nil {
@@ -485,6 +615,16 @@ func (self *BranchesController) createPullRequest(from string, to string) error
 	return nil
 }
 
+func (self *BranchesController) openPullRequest(pullRequest *models.PullRequest) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenPullRequest)
+
+	if err := self.os.OpenLink(pullRequest.URL); err != nil {
+		return self.c.Error(err)
+	}
+
+	return nil
+}
+
 func (self *BranchesController) checkSelected(callback func(*models.Branch) error) func() error {
 	return func() error {
 		selectedItem := self.context().GetSelected()
@@ -45,6 +45,12 @@ func (self *CommitMessageController) GetKeybindings(opts types.KeybindingsOpts)
 			Key:     opts.GetKey(opts.Config.Universal.ReturnAlt1),
 			Handler: self.close,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitMessage.TrailersMenu),
+			Handler:     self.openTrailersMenu,
+			Description: self.c.Tr.LcAddCommitTrailer,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -60,10 +66,22 @@ func (self *CommitMessageController) context() types.Context {
 	return self.contexts.CommitMessage
 }
 
+func (self *CommitMessageController) openTrailersMenu() error {
+	return self.helpers.CommitTrailers.CreateTrailersMenu()
+}
+
 func (self *CommitMessageController) confirm() error {
 	message := self.getCommitMessage()
+
+	return self.helpers.CommitLint.WithSubjectRegexConfirmation(message, func() error {
+		return self.commit(message)
+	})
+}
+
+func (self *CommitMessageController) commit(message string) error {
 	self.onCommitAttempt(message)
 
+	message = self.helpers.WorkingTree.StripCommitTemplateComments(message)
 	if message == "" {
 		return self.c.ErrorMsg(self.c.Tr.CommitWithoutMessageErr)
 	}
@@ -2,11 +2,13 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type CommitFilesController struct {
@@ -32,6 +34,11 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Handler:     self.checkSelected(self.checkout),
 			Description: self.c.Tr.LcCheckoutCommitFile,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitFiles.CopyFileURL),
+			Handler:     self.checkSelected(self.copyFileURL),
+			Description: self.c.Tr.LcCopyFileURL,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Remove),
 			Handler:     self.checkSelected(self.discard),
@@ -42,6 +49,11 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Handler:     self.checkSelected(self.open),
 			Description: self.c.Tr.LcOpenFile,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenDiffTool),
+			Handler:     self.checkSelected(self.openDiffTool),
+			Description: self.c.Tr.LcOpenDiffTool,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Edit),
 			Handler:     self.checkSelected(self.edit),
@@ -119,18 +131,52 @@ func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error
 	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 }
 
+func (self *CommitFilesController) copyFileURL(node *filetree.CommitFileNode) error {
+	url, err := self.helpers.Host.GetFileURL(self.context().GetRef().RefName(), node.GetPath(), 0, 0)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CopyFileURLToClipboard)
+	if err := self.os.CopyToClipboard(url); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(self.c.Tr.FileURLCopiedToClipboard)
+	return nil
+}
+
 func (self *CommitFilesController) discard(node *filetree.CommitFileNode) error {
 	if ok, err := self.helpers.PatchBuilding.ValidateNormalWorkingTreeState(); !ok {
 		return err
 	}
 
+	if !self.context().GetCanRebase() {
+		return self.c.ErrorMsg(self.c.Tr.CanOnlyDiscardFromLocalCommits)
+	}
+
+	sha := self.context().GetRef().RefName()
+	_, commitIndex, ok := lo.FindIndexOf(self.model.Commits, func(commit *models.Commit) bool {
+		return commit.Sha == sha
+	})
+	if !ok {
+		return self.c.ErrorMsg(self.c.Tr.CanOnlyDiscardFromLocalCommits)
+	}
+
+	prompt := self.c.Tr.DiscardFileChangesPrompt
+	if !node.IsFile() {
+		prompt = self.c.Tr.DiscardDirectoryChangesPrompt
+	}
+
+	fileNames := node.GetFilePathsMatching(func(*models.CommitFile) bool { return true })
+
 	return self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.DiscardFileChangesTitle,
-		Prompt: self.c.Tr.DiscardFileChangesPrompt,
+		Prompt: prompt,
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func() error {
 				self.c.LogAction(self.c.Tr.Actions.DiscardOldFileChange)
-				if err := self.git.Rebase.DiscardOldFileChanges(self.model.Commits, self.contexts.LocalCommits.GetSelectedLineIdx(), node.GetPath()); err != nil {
+				if err := self.git.Rebase.DiscardOldFileChanges(self.model.Commits, commitIndex, fileNames); err != nil {
 					if err := self.helpers.MergeAndRebase.CheckMergeOrRebase(err); err != nil {
 						return err
 					}
@@ -146,6 +192,22 @@ func (self *CommitFilesController) open(node *filetree.CommitFileNode) error {
 	return self.helpers.Files.OpenFile(node.GetPath())
 }
 
+func (self *CommitFilesController) openDiffTool(node *filetree.CommitFileNode) error {
+	ref := self.context().GetRef()
+	to := ref.RefName()
+	from, reverse := self.modes.Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
+	if reverse {
+		from, to = to, from
+	}
+
+	return self.helpers.Files.OpenDiffTool(git_commands.DifftoolCmdOptions{
+		From:        from,
+		To:          to,
+		Path:        node.GetPath(),
+		IsDirectory: node.File == nil,
+	})
+}
+
 func (self *CommitFilesController) edit(node *filetree.CommitFileNode) error {
 	if node.File == nil {
 		return self.c.ErrorMsg(self.c.Tr.ErrCannotEditDirectory)
@@ -157,10 +219,8 @@ func (self *CommitFilesController) edit(node *filetree.CommitFileNode) error {
 func (self *CommitFilesController) toggleForPatch(node *filetree.CommitFileNode) error {
 	toggle := func() error {
 		return self.c.WithWaitingStatus(self.c.Tr.LcUpdatingPatch, func() error {
-			if !self.git.Patch.PatchManager.Active() {
-				if err := self.startPatchManager(); err != nil {
-					return err
-				}
+			if err := self.startPatchManager(); err != nil {
+				return err
 			}
 
 			// if there is any file that hasn't been fully added we'll fully add everything,
@@ -188,17 +248,6 @@ func (self *CommitFilesController) toggleForPatch(node *filetree.CommitFileNode)
 		})
 	}
 
-	if self.git.Patch.PatchManager.Active() && self.git.Patch.PatchManager.To != self.context().GetRef().RefName() {
-		return self.c.Confirm(types.ConfirmOpts{
-			Title:  self.c.Tr.DiscardPatch,
-			Prompt: self.c.Tr.DiscardPatchConfirm,
-			HandleConfirm: func() error {
-				self.git.Patch.PatchManager.Reset()
-				return toggle()
-			},
-		})
-	}
-
 	return toggle()
 }
 
@@ -215,7 +264,13 @@ func (self *CommitFilesController) startPatchManager() error {
 	to := ref.RefName()
 	from, reverse := self.modes.Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
 
-	self.git.Patch.PatchManager.Start(from, to, reverse, canRebase)
+	// if we're already building a patch from another commit, we add to it
+	// rather than starting again
+	if self.git.Patch.PatchManager.Active() {
+		self.git.Patch.PatchManager.AddCommit(from, to, reverse, canRebase)
+	} else {
+		self.git.Patch.PatchManager.Start(from, to, reverse, canRebase)
+	}
 	return nil
 }
 
@@ -228,28 +283,11 @@ func (self *CommitFilesController) enterCommitFile(node *filetree.CommitFileNode
 		return self.handleToggleCommitFileDirCollapsed(node)
 	}
 
-	enterTheFile := func() error {
-		if !self.git.Patch.PatchManager.Active() {
-			if err := self.startPatchManager(); err != nil {
-				return err
-			}
-		}
-
-		return self.c.PushContext(self.contexts.CustomPatchBuilder, opts)
-	}
-
-	if self.git.Patch.PatchManager.Active() && self.git.Patch.PatchManager.To != self.context().GetRef().RefName() {
-		return self.c.Confirm(types.ConfirmOpts{
-			Title:  self.c.Tr.DiscardPatch,
-			Prompt: self.c.Tr.DiscardPatchConfirm,
-			HandleConfirm: func() error {
-				self.git.Patch.PatchManager.Reset()
-				return enterTheFile()
-			},
-		})
+	if err := self.startPatchManager(); err != nil {
+		return err
 	}
 
-	return enterTheFile()
+	return self.c.PushContext(self.contexts.CustomPatchBuilder, opts)
 }
 
 func (self *CommitFilesController) handleToggleCommitFileDirCollapsed(node *filetree.CommitFileNode) error {
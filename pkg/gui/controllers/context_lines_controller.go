@@ -75,7 +75,7 @@ func (self *ContextLinesController) Increase() error {
 func (self *ContextLinesController) Decrease() error {
 	old_size := self.c.UserConfig.Git.DiffContextSize
 
-	if self.isShowingDiff() && old_size > 1 {
+	if self.isShowingDiff() && old_size > 0 {
 		if err := self.checkCanChangeContext(); err != nil {
 			return self.c.Error(err)
 		}
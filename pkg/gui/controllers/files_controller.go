@@ -1,11 +1,14 @@
 package controllers
 
 import (
+	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -68,6 +71,17 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.helpers.WorkingTree.HandleCommitEditorPress,
 			Description: self.c.Tr.CommitChangesWithEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.AbsorbStagedChanges),
+			Handler:     self.helpers.Absorb.AbsorbStagedChanges,
+			Description: self.c.Tr.LcAbsorbStagedChanges,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.CreateFixupCommitsByFile),
+			Handler:     self.helpers.Absorb.CreateFixupCommitsByFile,
+			Description: self.c.Tr.LcCreateFixupCommitsByFile,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Edit),
 			Handler:     self.checkSelectedFileNode(self.edit),
@@ -78,12 +92,23 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.Open,
 			Description: self.c.Tr.LcOpenFile,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenDiffTool),
+			Handler:     self.checkSelectedFileNode(self.openDiffTool),
+			Description: self.c.Tr.LcOpenDiffTool,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.IgnoreFile),
 			Handler:     self.checkSelectedFileNode(self.ignoreOrExcludeMenu),
 			Description: self.c.Tr.Actions.LcIgnoreExcludeFile,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewSkipWorktreeOptions),
+			Handler:     self.checkSelectedFileNode(self.skipWorktreeMenu),
+			Description: self.c.Tr.LcViewSkipWorktreeOptions,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.RefreshFiles),
 			Handler:     self.refresh,
@@ -127,14 +152,34 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.toggleTreeView,
 			Description: self.c.Tr.LcToggleTreeView,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.CollapseAll),
+			Handler:     self.collapseAll,
+			Description: self.c.Tr.LcCollapseAllFiles,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ExpandAll),
+			Handler:     self.expandAll,
+			Description: self.c.Tr.LcExpandAllFiles,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ExpandToDefaultDepth),
+			Handler:     self.expandToDefaultDepth,
+			Description: self.c.Tr.LcExpandFilesToDefaultDepth,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.OpenMergeTool),
 			Handler:     self.helpers.WorkingTree.OpenMergeTool,
 			Description: self.c.Tr.LcOpenMergeTool,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.OpenMergeToolForFile),
+			Handler:     self.checkSelectedFileNode(self.openMergeToolForFile),
+			Description: self.c.Tr.LcOpenMergeToolForFile,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.Fetch),
-			Handler:     self.fetch,
+			Handler:     self.helpers.Fetch.Fetch,
 			Description: self.c.Tr.LcFetch,
 		},
 	}
@@ -383,11 +428,22 @@ func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 	}
 
 	if file.HasInlineMergeConflicts {
-		return self.switchToMerge()
+		switched, err := self.helpers.MergeConflicts.SwitchToMerge(file.Name)
+		if switched || err != nil {
+			return err
+		}
 	}
-	if file.HasMergeConflicts {
+	// a file whose conflict markers have been removed but which hasn't been
+	// staged yet is shown as a combined diff, which we can display but not
+	// stage lines of
+	if file.HasMergeConflicts && !patch.IsCombinedDiff(self.git.WorkingTree.WorktreeFileDiff(file, true, false, false)) {
 		return self.c.ErrorMsg(self.c.Tr.FileStagingRequirements)
 	}
+	// untracked files are shown as a diff against /dev/null so that we can
+	// stage some of their lines, but there are no lines to stage in a binary file
+	if !file.Tracked && patch.IsBinaryDiff(self.git.WorkingTree.WorktreeFileDiff(file, true, false, false)) {
+		return self.c.ErrorMsg(self.c.Tr.CannotStageLinesOfBinaryFile)
+	}
 
 	return self.c.PushContext(self.contexts.Staging, opts)
 }
@@ -453,7 +509,7 @@ func (self *FilesController) unstageFiles(node *filetree.FileNode) error {
 	})
 }
 
-func (self *FilesController) ignoreOrExcludeTracked(node *filetree.FileNode, trAction string, f func(string) error) error {
+func (self *FilesController) ignoreOrExcludeTracked(node *filetree.FileNode, trAction string, f func(string) error, pattern string) error {
 	self.c.LogAction(trAction)
 	// not 100% sure if this is necessary but I'll assume it is
 	if err := self.unstageFiles(node); err != nil {
@@ -464,41 +520,83 @@ func (self *FilesController) ignoreOrExcludeTracked(node *filetree.FileNode, trA
 		return err
 	}
 
-	if err := f(node.GetPath()); err != nil {
-		return err
-	}
-
-	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+	return self.writeIgnorePattern(f, pattern)
 }
 
-func (self *FilesController) ignoreOrExcludeUntracked(node *filetree.FileNode, trAction string, f func(string) error) error {
+func (self *FilesController) ignoreOrExcludeUntracked(trAction string, f func(string) error, pattern string) error {
 	self.c.LogAction(trAction)
 
-	if err := f(node.GetPath()); err != nil {
+	return self.writeIgnorePattern(f, pattern)
+}
+
+// writeIgnorePattern writes the pattern and lets the user know how many
+// untracked files it has caught, given that a pattern can match more than the
+// file it was created from.
+func (self *FilesController) writeIgnorePattern(f func(string) error, pattern string) error {
+	untrackedBefore, err := self.git.WorkingTree.UntrackedFiles()
+	if err != nil {
+		return err
+	}
+
+	if err := f(pattern); err != nil {
+		return err
+	}
+
+	untrackedAfter, err := self.git.WorkingTree.UntrackedFiles()
+	if err != nil {
 		return err
 	}
 
+	// adding a pattern can only ever shrink the set of untracked files
+	self.c.Toast(fmt.Sprintf(self.c.Tr.IgnorePatternMatchedFiles, len(untrackedBefore)-len(untrackedAfter), pattern))
+
 	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
 }
 
-func (self *FilesController) ignoreOrExcludeFile(node *filetree.FileNode, trText string, trPrompt string, trAction string, f func(string) error) error {
-	if node.GetIsTracked() {
-		return self.c.Confirm(types.ConfirmOpts{
-			Title:  trText,
-			Prompt: trPrompt,
-			HandleConfirm: func() error {
-				return self.ignoreOrExcludeTracked(node, trAction, f)
-			},
-		})
+// ignoreOrExcludeFile prompts for the pattern to write, prefilled with the
+// node's path relative to dir, which is the directory containing the ignore file.
+func (self *FilesController) ignoreOrExcludeFile(node *filetree.FileNode, dir string, trText string, trPrompt string, trAction string, f func(string) error) error {
+	// untracked directories are reported with a trailing slash
+	path := strings.TrimSuffix(node.GetPath(), "/")
+	isDir := !node.GetIsFile() || path != node.GetPath()
+	if dir != "" {
+		path = strings.TrimPrefix(path, dir+"/")
 	}
-	return self.ignoreOrExcludeUntracked(node, trAction, f)
+
+	initialContent := path
+	if isDir {
+		initialContent += "/"
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.IgnorePatternTitle,
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: self.helpers.Suggestions.GetIgnorePatternSuggestionsFunc(path, isDir),
+		HandleConfirm: func(pattern string) error {
+			pattern = strings.TrimSpace(pattern)
+			if pattern == "" {
+				return self.c.ErrorMsg(self.c.Tr.IgnorePatternEmptyErr)
+			}
+
+			if node.GetIsTracked() {
+				return self.c.Confirm(types.ConfirmOpts{
+					Title:  trText,
+					Prompt: trPrompt,
+					HandleConfirm: func() error {
+						return self.ignoreOrExcludeTracked(node, trAction, f, pattern)
+					},
+				})
+			}
+			return self.ignoreOrExcludeUntracked(trAction, f, pattern)
+		},
+	})
 }
 
 func (self *FilesController) ignore(node *filetree.FileNode) error {
 	if node.GetPath() == ".gitignore" {
 		return self.c.ErrorMsg(self.c.Tr.Actions.IgnoreFileErr)
 	}
-	err := self.ignoreOrExcludeFile(node, self.c.Tr.IgnoreTracked, self.c.Tr.IgnoreTrackedPrompt, self.c.Tr.Actions.LcIgnoreExcludeFile, self.git.WorkingTree.Ignore)
+	err := self.ignoreOrExcludeFile(node, "", self.c.Tr.IgnoreTracked, self.c.Tr.IgnoreTrackedPrompt, self.c.Tr.Actions.LcIgnoreExcludeFile, self.git.WorkingTree.Ignore)
 	if err != nil {
 		return err
 	}
@@ -506,6 +604,29 @@ func (self *FilesController) ignore(node *filetree.FileNode) error {
 	return nil
 }
 
+// nearestGitIgnoreDir returns the closest directory above the given path that
+// has its own .gitignore, falling back to the path's own directory. The repo
+// root is never returned, given that it has its own menu item.
+func (self *FilesController) nearestGitIgnoreDir(path string) string {
+	dir := filepath.Dir(strings.TrimSuffix(path, "/"))
+	for current := dir; current != "."; current = filepath.Dir(current) {
+		if exists, _ := self.os.FileExists(filepath.Join(current, ".gitignore")); exists {
+			return current
+		}
+	}
+
+	return dir
+}
+
+func (self *FilesController) ignoreInDirectory(node *filetree.FileNode, dir string) error {
+	if node.GetPath() == filepath.Join(dir, ".gitignore") {
+		return self.c.ErrorMsg(self.c.Tr.Actions.IgnoreFileErr)
+	}
+	return self.ignoreOrExcludeFile(node, dir, self.c.Tr.IgnoreTracked, self.c.Tr.IgnoreTrackedPrompt, self.c.Tr.Actions.LcIgnoreExcludeFile, func(pattern string) error {
+		return self.git.WorkingTree.IgnoreInDirectory(dir, pattern)
+	})
+}
+
 func (self *FilesController) exclude(node *filetree.FileNode) error {
 	if node.GetPath() == ".git/info/exclude" {
 		return self.c.ErrorMsg(self.c.Tr.Actions.ExcludeFileErr)
@@ -515,7 +636,7 @@ func (self *FilesController) exclude(node *filetree.FileNode) error {
 		return self.c.ErrorMsg(self.c.Tr.Actions.ExcludeGitIgnoreErr)
 	}
 
-	err := self.ignoreOrExcludeFile(node, self.c.Tr.ExcludeTracked, self.c.Tr.ExcludeTrackedPrompt, self.c.Tr.Actions.ExcludeFile, self.git.WorkingTree.Exclude)
+	err := self.ignoreOrExcludeFile(node, "", self.c.Tr.ExcludeTracked, self.c.Tr.ExcludeTrackedPrompt, self.c.Tr.Actions.ExcludeFile, self.git.WorkingTree.Exclude)
 	if err != nil {
 		return err
 	}
@@ -523,28 +644,155 @@ func (self *FilesController) exclude(node *filetree.FileNode) error {
 }
 
 func (self *FilesController) ignoreOrExcludeMenu(node *filetree.FileNode) error {
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.LcIgnoreFile},
+			OnPress: func() error {
+				if err := self.ignore(node); err != nil {
+					return self.c.Error(err)
+				}
+				return nil
+			},
+			Key: 'i',
+		},
+	}
+
+	if nearestDir := self.nearestGitIgnoreDir(node.GetPath()); nearestDir != "." {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{fmt.Sprintf(self.c.Tr.LcIgnoreFileInDirectory, filepath.Join(nearestDir, ".gitignore"))},
+			OnPress: func() error {
+				if err := self.ignoreInDirectory(node, nearestDir); err != nil {
+					return self.c.Error(err)
+				}
+				return nil
+			},
+			Key: 'n',
+		})
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Actions.LcIgnoreExcludeFile,
-		Items: []*types.MenuItem{
+		Items: append(menuItems, []*types.MenuItem{
 			{
-				LabelColumns: []string{self.c.Tr.LcIgnoreFile},
+				LabelColumns: []string{self.c.Tr.LcExcludeFile},
 				OnPress: func() error {
-					if err := self.ignore(node); err != nil {
+					if err := self.exclude(node); err != nil {
 						return self.c.Error(err)
 					}
 					return nil
 				},
-				Key: 'i',
+				Key: 'e',
 			},
 			{
-				LabelColumns: []string{self.c.Tr.LcExcludeFile},
+				LabelColumns: []string{self.c.Tr.LcUntrackFile},
 				OnPress: func() error {
-					if err := self.exclude(node); err != nil {
+					if err := self.untrack(node); err != nil {
 						return self.c.Error(err)
 					}
 					return nil
 				},
-				Key: 'e',
+				Key: 'u',
+			},
+		}...),
+	})
+}
+
+// untrack removes the node from the index while leaving it on disk, then offers
+// to add it to .gitignore so that it doesn't show up as untracked.
+func (self *FilesController) untrack(node *filetree.FileNode) error {
+	if !node.GetIsTracked() {
+		return self.c.ErrorMsg(self.c.Tr.UntrackFileNotTrackedErr)
+	}
+
+	// `git rm --cached` would throw away staged content that exists nowhere else
+	if node.SomeFile(func(file *models.File) bool { return file.HasStagedChanges && file.HasUnstagedChanges }) {
+		return self.c.ErrorMsg(self.c.Tr.UntrackFileStagedChangesErr)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.UntrackFile)
+	if err := self.git.WorkingTree.RemoveTrackedFiles(node.GetPath()); err != nil {
+		return err
+	}
+
+	if err := self.refresh(); err != nil {
+		return err
+	}
+
+	if node.GetPath() == ".gitignore" {
+		return nil
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.UntrackFileIgnoreTitle,
+		Prompt: fmt.Sprintf(self.c.Tr.UntrackFileIgnorePrompt, node.GetPath()),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.LcIgnoreExcludeFile)
+			if err := self.git.WorkingTree.Ignore(node.GetPath()); err != nil {
+				return err
+			}
+
+			return self.refresh()
+		},
+	})
+}
+
+func (self *FilesController) skipWorktreeMenu(node *filetree.FileNode) error {
+	if !node.GetIsTracked() {
+		return self.c.ErrorMsg(self.c.Tr.NoTrackedFilesToFlag)
+	}
+
+	// directories apply to every tracked file within them, not just the ones
+	// currently showing changes
+	getPaths := func() ([]string, error) {
+		if node.File != nil {
+			return []string{node.GetPath()}, nil
+		}
+
+		return self.git.WorkingTree.TrackedFilesInPath(node.GetPath())
+	}
+
+	setFlag := func(action string, f func([]string, bool) error, value bool) error {
+		paths, err := getPaths()
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		self.c.LogAction(action)
+		if err := f(paths, value); err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.refresh()
+	}
+
+	skipWorktree := !node.EveryFile(func(file *models.File) bool { return file.SkipWorktree })
+	skipWorktreeLabel := self.c.Tr.LcMarkSkipWorktree
+	if !skipWorktree {
+		skipWorktreeLabel = self.c.Tr.LcUnmarkSkipWorktree
+	}
+
+	assumeUnchanged := !node.EveryFile(func(file *models.File) bool { return file.AssumeUnchanged })
+	assumeUnchangedLabel := self.c.Tr.LcMarkAssumeUnchanged
+	if !assumeUnchanged {
+		assumeUnchangedLabel = self.c.Tr.LcUnmarkAssumeUnchanged
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LcViewSkipWorktreeOptions,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{skipWorktreeLabel},
+				OnPress: func() error {
+					return setFlag(self.c.Tr.Actions.ToggleSkipWorktree, self.git.WorkingTree.SetSkipWorktree, skipWorktree)
+				},
+				Key: 's',
+			},
+			{
+				LabelColumns: []string{assumeUnchangedLabel},
+				OnPress: func() error {
+					return setFlag(self.c.Tr.Actions.ToggleAssumeUnchanged, self.git.WorkingTree.SetAssumeUnchanged, assumeUnchanged)
+				},
+				Key: 'a',
 			},
 		},
 	})
@@ -626,13 +874,58 @@ func (self *FilesController) Open() error {
 	return self.helpers.Files.OpenFile(node.GetPath())
 }
 
+func (self *FilesController) openDiffTool(node *filetree.FileNode) error {
+	if node.File != nil && !node.File.GetIsTracked() {
+		return self.c.ErrorMsg(self.c.Tr.ErrCannotDiffUntrackedFile)
+	}
+
+	openDiffTool := func(staged bool) error {
+		return self.helpers.Files.OpenDiffTool(git_commands.DifftoolCmdOptions{
+			Staged:      staged,
+			Path:        node.GetPath(),
+			IsDirectory: node.File == nil,
+		})
+	}
+
+	hasStagedChanges := node.GetHasStagedChanges()
+	hasUnstagedChanges := node.GetHasUnstagedChanges()
+	if !hasStagedChanges || !hasUnstagedChanges {
+		return openDiffTool(hasStagedChanges)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LcOpenDiffTool,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.UnstagedChanges},
+				OnPress:      func() error { return openDiffTool(false) },
+				Key:          'u',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.StagedChanges},
+				OnPress:      func() error { return openDiffTool(true) },
+				Key:          's',
+			},
+		},
+	})
+}
+
+func (self *FilesController) openMergeToolForFile(node *filetree.FileNode) error {
+	if node.File == nil || !node.File.HasMergeConflicts {
+		return self.c.ErrorMsg(self.c.Tr.FileHasNoMergeConflicts)
+	}
+
+	return self.helpers.WorkingTree.OpenMergeToolForFile(node.GetPath())
+}
+
 func (self *FilesController) switchToMerge() error {
 	file := self.getSelectedFile()
 	if file == nil {
 		return nil
 	}
 
-	return self.helpers.MergeConflicts.SwitchToMerge(file.Name)
+	_, err := self.helpers.MergeConflicts.SwitchToMerge(file.Name)
+	return err
 }
 
 func (self *FilesController) createStashMenu() error {
@@ -692,6 +985,19 @@ func (self *FilesController) createStashMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				Label: self.c.Tr.LcStashSelectedPath,
+				OnPress: func() error {
+					paths := self.context().GetSelectedPaths()
+					if len(paths) == 0 {
+						return self.c.ErrorMsg(self.c.Tr.NoFilesToStash)
+					}
+					return self.handleStashSave(func(message string) error {
+						return self.git.Stash.StashPaths(message, paths)
+					}, self.c.Tr.Actions.StashSelectedPath)
+				},
+				Key: 'f',
+			},
 		},
 	})
 }
@@ -725,6 +1031,24 @@ func (self *FilesController) toggleTreeView() error {
 	return self.c.PostRefreshUpdate(self.context())
 }
 
+func (self *FilesController) collapseAll() error {
+	self.context().FileTreeViewModel.CollapseAll()
+
+	return self.c.PostRefreshUpdate(self.context())
+}
+
+func (self *FilesController) expandAll() error {
+	self.context().FileTreeViewModel.ExpandAll()
+
+	return self.c.PostRefreshUpdate(self.context())
+}
+
+func (self *FilesController) expandToDefaultDepth() error {
+	self.context().FileTreeViewModel.ExpandToDefaultDepth()
+
+	return self.c.PostRefreshUpdate(self.context())
+}
+
 func (self *FilesController) handleStashSave(stashFunc func(message string) error, action string) error {
 	return self.c.Prompt(types.PromptOpts{
 		Title: self.c.Tr.StashChanges,
@@ -746,25 +1070,3 @@ func (self *FilesController) onClickMain(opts gocui.ViewMouseBindingOpts) error
 func (self *FilesController) onClickSecondary(opts gocui.ViewMouseBindingOpts) error {
 	return self.EnterFile(types.OnFocusOpts{ClickedWindowName: "secondary", ClickedViewLineIdx: opts.Y})
 }
-
-func (self *FilesController) fetch() error {
-	return self.c.WithLoaderPanel(self.c.Tr.FetchWait, func() error {
-		if err := self.fetchAux(); err != nil {
-			_ = self.c.Error(err)
-		}
-		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
-	})
-}
-
-func (self *FilesController) fetchAux() (err error) {
-	self.c.LogAction("Fetch")
-	err = self.git.Sync.Fetch(git_commands.FetchOptions{})
-
-	if err != nil && strings.Contains(err.Error(), "exit status 128") {
-		_ = self.c.ErrorMsg(self.c.Tr.PassUnameWrong)
-	}
-
-	_ = self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
-
-	return err
-}
@@ -0,0 +1,408 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The absorb helper turns staged changes into fixup commits: for each staged
+// hunk we blame the lines it changes to find the commit that last touched
+// them, and then create a fixup! commit for that commit containing the hunk.
+// Hunks that we can't find a commit on the current branch for stay staged.
+
+type AbsorbHelper struct {
+	c            *types.HelperCommon
+	git          *commands.GitCommand
+	refsHelper   *RefsHelper
+	rebaseHelper *MergeAndRebaseHelper
+	model        *types.Model
+}
+
+func NewAbsorbHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	refsHelper *RefsHelper,
+	rebaseHelper *MergeAndRebaseHelper,
+	model *types.Model,
+) *AbsorbHelper {
+	return &AbsorbHelper{
+		c:            c,
+		git:          git,
+		refsHelper:   refsHelper,
+		rebaseHelper: rebaseHelper,
+		model:        model,
+	}
+}
+
+type absorbHunk struct {
+	file *models.File
+	diff string
+	hunk *patch.PatchHunk
+}
+
+func (self *absorbHunk) label() string {
+	return fmt.Sprintf("%s:%d", self.file.Name, self.hunk.LineNumberOfLine(self.hunk.FirstLineIdx))
+}
+
+// the staged hunks which belong in a fixup commit for the given commit
+type absorbTarget struct {
+	commit *models.Commit
+	hunks  []*absorbHunk
+}
+
+type skippedHunk struct {
+	label  string
+	reason string
+}
+
+func (self *AbsorbHelper) AbsorbStagedChanges() error {
+	files := slices.Filter(self.model.Files, func(file *models.File) bool {
+		return file.HasStagedChanges
+	})
+	if len(files) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoStagedChangesToAbsorb)
+	}
+
+	currentBranch := self.refsHelper.GetCheckedOutRef()
+	branchCommitShas, err := self.git.Branch.GetCommitsNotOnMainBranches(currentBranch.Name)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	targets, skipped := self.planFixups(files, branchCommitShas)
+	if len(targets) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NothingToAbsorb + "\n\n" + self.formatSkipped(skipped))
+	}
+
+	prompt := self.c.Tr.AbsorbPrompt + "\n\n" + strings.Join(slices.Map(targets, func(target *absorbTarget) string {
+		labels := slices.Map(target.hunks, func(hunk *absorbHunk) string { return hunk.label() })
+		return fmt.Sprintf("%s %s: %s", target.commit.ShortSha(), target.commit.Name, strings.Join(labels, ", "))
+	}), "\n")
+	if len(skipped) > 0 {
+		prompt += "\n\n" + self.c.Tr.AbsorbSkippedHunks + "\n\n" + self.formatSkipped(skipped)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.AbsorbStagedChanges,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			return self.commitFixups(targets, self.c.Tr.Actions.AbsorbStagedChanges)
+		},
+	})
+}
+
+// a staged file along with the commit whose fixup commit it will go into. If
+// target is nil the file is left staged.
+type fileFixup struct {
+	file   *models.File
+	diff   string
+	target *models.Commit
+	// set when we couldn't find a target ourselves, or when the file can't go
+	// into a fixup commit at all
+	reason   string
+	editable bool
+}
+
+// CreateFixupCommitsByFile is a coarser version of AbsorbStagedChanges: rather
+// than blaming each hunk, every staged file goes as a whole into a fixup commit
+// for the last commit on the branch that changed it. The mapping is shown in a
+// menu where the user can change it before any commits are made.
+func (self *AbsorbHelper) CreateFixupCommitsByFile() error {
+	files := slices.Filter(self.model.Files, func(file *models.File) bool {
+		return file.HasStagedChanges
+	})
+	if len(files) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoStagedChangesToAbsorb)
+	}
+
+	currentBranch := self.refsHelper.GetCheckedOutRef()
+	branchCommitShas, err := self.git.Branch.GetCommitsNotOnMainBranches(currentBranch.Name)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	branchCommits := slices.Filter(self.model.Commits, func(commit *models.Commit) bool {
+		return !commit.IsTODO() && slices.Contains(branchCommitShas, commit.Sha)
+	})
+	if len(branchCommits) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoBranchCommitsToFixup)
+	}
+
+	return self.showFileFixupsMenu(self.planFileFixups(files, branchCommits), branchCommits)
+}
+
+func (self *AbsorbHelper) planFileFixups(files []*models.File, branchCommits []*models.Commit) []*fileFixup {
+	return slices.Map(files, func(file *models.File) *fileFixup {
+		if file.IsRename() {
+			return &fileFixup{file: file, reason: self.c.Tr.AbsorbNewOrRenamedFile}
+		}
+
+		diff := self.git.WorkingTree.WorktreeFileDiff(file, true, true, false)
+		if patch.IsBinaryDiff(diff) {
+			return &fileFixup{file: file, reason: self.c.Tr.AbsorbBinaryFile}
+		}
+
+		fixup := &fileFixup{file: file, diff: diff, editable: true}
+		// a new file has no commit to go into, but there's nothing stopping the
+		// user from picking one
+		if file.Added {
+			fixup.reason = self.c.Tr.AbsorbNewOrRenamedFile
+			return fixup
+		}
+
+		sha, err := self.git.Commit.GetLastCommitForPath(file.Name)
+		if err != nil {
+			self.c.Log.Error(err)
+		}
+
+		// if the last commit to change the file isn't on the branch, we'd have to
+		// rewrite commits that are already on a main branch
+		target, ok := lo.Find(branchCommits, func(commit *models.Commit) bool { return commit.Sha == sha })
+		if !ok {
+			fixup.reason = self.c.Tr.FixupByFileNoCommitOnBranch
+			return fixup
+		}
+
+		fixup.target = target
+		return fixup
+	})
+}
+
+func (self *AbsorbHelper) showFileFixupsMenu(fixups []*fileFixup, branchCommits []*models.Commit) error {
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{style.FgGreen.Sprint(self.c.Tr.CreateFixupCommits), ""},
+			OnPress: func() error {
+				targets := self.fileFixupTargets(fixups, branchCommits)
+				if len(targets) == 0 {
+					return self.c.ErrorMsg(self.c.Tr.NoFilesToFixup)
+				}
+
+				return self.commitFixups(targets, self.c.Tr.Actions.CreateFixupCommitsByFile)
+			},
+		},
+	}
+
+	for _, fixup := range fixups {
+		fixup := fixup
+
+		targetLabel := ""
+		if fixup.target != nil {
+			targetLabel = fmt.Sprintf("%s %s", style.FgYellow.Sprint(fixup.target.ShortSha()), fixup.target.Name)
+		} else if fixup.reason != "" {
+			targetLabel = style.FgMagenta.Sprint(fmt.Sprintf("%s (%s)", self.c.Tr.LeaveFileStaged, fixup.reason))
+		} else {
+			targetLabel = style.FgMagenta.Sprint(self.c.Tr.LeaveFileStaged)
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{fixup.file.Name, targetLabel},
+			OnPress: func() error {
+				if !fixup.editable {
+					self.c.Toast(fixup.reason)
+					return self.showFileFixupsMenu(fixups, branchCommits)
+				}
+
+				return self.showFileFixupTargetMenu(fixup, fixups, branchCommits)
+			},
+			Tooltip: self.c.Tr.FixupByFileChangeTargetTooltip,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CreateFixupCommitsByFile,
+		Items: menuItems,
+	})
+}
+
+func (self *AbsorbHelper) showFileFixupTargetMenu(fixup *fileFixup, fixups []*fileFixup, branchCommits []*models.Commit) error {
+	setTarget := func(target *models.Commit) func() error {
+		return func() error {
+			fixup.target = target
+			fixup.reason = ""
+			return self.showFileFixupsMenu(fixups, branchCommits)
+		}
+	}
+
+	menuItems := slices.Map(branchCommits, func(commit *models.Commit) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{style.FgYellow.Sprint(commit.ShortSha()), commit.Name},
+			OnPress:      setTarget(commit),
+		}
+	})
+	menuItems = append(menuItems, &types.MenuItem{
+		LabelColumns: []string{style.FgMagenta.Sprint(self.c.Tr.LeaveFileStaged), ""},
+		OnPress:      setTarget(nil),
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fixup.file.Name,
+		Items: menuItems,
+	})
+}
+
+// fileFixupTargets groups the files by the commit they'll fix up, ordered from
+// newest commit to oldest like the targets of planFixups
+func (self *AbsorbHelper) fileFixupTargets(fixups []*fileFixup, branchCommits []*models.Commit) []*absorbTarget {
+	targets := []*absorbTarget{}
+	for _, commit := range branchCommits {
+		target := &absorbTarget{commit: commit}
+		for _, fixup := range fixups {
+			if fixup.target != commit {
+				continue
+			}
+
+			for _, hunk := range patch.GetHunksFromDiff(fixup.diff) {
+				target.hunks = append(target.hunks, &absorbHunk{file: fixup.file, diff: fixup.diff, hunk: hunk})
+			}
+		}
+
+		if len(target.hunks) > 0 {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
+
+// commitFixups creates the fixup commits for the given targets and then offers
+// to squash them
+func (self *AbsorbHelper) commitFixups(targets []*absorbTarget, action string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.CreatingFixupCommitsStatus, func() error {
+		self.c.LogAction(action)
+		if err := self.createFixupCommits(targets); err != nil {
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			return self.c.Error(err)
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		return self.promptToSquashFixups(targets[len(targets)-1].commit)
+	})
+}
+
+// planFixups works out which commit each of the staged hunks of the given
+// files should be absorbed into. The targets are ordered from newest to oldest.
+func (self *AbsorbHelper) planFixups(files []*models.File, branchCommitShas []string) ([]*absorbTarget, []skippedHunk) {
+	commitIndices := map[string]int{}
+	for i, commit := range self.model.Commits {
+		if slices.Contains(branchCommitShas, commit.Sha) {
+			commitIndices[commit.Sha] = i
+		}
+	}
+
+	targetsByIndex := map[int]*absorbTarget{}
+	skipped := []skippedHunk{}
+	for _, file := range files {
+		// the lines of a new file haven't been committed anywhere, and moving a
+		// rename into an earlier commit isn't something we want to do silently
+		if file.Added || file.IsRename() {
+			skipped = append(skipped, skippedHunk{label: file.Name, reason: self.c.Tr.AbsorbNewOrRenamedFile})
+			continue
+		}
+
+		diff := self.git.WorkingTree.WorktreeFileDiff(file, true, true, false)
+		if patch.IsBinaryDiff(diff) {
+			skipped = append(skipped, skippedHunk{label: file.Name, reason: self.c.Tr.AbsorbBinaryFile})
+			continue
+		}
+
+		for _, hunk := range patch.GetHunksFromDiff(diff) {
+			absorbHunk := &absorbHunk{file: file, diff: diff, hunk: hunk}
+
+			shas, err := self.git.Blame.GetCommitsForLines(file.Name, hunk.OriginalLineNumbers())
+			if err != nil {
+				self.c.Log.Error(err)
+				shas = []string{}
+			}
+
+			// if several commits changed the hunk's lines, the most recent of
+			// them is the one the hunk most likely belongs to
+			index := -1
+			for _, sha := range shas {
+				if i, ok := commitIndices[sha]; ok && (index == -1 || i < index) {
+					index = i
+				}
+			}
+			if index == -1 {
+				skipped = append(skipped, skippedHunk{label: absorbHunk.label(), reason: self.c.Tr.AbsorbNoCommitOnBranch})
+				continue
+			}
+
+			if _, ok := targetsByIndex[index]; !ok {
+				targetsByIndex[index] = &absorbTarget{commit: self.model.Commits[index]}
+			}
+			targetsByIndex[index].hunks = append(targetsByIndex[index].hunks, absorbHunk)
+		}
+	}
+
+	targets := []*absorbTarget{}
+	for i := range self.model.Commits {
+		if target, ok := targetsByIndex[i]; ok {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, skipped
+}
+
+func (self *AbsorbHelper) formatSkipped(skipped []skippedHunk) string {
+	return strings.Join(slices.Map(skipped, func(hunk skippedHunk) string {
+		return fmt.Sprintf("%s: %s", hunk.label, hunk.reason)
+	}), "\n")
+}
+
+// createFixupCommits commits the hunks of each target as a fixup! commit. The
+// commits are made from a temporary index, so the real index is left alone:
+// once HEAD moves, the hunks we've committed no longer show up as staged
+// while the ones we've skipped still do.
+func (self *AbsorbHelper) createFixupCommits(targets []*absorbTarget) error {
+	for _, target := range targets {
+		patches := []string{}
+		for _, file := range lo.Uniq(slices.Map(target.hunks, func(hunk *absorbHunk) *models.File { return hunk.file })) {
+			hunks := slices.Filter(target.hunks, func(hunk *absorbHunk) bool { return hunk.file == file })
+			lineIndices := []int{}
+			for _, hunk := range hunks {
+				for i := hunk.hunk.FirstLineIdx; i <= hunk.hunk.LastLineIdx(); i++ {
+					lineIndices = append(lineIndices, i)
+				}
+			}
+
+			patches = append(patches, patch.ModifiedPatchForLines(self.c.Log, file.Name, hunks[0].diff, lineIndices, patch.PatchOptions{}))
+		}
+
+		if err := self.git.WorkingTree.CommitPatch(strings.Join(patches, ""), "", false, self.git.Commit.CreateFixupCommitCmdObj(target.commit.Sha)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *AbsorbHelper) promptToSquashFixups(oldestTarget *models.Commit) error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.SquashAbsorbedFixupsTitle,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.SquashAbsorbedFixupsPrompt,
+			map[string]string{"commit": oldestTarget.ShortSha()},
+		),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.SquashingStatus, func() error {
+				self.c.LogAction(self.c.Tr.Actions.SquashAllAboveFixupCommits)
+				err := self.git.Rebase.SquashAllAboveFixupCommits(oldestTarget)
+				return self.rebaseHelper.CheckMergeOrRebase(err)
+			})
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameHelper struct {
+	c        *types.HelperCommon
+	git      *commands.GitCommand
+	contexts *context.ContextTree
+	model    *types.Model
+}
+
+func NewBlameHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	contexts *context.ContextTree,
+	model *types.Model,
+) *BlameHelper {
+	return &BlameHelper{
+		c:        c,
+		git:      git,
+		contexts: contexts,
+		model:    model,
+	}
+}
+
+// ShowBlame blames the file as of the given ref, or as it is in the working
+// tree if the ref is empty, and focuses the blame view with the given line
+// selected. Blaming a big file can take a while, so it happens in the
+// background.
+func (self *BlameHelper) ShowBlame(ref string, path string, lineNumber int) error {
+	return self.c.WithWaitingStatus(self.c.Tr.BlamingStatus, func() error {
+		blameLines, err := self.git.Loaders.BlameLoader.GetBlameLines(ref, path)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			self.model.BlameLines = blameLines
+
+			blameContext := self.contexts.Blame
+			blameContext.SetTarget(ref, path)
+			blameContext.SetSelectedLineIdx(utils.Clamp(lineNumber-1, 0, len(blameLines)-1))
+
+			if err := self.c.PostRefreshUpdate(blameContext); err != nil {
+				return err
+			}
+
+			return self.c.PushContext(blameContext)
+		})
+
+		return nil
+	})
+}
@@ -0,0 +1,182 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// The clean helper lets the user pick which of the untracked (and optionally
+// ignored) files that `git clean` would remove actually get removed. Ticking
+// entries reopens the menu, so the menu doubles as a checklist.
+
+type CleanHelper struct {
+	c   *types.HelperCommon
+	git *commands.GitCommand
+}
+
+func NewCleanHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+) *CleanHelper {
+	return &CleanHelper{
+		c:   c,
+		git: git,
+	}
+}
+
+type cleanSelection struct {
+	includeIgnored bool
+	candidates     []*git_commands.CleanCandidate
+	// keyed by path
+	ticked map[string]bool
+}
+
+// the items above the candidates in the menu
+const cleanMenuHeaderItemCount = 2
+
+func (self *CleanHelper) CreateCleanMenu() error {
+	selection := &cleanSelection{ticked: map[string]bool{}}
+	if err := self.loadCandidates(selection); err != nil {
+		return self.c.Error(err)
+	}
+
+	// start on the first candidate if there is one
+	selectedIdx := 0
+	if len(selection.candidates) > 0 {
+		selectedIdx = cleanMenuHeaderItemCount
+	}
+
+	return self.showCleanMenu(selection, selectedIdx)
+}
+
+// loadCandidates keeps the ticks of paths we already knew about. New paths
+// start off ticked, apart from nested repos, which have to be ticked explicitly.
+func (self *CleanHelper) loadCandidates(selection *cleanSelection) error {
+	candidates, err := self.git.WorkingTree.CleanCandidates(selection.includeIgnored)
+	if err != nil {
+		return err
+	}
+
+	ticked := map[string]bool{}
+	for _, candidate := range candidates {
+		if wasTicked, ok := selection.ticked[candidate.Path]; ok {
+			ticked[candidate.Path] = wasTicked
+		} else {
+			ticked[candidate.Path] = !candidate.ContainsRepo
+		}
+	}
+
+	selection.candidates = candidates
+	selection.ticked = ticked
+	return nil
+}
+
+func (self *CleanHelper) showCleanMenu(selection *cleanSelection, selectedIdx int) error {
+	tickedPaths := selection.tickedPaths()
+
+	deleteItem := &types.MenuItem{
+		LabelColumns: []string{"", style.FgRed.Sprintf(self.c.Tr.LcDeleteTickedPaths, len(tickedPaths))},
+		OnPress: func() error {
+			return self.confirmClean(selection)
+		},
+		Key: 'd',
+	}
+	if len(tickedPaths) == 0 {
+		deleteItem.DisabledReason = self.c.Tr.NothingTickedToClean
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{checkbox(selection.includeIgnored), self.c.Tr.LcIncludeIgnoredFiles},
+			OnPress: func() error {
+				selection.includeIgnored = !selection.includeIgnored
+				if err := self.loadCandidates(selection); err != nil {
+					return self.c.Error(err)
+				}
+				return self.showCleanMenu(selection, 0)
+			},
+			Key: 'i',
+		},
+		deleteItem,
+	}
+
+	for i, candidate := range selection.candidates {
+		candidate := candidate
+		idx := cleanMenuHeaderItemCount + i
+
+		columns := []string{checkbox(selection.ticked[candidate.Path]), candidate.Path}
+		if candidate.ContainsRepo {
+			columns[1] = style.FgYellow.Sprint(candidate.Path)
+			columns = append(columns, style.FgYellow.Sprint(self.c.Tr.LcContainsGitRepository))
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: columns,
+			OnPress: func() error {
+				selection.ticked[candidate.Path] = !selection.ticked[candidate.Path]
+				return self.showCleanMenu(selection, idx)
+			},
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:       self.c.Tr.CleanUntrackedFilesTitle,
+		Items:       menuItems,
+		SelectedIdx: selectedIdx,
+	})
+}
+
+// confirmClean lists exactly what's going to be removed, with an extra
+// warning for nested repos given that their unpushed work goes with them
+func (self *CleanHelper) confirmClean(selection *cleanSelection) error {
+	tickedPaths := selection.tickedPaths()
+	tickedRepos := slices.FilterMap(selection.candidates, func(candidate *git_commands.CleanCandidate) (string, bool) {
+		return candidate.Path, candidate.ContainsRepo && selection.ticked[candidate.Path]
+	})
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.CleanUntrackedFilesTitle,
+		Prompt: fmt.Sprintf(self.c.Tr.CleanUntrackedFilesPrompt, strings.Join(tickedPaths, "\n")),
+		HandleConfirm: func() error {
+			if len(tickedRepos) == 0 {
+				return self.clean(tickedPaths, selection.includeIgnored, false)
+			}
+
+			return self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.CleanNestedReposTitle,
+				Prompt: fmt.Sprintf(self.c.Tr.CleanNestedReposPrompt, strings.Join(tickedRepos, "\n")),
+				HandleConfirm: func() error {
+					return self.clean(tickedPaths, selection.includeIgnored, true)
+				},
+			})
+		},
+	})
+}
+
+func (self *CleanHelper) clean(paths []string, includeIgnored bool, includeRepos bool) error {
+	self.c.LogAction(self.c.Tr.Actions.CleanUntrackedFiles)
+	if err := self.git.WorkingTree.CleanPaths(paths, includeIgnored, includeRepos); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *cleanSelection) tickedPaths() []string {
+	return slices.FilterMap(self.candidates, func(candidate *git_commands.CleanCandidate) (string, bool) {
+		return candidate.Path, self.ticked[candidate.Path]
+	})
+}
+
+func checkbox(ticked bool) string {
+	if ticked {
+		return "[x]"
+	}
+	return "[ ]"
+}
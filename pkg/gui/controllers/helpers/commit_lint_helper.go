@@ -0,0 +1,140 @@
+package helpers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The commit lint helper checks the message in the commit message panel as
+// it's being typed. Its warnings never stop you from committing, except that
+// you can ask to confirm committing a subject that doesn't match
+// git.commit.subjectRegex.
+
+type CommitLintHelper struct {
+	c                 *types.HelperCommon
+	workingTreeHelper *WorkingTreeHelper
+}
+
+func NewCommitLintHelper(c *types.HelperCommon, workingTreeHelper *WorkingTreeHelper) *CommitLintHelper {
+	return &CommitLintHelper{
+		c:                 c,
+		workingTreeHelper: workingTreeHelper,
+	}
+}
+
+type commitLintProblem int
+
+const (
+	subjectTooLong commitLintProblem = iota
+	noBlankLineAfterSubject
+	subjectDoesNotMatchRegex
+	invalidSubjectRegex
+)
+
+type commitLintRule struct {
+	problem commitLintProblem
+	// returns true if the message breaks the rule
+	check func(commitConfig *config.CommitConfig, lines []string) bool
+}
+
+var commitLintRules = []commitLintRule{
+	{
+		problem: subjectTooLong,
+		check: func(commitConfig *config.CommitConfig, lines []string) bool {
+			return commitConfig.MaxSubjectLength > 0 &&
+				utf8.RuneCountInString(lines[0]) > commitConfig.MaxSubjectLength
+		},
+	},
+	{
+		problem: noBlankLineAfterSubject,
+		check: func(commitConfig *config.CommitConfig, lines []string) bool {
+			return len(lines) > 1 && strings.TrimSpace(lines[1]) != ""
+		},
+	},
+	{
+		problem: subjectDoesNotMatchRegex,
+		check: func(commitConfig *config.CommitConfig, lines []string) bool {
+			if commitConfig.SubjectRegex == "" {
+				return false
+			}
+
+			matched, err := regexp.MatchString(commitConfig.SubjectRegex, lines[0])
+			return err == nil && !matched
+		},
+	},
+	{
+		problem: invalidSubjectRegex,
+		check: func(commitConfig *config.CommitConfig, lines []string) bool {
+			_, err := regexp.Compile(commitConfig.SubjectRegex)
+			return err != nil
+		},
+	},
+}
+
+func lintCommitMessage(commitConfig *config.CommitConfig, message string) []commitLintProblem {
+	if strings.TrimSpace(message) == "" {
+		return nil
+	}
+
+	lines := strings.Split(message, "\n")
+	problems := []commitLintProblem{}
+	for _, rule := range commitLintRules {
+		if rule.check(commitConfig, lines) {
+			problems = append(problems, rule.problem)
+		}
+	}
+
+	return problems
+}
+
+func (self *CommitLintHelper) lint(message string) []commitLintProblem {
+	message = self.workingTreeHelper.StripCommitTemplateComments(message)
+	return lintCommitMessage(&self.c.UserConfig.Git.Commit, message)
+}
+
+// Warnings returns a description of each problem with the commit message
+func (self *CommitLintHelper) Warnings(message string) []string {
+	commitConfig := self.c.UserConfig.Git.Commit
+
+	return slices.Map(self.lint(message), func(problem commitLintProblem) string {
+		switch problem {
+		case subjectTooLong:
+			return utils.ResolvePlaceholderString(self.c.Tr.CommitSubjectTooLong, map[string]string{
+				"maxLength": strconv.Itoa(commitConfig.MaxSubjectLength),
+			})
+		case noBlankLineAfterSubject:
+			return self.c.Tr.CommitNoBlankLineAfterSubject
+		case subjectDoesNotMatchRegex:
+			return utils.ResolvePlaceholderString(self.c.Tr.CommitSubjectMismatch, map[string]string{
+				"regex": commitConfig.SubjectRegex,
+			})
+		default:
+			return self.c.Tr.InvalidCommitSubjectRegex
+		}
+	})
+}
+
+// WithSubjectRegexConfirmation calls f straight away unless the subject of the
+// message doesn't match git.commit.subjectRegex and the user has asked to
+// confirm committing such messages
+func (self *CommitLintHelper) WithSubjectRegexConfirmation(message string, f func() error) error {
+	commitConfig := self.c.UserConfig.Git.Commit
+	if !commitConfig.ConfirmOnSubjectRegexMismatch || !slices.Contains(self.lint(message), subjectDoesNotMatchRegex) {
+		return f()
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.CommitSubjectMismatchTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.CommitSubjectMismatchPrompt, map[string]string{
+			"regex": commitConfig.SubjectRegex,
+		}),
+		HandleConfirm: f,
+	})
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintCommitMessage(t *testing.T) {
+	conventionalCommitsRegex := `^(feat|fix|chore)(\(.+\))?: .+`
+
+	scenarios := []struct {
+		testName         string
+		maxSubjectLength int
+		subjectRegex     string
+		message          string
+		expected         []commitLintProblem
+	}{
+		{
+			testName:         "empty message",
+			maxSubjectLength: 10,
+			subjectRegex:     conventionalCommitsRegex,
+			message:          "  \n",
+			expected:         nil,
+		},
+		{
+			testName:         "no problems",
+			maxSubjectLength: 20,
+			subjectRegex:     conventionalCommitsRegex,
+			message:          "fix: the bug\n\nbody that is longer than the subject",
+			expected:         []commitLintProblem{},
+		},
+		{
+			testName:         "subject too long",
+			maxSubjectLength: 10,
+			message:          "a subject that is too long",
+			expected:         []commitLintProblem{subjectTooLong},
+		},
+		{
+			testName:         "subject exactly at the limit",
+			maxSubjectLength: 9,
+			message:          "ünïcödé!!",
+			expected:         []commitLintProblem{},
+		},
+		{
+			testName:         "no limit",
+			maxSubjectLength: 0,
+			message:          "a subject that would be too long if there was a limit",
+			expected:         []commitLintProblem{},
+		},
+		{
+			testName: "second line not blank",
+			message:  "subject\nbody",
+			expected: []commitLintProblem{noBlankLineAfterSubject},
+		},
+		{
+			testName:     "subject doesn't match the regex",
+			subjectRegex: conventionalCommitsRegex,
+			message:      "fixed the bug\n\nfix: only the subject has to match",
+			expected:     []commitLintProblem{subjectDoesNotMatchRegex},
+		},
+		{
+			testName:     "invalid regex",
+			subjectRegex: "(feat",
+			message:      "feat: add it",
+			expected:     []commitLintProblem{invalidSubjectRegex},
+		},
+		{
+			testName:         "several problems",
+			maxSubjectLength: 5,
+			subjectRegex:     conventionalCommitsRegex,
+			message:          "fixed the bug\nbody",
+			expected:         []commitLintProblem{subjectTooLong, noBlankLineAfterSubject, subjectDoesNotMatchRegex},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			commitConfig := &config.CommitConfig{
+				MaxSubjectLength: s.maxSubjectLength,
+				SubjectRegex:     s.subjectRegex,
+			}
+			assert.EqualValues(t, s.expected, lintCommitMessage(commitConfig, s.message))
+		})
+	}
+}
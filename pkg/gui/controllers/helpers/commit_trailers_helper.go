@@ -0,0 +1,171 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The commit trailers helper adds trailers like 'Co-authored-by: ...' to the
+// end of the message in the commit message panel.
+
+type CommitTrailersHelper struct {
+	c   *types.HelperCommon
+	git *commands.GitCommand
+	// unlike the message we commit, this isn't trimmed, so that we can tell
+	// when there's a blank subject line above the trailers
+	getCommitMessage       func() string
+	setCommitMessage       func(message string)
+	setCommitMessageCursor func(x int, y int)
+}
+
+func NewCommitTrailersHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	getCommitMessage func() string,
+	setCommitMessage func(message string),
+	setCommitMessageCursor func(x int, y int),
+) *CommitTrailersHelper {
+	return &CommitTrailersHelper{
+		c:                      c,
+		git:                    git,
+		getCommitMessage:       getCommitMessage,
+		setCommitMessage:       setCommitMessage,
+		setCommitMessageCursor: setCommitMessageCursor,
+	}
+}
+
+// how many of the latest authors we offer as co-authors
+const coAuthorsLimit = 20
+
+func (self *CommitTrailersHelper) CreateTrailersMenu() error {
+	message := self.getCommitMessage()
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AddCommitTrailer,
+		Items: []*types.MenuItem{
+			{
+				Label:     self.c.Tr.LcAddCoAuthor,
+				OpensMenu: true,
+				OnPress: func() error {
+					return self.createCoAuthorMenu(message)
+				},
+				Key: 'c',
+			},
+			{
+				Label: self.c.Tr.LcAddIssueTrailer,
+				OnPress: func() error {
+					return self.promptForIssue(message)
+				},
+				Key: 'i',
+			},
+		},
+	})
+}
+
+func (self *CommitTrailersHelper) createCoAuthorMenu(message string) error {
+	authors, err := self.git.Commit.GetAuthors()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	// you can't be your own co-author
+	userEmail := self.git.Config.GetUserEmail()
+	coAuthors := lo.Filter(lo.Uniq(authors), func(author string, _ int) bool {
+		return userEmail == "" || !strings.EqualFold(authorEmail(author), userEmail)
+	})
+
+	menuItems := slices.Map(utils.Limit(coAuthors, coAuthorsLimit), func(author string) *types.MenuItem {
+		trailer := fmt.Sprintf("Co-authored-by: %s", author)
+
+		disabledReason := ""
+		if hasTrailer(message, trailer) {
+			disabledReason = self.c.Tr.CoAuthorAlreadyAdded
+		}
+
+		return &types.MenuItem{
+			Label:          author,
+			DisabledReason: disabledReason,
+			OnPress: func() error {
+				self.addTrailer(message, trailer)
+				return nil
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AddCoAuthor,
+		Items: menuItems,
+	})
+}
+
+// authorEmail returns the email of an author like 'Jesse <jesse@example.com>'
+func authorEmail(author string) string {
+	_, email, _ := strings.Cut(author, "<")
+	return strings.TrimSuffix(email, ">")
+}
+
+func (self *CommitTrailersHelper) promptForIssue(message string) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.IssueNumber,
+		HandleConfirm: func(issue string) error {
+			issue = strings.TrimPrefix(strings.TrimSpace(issue), "#")
+			if issue == "" {
+				return nil
+			}
+
+			trailer := utils.ResolvePlaceholderString(
+				self.c.UserConfig.Git.Commit.IssueTrailerTemplate,
+				map[string]string{"issue": issue},
+			)
+			self.addTrailer(message, trailer)
+			return nil
+		},
+	})
+}
+
+func (self *CommitTrailersHelper) addTrailer(message string, trailer string) {
+	message = appendTrailer(message, trailer)
+	self.setCommitMessage(message)
+
+	// put the cursor on the blank subject line so that the user can fill it in
+	if strings.HasPrefix(message, "\n") {
+		self.setCommitMessageCursor(0, 0)
+	}
+}
+
+// matches lines like 'Co-authored-by: Jesse <jesse@example.com>' and 'Fixes #123'
+var trailerRegex = regexp.MustCompile(`^[\w-]+(: | #)\S`)
+
+// appendTrailer adds the trailer to the end of the message, after an empty
+// line unless the message already ends with trailers. An empty message gets a
+// blank subject line so that the trailer doesn't end up as the subject. Adding
+// a trailer that the message already has does nothing.
+func appendTrailer(message string, trailer string) string {
+	message = strings.TrimRight(message, " \n")
+	if hasTrailer(message, trailer) {
+		return message
+	}
+
+	paragraphs := strings.Split(message, "\n\n")
+	lastParagraph := paragraphs[len(paragraphs)-1]
+	endsWithTrailers := len(paragraphs) > 1 && lo.EveryBy(strings.Split(lastParagraph, "\n"), func(line string) bool {
+		return trailerRegex.MatchString(line)
+	})
+
+	if endsWithTrailers {
+		return message + "\n" + trailer
+	}
+
+	return message + "\n\n" + trailer
+}
+
+func hasTrailer(message string, trailer string) bool {
+	return lo.Contains(strings.Split(message, "\n"), trailer)
+}
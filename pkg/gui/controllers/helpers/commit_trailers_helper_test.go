@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendTrailer(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		message  string
+		trailer  string
+		expected string
+	}{
+		{
+			testName: "empty message",
+			message:  "",
+			trailer:  "Fixes #123",
+			expected: "\n\nFixes #123",
+		},
+		{
+			testName: "blank subject followed by trailers",
+			message:  "\n\nFixes #123\n",
+			trailer:  "Co-authored-by: Jesse <jesse@example.com>",
+			expected: "\n\nFixes #123\nCo-authored-by: Jesse <jesse@example.com>",
+		},
+		{
+			testName: "only a subject",
+			message:  "subject",
+			trailer:  "Fixes #123",
+			expected: "subject\n\nFixes #123",
+		},
+		{
+			testName: "subject that looks like a trailer",
+			message:  "fix: the bug",
+			trailer:  "Fixes #123",
+			expected: "fix: the bug\n\nFixes #123",
+		},
+		{
+			testName: "subject and body with trailing newlines",
+			message:  "subject\n\nbody\n\n",
+			trailer:  "Co-authored-by: Jesse <jesse@example.com>",
+			expected: "subject\n\nbody\n\nCo-authored-by: Jesse <jesse@example.com>",
+		},
+		{
+			testName: "already ends with trailers",
+			message:  "subject\n\nbody\n\nFixes #123\nCo-authored-by: Jesse <jesse@example.com>",
+			trailer:  "Co-authored-by: Jeff <jeff@example.com>",
+			expected: "subject\n\nbody\n\nFixes #123\nCo-authored-by: Jesse <jesse@example.com>\nCo-authored-by: Jeff <jeff@example.com>",
+		},
+		{
+			testName: "body that only partly looks like trailers",
+			message:  "subject\n\nSee: the docs\nfor more",
+			trailer:  "Fixes #123",
+			expected: "subject\n\nSee: the docs\nfor more\n\nFixes #123",
+		},
+		{
+			testName: "trailer already added",
+			message:  "subject\n\nCo-authored-by: Jesse <jesse@example.com>\n",
+			trailer:  "Co-authored-by: Jesse <jesse@example.com>",
+			expected: "subject\n\nCo-authored-by: Jesse <jesse@example.com>",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, appendTrailer(s.message, s.trailer))
+		})
+	}
+}
+
+func TestAuthorEmail(t *testing.T) {
+	assert.Equal(t, "jesse@example.com", authorEmail("Jesse Duffield <jesse@example.com>"))
+	assert.Equal(t, "", authorEmail("Jesse Duffield"))
+}
@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -19,14 +20,38 @@ func NewCredentialsHelper(
 	}
 }
 
-// promptUserForCredential wait for a username, password or passphrase input from the credentials popup
-func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.CredentialType) string {
+// promptUserForCredential wait for a username, password or passphrase input from the credentials popup.
+// Returns false if the user cancelled, in which case the command gets killed.
+func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.CredentialType, output string) (string, bool) {
 	waitGroup := sync.WaitGroup{}
 	waitGroup.Add(1)
 
 	userInput := ""
+	answered := false
 
 	self.c.OnUIThread(func() error {
+		if passOrUname == oscommands.HostKeyConfirmation {
+			return self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.UnknownHostTitle,
+				Prompt: hostKeyPrompt(output),
+				HandleConfirm: func() error {
+					userInput = "yes"
+					answered = true
+					waitGroup.Done()
+
+					return nil
+				},
+				HandleClose: func() error {
+					// telling ssh 'no' lets it fail with its own error rather than us killing it
+					userInput = "no"
+					answered = true
+					waitGroup.Done()
+
+					return nil
+				},
+			})
+		}
+
 		title, mask := self.getTitleAndMask(passOrUname)
 
 		return self.c.Prompt(types.PromptOpts{
@@ -34,6 +59,7 @@ func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.Cr
 			Mask:  mask,
 			HandleConfirm: func(input string) error {
 				userInput = input
+				answered = true
 
 				waitGroup.Done()
 
@@ -50,7 +76,11 @@ func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.Cr
 	// wait for username/passwords/passphrase input
 	waitGroup.Wait()
 
-	return userInput + "\n"
+	if !answered {
+		return "", false
+	}
+
+	return userInput + "\n", true
 }
 
 func (self *CredentialsHelper) getTitleAndMask(passOrUname oscommands.CredentialType) (string, bool) {
@@ -63,8 +93,21 @@ func (self *CredentialsHelper) getTitleAndMask(passOrUname oscommands.Credential
 		return self.c.Tr.CredentialsPassphrase, true
 	case oscommands.PIN:
 		return self.c.Tr.CredentialsPIN, true
+	case oscommands.TwoFactorCode:
+		return self.c.Tr.CredentialsTwoFactorCode, true
 	}
 
 	// should never land here
 	panic("unexpected credential request")
 }
+
+// ssh explains which host it doesn't know along with its fingerprint before
+// asking whether to continue, so we show that rather than anything the
+// command printed before it
+func hostKeyPrompt(output string) string {
+	if idx := strings.Index(output, "The authenticity of host"); idx != -1 {
+		output = output[idx:]
+	}
+
+	return strings.TrimSpace(output)
+}
@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// The detached head helper looks out for commits made on a detached HEAD that
+// no branch contains, which become hard to find once HEAD moves away from them.
+
+type DetachedHeadHelper struct {
+	c     *types.HelperCommon
+	git   *commands.GitCommand
+	model *types.Model
+}
+
+func NewDetachedHeadHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	model *types.Model,
+) *DetachedHeadHelper {
+	return &DetachedHeadHelper{
+		c:     c,
+		git:   git,
+		model: model,
+	}
+}
+
+// WithDetachedCommitsCheck runs f, which is about to leave HEAD, unless HEAD is
+// detached at commits that no branch contains. In that case we first offer to
+// create a branch or a tag there, or to leave the commits behind.
+func (self *DetachedHeadHelper) WithDetachedCommitsCheck(f func() error) error {
+	if !self.c.UserConfig.Git.WarnOnDetachedCommits || !self.isHeadDetached() || self.isHeadDetachedByGit() {
+		return f()
+	}
+
+	onBranch, err := self.git.Branch.IsHeadOnAnyBranch()
+	if err != nil {
+		// not worth getting in the user's way over
+		self.c.Log.Error(err)
+		return f()
+	}
+	if onBranch {
+		return f()
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.DetachedCommitsTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LcCreateBranchAtHead,
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title: self.c.Tr.NewBranchNamePrompt,
+						HandleConfirm: func(name string) error {
+							self.c.LogAction(self.c.Tr.Actions.CreateBranch)
+							if err := self.git.Branch.CreateAt(name, "HEAD"); err != nil {
+								return self.c.Error(err)
+							}
+							return f()
+						},
+					})
+				},
+				Key: 'b',
+			},
+			{
+				Label: self.c.Tr.LcCreateTagAtHead,
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title: self.c.Tr.TagNameTitle,
+						HandleConfirm: func(name string) error {
+							self.c.LogAction(self.c.Tr.Actions.CreateLightweightTag)
+							if err := self.git.Tag.CreateLightweight(name, "HEAD"); err != nil {
+								return self.c.Error(err)
+							}
+							return f()
+						},
+					})
+				},
+				Key: 't',
+			},
+			{
+				Label:   self.c.Tr.LcLeaveDetachedCommitsBehind,
+				OnPress: f,
+				Key:     'l',
+			},
+		},
+	})
+}
+
+func (self *DetachedHeadHelper) isHeadDetached() bool {
+	return len(self.model.Branches) > 0 && self.model.Branches[0].DetachedHead
+}
+
+// a rebase or bisect detaches HEAD while it runs and takes care of moving it
+// back, so any commits it leaves there aren't ours to warn about
+func (self *DetachedHeadHelper) isHeadDetachedByGit() bool {
+	return self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE ||
+		(self.model.BisectInfo != nil && self.model.BisectInfo.Started())
+}
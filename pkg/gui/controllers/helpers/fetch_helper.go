@@ -0,0 +1,206 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// The fetch helper fetches remotes, both in the background and when asked to
+// fetch them all, and keeps track of when each remote was last fetched so the
+// remotes panel can show it.
+
+type FetchHelper struct {
+	c        *types.HelperCommon
+	git      *commands.GitCommand
+	contexts *context.ContextTree
+	model    *types.Model
+}
+
+func NewFetchHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	contexts *context.ContextTree,
+	model *types.Model,
+) *FetchHelper {
+	return &FetchHelper{
+		c:        c,
+		git:      git,
+		contexts: contexts,
+		model:    model,
+	}
+}
+
+// BackgroundFetch fetches the remotes from the git.autoFetchRemotes config,
+// or whatever plain `git fetch` fetches if that's not set. Failures are
+// logged rather than shown, given that nobody asked for the fetch.
+func (self *FetchHelper) BackgroundFetch() error {
+	remoteNames := self.autoFetchRemoteNames()
+
+	var err error
+	if len(remoteNames) == 0 {
+		err = self.git.Sync.Fetch(git_commands.FetchOptions{Background: true})
+		if err == nil {
+			self.recordFetched(map[string]time.Time{self.defaultRemoteName(): time.Now()})
+		}
+	} else {
+		err = self.fetchRemotes(remoteNames, true, func(remoteName string, err error) {
+			if err != nil {
+				self.c.Log.Errorf("Failed to fetch %s: %v", remoteName, err)
+			}
+		})
+	}
+
+	_ = self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
+
+	return err
+}
+
+// FetchAllRemotes fetches every remote at once, noting in the command log as
+// each one finishes. Any failures are shown in a toast so that they don't
+// interrupt whatever the user is doing in the meantime.
+func (self *FetchHelper) FetchAllRemotes() error {
+	remoteNames := self.allRemoteNames()
+	if len(remoteNames) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoRemotesToFetch)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingAllRemotesStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.FetchAllRemotes)
+
+		failed := []string{}
+		mutex := sync.Mutex{}
+		_ = self.fetchRemotes(remoteNames, false, func(remoteName string, err error) {
+			if err == nil {
+				self.c.LogCommand(fmt.Sprintf(self.c.Tr.FetchedRemote, remoteName), false)
+				return
+			}
+
+			self.c.LogCommand(fmt.Sprintf(self.c.Tr.FailedToFetchRemote, remoteName, firstLine(err.Error())), false)
+			mutex.Lock()
+			failed = append(failed, remoteName)
+			mutex.Unlock()
+		})
+
+		if len(failed) > 0 {
+			// the remotes finish in no particular order
+			slices.Sort(failed)
+			self.c.Toast(fmt.Sprintf(self.c.Tr.FetchAllRemotesFailed, strings.Join(failed, ", ")))
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
+	})
+}
+
+// Fetch runs a plain `git fetch` on the user's request, pointing them at
+// their credentials if git couldn't authenticate
+func (self *FetchHelper) Fetch() error {
+	return self.c.WithLoaderPanel(self.c.Tr.FetchWait, func() error {
+		if err := self.fetchAux(); err != nil {
+			_ = self.c.Error(err)
+		}
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	})
+}
+
+func (self *FetchHelper) fetchAux() (err error) {
+	self.c.LogAction(self.c.Tr.Actions.Fetch)
+	err = self.git.Sync.Fetch(git_commands.FetchOptions{})
+
+	if err != nil && strings.Contains(err.Error(), "exit status 128") {
+		_ = self.c.ErrorMsg(self.c.Tr.PassUnameWrong)
+	}
+	if err == nil {
+		self.RecordDefaultRemoteFetched()
+	}
+
+	_ = self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
+
+	return err
+}
+
+// FetchRemote fetches a single remote, prompting for credentials if need be
+func (self *FetchHelper) FetchRemote(remoteName string) error {
+	if err := self.git.Sync.FetchRemote(remoteName); err != nil {
+		return err
+	}
+
+	self.recordFetched(map[string]time.Time{remoteName: time.Now()})
+	return nil
+}
+
+// RecordDefaultRemoteFetched is for after a plain `git fetch`, which fetches
+// the remote of the checked-out branch's upstream, falling back to origin
+func (self *FetchHelper) RecordDefaultRemoteFetched() {
+	self.recordFetched(map[string]time.Time{self.defaultRemoteName(): time.Now()})
+}
+
+// fetchRemotes returns the first error it comes across, having still fetched
+// the remaining remotes
+func (self *FetchHelper) fetchRemotes(remoteNames []string, background bool, onFetched func(remoteName string, err error)) error {
+	mutex := sync.Mutex{}
+	fetchTimes := map[string]time.Time{}
+	var firstErr error
+
+	self.git.Sync.FetchRemotes(remoteNames, background, func(remoteName string, err error) {
+		mutex.Lock()
+		if err == nil {
+			fetchTimes[remoteName] = time.Now()
+		} else if firstErr == nil {
+			firstErr = err
+		}
+		mutex.Unlock()
+
+		onFetched(remoteName, err)
+	})
+
+	self.recordFetched(fetchTimes)
+
+	return firstErr
+}
+
+func (self *FetchHelper) recordFetched(fetchTimes map[string]time.Time) {
+	self.c.OnUIThread(func() error {
+		for remoteName, fetchTime := range fetchTimes {
+			self.model.RemoteFetchTimes[remoteName] = fetchTime
+		}
+
+		return self.c.PostRefreshUpdate(self.contexts.Remotes)
+	})
+}
+
+func (self *FetchHelper) autoFetchRemoteNames() []string {
+	configured := self.c.UserConfig.Git.AutoFetchRemotes
+	if configured.IsAll() {
+		return self.allRemoteNames()
+	}
+
+	return configured
+}
+
+func (self *FetchHelper) allRemoteNames() []string {
+	return slices.Map(self.model.Remotes, func(remote *models.Remote) string {
+		return remote.Name
+	})
+}
+
+func (self *FetchHelper) defaultRemoteName() string {
+	if len(self.model.Branches) > 0 && self.model.Branches[0].Head && self.model.Branches[0].UpstreamRemote != "" {
+		return self.model.Branches[0].UpstreamRemote
+	}
+
+	return "origin"
+}
+
+func firstLine(str string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(str), "\n")
+	return line
+}
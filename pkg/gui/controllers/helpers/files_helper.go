@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -11,6 +12,7 @@ type IFilesHelper interface {
 	EditFileAtLine(filename string, lineNumber int) error
 	OpenFile(filename string) error
 	OpenFileAtLine(filename string, lineNumber int) error
+	OpenDiffTool(opts git_commands.DifftoolCmdOptions) error
 }
 
 type FilesHelper struct {
@@ -60,3 +62,10 @@ func (self *FilesHelper) OpenFileAtLine(filename string, lineNumber int) error {
 	}
 	return nil
 }
+
+func (self *FilesHelper) OpenDiffTool(opts git_commands.DifftoolCmdOptions) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenDiffTool)
+	return self.c.RunSubprocessAndRefresh(
+		self.os.Cmd.NewShell(self.git.File.GetDifftoolCmdStr(opts)),
+	)
+}
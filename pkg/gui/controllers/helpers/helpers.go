@@ -14,6 +14,14 @@ type Helpers struct {
 	PatchBuilding  *PatchBuildingHelper
 	GPG            *GpgHelper
 	Upstream       *UpstreamHelper
+	Absorb         *AbsorbHelper
+	Worktree       *WorktreeHelper
+	CommitLint     *CommitLintHelper
+	CommitTrailers *CommitTrailersHelper
+	Fetch          *FetchHelper
+	Clean          *CleanHelper
+	DetachedHead   *DetachedHeadHelper
+	Blame          *BlameHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -31,5 +39,13 @@ func NewStubHelpers() *Helpers {
 		PatchBuilding:  &PatchBuildingHelper{},
 		GPG:            &GpgHelper{},
 		Upstream:       &UpstreamHelper{},
+		Absorb:         &AbsorbHelper{},
+		Worktree:       &WorktreeHelper{},
+		CommitLint:     &CommitLintHelper{},
+		CommitTrailers: &CommitTrailersHelper{},
+		Fetch:          &FetchHelper{},
+		Clean:          &CleanHelper{},
+		DetachedHead:   &DetachedHeadHelper{},
+		Blame:          &BlameHelper{},
 	}
 }
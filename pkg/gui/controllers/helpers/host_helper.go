@@ -1,8 +1,13 @@
 package helpers
 
 import (
+	"strings"
+	"sync"
+
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -11,20 +16,31 @@ import (
 type IHostHelper interface {
 	GetPullRequestURL(from string, to string) (string, error)
 	GetCommitURL(commitSha string) (string, error)
+	GetFileURL(commitSha string, path string, firstLine int, lastLine int) (string, error)
+	GetPullRequests(branchNames []string) (map[string]*models.PullRequest, error)
 }
 
 type HostHelper struct {
 	c   *types.HelperCommon
 	git *commands.GitCommand
+	os  *oscommands.OSCommand
+
+	// the tokens we got from the services' CLIs, keyed by provider and host.
+	// Asking the CLI means running it, so we do that once per session
+	cliTokens      map[string]string
+	cliTokensMutex sync.Mutex
 }
 
 func NewHostHelper(
 	c *types.HelperCommon,
 	git *commands.GitCommand,
+	os *oscommands.OSCommand,
 ) *HostHelper {
 	return &HostHelper{
-		c:   c,
-		git: git,
+		c:         c,
+		git:       git,
+		os:        os,
+		cliTokens: map[string]string{},
 	}
 }
 
@@ -36,6 +52,59 @@ func (self *HostHelper) GetCommitURL(commitSha string) (string, error) {
 	return self.getHostingServiceMgr().GetCommitURL(commitSha)
 }
 
+func (self *HostHelper) GetFileURL(commitSha string, path string, firstLine int, lastLine int) (string, error) {
+	return self.getHostingServiceMgr().GetFileURL(commitSha, path, firstLine, lastLine)
+}
+
+// GetPullRequests returns the pull requests of the given branches, keyed by
+// branch name. This talks to the hosting service's API, so should be called
+// in the background.
+func (self *HostHelper) GetPullRequests(branchNames []string) (map[string]*models.PullRequest, error) {
+	return self.getHostingServiceMgr().GetPullRequests(branchNames, self.getToken)
+}
+
+// getToken prefers a token from the config, falling back to the one the
+// service's own CLI is logged in with
+func (self *HostHelper) getToken(provider string, host string) string {
+	if token := self.c.UserConfig.Git.HostingServiceTokens[host]; token != "" {
+		return token
+	}
+
+	self.cliTokensMutex.Lock()
+	defer self.cliTokensMutex.Unlock()
+
+	key := provider + " " + host
+	if token, ok := self.cliTokens[key]; ok {
+		return token
+	}
+	token := self.getTokenFromCLI(provider, host)
+	// remembering failures too, so that we don't keep running a CLI that isn't
+	// installed or logged in
+	self.cliTokens[key] = token
+	return token
+}
+
+func (self *HostHelper) getTokenFromCLI(provider string, host string) string {
+	var cmdObj oscommands.ICmdObj
+	switch provider {
+	case "github":
+		cmdObj = self.os.Cmd.NewFromArgs([]string{"gh", "auth", "token", "--hostname", host})
+	case "gitlab":
+		cmdObj = self.os.Cmd.NewFromArgs([]string{"glab", "config", "get", "token", "--host", host})
+	default:
+		return ""
+	}
+
+	output, err := cmdObj.DontLog().RunWithOutput()
+	if err != nil {
+		// most likely the CLI isn't installed or isn't logged in
+		self.c.Log.Warnf("Could not get token for %s from its CLI: %v", host, err)
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
 // getting this on every request rather than storing it in state in case our remoteURL changes
 // from one invocation to the next. Note however that we're currently caching config
 // results so we might want to invalidate the cache here if it becomes a problem.
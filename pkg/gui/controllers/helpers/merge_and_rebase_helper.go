@@ -14,10 +14,12 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c          *types.HelperCommon
-	contexts   *context.ContextTree
-	git        *commands.GitCommand
-	refsHelper *RefsHelper
+	c                 *types.HelperCommon
+	contexts          *context.ContextTree
+	git               *commands.GitCommand
+	refsHelper        *RefsHelper
+	suggestionsHelper *SuggestionsHelper
+	workingTreeHelper *WorkingTreeHelper
 }
 
 func NewMergeAndRebaseHelper(
@@ -25,12 +27,16 @@ func NewMergeAndRebaseHelper(
 	contexts *context.ContextTree,
 	git *commands.GitCommand,
 	refsHelper *RefsHelper,
+	suggestionsHelper *SuggestionsHelper,
+	workingTreeHelper *WorkingTreeHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c:          c,
-		contexts:   contexts,
-		git:        git,
-		refsHelper: refsHelper,
+		c:                 c,
+		contexts:          contexts,
+		git:               git,
+		refsHelper:        refsHelper,
+		suggestionsHelper: suggestionsHelper,
+		workingTreeHelper: workingTreeHelper,
 	}
 }
 
@@ -220,6 +226,75 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 	})
 }
 
+// CreateRebaseOntoRefMenu lets the user type the ref to rebase the checked out
+// branch onto, rather than having to select it in a list
+func (self *MergeAndRebaseHelper) CreateRebaseOntoRefMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RebaseOntoRef,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LcRebaseOntoRef,
+				OnPress: func() error {
+					return self.promptForRef(self.c.Tr.RebaseOntoRefPromptTitle, "", self.RebaseOntoRef)
+				},
+				Key: 'r',
+			},
+			{
+				Label: self.c.Tr.LcRebaseOntoRefFromUpstream,
+				OnPress: func() error {
+					return self.promptForRef(self.c.Tr.RebaseOntoRefPromptTitle, "", func(newBase string) error {
+						return self.promptForRef(self.c.Tr.RebaseOntoUpstreamPromptTitle, "", func(upstream string) error {
+							return self.rebaseOnto(newBase, upstream)
+						})
+					})
+				},
+				Key: 'o',
+			},
+		},
+	})
+}
+
+// promptForRef asks for a ref, with suggestions, and only continues once it
+// resolves to a commit. If it doesn't, we show git's error and ask again with
+// what was typed so that it can be corrected.
+func (self *MergeAndRebaseHelper) promptForRef(title string, initialContent string, onValidRef func(ref string) error) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               title,
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: self.suggestionsHelper.GetRefsSuggestionsFunc(),
+		HandleConfirm: func(ref string) error {
+			ref = strings.TrimSpace(ref)
+			if err := self.git.Rebase.VerifyRef(ref); err != nil {
+				self.c.Toast(strings.TrimSpace(err.Error()))
+				return self.promptForRef(title, ref, onValidRef)
+			}
+
+			return onValidRef(ref)
+		},
+	})
+}
+
+func (self *MergeAndRebaseHelper) rebaseOnto(newBase string, upstream string) error {
+	prompt := utils.ResolvePlaceholderString(
+		self.c.Tr.ConfirmRebaseOnto,
+		map[string]string{
+			"checkedOutBranch": self.refsHelper.GetCheckedOutRef().Name,
+			"newBase":          newBase,
+			"upstream":         upstream,
+		},
+	)
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RebasingTitle,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RebaseBranch)
+			err := self.git.Rebase.RebaseOnto(newBase, upstream)
+			return self.CheckMergeOrRebase(err)
+		},
+	})
+}
+
 func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) error {
 	if self.git.Branch.IsHeadDetached() {
 		return self.c.ErrorMsg("Cannot merge branch in detached head state. You might have checked out a commit directly or a remote branch, in which case you should checkout the local branch you want to be on")
@@ -228,21 +303,77 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 	if checkedOutBranchName == refName {
 		return self.c.ErrorMsg(self.c.Tr.CantMergeBranchIntoItself)
 	}
-	prompt := utils.ResolvePlaceholderString(
-		self.c.Tr.ConfirmMerge,
+	title := utils.ResolvePlaceholderString(
+		self.c.Tr.MergeBranchMenuTitle,
 		map[string]string{
 			"checkedOutBranch": checkedOutBranchName,
 			"selectedBranch":   refName,
 		},
 	)
 
-	return self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.MergeConfirmTitle,
-		Prompt: prompt,
-		HandleConfirm: func() error {
+	merge := func(opts git_commands.MergeOpts) func() error {
+		return func() error {
 			self.c.LogAction(self.c.Tr.Actions.Merge)
-			err := self.git.Branch.Merge(refName, git_commands.MergeOpts{})
+			err := self.git.Branch.Merge(refName, opts)
 			return self.CheckMergeOrRebase(err)
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.LcRegularMerge,
+				OnPress: merge(git_commands.MergeOpts{}),
+				Key:     'm',
+			},
+			{
+				Label:   self.c.Tr.LcNonFastForwardMerge,
+				OnPress: merge(git_commands.MergeOpts{NoFastForward: true}),
+				Key:     'n',
+			},
+			{
+				Label:   self.c.Tr.LcSquashMerge,
+				OnPress: func() error { return self.squashMerge(refName) },
+				Key:     's',
+			},
 		},
 	})
 }
+
+// squashMerge stages the changes from the given ref and opens the commit
+// message panel so that the user can commit them as a single commit, with a
+// message that lists the subjects of the squashed commits
+func (self *MergeAndRebaseHelper) squashMerge(refName string) error {
+	subjects, err := self.git.Commit.GetSubjectsToMerge(refName)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.SquashMerge)
+	if err := self.git.Branch.Merge(refName, git_commands.MergeOpts{Squash: true}); err != nil {
+		return self.CheckMergeOrRebase(err)
+	}
+
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}}); err != nil {
+		return err
+	}
+
+	// e.g. if the ref's changes were already cherry-picked onto our branch
+	if !self.workingTreeHelper.AnyStagedFiles() {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(
+			self.c.Tr.SquashMergeNoChanges,
+			map[string]string{"selectedBranch": refName},
+		))
+	}
+
+	message := fmt.Sprintf("Squashed '%s'\n\n%s", refName, strings.Join(
+		slices.Map(subjects, func(subject string) string { return "- " + subject }),
+		"\n",
+	))
+	if self.c.UserConfig.Git.Commit.AutoWrapCommitMessage {
+		message = utils.WrapLines(message, self.c.UserConfig.Git.Commit.AutoWrapWidth)
+	}
+
+	return self.workingTreeHelper.OpenCommitMessagePanel(message)
+}
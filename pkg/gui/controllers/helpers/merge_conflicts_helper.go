@@ -33,9 +33,10 @@ func (self *MergeConflictsHelper) GetMergingOptions() map[string]string {
 	return map[string]string{
 		fmt.Sprintf("%s %s", keybindings.Label(keybindingConfig.Universal.PrevItem), keybindings.Label(keybindingConfig.Universal.NextItem)):   self.c.Tr.LcSelectHunk,
 		fmt.Sprintf("%s %s", keybindings.Label(keybindingConfig.Universal.PrevBlock), keybindings.Label(keybindingConfig.Universal.NextBlock)): self.c.Tr.LcNavigateConflicts,
-		keybindings.Label(keybindingConfig.Universal.Select):   self.c.Tr.LcPickHunk,
-		keybindings.Label(keybindingConfig.Main.PickBothHunks): self.c.Tr.LcPickAllHunks,
-		keybindings.Label(keybindingConfig.Universal.Undo):     self.c.Tr.LcUndo,
+		keybindings.Label(keybindingConfig.Universal.Select):              self.c.Tr.LcPickHunk,
+		keybindings.Label(keybindingConfig.Main.PickBothHunks):            self.c.Tr.LcPickAllHunks,
+		keybindings.Label(keybindingConfig.Main.PickBothHunksBottomFirst): self.c.Tr.LcPickAllHunksBottomFirst,
+		keybindings.Label(keybindingConfig.Universal.Undo):                self.c.Tr.LcUndo,
 	}
 }
 
@@ -96,18 +97,20 @@ func (self *MergeConflictsHelper) SetConflictsAndRender(path string, isFocused b
 	return false, nil
 }
 
-func (self *MergeConflictsHelper) SwitchToMerge(path string) error {
+// SwitchToMerge switches to the merge conflicts view for the given file,
+// returning false if the file has no conflict markers left to resolve
+func (self *MergeConflictsHelper) SwitchToMerge(path string) (bool, error) {
 	if self.context().GetState().GetPath() != path {
 		hasConflicts, err := self.SetMergeState(path)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if !hasConflicts {
-			return nil
+			return false, nil
 		}
 	}
 
-	return self.c.PushContext(self.contexts.MergeConflicts)
+	return true, self.c.PushContext(self.contexts.MergeConflicts)
 }
 
 func (self *MergeConflictsHelper) context() *context.MergeConflictsContext {
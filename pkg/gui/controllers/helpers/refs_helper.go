@@ -23,10 +23,13 @@ type IRefsHelper interface {
 }
 
 type RefsHelper struct {
-	c        *types.HelperCommon
-	git      *commands.GitCommand
-	contexts *context.ContextTree
-	model    *types.Model
+	c            *types.HelperCommon
+	git          *commands.GitCommand
+	contexts     *context.ContextTree
+	model        *types.Model
+	detachedHead *DetachedHeadHelper
+
+	switchToWorktree func(path string) error
 }
 
 func NewRefsHelper(
@@ -34,24 +37,40 @@ func NewRefsHelper(
 	git *commands.GitCommand,
 	contexts *context.ContextTree,
 	model *types.Model,
+	detachedHead *DetachedHeadHelper,
+	switchToWorktree func(path string) error,
 ) *RefsHelper {
 	return &RefsHelper{
-		c:        c,
-		git:      git,
-		contexts: contexts,
-		model:    model,
+		c:                c,
+		git:              git,
+		contexts:         contexts,
+		model:            model,
+		detachedHead:     detachedHead,
+		switchToWorktree: switchToWorktree,
 	}
 }
 
 var _ IRefsHelper = &RefsHelper{}
 
 func (self *RefsHelper) CheckoutRef(ref string, options types.CheckoutRefOptions) error {
+	return self.detachedHead.WithDetachedCommitsCheck(func() error {
+		return self.checkoutRef(ref, options)
+	})
+}
+
+func (self *RefsHelper) checkoutRef(ref string, options types.CheckoutRefOptions) error {
 	waitingStatus := options.WaitingStatus
 	if waitingStatus == "" {
 		waitingStatus = self.c.Tr.CheckingOutStatus
 	}
 
-	cmdOptions := git_commands.CheckoutOptions{Force: false, EnvVars: options.EnvVars}
+	if !options.IgnoreOtherWorktrees {
+		if branch := self.branchInOtherWorktree(ref); branch != nil {
+			return self.checkoutBranchInOtherWorktreeMenu(branch, options)
+		}
+	}
+
+	cmdOptions := git_commands.CheckoutOptions{Force: false, IgnoreOtherWorktrees: options.IgnoreOtherWorktrees, EnvVars: options.EnvVars}
 
 	onSuccess := func() {
 		self.contexts.Branches.SetSelectedLineIdx(0)
@@ -104,6 +123,48 @@ func (self *RefsHelper) CheckoutRef(ref string, options types.CheckoutRefOptions
 	})
 }
 
+func (self *RefsHelper) branchInOtherWorktree(ref string) *models.Branch {
+	branch, ok := slices.Find(self.model.Branches, func(branch *models.Branch) bool {
+		return branch.Name == ref
+	})
+	if !ok || branch.WorktreePath == "" {
+		return nil
+	}
+
+	return branch
+}
+
+// git refuses to check out a branch that's checked out in another worktree, so
+// we offer to go to that worktree instead
+func (self *RefsHelper) checkoutBranchInOtherWorktreeMenu(branch *models.Branch, options types.CheckoutRefOptions) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.BranchInOtherWorktreeTitle,
+			map[string]string{"branchName": branch.Name},
+		),
+		Items: []*types.MenuItem{
+			{
+				Label: utils.ResolvePlaceholderString(
+					self.c.Tr.LcSwitchToWorktreeAtPath,
+					map[string]string{"path": branch.WorktreePath},
+				),
+				OnPress: func() error {
+					return self.switchToWorktree(branch.WorktreePath)
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.LcCheckoutIgnoringOtherWorktrees,
+				OnPress: func() error {
+					options.IgnoreOtherWorktrees = true
+					return self.CheckoutRef(branch.Name, options)
+				},
+				Key: 'c',
+			},
+		},
+	})
+}
+
 func (self *RefsHelper) GetCheckedOutRef() *models.Branch {
 	if len(self.model.Branches) == 0 {
 		return nil
@@ -173,7 +234,7 @@ func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggest
 		InitialContent: suggestedBranchName,
 		HandleConfirm: func(response string) error {
 			self.c.LogAction(self.c.Tr.Actions.CreateBranch)
-			if err := self.git.Branch.New(sanitizedBranchName(response), from); err != nil {
+			if err := self.git.Branch.New(SanitizedBranchName(response), from); err != nil {
 				return err
 			}
 
@@ -191,8 +252,8 @@ func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggest
 	})
 }
 
-// sanitizedBranchName will remove all spaces in favor of a dash "-" to meet
+// SanitizedBranchName will remove all spaces in favor of a dash "-" to meet
 // git's branch naming requirement.
-func sanitizedBranchName(input string) string {
+func SanitizedBranchName(input string) string {
 	return strings.Replace(input, " ", "-", -1)
 }
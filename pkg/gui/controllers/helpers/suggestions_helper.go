@@ -3,8 +3,10 @@ package helpers
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -35,6 +37,7 @@ type ISuggestionsHelper interface {
 type SuggestionsHelper struct {
 	c *types.HelperCommon
 
+	git                  *commands.GitCommand
 	model                *types.Model
 	refreshSuggestionsFn func()
 }
@@ -43,11 +46,13 @@ var _ ISuggestionsHelper = &SuggestionsHelper{}
 
 func NewSuggestionsHelper(
 	c *types.HelperCommon,
+	git *commands.GitCommand,
 	model *types.Model,
 	refreshSuggestionsFn func(),
 ) *SuggestionsHelper {
 	return &SuggestionsHelper{
 		c:                    c,
+		git:                  git,
 		model:                model,
 		refreshSuggestionsFn: refreshSuggestionsFn,
 	}
@@ -158,6 +163,10 @@ func (self *SuggestionsHelper) GetRemoteBranchesSuggestionsFunc(separator string
 	return FuzzySearchFunc(self.getRemoteBranchNames(separator))
 }
 
+func (self *SuggestionsHelper) GetLocalAndRemoteBranchesSuggestionsFunc() func(string) []*types.Suggestion {
+	return FuzzySearchFunc(append(self.getBranchNames(), self.getRemoteBranchNames("/")...))
+}
+
 func (self *SuggestionsHelper) getTagNames() []string {
 	return slices.Map(self.model.Tags, func(tag *models.Tag) string {
 		return tag.Name
@@ -183,6 +192,42 @@ func (self *SuggestionsHelper) GetAuthorsSuggestionsFunc() func(string) []*types
 	return FuzzySearchFunc(authors)
 }
 
+// GetAllAuthorsSuggestionsFunc suggests the authors of the history of HEAD
+// rather than just of the commits we've loaded, which may be filtered
+func (self *SuggestionsHelper) GetAllAuthorsSuggestionsFunc() func(string) []*types.Suggestion {
+	authors, err := self.git.Commit.GetAuthors()
+	if err != nil {
+		self.c.Log.Error(err)
+		return self.GetAuthorsSuggestionsFunc()
+	}
+
+	return FuzzySearchFunc(lo.Uniq(authors))
+}
+
+// GetIgnorePatternSuggestionsFunc suggests gitignore patterns for the given path,
+// which should be relative to the directory of the ignore file. Unlike the other
+// suggestion functions we ignore the input: the prompt starts out with the path
+// itself, which would otherwise filter out the broader patterns.
+func (self *SuggestionsHelper) GetIgnorePatternSuggestionsFunc(path string, isDir bool) func(string) []*types.Suggestion {
+	var patterns []string
+	if isDir {
+		patterns = []string{path + "/", filepath.Base(path) + "/"}
+	} else {
+		patterns = []string{path}
+		if ext := filepath.Ext(path); ext != "" {
+			patterns = append(patterns, "*"+ext)
+			if dir := filepath.Dir(path); dir != "." {
+				patterns = append(patterns, dir+"/*"+ext)
+			}
+		}
+	}
+	suggestions := matchesToSuggestions(lo.Uniq(patterns))
+
+	return func(string) []*types.Suggestion {
+		return suggestions
+	}
+}
+
 func FuzzySearchFunc(options []string) func(string) []*types.Suggestion {
 	return func(input string) []*types.Suggestion {
 		var matches []string
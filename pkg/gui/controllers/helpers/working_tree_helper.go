@@ -2,14 +2,23 @@ package helpers
 
 import (
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
 	"regexp"
+	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type IWorkingTreeHelper interface {
@@ -20,13 +29,14 @@ type IWorkingTreeHelper interface {
 }
 
 type WorkingTreeHelper struct {
-	c                     *types.HelperCommon
-	git                   *commands.GitCommand
-	contexts              *context.ContextTree
-	refHelper             *RefsHelper
-	model                 *types.Model
-	setCommitMessage      func(message string)
-	getSavedCommitMessage func() string
+	c                      *types.HelperCommon
+	git                    *commands.GitCommand
+	contexts               *context.ContextTree
+	refHelper              *RefsHelper
+	model                  *types.Model
+	setCommitMessage       func(message string)
+	setCommitMessageCursor func(x int, y int)
+	getSavedCommitMessage  func() string
 }
 
 func NewWorkingTreeHelper(
@@ -36,16 +46,18 @@ func NewWorkingTreeHelper(
 	refHelper *RefsHelper,
 	model *types.Model,
 	setCommitMessage func(message string),
+	setCommitMessageCursor func(x int, y int),
 	getSavedCommitMessage func() string,
 ) *WorkingTreeHelper {
 	return &WorkingTreeHelper{
-		c:                     c,
-		git:                   git,
-		contexts:              contexts,
-		refHelper:             refHelper,
-		model:                 model,
-		setCommitMessage:      setCommitMessage,
-		getSavedCommitMessage: getSavedCommitMessage,
+		c:                      c,
+		git:                    git,
+		contexts:               contexts,
+		refHelper:              refHelper,
+		model:                  model,
+		setCommitMessage:       setCommitMessage,
+		setCommitMessageCursor: setCommitMessageCursor,
+		getSavedCommitMessage:  getSavedCommitMessage,
 	}
 }
 
@@ -81,6 +93,65 @@ func (self *WorkingTreeHelper) FileForSubmodule(submodule *models.SubmoduleConfi
 	return nil
 }
 
+type HardResetOptions struct {
+	WaitingStatus string
+	EnvVars       []string
+	// the branch to check out and reset, if not the checked-out one
+	Branch string
+}
+
+// HardResetWithAutoStash hard resets to the given commit, offering to stash any
+// changes first and pop them afterwards
+func (self *WorkingTreeHelper) HardResetWithAutoStash(commitSha string, options HardResetOptions) error {
+	reset := func() error {
+		if options.Branch != "" {
+			if err := self.git.Branch.Checkout(options.Branch, git_commands.CheckoutOptions{EnvVars: options.EnvVars}); err != nil {
+				return self.c.Error(err)
+			}
+		}
+		if err := self.refHelper.ResetToRef(commitSha, "hard", options.EnvVars); err != nil {
+			return self.c.Error(err)
+		}
+		return nil
+	}
+
+	// if we have any modified tracked files we need to ask the user if they want us to stash for them
+	dirtyWorkingTree := self.IsWorkingTreeDirty()
+	if dirtyWorkingTree {
+		// offer to autostash changes
+		return self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.AutoStashTitle,
+			Prompt: self.c.Tr.AutoStashPrompt,
+			HandleConfirm: func() error {
+				return self.c.WithWaitingStatus(options.WaitingStatus, func() error {
+					if err := self.git.Stash.Save(self.c.Tr.StashPrefix + commitSha); err != nil {
+						return self.c.Error(err)
+					}
+					if err := reset(); err != nil {
+						return err
+					}
+
+					err := self.git.Stash.Pop(0)
+					// so that we don't stop waiting until the popped changes are
+					// showing, given that whether there are any changes decides
+					// whether to stash them next time
+					if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
+						return err
+					}
+					if err != nil {
+						return self.c.Error(err)
+					}
+					return nil
+				})
+			},
+		})
+	}
+
+	return self.c.WithWaitingStatus(options.WaitingStatus, func() error {
+		return reset()
+	})
+}
+
 func (self *WorkingTreeHelper) OpenMergeTool() error {
 	return self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.MergeToolTitle,
@@ -94,6 +165,100 @@ func (self *WorkingTreeHelper) OpenMergeTool() error {
 	})
 }
 
+// OpenMergeToolForFile resolves a single file's conflicts in the user's
+// mergetool, then offers to stage the file if no conflict markers are left
+func (self *WorkingTreeHelper) OpenMergeToolForFile(path string) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenMergeTool)
+	ok, err := self.c.RunSubprocess(self.git.WorkingTree.OpenMergeToolForFileCmdObj(path))
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := self.git.WorkingTree.RemoveMergeToolBackup(path); err != nil {
+		return self.c.Error(err)
+	}
+
+	refresh := func() error {
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	}
+
+	hasConflictMarkers, err := mergeconflicts.FileHasConflictMarkers(path)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	// git mergetool stages the file itself when the tool reports success
+	stillUnmerged := lo.SomeBy(
+		self.git.Loaders.FileLoader.GetStatusFiles(git_commands.GetStatusFileOptions{}),
+		func(file *models.File) bool { return file.Name == path && file.HasMergeConflicts },
+	)
+	if hasConflictMarkers || !stillUnmerged {
+		return refresh()
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.MergeToolResolvedTitle,
+		Prompt: fmt.Sprintf(self.c.Tr.MergeToolResolvedPrompt, path),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.StageFile)
+			if err := self.git.WorkingTree.StageFile(path); err != nil {
+				return self.c.Error(err)
+			}
+
+			return refresh()
+		},
+		HandleClose: refresh,
+	})
+}
+
+// CreateFlaggedFilesMenu lists the files marked as skip-worktree or
+// assume-unchanged, so that they don't get forgotten. Selecting one unmarks it.
+func (self *WorkingTreeHelper) CreateFlaggedFilesMenu() error {
+	flaggedFiles, err := self.git.Loaders.FileLoader.GetFlaggedFiles()
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if len(flaggedFiles) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoFlaggedFiles)
+	}
+
+	menuItems := slices.Map(flaggedFiles, func(file *models.FlaggedFile) *types.MenuItem {
+		flags := []string{}
+		if file.SkipWorktree {
+			flags = append(flags, "skip-worktree")
+		}
+		if file.AssumeUnchanged {
+			flags = append(flags, "assume-unchanged")
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{file.Name, style.FgYellow.Sprint(strings.Join(flags, ", "))},
+			OnPress: func() error {
+				if file.SkipWorktree {
+					self.c.LogAction(self.c.Tr.Actions.ToggleSkipWorktree)
+					if err := self.git.WorkingTree.SetSkipWorktree([]string{file.Name}, false); err != nil {
+						return self.c.Error(err)
+					}
+				}
+				if file.AssumeUnchanged {
+					self.c.LogAction(self.c.Tr.Actions.ToggleAssumeUnchanged)
+					if err := self.git.WorkingTree.SetAssumeUnchanged([]string{file.Name}, false); err != nil {
+						return self.c.Error(err)
+					}
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FlaggedFilesTitle,
+		Items: menuItems,
+	})
+}
+
 func (self *WorkingTreeHelper) HandleCommitPress() error {
 	if err := self.prepareFilesForCommit(); err != nil {
 		return self.c.Error(err)
@@ -108,8 +273,20 @@ func (self *WorkingTreeHelper) HandleCommitPress() error {
 	}
 
 	savedCommitMessage := self.getSavedCommitMessage()
+	template := self.getCommitTemplate()
+
 	if len(savedCommitMessage) > 0 {
 		self.setCommitMessage(savedCommitMessage)
+	} else if template != "" {
+		self.setCommitMessage(template)
+		// templates typically leave the first line empty for the summary,
+		// followed by comments explaining what to write
+		for i, line := range strings.Split(template, "\n") {
+			if strings.TrimSpace(line) == "" {
+				self.setCommitMessageCursor(0, i)
+				break
+			}
+		}
 	} else {
 		commitPrefixConfig := self.commitPrefixConfigForRepo()
 		if commitPrefixConfig != nil {
@@ -131,6 +308,14 @@ func (self *WorkingTreeHelper) HandleCommitPress() error {
 	return nil
 }
 
+// OpenCommitMessagePanel opens the commit message panel with the given message
+// filled in, for when we've staged changes on the user's behalf
+func (self *WorkingTreeHelper) OpenCommitMessagePanel(message string) error {
+	self.setCommitMessage(message)
+
+	return self.c.PushContext(self.contexts.CommitMessage)
+}
+
 // HandleCommitEditorPress - handle when the user wants to commit changes via
 // their editor rather than via the popup panel
 func (self *WorkingTreeHelper) HandleCommitEditorPress() error {
@@ -197,6 +382,77 @@ func (self *WorkingTreeHelper) prepareFilesForCommit() error {
 	return nil
 }
 
+// getCommitTemplate returns the template to prefill the commit message panel
+// with, or an empty string if there isn't one. A template matched by branch name
+// wins over git's commit.template, which in turn wins over a .gitmessage file in
+// the repo's root. A template we can't read shouldn't stop the user from
+// committing, so in that case we log the error and carry on without one.
+func (self *WorkingTreeHelper) getCommitTemplate() string {
+	path := ""
+	if checkedOutRef := self.refHelper.GetCheckedOutRef(); checkedOutRef != nil {
+		path = matchCommitTemplateGlob(self.c.UserConfig.Git.Commit.TemplateGlobs, checkedOutRef.Name)
+	}
+	if path == "" {
+		path = self.git.Config.GetCommitTemplatePath()
+	}
+	if path == "" {
+		if _, err := os.Stat(".gitmessage"); err != nil {
+			return ""
+		}
+		path = ".gitmessage"
+	}
+
+	if strings.HasPrefix(path, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			self.c.Log.Errorf("could not find the home directory for commit template %s: %v", path, err)
+			return ""
+		}
+		path = filepath.Join(homeDir, strings.TrimPrefix(path, "~/"))
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		self.c.Log.Errorf("could not read commit template: %v", err)
+		return ""
+	}
+
+	return string(content)
+}
+
+// StripCommitTemplateComments removes the comment lines from a commit message
+// if the user has a commit template, the way git does when you commit with an
+// editor. Without a template we leave the message alone, because then a line
+// like '#123 fix typo' is more likely to be an issue reference.
+func (self *WorkingTreeHelper) StripCommitTemplateComments(message string) string {
+	if self.getCommitTemplate() == "" {
+		return message
+	}
+
+	return stripCommentLines(message, self.git.Config.GetCommentChar())
+}
+
+func stripCommentLines(message string, commentChar string) string {
+	lines := slices.Filter(strings.Split(message, "\n"), func(line string) bool {
+		return !strings.HasPrefix(line, commentChar)
+	})
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// matchCommitTemplateGlob returns the template for the longest of the globs that
+// matches the branch name, or an empty string if none of them do
+func matchCommitTemplateGlob(templateGlobs map[string]string, branchName string) string {
+	longestGlob := ""
+	for glob := range templateGlobs {
+		if matched, _ := path.Match(glob, branchName); matched && len(glob) > len(longestGlob) {
+			longestGlob = glob
+		}
+	}
+
+	return templateGlobs[longestGlob]
+}
+
 func (self *WorkingTreeHelper) commitPrefixConfigForRepo() *config.CommitPrefixConfig {
 	cfg, ok := self.c.UserConfig.Git.CommitPrefixes[utils.GetCurrentRepoName()]
 	if !ok {
@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchCommitTemplateGlob(t *testing.T) {
+	templateGlobs := map[string]string{
+		"feature/*":     "feature-template",
+		"feature/ui-*":  "ui-template",
+		"release-[0-9]": "release-template",
+	}
+
+	cases := []struct {
+		branchName string
+		expected   string
+	}{
+		{"feature/login", "feature-template"},
+		{"feature/ui-buttons", "ui-template"},
+		{"release-1", "release-template"},
+		{"master", ""},
+		{"feature/nested/branch", ""},
+	}
+
+	for _, c := range cases {
+		assert.EqualValues(t, c.expected, matchCommitTemplateGlob(templateGlobs, c.branchName))
+	}
+}
+
+func TestStripCommentLines(t *testing.T) {
+	cases := []struct {
+		message     string
+		commentChar string
+		expected    string
+	}{
+		{"fix the thing\n\n# Please enter a message\n# Lines starting with '#' are ignored", "#", "fix the thing"},
+		{"fix #123\n; explain why", ";", "fix #123"},
+		{"#123 fix typo", ";", "#123 fix typo"},
+	}
+
+	for _, c := range cases {
+		assert.EqualValues(t, c.expected, stripCommentLines(c.message, c.commentChar))
+	}
+}
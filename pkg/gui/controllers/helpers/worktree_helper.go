@@ -0,0 +1,162 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type WorktreeHelper struct {
+	c           *types.HelperCommon
+	git         *commands.GitCommand
+	model       *types.Model
+	suggestions *SuggestionsHelper
+
+	switchToRepo func(path string) error
+}
+
+func NewWorktreeHelper(
+	c *types.HelperCommon,
+	git *commands.GitCommand,
+	model *types.Model,
+	suggestions *SuggestionsHelper,
+	switchToRepo func(path string) error,
+) *WorktreeHelper {
+	return &WorktreeHelper{
+		c:            c,
+		git:          git,
+		model:        model,
+		suggestions:  suggestions,
+		switchToRepo: switchToRepo,
+	}
+}
+
+// CreateWorktreeMenu offers to create a worktree either from a (local or
+// remote) branch or with a detached HEAD. initialRef prefills the prompts.
+func (self *WorktreeHelper) CreateWorktreeMenu(initialRef string) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CreateWorktreeMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LcCreateWorktreeFromBranch,
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title:               self.c.Tr.CreateWorktreeBranchPrompt,
+						InitialContent:      initialRef,
+						FindSuggestionsFunc: self.suggestions.GetLocalAndRemoteBranchesSuggestionsFunc(),
+						HandleConfirm:       self.NewWorktreeFromBranch,
+					})
+				},
+				Key: 'b',
+			},
+			{
+				Label: self.c.Tr.LcCreateDetachedWorktree,
+				OnPress: func() error {
+					return self.c.Prompt(types.PromptOpts{
+						Title:               self.c.Tr.CreateWorktreeRefPrompt,
+						InitialContent:      initialRef,
+						FindSuggestionsFunc: self.suggestions.GetRefsSuggestionsFunc(),
+						HandleConfirm:       self.NewDetachedWorktree,
+					})
+				},
+				Key: 'd',
+			},
+		},
+	})
+}
+
+// NewWorktreeFromBranch creates a worktree for the given branch. If it names a
+// remote branch that we don't have locally, a local branch tracking it is
+// created along with the worktree.
+func (self *WorktreeHelper) NewWorktreeFromBranch(branchName string) error {
+	if self.localBranchExists(branchName) {
+		return self.promptForPath(branchName, func(path string) error {
+			return self.git.Worktree.New(path, branchName)
+		})
+	}
+
+	remoteBranch := self.findRemoteBranch(branchName)
+	if remoteBranch == nil {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(
+			self.c.Tr.CreateWorktreeBranchNotFound,
+			map[string]string{"branchName": branchName},
+		))
+	}
+
+	// if we already have a local branch of that name we'll use it rather than
+	// failing to create another one
+	if self.localBranchExists(remoteBranch.Name) {
+		return self.NewWorktreeFromBranch(remoteBranch.Name)
+	}
+
+	return self.promptForPath(remoteBranch.Name, func(path string) error {
+		return self.git.Worktree.NewFromRemoteBranch(path, remoteBranch.Name, remoteBranch.FullName())
+	})
+}
+
+func (self *WorktreeHelper) NewDetachedWorktree(ref string) error {
+	return self.promptForPath(ref, func(path string) error {
+		return self.git.Worktree.NewDetached(path, ref)
+	})
+}
+
+func (self *WorktreeHelper) promptForPath(name string, create func(path string) error) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.CreateWorktreePathPrompt,
+		InitialContent: self.defaultPath(name),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.CreateWorktree)
+			if err := create(path); err != nil {
+				return self.c.Error(err)
+			}
+
+			if err := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
+				return err
+			}
+
+			return self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.SwitchToWorktreeTitle,
+				Prompt: self.c.Tr.SwitchToWorktreePrompt,
+				HandleConfirm: func() error {
+					return self.switchToRepo(path)
+				},
+			})
+		},
+	})
+}
+
+// defaultPath is a directory next to the current repo, named after the branch
+func (self *WorktreeHelper) defaultPath(name string) string {
+	dirName := strings.ReplaceAll(name, "/", "-")
+
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return dirName
+	}
+
+	return filepath.Join(filepath.Dir(repoPath), dirName)
+}
+
+func (self *WorktreeHelper) localBranchExists(branchName string) bool {
+	return slices.Some(self.model.Branches, func(branch *models.Branch) bool {
+		return branch.Name == branchName
+	})
+}
+
+func (self *WorktreeHelper) findRemoteBranch(fullName string) *models.RemoteBranch {
+	for _, remote := range self.model.Remotes {
+		for _, branch := range remote.Branches {
+			if branch.FullName() == fullName {
+				return branch
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// given we have no fields here, arguably we shouldn't even need this factory
+// struct, but we're maintaining consistency with the other files.
+type HorizontalScrollControllerFactory struct {
+	controllerCommon *controllerCommon
+}
+
+func NewHorizontalScrollControllerFactory(c *controllerCommon) *HorizontalScrollControllerFactory {
+	return &HorizontalScrollControllerFactory{
+		controllerCommon: c,
+	}
+}
+
+func (self *HorizontalScrollControllerFactory) Create(context types.Context) types.IController {
+	return &HorizontalScrollController{
+		baseController:   baseController{},
+		controllerCommon: self.controllerCommon,
+		context:          context,
+	}
+}
+
+// HorizontalScrollController lets you scroll wide content sideways with a
+// trackpad or horizontal mouse wheel. Many terminals also send horizontal wheel
+// events when scrolling with shift held.
+type HorizontalScrollController struct {
+	baseController
+	*controllerCommon
+
+	context types.Context
+}
+
+func (self *HorizontalScrollController) Context() types.Context {
+	return self.context
+}
+
+func (self *HorizontalScrollController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{}
+}
+
+func (self *HorizontalScrollController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
+	return []*gocui.ViewMouseBinding{
+		{
+			ViewName: self.context.GetViewName(),
+			Key:      gocui.MouseWheelLeft,
+			Handler:  func(gocui.ViewMouseBindingOpts) error { return self.HandleScrollLeft() },
+		},
+		{
+			ViewName: self.context.GetViewName(),
+			Key:      gocui.MouseWheelRight,
+			Handler:  func(gocui.ViewMouseBindingOpts) error { return self.HandleScrollRight() },
+		},
+	}
+}
+
+func (self *HorizontalScrollController) HandleScrollLeft() error {
+	self.context.GetViewTrait().ScrollLeft()
+
+	return nil
+}
+
+func (self *HorizontalScrollController) HandleScrollRight() error {
+	self.context.GetViewTrait().ScrollRight()
+
+	return nil
+}
@@ -87,6 +87,10 @@ func (self *ListController) scrollHorizontal(scrollFunc func()) error {
 }
 
 func (self *ListController) handleLineChange(change int) error {
+	if err := self.cancelRangeSelect(); err != nil {
+		return err
+	}
+
 	before := self.context.GetList().GetSelectedLineIdx()
 	self.context.GetList().MoveSelectedLine(change)
 	after := self.context.GetList().GetSelectedLineIdx()
@@ -133,6 +137,10 @@ func (self *ListController) HandleClick(opts gocui.ViewMouseBindingOpts) error {
 		return nil
 	}
 
+	if err := self.cancelRangeSelect(); err != nil {
+		return err
+	}
+
 	self.context.GetList().SetSelectedLineIdx(newSelectedLineIdx)
 
 	if prevSelectedLineIdx == newSelectedLineIdx && alreadyFocused && self.context.GetOnClick() != nil {
@@ -141,6 +149,47 @@ func (self *ListController) HandleClick(opts gocui.ViewMouseBindingOpts) error {
 	return self.context.HandleFocus(types.OnFocusOpts{})
 }
 
+// dragging selects the range from the line where the mouse went down to the
+// line it's over now. In lists that have no use for a range it just moves the
+// selection along with the mouse
+func (self *ListController) HandleDrag(opts gocui.ViewMouseBindingOpts) error {
+	if !self.isFocused() || opts.Y < 0 || opts.Y > self.context.GetList().Len()-1 {
+		return nil
+	}
+
+	list := self.context.GetList()
+	if opts.Y == list.GetSelectedLineIdx() {
+		return nil
+	}
+
+	if !self.context.CanSelectRange() {
+		list.SetSelectedLineIdx(opts.Y)
+		return self.context.HandleFocus(types.OnFocusOpts{})
+	}
+
+	if !list.IsSelectingRange() {
+		list.SetRangeSelectStart(list.GetSelectedLineIdx())
+	}
+	list.SetSelectedLineIdx(opts.Y)
+
+	// re-rendering to clear the highlight from lines that have left the range
+	if err := self.context.HandleRender(); err != nil {
+		return err
+	}
+
+	return self.context.HandleFocus(types.OnFocusOpts{})
+}
+
+// moving the selection any other way selects a single line again
+func (self *ListController) cancelRangeSelect() error {
+	if !self.context.GetList().IsSelectingRange() {
+		return nil
+	}
+
+	self.context.GetList().CancelRangeSelect()
+	return self.context.HandleRender()
+}
+
 func (self *ListController) pushContextIfNotFocused() error {
 	if !self.isFocused() {
 		if err := self.c.PushContext(self.context); err != nil {
@@ -193,10 +242,26 @@ func (self *ListController) GetMouseKeybindings(opts types.KeybindingsOpts) []*g
 			Key:      gocui.MouseLeft,
 			Handler:  func(opts gocui.ViewMouseBindingOpts) error { return self.HandleClick(opts) },
 		},
+		{
+			ViewName: self.context.GetViewName(),
+			Key:      gocui.MouseLeft,
+			Modifier: gocui.ModMotion,
+			Handler:  func(opts gocui.ViewMouseBindingOpts) error { return self.HandleDrag(opts) },
+		},
 		{
 			ViewName: self.context.GetViewName(),
 			Key:      gocui.MouseWheelDown,
 			Handler:  func(gocui.ViewMouseBindingOpts) error { return self.HandleScrollDown() },
 		},
+		{
+			ViewName: self.context.GetViewName(),
+			Key:      gocui.MouseWheelLeft,
+			Handler:  func(gocui.ViewMouseBindingOpts) error { return self.HandleScrollLeft() },
+		},
+		{
+			ViewName: self.context.GetViewName(),
+			Key:      gocui.MouseWheelRight,
+			Handler:  func(gocui.ViewMouseBindingOpts) error { return self.HandleScrollRight() },
+		},
 	}
 }
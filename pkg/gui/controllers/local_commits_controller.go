@@ -2,11 +2,16 @@ package controllers
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type (
@@ -55,9 +60,14 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.checkSelected(self.rewordEditor),
 			Description: self.c.Tr.LcRenameCommitEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.RenameCommitsWithEditor),
+			Handler:     self.checkSelected(self.rewordCommitsEditor),
+			Description: self.c.Tr.LcRewordCommitsInEditor,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Remove),
-			Handler:     self.checkSelected(self.drop),
+			Handler:     self.checkSelectedEntry(self.drop),
 			Description: self.c.Tr.LcDeleteCommit,
 		},
 		{
@@ -82,14 +92,24 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.MoveDownCommit),
-			Handler:     self.checkSelected(self.moveDown),
+			Handler:     self.checkSelectedEntry(self.moveDown),
 			Description: self.c.Tr.LcMoveDownCommit,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.MoveUpCommit),
-			Handler:     self.checkSelected(self.moveUp),
+			Handler:     self.checkSelectedEntry(self.moveUp),
 			Description: self.c.Tr.LcMoveUpCommit,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.AddExecTodo),
+			Handler:     self.checkSelectedEntry(self.addExecTodo),
+			Description: self.c.Tr.LcAddExecTodo,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.AddBreakTodo),
+			Handler:     self.checkSelectedEntry(self.addBreakTodo),
+			Description: self.c.Tr.LcAddBreakTodo,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.PasteCommits),
 			Handler:     opts.Guards.OutsideFilterMode(self.paste),
@@ -109,6 +129,12 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description: self.c.Tr.LcGotoBottom,
 			Tag:         "navigation",
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.RebaseOntoRef),
+			Handler:     self.helpers.MergeAndRebase.CreateRebaseOntoRefMenu,
+			Description: self.c.Tr.LcRebaseOntoRef,
+			OpensMenu:   true,
+		},
 	}
 
 	for _, binding := range outsideFilterModeBindings {
@@ -142,6 +168,21 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description: self.c.Tr.LcOpenLogMenu,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.VerifySignature),
+			Handler:     self.checkSelected(self.verifySignature),
+			Description: self.c.Tr.LcVerifyCommitSignature,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.MoveCommitsToBranch),
+			Handler:     self.checkSelected(self.moveToBranch),
+			Description: self.c.Tr.LcMoveCommitsToBranch,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ToggleMarkCommit),
+			Handler:     self.checkSelected(self.toggleMark),
+			Description: self.c.Tr.LcToggleMarkCommit,
+		},
 	}...)
 
 	return bindings
@@ -266,7 +307,54 @@ func (self *LocalCommitsController) rewordEditor(commit *models.Commit) error {
 	}
 }
 
+func (self *LocalCommitsController) rewordCommitsEditor(commit *models.Commit) error {
+	midRebase, err := self.handleMidRebaseCommand("reword", commit)
+	if err != nil {
+		return err
+	}
+	if midRebase {
+		return nil
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RewordInEditorTitle,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.RewordCommitsInEditorPrompt,
+			map[string]string{
+				"count": fmt.Sprintf("%d", self.context().GetSelectedLineIdx()),
+			},
+		),
+		HandleConfirm: self.doRewordCommitsEditor,
+	})
+}
+
+func (self *LocalCommitsController) doRewordCommitsEditor() error {
+	self.c.LogAction(self.c.Tr.Actions.RewordCommits)
+
+	subProcess := self.git.Rebase.RewordCommitsInEditor(
+		self.model.Commits, self.context().GetSelectedLineIdx(),
+	)
+	if _, err := self.c.RunSubprocess(subProcess); err != nil {
+		return err
+	}
+
+	// the rebase only stops if the user cancelled rewording one of the commits
+	// (e.g. by leaving its message empty), in which case we put the branch back
+	// the way it was rather than leaving it half reworded
+	if self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		if err := self.git.Rebase.AbortRebase(); err != nil {
+			return self.c.Error(err)
+		}
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
+
 func (self *LocalCommitsController) drop(commit *models.Commit) error {
+	if indexes := self.getMultiSelectedIndexes(); len(indexes) > 0 {
+		return self.dropCommits(indexes)
+	}
+
 	applied, err := self.handleMidRebaseCommand("drop", commit)
 	if err != nil {
 		return err
@@ -287,6 +375,143 @@ func (self *LocalCommitsController) drop(commit *models.Commit) error {
 	})
 }
 
+func (self *LocalCommitsController) dropCommits(indexes []int) error {
+	if self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return self.c.ErrorMsg(self.c.Tr.CantDropCommitsWhileRebasing)
+	}
+
+	if self.dropWouldRebaseOverMergeCommit(indexes) {
+		return self.c.ErrorMsg(self.c.Tr.CantRebaseOverMergeCommit)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DeleteCommitTitle,
+		Prompt: fmt.Sprintf(self.c.Tr.DeleteCommitsPrompt, len(indexes)),
+		HandleConfirm: func() error {
+			self.clearMultiSelection()
+
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func() error {
+				self.c.LogAction(self.c.Tr.Actions.DropCommits)
+				err := self.git.Rebase.DropCommits(self.model.Commits, indexes)
+				return self.helpers.MergeAndRebase.CheckMergeOrRebase(err)
+			})
+		},
+	})
+}
+
+// Dropping the commits at the given indexes means rebasing every commit above
+// the lowest of them, and we'd lose any merge commits among those we're keeping
+func (self *LocalCommitsController) dropWouldRebaseOverMergeCommit(indexes []int) bool {
+	for i, commit := range self.model.Commits[:lo.Max(indexes)] {
+		if commit.IsMerge() && !lo.Contains(indexes, i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getMultiSelectedIndexes returns the indexes of the commits that actions on
+// several commits at once apply to: the marked commits if there are any,
+// otherwise the range selected by dragging. It returns nil if neither is the
+// case, and the action should apply to the selected commit.
+func (self *LocalCommitsController) getMultiSelectedIndexes() []int {
+	if indexes := self.context().GetMarkedIndexes(); len(indexes) > 0 {
+		return indexes
+	}
+
+	list := self.context().GetList()
+	if !list.IsSelectingRange() {
+		return nil
+	}
+	start, end := list.GetSelectionRange()
+	if start == end {
+		return nil
+	}
+
+	return lo.RangeFrom(start, end-start+1)
+}
+
+func (self *LocalCommitsController) clearMultiSelection() {
+	self.context().ClearMarked()
+	self.context().GetList().CancelRangeSelect()
+}
+
+func (self *LocalCommitsController) toggleMark(commit *models.Commit) error {
+	self.context().ToggleMarked(commit.Sha)
+
+	return self.c.PostRefreshUpdate(self.context())
+}
+
+// moveToBranch moves the selected commit, or the marked or dragged over commits
+// if there are any, onto another branch by cherry-picking them onto it and then dropping
+// them from this one. If they can't be dropped we put the other branch back
+// where it was, so that the commits don't end up on both branches.
+func (self *LocalCommitsController) moveToBranch(commit *models.Commit) error {
+	if self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return self.c.ErrorMsg(self.c.Tr.CantMoveCommitsWhileRebasing)
+	}
+
+	indexes := self.getMultiSelectedIndexes()
+	if len(indexes) == 0 {
+		indexes = []int{self.context().GetSelectedLineIdx()}
+	}
+
+	commits := self.model.Commits
+	if lo.SomeBy(indexes, func(i int) bool { return commits[i].IsMerge() }) {
+		return self.c.ErrorMsg(self.c.Tr.CantMoveMergeCommits)
+	}
+	if self.dropWouldRebaseOverMergeCommit(indexes) {
+		return self.c.ErrorMsg(self.c.Tr.CantRebaseOverMergeCommit)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.MoveCommitsToBranchTitle,
+		FindSuggestionsFunc: self.helpers.Suggestions.GetBranchNameSuggestionsFunc(),
+		HandleConfirm: func(branchName string) error {
+			if branchName == self.helpers.Refs.GetCheckedOutRef().Name {
+				return self.c.ErrorMsg(self.c.Tr.CantMoveCommitsToCheckedOutBranch)
+			}
+			if !lo.SomeBy(self.model.Branches, func(branch *models.Branch) bool { return branch.Name == branchName }) {
+				return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.MoveCommitsBranchNotFound, branchName))
+			}
+
+			// commits are listed newest first but need to be cherry-picked oldest first
+			shas := []string{}
+			for i := len(commits) - 1; i >= 0; i-- {
+				if lo.Contains(indexes, i) {
+					shas = append(shas, commits[i].Sha)
+				}
+			}
+
+			self.clearMultiSelection()
+
+			return self.c.WithWaitingStatus(self.c.Tr.MovingStatus, func() error {
+				self.c.LogAction(self.c.Tr.Actions.MoveCommitsToBranch)
+				oldSha, newSha, err := self.git.Commit.CherryPickOntoBranch(branchName, shas)
+				if err != nil {
+					return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.MoveCommitsCherryPickFailed, branchName, err.Error()))
+				}
+
+				if err := self.git.Branch.UpdateRef(branchName, newSha, oldSha); err != nil {
+					return self.c.Error(err)
+				}
+
+				if err := self.git.Rebase.DropCommits(commits, indexes); err != nil {
+					if self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+						_ = self.git.Rebase.AbortRebase()
+					}
+					_ = self.git.Branch.UpdateRef(branchName, oldSha, newSha)
+					_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.MoveCommitsDropFailed, err.Error()))
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			})
+		},
+	})
+}
+
 func (self *LocalCommitsController) edit(commit *models.Commit) error {
 	applied, err := self.handleMidRebaseCommand("edit", commit)
 	if err != nil {
@@ -330,6 +555,10 @@ func (self *LocalCommitsController) handleMidRebaseCommand(action string, commit
 		return false, nil
 	}
 
+	if commit.IsTODOCommand() {
+		return true, self.handleMidRebaseCommandForTodoCommand(action, commit)
+	}
+
 	// for now we do not support setting 'reword' because it requires an editor
 	// and that means we either unconditionally wait around for the subprocess to ask for
 	// our input or we set a lazygit client as the EDITOR env variable and have it
@@ -355,6 +584,29 @@ func (self *LocalCommitsController) handleMidRebaseCommand(action string, commit
 	})
 }
 
+// handleMidRebaseCommandForTodoCommand handles an action on an entry like
+// 'exec' that doesn't have a commit to apply the action to. The only one that
+// makes sense is dropping, which removes the entry.
+func (self *LocalCommitsController) handleMidRebaseCommandForTodoCommand(action string, commit *models.Commit) error {
+	if action != "drop" || commit.IsRebaseMergesTODO() {
+		return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.NotAllowedForTodoCommand, commit.Action))
+	}
+
+	self.c.LogAction("Update rebase TODO")
+	self.c.LogCommand(
+		fmt.Sprintf("Removing '%s' from rebase TODO", strings.TrimSpace(commit.Action+" "+commit.Name)),
+		false,
+	)
+
+	if err := self.git.Rebase.DeleteRebaseTodo(self.context().GetSelectedLineIdx()); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{
+		Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+	})
+}
+
 func (self *LocalCommitsController) moveDown(commit *models.Commit) error {
 	index := self.context().GetSelectedLineIdx()
 	commits := self.model.Commits
@@ -369,6 +621,10 @@ func (self *LocalCommitsController) moveDown(commit *models.Commit) error {
 			return nil
 		}
 
+		if commit.IsRebaseMergesTODO() || commits[index+1].IsRebaseMergesTODO() {
+			return self.c.ErrorMsg(self.c.Tr.CantMovePastRebaseMergesTodo)
+		}
+
 		// logging directly here because MoveTodoDown doesn't have enough information
 		// to provide a useful log
 		self.c.LogAction(self.c.Tr.Actions.MoveCommitDown)
@@ -400,6 +656,10 @@ func (self *LocalCommitsController) moveUp(commit *models.Commit) error {
 	}
 
 	if commit.Status == "rebasing" {
+		if commit.IsRebaseMergesTODO() || self.model.Commits[index-1].IsRebaseMergesTODO() {
+			return self.c.ErrorMsg(self.c.Tr.CantMovePastRebaseMergesTodo)
+		}
+
 		// logging directly here because MoveTodoDown doesn't have enough information
 		// to provide a useful log
 		self.c.LogAction(self.c.Tr.Actions.MoveCommitUp)
@@ -427,10 +687,77 @@ func (self *LocalCommitsController) moveUp(commit *models.Commit) error {
 	})
 }
 
+func (self *LocalCommitsController) addExecTodo(commit *models.Commit) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ExecTodoTitle,
+		HandleConfirm: func(command string) error {
+			if strings.TrimSpace(command) == "" {
+				return nil
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.AddExecTodo)
+			return self.insertTodoAfter(commit, git_commands.TodoLine{Action: "exec", Command: command})
+		},
+	})
+}
+
+func (self *LocalCommitsController) addBreakTodo(commit *models.Commit) error {
+	self.c.LogAction(self.c.Tr.Actions.AddBreakTodo)
+	return self.insertTodoAfter(commit, git_commands.TodoLine{Action: "break"})
+}
+
+// insertTodoAfter adds the given line to the rebase right after the selected
+// entry, starting a rebase for it if we're not already in one
+func (self *LocalCommitsController) insertTodoAfter(commit *models.Commit, todoLine git_commands.TodoLine) error {
+	index := self.context().GetSelectedLineIdx()
+
+	if commit.Status == "rebasing" {
+		self.c.LogCommand(
+			fmt.Sprintf("Adding '%s' to rebase TODO", strings.TrimSpace(todoLine.ToString())),
+			false,
+		)
+
+		if err := self.git.Rebase.InsertRebaseTodoAfter(index, todoLine); err != nil {
+			return self.c.Error(err)
+		}
+		return self.c.Refresh(types.RefreshOptions{
+			Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+		})
+	}
+
+	if self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return self.c.ErrorMsg(self.c.Tr.CantAddTodoToAppliedCommit)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func() error {
+		err := self.git.Rebase.InteractiveRebaseInsertAfter(self.model.Commits, index, todoLine)
+		return self.helpers.MergeAndRebase.CheckMergeOrRebase(err)
+	})
+}
+
 func (self *LocalCommitsController) amendTo(commit *models.Commit) error {
+	if !self.helpers.WorkingTree.AnyStagedFiles() {
+		return self.c.ErrorMsg(self.c.Tr.NoStagedChangesToAmendWith)
+	}
+
+	// rewriting a commit which others may have built on deserves a warning
+	prompt := self.c.Tr.AmendCommitPrompt
+	pushedMainBranches, err := self.git.Branch.GetPushedMainBranchesContaining(commit.Sha)
+	if err != nil {
+		return self.c.Error(err)
+	}
+	if len(pushedMainBranches) > 0 {
+		prompt = utils.ResolvePlaceholderString(
+			self.c.Tr.AmendPushedCommitPrompt,
+			map[string]string{
+				"branches": strings.Join(pushedMainBranches, ", "),
+			},
+		)
+	}
+
 	return self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.AmendCommitTitle,
-		Prompt: self.c.Tr.AmendCommitPrompt,
+		Prompt: prompt,
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func() error {
 				self.c.LogAction(self.c.Tr.Actions.AmendCommit)
@@ -457,6 +784,22 @@ func (self *LocalCommitsController) amendAttribute(commit *models.Commit) error
 				Key:     'A',
 				Tooltip: "Set the author based on a prompt",
 			},
+			{
+				Label: self.c.Tr.LcChangeAuthorAndDate,
+				OnPress: func() error {
+					return self.changeAuthorAndDate(commit, false)
+				},
+				Key:     'd',
+				Tooltip: self.c.Tr.ChangeAuthorAndDateTooltip,
+			},
+			{
+				Label: self.c.Tr.LcChangeAuthorAndDateOfCommitsAbove,
+				OnPress: func() error {
+					return self.changeAuthorAndDate(commit, true)
+				},
+				Key:     'D',
+				Tooltip: self.c.Tr.ChangeAuthorAndDateTooltip,
+			},
 		},
 	})
 }
@@ -489,6 +832,60 @@ func (self *LocalCommitsController) setAuthor() error {
 	})
 }
 
+// changeAuthorAndDate prompts for an author and an optional date and applies
+// them to the given commit, along with every commit above it if asked to.
+func (self *LocalCommitsController) changeAuthorAndDate(commit *models.Commit, includeCommitsAbove bool) error {
+	if self.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return self.c.ErrorMsg(self.c.Tr.CantChangeAuthorWhileRebasing)
+	}
+
+	endIdx := self.context().GetSelectedLineIdx()
+	startIdx := endIdx
+	if includeCommitsAbove {
+		startIdx = 0
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.SetAuthorPromptTitle,
+		InitialContent:      fmt.Sprintf("%s <%s>", commit.AuthorName, commit.AuthorEmail),
+		FindSuggestionsFunc: self.helpers.Suggestions.GetAllAuthorsSuggestionsFunc(),
+		HandleConfirm: func(author string) error {
+			return self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.SetAuthorDatePromptTitle,
+				HandleConfirm: func(date string) error {
+					author, date := strings.TrimSpace(author), strings.TrimSpace(date)
+					if author == "" && date == "" {
+						return nil
+					}
+
+					return self.confirmRewritingMergedCommits(endIdx, func() error {
+						return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func() error {
+							self.c.LogAction(self.c.Tr.Actions.ChangeCommitAuthorAndDate)
+							err := self.git.Rebase.SetCommitsAuthorAndDateCmdObj(self.model.Commits, startIdx, endIdx, author, date).Run()
+							return self.helpers.MergeAndRebase.CheckMergeOrRebase(err)
+						})
+					})
+				},
+			})
+		},
+	})
+}
+
+// confirmRewritingMergedCommits warns the user before rewriting commits that
+// are already on a main branch, given that everyone else has them too. All the
+// commits from the top of the branch down to endIdx get rewritten.
+func (self *LocalCommitsController) confirmRewritingMergedCommits(endIdx int, f func() error) error {
+	if !slices.Some(self.model.Commits[0:endIdx+1], func(commit *models.Commit) bool { return commit.Status == "merged" }) {
+		return f()
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:         self.c.Tr.RewriteMergedCommitsTitle,
+		Prompt:        self.c.Tr.RewriteMergedCommitsPrompt,
+		HandleConfirm: f,
+	})
+}
+
 func (self *LocalCommitsController) revert(commit *models.Commit) error {
 	if commit.IsMerge() {
 		return self.createRevertMergeCommitMenu(commit)
@@ -503,7 +900,7 @@ func (self *LocalCommitsController) revert(commit *models.Commit) error {
 			HandleConfirm: func() error {
 				self.c.LogAction(self.c.Tr.Actions.RevertCommit)
 				if err := self.git.Commit.Revert(commit.Sha); err != nil {
-					return self.c.Error(err)
+					return self.handleRevertError(err)
 				}
 				return self.afterRevertCommit()
 			},
@@ -512,6 +909,13 @@ func (self *LocalCommitsController) revert(commit *models.Commit) error {
 }
 
 func (self *LocalCommitsController) createRevertMergeCommitMenu(commit *models.Commit) error {
+	// if the merge was made on the current branch, its first parent is the
+	// commit the branch was at before the merge
+	mergedIntoCurrentBranch, err := self.git.Commit.IsInFirstParentHistory(commit.Sha)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
 	menuItems := make([]*types.MenuItem, len(commit.Parents))
 	for i, parentSha := range commit.Parents {
 		i := i
@@ -520,13 +924,18 @@ func (self *LocalCommitsController) createRevertMergeCommitMenu(commit *models.C
 			return self.c.Error(err)
 		}
 
+		label := fmt.Sprintf("%s: %s", utils.SafeTruncate(parentSha, 8), message)
+		if i == 0 && mergedIntoCurrentBranch {
+			label += " " + self.c.Tr.ParentOnCurrentBranch
+		}
+
 		menuItems[i] = &types.MenuItem{
-			Label: fmt.Sprintf("%s: %s", utils.SafeTruncate(parentSha, 8), message),
+			Label: label,
 			OnPress: func() error {
 				parentNumber := i + 1
 				self.c.LogAction(self.c.Tr.Actions.RevertCommit)
 				if err := self.git.Commit.RevertMerge(commit.Sha, parentNumber); err != nil {
-					return self.c.Error(err)
+					return self.handleRevertError(err)
 				}
 				return self.afterRevertCommit()
 			},
@@ -536,6 +945,30 @@ func (self *LocalCommitsController) createRevertMergeCommitMenu(commit *models.C
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.SelectParentCommitForMerge, Items: menuItems})
 }
 
+// when a revert runs into conflicts, git leaves the conflicted files in the
+// working tree and prepares the revert's commit message, so once the conflicts
+// are resolved, committing finishes the revert
+func (self *LocalCommitsController) handleRevertError(err error) error {
+	if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}}); refreshErr != nil {
+		return refreshErr
+	}
+
+	hasConflicts := slices.Some(self.model.Files, func(file *models.File) bool {
+		return file.HasMergeConflicts
+	})
+	if !hasConflicts {
+		return self.c.Error(err)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.FoundConflictsTitle,
+		Prompt: self.c.Tr.RevertConflictsPrompt,
+		HandleConfirm: func() error {
+			return self.c.PushContext(self.contexts.Files)
+		},
+	})
+}
+
 func (self *LocalCommitsController) afterRevertCommit() error {
 	self.context().MoveSelectedLine(1)
 	return self.c.Refresh(types.RefreshOptions{
@@ -611,9 +1044,36 @@ func (self *LocalCommitsController) gotoBottom() error {
 		}
 	}
 
-	self.context().SetSelectedLineIdx(self.context().Len() - 1)
+	selectLast := func() error {
+		self.context().SetSelectedLineIdx(self.context().Len() - 1)
+		return self.c.PostRefreshUpdate(self.context())
+	}
+
+	// if the commits are still loading we go to the last of those we have so
+	// far, and then to the very last once they're all in
+	if err := selectLast(); err != nil {
+		return err
+	}
 
-	return nil
+	return self.c.AfterCommitsLoaded(selectLast)
+}
+
+// verifySignature shows what git has to say about the commit's signature in
+// the main view. Moving to another commit puts the usual diff back.
+func (self *LocalCommitsController) verifySignature(commit *models.Commit) error {
+	if commit.IsTODO() {
+		return nil
+	}
+
+	cmdObj := self.git.Commit.VerifyCommitCmdObj(commit.Sha)
+
+	return self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: self.c.Tr.VerifyCommitSignatureTitle,
+			Task:  types.NewRunPtyTask(cmdObj.GetCmd()),
+		},
+	})
 }
 
 func (self *LocalCommitsController) handleOpenLogMenu() error {
@@ -624,10 +1084,9 @@ func (self *LocalCommitsController) handleOpenLogMenu() error {
 				Label: self.c.Tr.ToggleShowGitGraphAll,
 				OnPress: func() error {
 					self.context().SetShowWholeGitGraph(!self.context().GetShowWholeGitGraph())
-
-					if self.context().GetShowWholeGitGraph() {
-						self.context().SetLimitCommits(false)
-					}
+					// there can be a lot more commits across all branches, so we
+					// go back to loading them incrementally as the user scrolls
+					self.context().SetLimitCommits(true)
 
 					return self.c.WithWaitingStatus(self.c.Tr.LcLoadingCommits, func() error {
 						return self.c.Refresh(
@@ -707,6 +1166,24 @@ func (self *LocalCommitsController) handleOpenLogMenu() error {
 }
 
 func (self *LocalCommitsController) checkSelected(callback func(*models.Commit) error) func() error {
+	return func() error {
+		commit := self.context().GetSelected()
+		if commit == nil {
+			return nil
+		}
+
+		if commit.IsTODOCommand() {
+			return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.NotAllowedForTodoCommand, commit.Action))
+		}
+
+		return callback(commit)
+	}
+}
+
+// checkSelectedEntry is like checkSelected except that it also lets through
+// rebase TODO entries that aren't commits, like 'exec' lines, for the handlers
+// that know how to deal with them
+func (self *LocalCommitsController) checkSelectedEntry(callback func(*models.Commit) error) func() error {
 	return func() error {
 		commit := self.context().GetSelected()
 		if commit == nil {
@@ -95,6 +95,11 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.helpers.WorkingTree.OpenMergeTool,
 			Description: self.c.Tr.LcOpenMergeTool,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.OpenMergeToolForFile),
+			Handler:     self.openMergeToolForFile,
+			Description: self.c.Tr.LcOpenMergeToolForFile,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Select),
 			Handler:     self.withRenderAndFocus(self.HandlePickHunk),
@@ -105,6 +110,11 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.withRenderAndFocus(self.HandlePickAllHunks),
 			Description: self.c.Tr.PickAllHunks,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.PickBothHunksBottomFirst),
+			Handler:     self.withRenderAndFocus(self.HandlePickAllHunksBottomFirst),
+			Description: self.c.Tr.PickAllHunksBottomFirst,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -187,6 +197,10 @@ func (self *MergeConflictsController) HandleScrollRight() error {
 	return nil
 }
 
+func (self *MergeConflictsController) openMergeToolForFile() error {
+	return self.helpers.WorkingTree.OpenMergeToolForFile(self.context().GetState().GetPath())
+}
+
 func (self *MergeConflictsController) HandleUndo() error {
 	state := self.context().GetState()
 
@@ -240,6 +254,10 @@ func (self *MergeConflictsController) HandlePickAllHunks() error {
 	return self.pickSelection(mergeconflicts.ALL)
 }
 
+func (self *MergeConflictsController) HandlePickAllHunksBottomFirst() error {
+	return self.pickSelection(mergeconflicts.ALL_BOTTOM_FIRST)
+}
+
 func (self *MergeConflictsController) pickSelection(selection mergeconflicts.Selection) error {
 	ok, err := self.resolveConflict(selection)
 	if err != nil {
@@ -281,6 +299,8 @@ func (self *MergeConflictsController) resolveConflict(selection mergeconflicts.S
 		logStr = "Picking bottom hunk"
 	case mergeconflicts.ALL:
 		logStr = "Picking all hunks"
+	case mergeconflicts.ALL_BOTTOM_FIRST:
+		logStr = "Picking all hunks, bottom first"
 	}
 	self.c.LogAction("Resolve merge conflict")
 	self.c.LogCommand(logStr, false)
@@ -2,6 +2,8 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -31,14 +33,35 @@ func (self *PatchBuildingController) GetKeybindings(opts types.KeybindingsOpts)
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Edit),
+			Handler:     self.EditLine,
+			Description: self.c.Tr.EditPatchLine,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.EditSelectHunk),
 			Handler:     self.EditFile,
 			Description: self.c.Tr.LcEditFile,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitFiles.CopyFileURL),
+			Handler:     self.CopyFileURL,
+			Description: self.c.Tr.LcCopyFileURL,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Select),
 			Handler:     self.ToggleSelectionAndRefresh,
 			Description: self.c.Tr.ToggleSelectionForPatch,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ApplyInvertedSelection),
+			Handler:     self.AddInvertedSelectionAndRefresh,
+			Description: self.c.Tr.AddInvertedSelectionToPatch,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.GoToPatchFile),
+			Handler:     self.createGoToPatchFileMenu,
+			Description: self.c.Tr.LcGoToPatchFile,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -61,7 +84,74 @@ func (self *PatchBuildingController) context() types.IPatchExplorerContext {
 }
 
 func (self *PatchBuildingController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
-	return []*gocui.ViewMouseBinding{}
+	return []*gocui.ViewMouseBinding{
+		{
+			ViewName:    self.contexts.CustomPatchBuilderSecondary.GetViewName(),
+			Key:         gocui.MouseLeft,
+			Handler:     self.onClickPatchSummary,
+			FocusedView: self.context().GetViewName(),
+		},
+	}
+}
+
+// the secondary view starts with a summary of the files in the patch. Clicking
+// on one of those files switches to it, provided it's from the commit whose
+// files we're looking at.
+func (self *PatchBuildingController) onClickPatchSummary(opts gocui.ViewMouseBindingOpts) error {
+	files := self.git.Patch.PatchManager.IncludedFiles()
+	if opts.Y < 0 || opts.Y >= len(files) || !self.isFromCurrentCommit(files[opts.Y]) {
+		return nil
+	}
+
+	return self.goToPatchFile(files[opts.Y])
+}
+
+// offers the same files as the summary, for those who don't use the mouse
+func (self *PatchBuildingController) createGoToPatchFileMenu() error {
+	files := self.git.Patch.PatchManager.IncludedFiles()
+	summaryLines := presentation.GetPatchSummaryLines(files, self.git.Patch.PatchManager.SpansMultipleCommits())
+
+	menuItems := make([]*types.MenuItem, 0, len(files))
+	for i, file := range files {
+		file := file
+		disabledReason := ""
+		if !self.isFromCurrentCommit(file) {
+			disabledReason = self.c.Tr.PatchFileFromOtherCommit
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          summaryLines[i],
+			DisabledReason: disabledReason,
+			OnPress: func() error {
+				return self.goToPatchFile(file)
+			},
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CustomPatch,
+		Items: menuItems,
+	})
+}
+
+func (self *PatchBuildingController) isFromCurrentCommit(file *patch.IncludedFile) bool {
+	return file.Commit == self.contexts.CommitFiles.GetRef().RefName()
+}
+
+func (self *PatchBuildingController) goToPatchFile(file *patch.IncludedFile) error {
+	commitFilesContext := self.contexts.CommitFiles
+	commitFilesContext.CommitFileTreeViewModel.ExpandToPath(file.Name)
+	index, found := commitFilesContext.CommitFileTreeViewModel.GetIndexForPath(file.Name)
+	if !found {
+		return nil
+	}
+	commitFilesContext.SetSelectedLineIdx(index)
+
+	if err := self.c.PostRefreshUpdate(commitFilesContext); err != nil {
+		return err
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.PATCH_BUILDING}})
 }
 
 func (self *PatchBuildingController) OpenFile() error {
@@ -92,6 +182,32 @@ func (self *PatchBuildingController) EditFile() error {
 	return self.helpers.Files.EditFileAtLine(path, lineNumber)
 }
 
+// links to the selected lines of the file
+func (self *PatchBuildingController) CopyFileURL() error {
+	self.context().GetMutex().Lock()
+	defer self.context().GetMutex().Unlock()
+
+	path := self.contexts.CommitFiles.GetSelectedPath()
+
+	if path == "" {
+		return nil
+	}
+
+	firstLine, lastLine := self.context().GetState().SelectedLineNumberRange()
+	url, err := self.helpers.Host.GetFileURL(self.contexts.CommitFiles.GetRef().RefName(), path, firstLine, lastLine)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CopyFileURLToClipboard)
+	if err := self.os.CopyToClipboard(url); err != nil {
+		return self.c.Error(err)
+	}
+
+	self.c.Toast(self.c.Tr.FileURLCopiedToClipboard)
+	return nil
+}
+
 func (self *PatchBuildingController) ToggleSelectionAndRefresh() error {
 	if err := self.toggleSelection(); err != nil {
 		return err
@@ -138,6 +254,77 @@ func (self *PatchBuildingController) toggleSelection() error {
 	return nil
 }
 
+func (self *PatchBuildingController) AddInvertedSelectionAndRefresh() error {
+	if err := self.addInvertedSelection(); err != nil {
+		return err
+	}
+
+	return self.c.Refresh(types.RefreshOptions{
+		Scope: []types.RefreshableView{types.PATCH_BUILDING, types.COMMIT_FILES},
+	})
+}
+
+// adds every line of the selected hunk(s) to the patch except the selected ones
+func (self *PatchBuildingController) addInvertedSelection() error {
+	self.context().GetMutex().Lock()
+	defer self.context().GetMutex().Unlock()
+
+	filename := self.contexts.CommitFiles.GetSelectedPath()
+	if filename == "" {
+		return nil
+	}
+
+	state := self.context().GetState()
+	firstHunkLineIdx, lastHunkLineIdx := state.SelectedHunksRange()
+	firstLineIdx, lastLineIdx := state.SelectedRange()
+
+	if err := self.git.Patch.PatchManager.AddFileLineRange(filename, firstHunkLineIdx, lastHunkLineIdx); err != nil {
+		return self.c.Error(err)
+	}
+	if err := self.git.Patch.PatchManager.RemoveFileLineRange(filename, firstLineIdx, lastLineIdx); err != nil {
+		return self.c.Error(err)
+	}
+
+	if state.SelectingRange() {
+		state.SetLineSelectMode()
+	}
+
+	return nil
+}
+
+func (self *PatchBuildingController) EditLine() error {
+	self.context().GetMutex().Lock()
+	defer self.context().GetMutex().Unlock()
+
+	filename := self.contexts.CommitFiles.GetSelectedPath()
+	if filename == "" {
+		return nil
+	}
+
+	lineIdx := self.context().GetState().GetSelectedLineIdx()
+	content, ok, err := self.git.Patch.PatchManager.GetEditableLineContent(filename, lineIdx)
+	if err != nil {
+		return self.c.Error(err)
+	}
+	if !ok {
+		return self.c.ErrorMsg(self.c.Tr.CanOnlyEditAddedLinesError)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.EditPatchLineTitle,
+		InitialContent: content,
+		HandleConfirm: func(response string) error {
+			if err := self.git.Patch.PatchManager.EditLine(filename, lineIdx, response); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.Refresh(types.RefreshOptions{
+				Scope: []types.RefreshableView{types.PATCH_BUILDING, types.COMMIT_FILES},
+			})
+		},
+	})
+}
+
 func (self *PatchBuildingController) Escape() error {
 	return self.helpers.PatchBuilding.Escape()
 }
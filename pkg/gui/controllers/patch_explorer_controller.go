@@ -1,6 +1,10 @@
 package controllers
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -89,6 +93,16 @@ func (self *PatchExplorerController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.withRenderAndFocus(self.HandleToggleSelectHunk),
 			Description: self.c.Tr.ToggleSelectHunk,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleDiffLayout),
+			Handler:     self.withLock(self.HandleToggleDiffLayout),
+			Description: self.c.Tr.ToggleDiffLayout,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.GoToLine),
+			Handler:     self.HandleGoToLine,
+			Description: self.c.Tr.GoToLine,
+		},
 		{
 			Tag:         "navigation",
 			Key:         opts.GetKey(opts.Config.Universal.PrevPage),
@@ -134,6 +148,12 @@ func (self *PatchExplorerController) GetKeybindings(opts types.KeybindingsOpts)
 			Handler:     self.withLock(self.CopySelectedToClipboard),
 			Description: self.c.Tr.LcCopySelectedTexToClipboard,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.CopySelectedLines),
+			Handler:     self.withLock(self.CreateCopySelectedLinesMenu),
+			Description: self.c.Tr.CopySelectedLines,
+			OpensMenu:   true,
+		},
 	}
 }
 
@@ -200,6 +220,43 @@ func (self *PatchExplorerController) HandleToggleSelectHunk() error {
 	return nil
 }
 
+func (self *PatchExplorerController) HandleToggleDiffLayout() error {
+	if self.c.UserConfig.Gui.DiffLayout == "sideBySide" {
+		self.c.UserConfig.Gui.DiffLayout = "unified"
+	} else {
+		self.c.UserConfig.Gui.DiffLayout = "sideBySide"
+	}
+
+	if err := self.context.RenderAndFocus(self.isFocused()); err != nil {
+		return err
+	}
+
+	if self.c.UserConfig.Gui.DiffLayout == "sideBySide" && !self.context.GetState().IsSideBySide() {
+		self.c.Toast(self.c.Tr.CannotShowDiffSideBySide)
+	}
+
+	return nil
+}
+
+func (self *PatchExplorerController) HandleGoToLine() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.GoToLineTitle,
+		HandleConfirm: func(response string) error {
+			lineNumber, err := strconv.Atoi(strings.TrimSpace(response))
+			if err != nil || lineNumber < 1 {
+				return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.InvalidLineNumber, response))
+			}
+
+			return self.withRenderAndFocus(func() error {
+				if !self.context.GetState().SelectNewLineNumber(lineNumber) {
+					return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.LineNotInDiff, lineNumber))
+				}
+				return nil
+			})()
+		},
+	})
+}
+
 func (self *PatchExplorerController) HandleScrollLeft() error {
 	self.context.GetViewTrait().ScrollLeft()
 
@@ -239,13 +296,15 @@ func (self *PatchExplorerController) HandleGotoBottom() error {
 }
 
 func (self *PatchExplorerController) HandleMouseDown() error {
-	self.context.GetState().SelectNewLineForRange(self.context.GetViewTrait().SelectedLineIdx())
+	state := self.context.GetState()
+	state.SelectNewLineForRange(state.PatchLineIdx(self.context.GetViewTrait().SelectedLineIdx()))
 
 	return nil
 }
 
 func (self *PatchExplorerController) HandleMouseDrag() error {
-	self.context.GetState().SelectLine(self.context.GetViewTrait().SelectedLineIdx())
+	state := self.context.GetState()
+	state.SelectLine(state.PatchLineIdx(self.context.GetViewTrait().SelectedLineIdx()))
 
 	return nil
 }
@@ -261,6 +320,42 @@ func (self *PatchExplorerController) CopySelectedToClipboard() error {
 	return nil
 }
 
+func (self *PatchExplorerController) CreateCopySelectedLinesMenu() error {
+	state := self.context.GetState()
+	copyToClipboard := func(text string) func() error {
+		return func() error {
+			self.c.LogAction(self.c.Tr.Actions.CopySelectedTextToClipboard)
+			if err := self.os.CopyToClipboard(text); err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.Toast(self.c.Tr.SelectedLinesCopiedToClipboard)
+			return nil
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CopySelectedLines,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.CopySelectedLinesWithoutPrefixes,
+				OnPress: copyToClipboard(state.RenderSelectedWithoutPrefixes(false)),
+				Key:     'l',
+			},
+			{
+				Label:   self.c.Tr.CopySelectedLinesAsDiff,
+				OnPress: copyToClipboard(state.RenderSelectedAsDiff()),
+				Key:     'd',
+			},
+			{
+				Label:   self.c.Tr.CopySelectedNewLines,
+				OnPress: copyToClipboard(state.RenderSelectedWithoutPrefixes(true)),
+				Key:     'n',
+			},
+		},
+	})
+}
+
 func (self *PatchExplorerController) isFocused() bool {
 	return self.c.CurrentContext().GetKey() == self.context.GetKey()
 }
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type ReflogController struct {
+	baseController
+	*controllerCommon
+}
+
+var _ types.IController = &ReflogController{}
+
+func NewReflogController(
+	common *controllerCommon,
+) *ReflogController {
+	return &ReflogController{
+		baseController:   baseController{},
+		controllerCommon: common,
+	}
+}
+
+func (self *ReflogController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Reflog.RestoreToEntry),
+			Handler:     self.checkSelected(self.restoreToEntry),
+			Description: self.c.Tr.LcRestoreToReflogEntry,
+		},
+	}
+
+	return bindings
+}
+
+// restoreToEntry gets the repo back to how it was right after the reflog entry
+// was made. If the entry is a checkout of a branch we check out that branch
+// and reset it to the entry's commit; otherwise we hard reset the current
+// branch, or check out the commit if the entry left us on a detached head.
+// Before asking for confirmation we show what would change in the main view.
+func (self *ReflogController) restoreToEntry(commit *models.Commit) error {
+	if self.git.Status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
+		return self.c.ErrorMsg(self.c.Tr.CantRestoreReflogEntryWhileRebasing)
+	}
+
+	if err := self.renderPreview(commit); err != nil {
+		return err
+	}
+
+	// the entry's commit is what HEAD pointed at right after the checkout, but
+	// the checked out ref may well have moved on since
+	checkoutRef := ""
+	if ok, match := utils.FindStringSubmatch(commit.Name, `^checkout: moving from \S+ to (\S+)`); ok {
+		checkoutRef = match[1]
+	}
+	_, isBranch := lo.Find(self.model.Branches, func(branch *models.Branch) bool {
+		return branch.Name == checkoutRef
+	})
+
+	resetOptions := helpers.HardResetOptions{WaitingStatus: self.c.Tr.ResettingStatus}
+	restore := func() error {
+		self.c.LogAction(self.c.Tr.Actions.RestoreToReflogEntry)
+		return self.helpers.WorkingTree.HardResetWithAutoStash(commit.Sha, resetOptions)
+	}
+	prompt := utils.ResolvePlaceholderString(self.c.Tr.RestoreToReflogEntryResetPrompt, map[string]string{"sha": commit.ShortSha()})
+
+	if isBranch {
+		resetOptions.Branch = checkoutRef
+		prompt = utils.ResolvePlaceholderString(self.c.Tr.RestoreToReflogEntryBranchPrompt, map[string]string{"branch": checkoutRef, "sha": commit.ShortSha()})
+	} else if checkoutRef != "" {
+		restore = func() error {
+			self.c.LogAction(self.c.Tr.Actions.RestoreToReflogEntry)
+			return self.helpers.Refs.CheckoutRef(commit.Sha, types.CheckoutRefOptions{})
+		}
+		prompt = utils.ResolvePlaceholderString(self.c.Tr.RestoreToReflogEntryCheckoutPrompt, map[string]string{"ref": commit.ShortSha()})
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:         self.c.Tr.RestoreToReflogEntry,
+		Prompt:        prompt,
+		HandleConfirm: restore,
+		HandleClose: func() error {
+			// put back the usual view of the selected entry
+			return self.c.PostRefreshUpdate(self.context())
+		},
+	})
+}
+
+func (self *ReflogController) renderPreview(commit *models.Commit) error {
+	cmdObj := self.git.WorkingTree.ShowFileDiffCmdObj("HEAD", commit.Sha, false, ".", false, false)
+
+	return self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: self.c.Tr.RestoreToReflogEntryPreviewTitle,
+			Task:  types.NewRunPtyTask(cmdObj.GetCmd()),
+		},
+	})
+}
+
+func (self *ReflogController) checkSelected(callback func(*models.Commit) error) func() error {
+	return func() error {
+		commit := self.context().GetSelected()
+		if commit == nil {
+			return nil
+		}
+
+		return callback(commit)
+	}
+}
+
+func (self *ReflogController) Context() types.Context {
+	return self.context()
+}
+
+func (self *ReflogController) context() *context.ReflogCommitsContext {
+	return self.contexts.ReflogCommits
+}
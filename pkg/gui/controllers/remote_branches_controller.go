@@ -75,6 +75,11 @@ func (self *RemoteBranchesController) GetKeybindings(opts types.KeybindingsOpts)
 			Description: self.c.Tr.LcViewResetOptions,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateWorktree),
+			Handler:     self.checkSelected(self.createWorktree),
+			Description: self.c.Tr.LcCreateWorktreeFromRemoteBranch,
+		},
 	}
 }
 
@@ -86,6 +91,10 @@ func (self *RemoteBranchesController) context() *context.RemoteBranchesContext {
 	return self.contexts.RemoteBranches
 }
 
+func (self *RemoteBranchesController) createWorktree(selectedBranch *models.RemoteBranch) error {
+	return self.helpers.Worktree.NewWorktreeFromBranch(selectedBranch.FullName())
+}
+
 func (self *RemoteBranchesController) checkSelected(callback func(*models.RemoteBranch) error) func() error {
 	return func() error {
 		selectedItem := self.context().GetSelected()
@@ -1,6 +1,9 @@
 package controllers
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -40,6 +43,11 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.checkSelected(self.fetch),
 			Description: self.c.Tr.LcFetchRemote,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Remotes.FetchAllRemotes),
+			Handler:     self.helpers.Fetch.FetchAllRemotes,
+			Description: self.c.Tr.LcFetchAllRemotes,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.add,
@@ -89,6 +97,10 @@ func (self *RemotesController) add() error {
 			return self.c.Prompt(types.PromptOpts{
 				Title: self.c.Tr.LcNewRemoteUrl,
 				HandleConfirm: func(remoteUrl string) error {
+					if !looksLikeRemoteUrl(remoteUrl) {
+						return self.invalidUrlError(remoteUrl)
+					}
+
 					self.c.LogAction(self.c.Tr.Actions.AddRemote)
 					if err := self.git.Remote.AddRemote(remoteName, remoteUrl); err != nil {
 						return err
@@ -116,6 +128,66 @@ func (self *RemotesController) remove(remote *models.Remote) error {
 }
 
 func (self *RemotesController) edit(remote *models.Remote) error {
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.LcRenameRemote,
+			OnPress: func() error { return self.rename(remote) },
+			Key:     'r',
+		},
+		{
+			Label:   self.c.Tr.LcEditRemoteFetchUrl,
+			OnPress: func() error { return self.editUrl(remote) },
+			Key:     'u',
+		},
+		{
+			Label:   self.c.Tr.LcSetRemotePushUrl,
+			OnPress: func() error { return self.setPushUrl(remote) },
+			Key:     'p',
+		},
+	}
+
+	if len(remote.PushUrls) > 0 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.LcRemoveRemotePushUrl,
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
+				if err := self.git.Remote.RemovePushUrl(remote.Name); err != nil {
+					return self.c.Error(err)
+				}
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+			},
+			Key: 'P',
+		})
+	}
+
+	pruneLabel := self.c.Tr.LcEnablePruneOnFetch
+	if remote.Prune {
+		pruneLabel = self.c.Tr.LcDisablePruneOnFetch
+	}
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: pruneLabel,
+		OnPress: func() error {
+			self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
+			if err := self.git.Remote.SetPrune(remote.Name, !remote.Prune); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+		},
+		Key: 't',
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.EditRemoteMenuTitle,
+			map[string]string{
+				"remoteName": remote.Name,
+			},
+		),
+		Items: menuItems,
+	})
+}
+
+func (self *RemotesController) rename(remote *models.Remote) error {
 	editNameMessage := utils.ResolvePlaceholderString(
 		self.c.Tr.LcEditRemoteName,
 		map[string]string{
@@ -127,44 +199,109 @@ func (self *RemotesController) edit(remote *models.Remote) error {
 		Title:          editNameMessage,
 		InitialContent: remote.Name,
 		HandleConfirm: func(updatedRemoteName string) error {
-			if updatedRemoteName != remote.Name {
-				self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
-				if err := self.git.Remote.RenameRemote(remote.Name, updatedRemoteName); err != nil {
-					return self.c.Error(err)
-				}
+			if updatedRemoteName == remote.Name {
+				return nil
 			}
 
-			editUrlMessage := utils.ResolvePlaceholderString(
-				self.c.Tr.LcEditRemoteUrl,
-				map[string]string{
-					"remoteName": updatedRemoteName,
-				},
-			)
+			self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
+			if err := self.git.Remote.RenameRemote(remote.Name, updatedRemoteName); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+		},
+	})
+}
 
-			urls := remote.Urls
-			url := ""
-			if len(urls) > 0 {
-				url = urls[0]
+func (self *RemotesController) editUrl(remote *models.Remote) error {
+	editUrlMessage := utils.ResolvePlaceholderString(
+		self.c.Tr.LcEditRemoteUrl,
+		map[string]string{
+			"remoteName": remote.Name,
+		},
+	)
+
+	url := ""
+	if len(remote.Urls) > 0 {
+		url = remote.Urls[0]
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:          editUrlMessage,
+		InitialContent: url,
+		HandleConfirm: func(updatedRemoteUrl string) error {
+			if !looksLikeRemoteUrl(updatedRemoteUrl) {
+				return self.invalidUrlError(updatedRemoteUrl)
 			}
 
-			return self.c.Prompt(types.PromptOpts{
-				Title:          editUrlMessage,
-				InitialContent: url,
-				HandleConfirm: func(updatedRemoteUrl string) error {
-					self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
-					if err := self.git.Remote.UpdateRemoteUrl(updatedRemoteName, updatedRemoteUrl); err != nil {
-						return self.c.Error(err)
-					}
-					return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
-				},
-			})
+			self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
+			if err := self.git.Remote.UpdateRemoteUrl(remote.Name, updatedRemoteUrl); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
 		},
 	})
 }
 
+func (self *RemotesController) setPushUrl(remote *models.Remote) error {
+	// we start from the url we currently push to, whichever that is
+	url := ""
+	if len(remote.PushUrls) > 0 {
+		url = remote.PushUrls[0]
+	} else if len(remote.Urls) > 0 {
+		url = remote.Urls[0]
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.LcEditRemotePushUrl,
+			map[string]string{
+				"remoteName": remote.Name,
+			},
+		),
+		InitialContent: url,
+		HandleConfirm: func(pushUrl string) error {
+			if !looksLikeRemoteUrl(pushUrl) {
+				return self.invalidUrlError(pushUrl)
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.UpdateRemote)
+			if err := self.git.Remote.SetPushUrl(remote.Name, pushUrl); err != nil {
+				return self.c.Error(err)
+			}
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+		},
+	})
+}
+
+func (self *RemotesController) invalidUrlError(url string) error {
+	return self.c.ErrorMsg(utils.ResolvePlaceholderString(
+		self.c.Tr.InvalidRemoteUrl,
+		map[string]string{
+			"url": url,
+		},
+	))
+}
+
+var urlSchemeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*$`)
+
+// looksLikeRemoteUrl only catches urls that are obviously malformed. Git takes
+// all sorts of urls, including plain paths, so we leave the rest to git.
+func looksLikeRemoteUrl(url string) bool {
+	if url == "" || strings.ContainsAny(url, " \t\n") {
+		return false
+	}
+
+	scheme, rest, found := strings.Cut(url, "://")
+	if found {
+		return urlSchemeRegex.MatchString(scheme) && rest != ""
+	}
+
+	return true
+}
+
 func (self *RemotesController) fetch(remote *models.Remote) error {
 	return self.c.WithWaitingStatus(self.c.Tr.FetchingRemoteStatus, func() error {
-		err := self.git.Sync.FetchRemote(remote.Name)
+		err := self.helpers.Fetch.FetchRemote(remote.Name)
 		if err != nil {
 			_ = self.c.Error(err)
 		}
@@ -1,11 +1,14 @@
 package controllers
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type StagingController struct {
@@ -65,17 +68,52 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Select),
-			Handler:     self.ToggleStaged,
+			Handler:     self.withStageableLines(self.ToggleStaged),
 			Description: self.c.Tr.StageSelection,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ApplyInvertedSelection),
+			Handler:     self.withStageableLines(self.ToggleStagedInverted),
+			Description: self.c.Tr.StageInvertedSelection,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ApplySelectionSkippingWhitespace),
+			Handler:     self.withStageableLines(self.ToggleStagedSkippingWhitespace),
+			Description: self.c.Tr.StageSelectionSkippingWhitespace,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleMarkLine),
+			Handler:     self.withStageableLines(self.ToggleMarkLine),
+			Description: self.c.Tr.ToggleMarkLine,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.CommitSelection),
+			Handler:     self.withStageableLines(self.CommitSelection),
+			Description: self.c.Tr.CommitSelection,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.StashSelection),
+			Handler:     self.withStageableLines(self.StashSelection),
+			Description: self.c.Tr.StashSelection,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.ToggleCollapseHunk),
+			Handler:     self.ToggleCollapseHunk,
+			Description: self.c.Tr.ToggleCollapseHunk,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.SplitHunk),
+			Handler:     self.withStageableLines(self.SplitHunk),
+			Description: self.c.Tr.SplitHunk,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Remove),
-			Handler:     self.ResetSelection,
+			Handler:     self.withStageableLines(self.ResetSelection),
 			Description: self.c.Tr.ResetSelection,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Main.EditSelectHunk),
-			Handler:     self.EditHunkAndRefresh,
+			Handler:     self.withStageableLines(self.EditHunkAndRefresh),
 			Description: self.c.Tr.EditHunk,
 		},
 		{
@@ -96,6 +134,18 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 	}
 }
 
+// the combined diff of a file with merge conflicts is only shown for reference:
+// none of its lines can be staged
+func (self *StagingController) withStageableLines(f func() error) func() error {
+	return func() error {
+		if state := self.context.GetState(); state != nil && state.IsCombinedDiff() {
+			return self.c.ErrorMsg(self.c.Tr.CannotStageLinesOfConflictedFile)
+		}
+
+		return f()
+	}
+}
+
 func (self *StagingController) Context() types.Context {
 	return self.context
 }
@@ -144,14 +194,80 @@ func (self *StagingController) TogglePanel() error {
 	return nil
 }
 
+func (self *StagingController) ToggleMarkLine() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	if state == nil {
+		return nil
+	}
+
+	state.ToggleMarkLine()
+
+	return self.context.RenderAndFocus(true)
+}
+
+func (self *StagingController) ToggleCollapseHunk() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	if state == nil {
+		return nil
+	}
+
+	state.ToggleCollapseHunk()
+
+	return self.context.RenderAndFocus(true)
+}
+
+func (self *StagingController) SplitHunk() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	if state == nil {
+		return nil
+	}
+
+	if !state.SplitHunk() {
+		self.c.Toast(self.c.Tr.HunkCannotBeSplit)
+		return nil
+	}
+
+	return self.context.RenderAndFocus(true)
+}
+
 func (self *StagingController) ToggleStaged() error {
-	return self.applySelectionAndRefresh(self.staged)
+	return self.applySelectionAndRefresh(self.staged, false, false)
+}
+
+// stages (or unstages) every line of the selected hunk(s) except the selected ones
+func (self *StagingController) ToggleStagedInverted() error {
+	return self.applySelectionAndRefresh(self.staged, true, false)
+}
+
+// stages (or unstages) the selected lines, leaving out any changes which only
+// affect whitespace
+func (self *StagingController) ToggleStagedSkippingWhitespace() error {
+	return self.applySelectionAndRefresh(self.staged, false, true)
 }
 
 func (self *StagingController) ResetSelection() error {
-	reset := func() error { return self.applySelectionAndRefresh(true) }
+	reset := func() error { return self.applySelectionAndRefresh(true, false, false) }
+
+	if self.staged {
+		return reset()
+	}
+
+	// discarding lines can't be undone, so before asking for confirmation we
+	// make sure git will be able to remove them from the working tree
+	if err := self.checkSelectionCanBeDiscarded(); err != nil {
+		return self.c.ErrorMsg(fmt.Sprintf("%s\n\n%s", self.c.Tr.CannotDiscardLines, err.Error()))
+	}
 
-	if !self.staged && !self.c.UserConfig.Gui.SkipUnstageLineWarning {
+	if !self.c.UserConfig.Gui.SkipUnstageLineWarning {
 		return self.c.Confirm(types.ConfirmOpts{
 			Title:         self.c.Tr.UnstageLinesTitle,
 			Prompt:        self.c.Tr.UnstageLinesPrompt,
@@ -162,31 +278,38 @@ func (self *StagingController) ResetSelection() error {
 	return reset()
 }
 
-func (self *StagingController) applySelectionAndRefresh(reverse bool) error {
-	if err := self.applySelection(reverse); err != nil {
+func (self *StagingController) checkSelectionCanBeDiscarded() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	patchText, _ := self.selectionPatch(true, false, false)
+	if patchText == "" {
+		return nil
+	}
+
+	return self.git.WorkingTree.ApplyPatch(patchText, "reverse", "check")
+}
+
+func (self *StagingController) applySelectionAndRefresh(reverse bool, invert bool, skipWhitespace bool) error {
+	if err := self.applySelection(reverse, invert, skipWhitespace); err != nil {
 		return err
 	}
 
 	return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
 }
 
-func (self *StagingController) applySelection(reverse bool) error {
+func (self *StagingController) applySelection(reverse bool, invert bool, skipWhitespace bool) error {
 	self.context.GetMutex().Lock()
 	defer self.context.GetMutex().Unlock()
 
 	state := self.context.GetState()
-	path := self.FilePath()
-	if path == "" {
-		return nil
-	}
-
-	firstLineIdx, lastLineIdx := state.SelectedRange()
-	patch := patch.ModifiedPatchForRange(self.c.Log, path, state.GetDiff(), firstLineIdx, lastLineIdx,
-		patch.PatchOptions{Reverse: reverse, KeepOriginalHeader: false})
-
+	patch, appliedAsWhole := self.selectionPatch(reverse, invert, skipWhitespace)
 	if patch == "" {
 		return nil
 	}
+	if appliedAsWhole {
+		self.c.Toast(self.c.Tr.PartialDeletionAppliedAsWhole)
+	}
 
 	// apply the patch then refresh this panel
 	// create a new temp file with the patch, then call git apply with that patch
@@ -211,6 +334,125 @@ func (self *StagingController) applySelection(reverse bool) error {
 	return nil
 }
 
+// selectionPatch returns the patch for applying the selected lines, or an
+// empty string if there's nothing to apply. The returned bool tells us whether
+// the whole file had to be included (see lineIndicesToApply).
+func (self *StagingController) selectionPatch(reverse bool, invert bool, skipWhitespace bool) (string, bool) {
+	path := self.FilePath()
+	if path == "" {
+		return "", false
+	}
+
+	state := self.context.GetState()
+	lineIndices, invert, appliedAsWhole := self.lineIndicesToApply(state, invert)
+	patchText := patch.ModifiedPatchForLines(self.c.Log, path, state.GetDiff(), lineIndices,
+		patch.PatchOptions{Reverse: reverse, KeepOriginalHeader: false, InvertSelection: invert, SkipWhitespaceOnlyChanges: skipWhitespace})
+	return patchText, appliedAsWhole
+}
+
+// lineIndicesToApply returns the lines to include in the patch along with
+// whether they should be inverted. Git won't apply part of a file's deletion,
+// so if only some of a deleted file's lines would be applied we fall back to
+// applying all of them, in which case the returned bool is true.
+func (self *StagingController) lineIndicesToApply(state *patch_exploring.State, invert bool) ([]int, bool, bool) {
+	diff := state.GetDiff()
+	lineIndices := state.SelectedLineIndices()
+	if !patch.IsDeletedFileDiff(diff) {
+		return lineIndices, invert, false
+	}
+
+	stageableLines := patch.NewPatchParser(self.c.Log, diff).StageableLines
+	appliedCount := len(lo.Intersect(stageableLines, lineIndices))
+	if invert {
+		appliedCount = len(stageableLines) - appliedCount
+	}
+	if appliedCount == 0 || appliedCount == len(stageableLines) {
+		return lineIndices, invert, false
+	}
+
+	return stageableLines, false, true
+}
+
+// CommitSelection moves the selected lines into a new commit without touching
+// the working tree or any other staged changes
+func (self *StagingController) CommitSelection() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	path := self.FilePath()
+	patchText, appliedAsWhole := self.selectionPatch(false, false, false)
+	if patchText == "" {
+		return nil
+	}
+	if appliedAsWhole {
+		self.c.Toast(self.c.Tr.PartialDeletionAppliedAsWhole)
+	}
+
+	prompt := func() error {
+		return self.c.Prompt(types.PromptOpts{
+			Title: self.c.Tr.CommitSelectionTitle,
+			HandleConfirm: func(message string) error {
+				self.c.LogAction(self.c.Tr.Actions.CommitSelection)
+				if err := self.git.WorkingTree.CommitPatch(patchText, path, !self.staged, self.git.Commit.CommitCmdObj(message)); err != nil {
+					return self.c.Error(err)
+				}
+
+				return self.c.Refresh(types.RefreshOptions{
+					Scope: []types.RefreshableView{types.FILES, types.STAGING, types.COMMITS},
+				})
+			},
+		})
+	}
+
+	file := self.contexts.Files.GetSelectedFile()
+	if !self.staged && file != nil && file.HasStagedChanges {
+		return self.c.Confirm(types.ConfirmOpts{
+			Title:         self.c.Tr.CommitSelectionTitle,
+			Prompt:        self.c.Tr.CommitSelectionWithStagedPrompt,
+			HandleConfirm: prompt,
+		})
+	}
+
+	return prompt()
+}
+
+// StashSelection stashes the selected lines, leaving all other changes in
+// place
+func (self *StagingController) StashSelection() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	patchText, appliedAsWhole := self.selectionPatch(false, false, false)
+	if patchText == "" {
+		return nil
+	}
+	removalPatchText, _ := self.selectionPatch(true, false, false)
+
+	// the patch has to be relative to HEAD, which unstaged lines are only if
+	// nothing has been staged in the file
+	file := self.contexts.Files.GetSelectedFile()
+	if !self.staged && file != nil && file.HasStagedChanges {
+		return self.c.ErrorMsg(self.c.Tr.StashSelectionHasStagedChangesError)
+	}
+	if appliedAsWhole {
+		self.c.Toast(self.c.Tr.PartialDeletionAppliedAsWhole)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.StashChanges,
+		HandleConfirm: func(message string) error {
+			self.c.LogAction(self.c.Tr.Actions.StashSelection)
+			if err := self.git.Stash.StashPatch(patchText, removalPatchText, self.staged, message); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.c.Refresh(types.RefreshOptions{
+				Scope: []types.RefreshableView{types.FILES, types.STAGING, types.STASH},
+			})
+		},
+	})
+}
+
 func (self *StagingController) EditHunkAndRefresh() error {
 	if err := self.editHunk(); err != nil {
 		return err
@@ -239,7 +481,9 @@ func (self *StagingController) editHunk() error {
 		return err
 	}
 
-	lineOffset := 3
+	// the hunk comes after the patch's header, which is longer for e.g. a
+	// change of mode
+	lineOffset := strings.Count(patchText[:strings.Index(patchText, "\n@@")+1], "\n") + 1
 	lineIdxInHunk := state.GetSelectedLineIdx() - hunk.FirstLineIdx
 	if err := self.helpers.Files.EditFileAtLine(patchFilepath, lineIdxInHunk+lineOffset); err != nil {
 		return err
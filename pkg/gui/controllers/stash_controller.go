@@ -1,8 +1,11 @@
 package controllers
 
 import (
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -50,6 +53,17 @@ func (self *StashController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.checkSelected(self.handleRenameStashEntry),
 			Description: self.c.Tr.LcRenameStash,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Stash.StashBranch),
+			Handler:     self.checkSelected(self.handleStashBranch),
+			Description: self.c.Tr.LcStashBranch,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Stash.ApplyOntoBranch),
+			Handler:     self.checkSelected(self.handleApplyStashOntoBranch),
+			Description: self.c.Tr.LcApplyStashOntoBranch,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -146,6 +160,138 @@ func (self *StashController) handleNewBranchOffStashEntry(stashEntry *models.Sta
 	return self.helpers.Refs.NewBranch(stashEntry.RefName(), stashEntry.Description(), "")
 }
 
+func (self *StashController) handleStashBranch(stashEntry *models.StashEntry) error {
+	message := utils.ResolvePlaceholderString(
+		self.c.Tr.StashBranchPrompt,
+		map[string]string{
+			"stashName": stashEntry.RefName(),
+		},
+	)
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: message,
+		HandleConfirm: func(response string) error {
+			self.c.LogAction(self.c.Tr.Actions.StashBranch)
+			err := self.git.Stash.Branch(stashEntry.Index, helpers.SanitizedBranchName(response))
+			// if popping the stash fails, the branch has still been checked out
+			// and the stash entry is kept, so we refresh everything either way
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			self.contexts.Branches.SetSelectedLineIdx(0)
+			self.contexts.LocalCommits.SetSelectedLineIdx(0)
+			return self.c.PushContext(self.contexts.Files)
+		},
+	})
+}
+
+func (self *StashController) handleApplyStashOntoBranch(stashEntry *models.StashEntry) error {
+	menuItems := slices.Map(self.model.Branches, func(branch *models.Branch) *types.MenuItem {
+		return &types.MenuItem{
+			Label:     branch.Name,
+			OpensMenu: true,
+			OnPress: func() error {
+				return self.createApplyOntoBranchMenu(stashEntry, branch.Name)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.ApplyStashOntoBranchTitle,
+			map[string]string{"stashName": stashEntry.RefName()},
+		),
+		Items: menuItems,
+	})
+}
+
+func (self *StashController) createApplyOntoBranchMenu(stashEntry *models.StashEntry, branchName string) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.ApplyStashOntoBranchMenuTitle,
+			map[string]string{"branchName": branchName},
+		),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LcApply,
+				OnPress: func() error {
+					return self.applyOntoBranch(stashEntry, branchName, self.git.Stash.Apply)
+				},
+				Key: 'a',
+			},
+			{
+				Label: self.c.Tr.LcPop,
+				OnPress: func() error {
+					return self.applyOntoBranch(stashEntry, branchName, self.git.Stash.Pop)
+				},
+				Key: 'p',
+			},
+		},
+	})
+}
+
+// applyOntoBranch checks out the given branch and then applies (or pops) the
+// stash entry onto it. Any uncommitted changes are stashed first, once the user
+// has confirmed that, and put back if the checkout fails. There's nothing to
+// check out for the current branch, so there we leave the changes alone.
+func (self *StashController) applyOntoBranch(stashEntry *models.StashEntry, branchName string, apply func(index int) error) error {
+	isCheckedOut := branchName == self.helpers.Refs.GetCheckedOutRef().Name
+
+	run := func(autoStash bool) error {
+		return self.c.WithWaitingStatus(self.c.Tr.CheckingOutStatus, func() error {
+			self.c.LogAction(self.c.Tr.Actions.ApplyStashOntoBranch)
+
+			index := stashEntry.Index
+			if autoStash {
+				if err := self.git.Stash.Save(self.c.Tr.StashPrefix + branchName); err != nil {
+					return self.c.Error(err)
+				}
+				// the new stash entry goes on top, pushing ours down by one
+				index++
+			}
+
+			if !isCheckedOut {
+				if err := self.git.Branch.Checkout(branchName, git_commands.CheckoutOptions{}); err != nil {
+					if autoStash {
+						if popErr := self.git.Stash.Pop(0); popErr != nil {
+							self.c.Log.Error(popErr)
+						}
+					}
+					_ = self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI})
+					return self.c.Error(err)
+				}
+				self.contexts.Branches.SetSelectedLineIdx(0)
+				self.contexts.LocalCommits.SetSelectedLineIdx(0)
+			}
+
+			// if this conflicts, the conflicts show up in the files panel as usual
+			err := apply(index)
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI})
+			if err != nil {
+				return self.c.Error(err)
+			}
+			return nil
+		})
+	}
+
+	if isCheckedOut || !self.helpers.WorkingTree.IsWorkingTreeDirty() {
+		return run(false)
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.AutoStashTitle,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.ApplyStashOntoBranchAutoStashPrompt,
+			map[string]string{"branchName": branchName},
+		),
+		HandleConfirm: func() error {
+			return run(true)
+		},
+	})
+}
+
 func (self *StashController) handleRenameStashEntry(stashEntry *models.StashEntry) error {
 	message := utils.ResolvePlaceholderString(
 		self.c.Tr.RenameStashPrompt,
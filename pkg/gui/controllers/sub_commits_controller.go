@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type SubCommitsController struct {
+	baseController
+	*controllerCommon
+}
+
+var _ types.IController = &SubCommitsController{}
+
+func NewSubCommitsController(
+	common *controllerCommon,
+) *SubCommitsController {
+	return &SubCommitsController{
+		baseController:   baseController{},
+		controllerCommon: common,
+	}
+}
+
+func (self *SubCommitsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Commits.CheckoutFileVersion),
+			Handler:     self.checkSelected(self.checkoutFileVersion),
+			Description: self.c.Tr.LcCheckoutFileVersion,
+		},
+	}
+}
+
+// checkoutFileVersion restores the file whose history we're viewing to how it
+// was in the selected commit
+func (self *SubCommitsController) checkoutFileVersion(commit *models.Commit) error {
+	path := self.context().GetFilterPath()
+	if path == "" {
+		return self.c.ErrorMsg(self.c.Tr.OnlyAvailableInFileHistory)
+	}
+
+	// directories aren't followed through renames (see filterPathArgs), so
+	// only a file may have been called something else back then
+	if !strings.HasSuffix(path, "/") {
+		var err error
+		path, err = self.git.Commit.GetFilePathInCommit(self.context().GetRef().FullRefName(), path, commit.Sha)
+		if err != nil {
+			return self.c.Error(err)
+		}
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CheckoutFile)
+	if err := self.git.WorkingTree.CheckoutFile(commit.Sha, path); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+}
+
+func (self *SubCommitsController) checkSelected(callback func(*models.Commit) error) func() error {
+	return func() error {
+		commit := self.context().GetSelected()
+		if commit == nil {
+			return nil
+		}
+
+		return callback(commit)
+	}
+}
+
+func (self *SubCommitsController) Context() types.Context {
+	return self.context()
+}
+
+func (self *SubCommitsController) context() *context.SubCommitsContext {
+	return self.contexts.SubCommits
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SubmodulesController struct {
@@ -48,6 +50,11 @@ func (self *SubmodulesController) GetKeybindings(opts types.KeybindingsOpts) []*
 			Handler:     self.checkSelected(self.update),
 			Description: self.c.Tr.LcSubmoduleUpdate,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Submodules.UpdateToTrackedBranch),
+			Handler:     self.checkSelected(self.updateToTrackedBranch),
+			Description: self.c.Tr.LcUpdateSubmoduleToTrackedBranch,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.add,
@@ -117,6 +124,10 @@ func (self *SubmodulesController) add() error {
 }
 
 func (self *SubmodulesController) editURL(submodule *models.SubmoduleConfig) error {
+	if submodule.ParentModule != nil {
+		return self.nestedSubmoduleError(submodule)
+	}
+
 	return self.c.Prompt(types.PromptOpts{
 		Title:          fmt.Sprintf(self.c.Tr.LcUpdateSubmoduleUrl, submodule.Name),
 		InitialContent: submodule.Url,
@@ -137,7 +148,7 @@ func (self *SubmodulesController) editURL(submodule *models.SubmoduleConfig) err
 func (self *SubmodulesController) init(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.LcInitializingSubmoduleStatus, func() error {
 		self.c.LogAction(self.c.Tr.Actions.InitialiseSubmodule)
-		err := self.git.Submodule.Init(submodule.Path)
+		err := self.git.Submodule.Init(submodule)
 		if err != nil {
 			_ = self.c.Error(err)
 		}
@@ -179,6 +190,32 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				LabelColumns: []string{self.c.Tr.LcBulkUpdateSubmodulesToBranches, style.FgYellow.Sprint(self.git.Submodule.BulkUpdateToTrackedBranchesCmdObj().ToString())},
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.LcRunningCommand, func() error {
+						self.c.LogAction(self.c.Tr.Actions.BulkUpdateSubmodulesToBranches)
+						// the bulk command doesn't recurse into nested submodules
+						submodules := lo.Filter(self.model.Submodules, func(submodule *models.SubmoduleConfig, _ int) bool {
+							return submodule.ParentModule == nil
+						})
+						oldShas := lo.Map(submodules, func(submodule *models.SubmoduleConfig, _ int) string {
+							return self.git.Submodule.GetCheckedOutSha(submodule)
+						})
+
+						if err := self.git.Submodule.BulkUpdateToTrackedBranchesCmdObj().Run(); err != nil {
+							_ = self.c.Error(err)
+						}
+
+						for i, submodule := range submodules {
+							self.logShaChange(submodule, oldShas[i])
+						}
+
+						return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+					})
+				},
+				Key: 'r',
+			},
 			{
 				LabelColumns: []string{self.c.Tr.LcBulkDeinitSubmodules, style.FgRed.Sprint(self.git.Submodule.BulkDeinitCmdObj().ToString())},
 				OnPress: func() error {
@@ -200,7 +237,7 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.LcUpdatingSubmoduleStatus, func() error {
 		self.c.LogAction(self.c.Tr.Actions.UpdateSubmodule)
-		err := self.git.Submodule.Update(submodule.Path)
+		err := self.git.Submodule.Update(submodule)
 		if err != nil {
 			_ = self.c.Error(err)
 		}
@@ -209,7 +246,54 @@ func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) erro
 	})
 }
 
+func (self *SubmodulesController) updateToTrackedBranch(submodule *models.SubmoduleConfig) error {
+	if self.git.Submodule.GetTrackedBranch(submodule) != "" {
+		return self.runUpdateToTrackedBranch(submodule)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: fmt.Sprintf(self.c.Tr.SubmoduleTrackedBranchPrompt, submodule.Name),
+		HandleConfirm: func(branchName string) error {
+			self.c.LogAction(self.c.Tr.Actions.SetSubmoduleTrackedBranch)
+			if err := self.git.Submodule.SetTrackedBranch(submodule, branchName); err != nil {
+				return self.c.Error(err)
+			}
+
+			return self.runUpdateToTrackedBranch(submodule)
+		},
+	})
+}
+
+func (self *SubmodulesController) runUpdateToTrackedBranch(submodule *models.SubmoduleConfig) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LcUpdatingSubmoduleStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.UpdateSubmoduleToTrackedBranch)
+		oldSha := self.git.Submodule.GetCheckedOutSha(submodule)
+		if err := self.git.Submodule.UpdateToTrackedBranch(submodule); err != nil {
+			_ = self.c.Error(err)
+		} else {
+			self.logShaChange(submodule, oldSha)
+		}
+
+		// the parent repo sees the new commit as a change to the submodule
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+	})
+}
+
+// logShaChange shows in the command log which commit the submodule moved to
+func (self *SubmodulesController) logShaChange(submodule *models.SubmoduleConfig, oldSha string) {
+	newSha := self.git.Submodule.GetCheckedOutSha(submodule)
+	if newSha == oldSha {
+		return
+	}
+
+	self.c.LogCommand(fmt.Sprintf("%s: %s → %s", submodule.Name, utils.ShortSha(oldSha), utils.ShortSha(newSha)), false)
+}
+
 func (self *SubmodulesController) remove(submodule *models.SubmoduleConfig) error {
+	if submodule.ParentModule != nil {
+		return self.nestedSubmoduleError(submodule)
+	}
+
 	return self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.RemoveSubmodule,
 		Prompt: fmt.Sprintf(self.c.Tr.RemoveSubmodulePrompt, submodule.Name),
@@ -224,6 +308,12 @@ func (self *SubmodulesController) remove(submodule *models.SubmoduleConfig) erro
 	})
 }
 
+// we can only edit the .gitmodules file of our own repo, so changing a nested
+// submodule's config has to be done from within its parent
+func (self *SubmodulesController) nestedSubmoduleError(submodule *models.SubmoduleConfig) error {
+	return self.c.ErrorMsg(fmt.Sprintf(self.c.Tr.NestedSubmoduleError, submodule.Name, submodule.ParentModule.Name))
+}
+
 func (self *SubmodulesController) easterEgg() error {
 	return self.c.PushContext(self.contexts.Snake)
 }
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+var _ types.IController = &SwitchToBlameController{}
+
+// SwitchToBlameController shows the blame of the selected file in the main
+// view
+type SwitchToBlameController struct {
+	baseController
+	*controllerCommon
+	context types.Context
+
+	// returns "" if a directory is selected
+	getSelectedFilePath func() string
+	// the ref to blame the file as of, or "" for the working tree
+	getRef func() string
+}
+
+func NewSwitchToBlameController(
+	controllerCommon *controllerCommon,
+	context types.Context,
+	getSelectedFilePath func() string,
+	getRef func() string,
+) *SwitchToBlameController {
+	return &SwitchToBlameController{
+		baseController:      baseController{},
+		controllerCommon:    controllerCommon,
+		context:             context,
+		getSelectedFilePath: getSelectedFilePath,
+		getRef:              getRef,
+	}
+}
+
+func (self *SwitchToBlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Handler:     self.viewBlame,
+			Key:         opts.GetKey(opts.Config.Files.ViewBlame),
+			Description: self.c.Tr.LcViewBlame,
+		},
+	}
+
+	return bindings
+}
+
+func (self *SwitchToBlameController) viewBlame() error {
+	path := self.getSelectedFilePath()
+	if path == "" {
+		return nil
+	}
+
+	return self.helpers.Blame.ShowBlame(self.getRef(), path, 1)
+}
+
+func (self *SwitchToBlameController) Context() types.Context {
+	return self.context
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -53,7 +54,8 @@ func (self *SwitchToDiffFilesController) GetOnClick() func() error {
 func (self *SwitchToDiffFilesController) checkSelected(callback func(types.Ref) error) func() error {
 	return func() error {
 		ref := self.context.GetSelectedRef()
-		if ref == nil {
+		// rebase TODO entries like 'exec' lines have no commit to show the files of
+		if ref == nil || ref.RefName() == "" {
 			return nil
 		}
 
@@ -62,6 +64,18 @@ func (self *SwitchToDiffFilesController) checkSelected(callback func(types.Ref)
 }
 
 func (self *SwitchToDiffFilesController) enter(ref types.Ref) error {
+	if stashEntry, ok := ref.(*models.StashEntry); ok {
+		tree, err := self.git.Stash.TreeIncludingUntracked(stashEntry.Index)
+		if err != nil {
+			return self.c.Error(err)
+		}
+		if tree != "" {
+			withUntracked := *stashEntry
+			withUntracked.TreeIncludingUntracked = tree
+			ref = &withUntracked
+		}
+	}
+
 	return self.viewFiles(SwitchToCommitFilesContextOpts{
 		Ref:       ref,
 		CanRebase: self.context.CanRebase(),
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+var _ types.IController = &SwitchToFileHistoryController{}
+
+type CanSwitchToFileHistory interface {
+	types.Context
+	GetSelectedPath() string
+	IsSelectedFile() bool
+}
+
+// SwitchToFileHistoryController shows the commits that touched the selected
+// file in the sub-commits view
+type SwitchToFileHistoryController struct {
+	baseController
+	*controllerCommon
+	context CanSwitchToFileHistory
+
+	setSubCommits func([]*models.Commit)
+	// the ref whose history we look at
+	getRef func() types.Ref
+}
+
+func NewSwitchToFileHistoryController(
+	controllerCommon *controllerCommon,
+	setSubCommits func([]*models.Commit),
+	context CanSwitchToFileHistory,
+	getRef func() types.Ref,
+) *SwitchToFileHistoryController {
+	return &SwitchToFileHistoryController{
+		baseController:   baseController{},
+		controllerCommon: controllerCommon,
+		context:          context,
+		setSubCommits:    setSubCommits,
+		getRef:           getRef,
+	}
+}
+
+func (self *SwitchToFileHistoryController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Handler:     self.viewFileHistory,
+			Key:         opts.GetKey(opts.Config.Files.ViewFileHistory),
+			Description: self.c.Tr.LcViewFileHistory,
+		},
+	}
+
+	return bindings
+}
+
+func (self *SwitchToFileHistoryController) viewFileHistory() error {
+	path := self.context.GetSelectedPath()
+	ref := self.getRef()
+	if path == "" || ref == nil {
+		return nil
+	}
+
+	// git can only follow a single file through renames, so for a directory
+	// we show the commits that touched it under its current name, which the
+	// trailing slash tells the commit loader
+	var pathsByCommit map[string]string
+	if self.context.IsSelectedFile() {
+		var err error
+		pathsByCommit, err = self.git.Commit.GetFileHistoryPaths(ref.FullRefName(), path)
+		if err != nil {
+			return self.c.Error(err)
+		}
+	} else {
+		path += "/"
+	}
+
+	commits, err := self.git.Loaders.CommitLoader.GetCommits(
+		git_commands.GetCommitsOptions{
+			Limit:                true,
+			FilterPaths:          []string{path},
+			IncludeRebaseCommits: false,
+			RefName:              ref.FullRefName(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	self.setSubCommits(commits)
+
+	self.contexts.SubCommits.SetSelectedLineIdx(0)
+	self.contexts.SubCommits.SetParentContext(self.context)
+	self.contexts.SubCommits.SetWindowName(self.context.GetWindowName())
+	self.contexts.SubCommits.SetRef(ref)
+	self.contexts.SubCommits.SetFileHistory(path, pathsByCommit)
+	self.contexts.SubCommits.SetLimitCommits(true)
+
+	err = self.c.PostRefreshUpdate(self.contexts.SubCommits)
+	if err != nil {
+		return err
+	}
+
+	return self.c.PushContext(self.contexts.SubCommits)
+}
+
+func (self *SwitchToFileHistoryController) Context() types.Context {
+	return self.context
+}
@@ -60,7 +60,8 @@ func (self *SwitchToSubCommitsController) viewCommits() error {
 	commits, err := self.git.Loaders.CommitLoader.GetCommits(
 		git_commands.GetCommitsOptions{
 			Limit:                true,
-			FilterPath:           self.modes.Filtering.GetPath(),
+			FilterPaths:          self.modes.Filtering.GetPaths(),
+			FilterAuthor:         self.modes.Filtering.GetAuthor(),
 			IncludeRebaseCommits: false,
 			RefName:              ref.FullRefName(),
 		},
@@ -76,6 +77,7 @@ func (self *SwitchToSubCommitsController) viewCommits() error {
 	self.contexts.SubCommits.SetWindowName(self.context.GetWindowName())
 	self.contexts.SubCommits.SetTitleRef(ref.Description())
 	self.contexts.SubCommits.SetRef(ref)
+	self.contexts.SubCommits.SetFileHistory("", nil)
 	self.contexts.SubCommits.SetLimitCommits(true)
 
 	err = self.c.PostRefreshUpdate(self.contexts.SubCommits)
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type SyncController struct {
@@ -32,11 +34,23 @@ func (self *SyncController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Handler:     opts.Guards.NoPopupPanel(self.HandlePush),
 			Description: self.c.Tr.LcPush,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.PushMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.HandlePushMenu),
+			Description: self.c.Tr.LcViewPushOptions,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Pull),
 			Handler:     opts.Guards.NoPopupPanel(self.HandlePull),
 			Description: self.c.Tr.LcPull,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.PullMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.HandlePullMenu),
+			Description: self.c.Tr.LcViewPullOptions,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -50,10 +64,18 @@ func (self *SyncController) HandlePush() error {
 	return self.branchCheckedOut(self.push)()
 }
 
+func (self *SyncController) HandlePushMenu() error {
+	return self.branchCheckedOut(self.createPushMenu)()
+}
+
 func (self *SyncController) HandlePull() error {
 	return self.branchCheckedOut(self.pull)()
 }
 
+func (self *SyncController) HandlePullMenu() error {
+	return self.branchCheckedOut(self.createPullMenu)()
+}
+
 func (self *SyncController) branchCheckedOut(f func(*models.Branch) error) func() error {
 	return func() error {
 		currentBranch := self.helpers.Refs.GetCheckedOutRef()
@@ -95,9 +117,165 @@ func (self *SyncController) push(currentBranch *models.Branch) error {
 	}
 }
 
+// createPushMenu offers the ways of pushing that pressing push doesn't give
+// you, showing the git command for each one
+func (self *SyncController) createPushMenu(currentBranch *models.Branch) error {
+	forcePushDisabledReason := ""
+	if self.c.UserConfig.Git.DisableForcePushing {
+		forcePushDisabledReason = self.c.Tr.ForcePushDisabled
+	} else if !currentBranch.IsTrackingRemote() {
+		forcePushDisabledReason = self.c.Tr.NoUpstreamToForcePushTo
+	}
+
+	forcePushIfIncludesDisabledReason := forcePushDisabledReason
+	if forcePushIfIncludesDisabledReason == "" && !self.git.Sync.SupportsForceIfIncludes() {
+		forcePushIfIncludesDisabledReason = self.c.Tr.ForceIfIncludesNotSupported
+	}
+
+	pushToOtherRemoteDisabledReason := ""
+	pushToAllRemotesDisabledReason := ""
+	if len(self.model.Remotes) == 0 {
+		pushToOtherRemoteDisabledReason = self.c.Tr.NoRemotes
+		pushToAllRemotesDisabledReason = self.c.Tr.NoRemotes
+	} else if len(self.pushRemotes(currentBranch)) == 0 {
+		pushToAllRemotesDisabledReason = self.c.Tr.NoPushRemotesConfigured
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PushOptions,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.LcPush, "git push"},
+				OnPress: func() error {
+					return self.push(currentBranch)
+				},
+				Key: 'p',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcForcePushWithLease, "git push --force-with-lease"},
+				OnPress: func() error {
+					return self.pushAux(pushOpts{force: true, noForceIfIncludes: true})
+				},
+				Key:            'f',
+				DisabledReason: forcePushDisabledReason,
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcForcePushWithLeaseIfIncludes, "git push --force-with-lease --force-if-includes"},
+				OnPress: func() error {
+					return self.pushAux(pushOpts{force: true})
+				},
+				Key:            'i',
+				DisabledReason: forcePushIfIncludesDisabledReason,
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcPushToOtherRemote, fmt.Sprintf("git push <remote> %s", currentBranch.Name)},
+				OnPress: func() error {
+					return self.createPushToRemoteMenu(currentBranch)
+				},
+				Key:            'r',
+				DisabledReason: pushToOtherRemoteDisabledReason,
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcPushToAllRemotes, fmt.Sprintf("git push <remote> %s", currentBranch.Name)},
+				OnPress: func() error {
+					return self.pushToAllRemotes(currentBranch)
+				},
+				Key:            'a',
+				DisabledReason: pushToAllRemotesDisabledReason,
+			},
+		},
+	})
+}
+
+func (self *SyncController) createPushToRemoteMenu(currentBranch *models.Branch) error {
+	menuItems := slices.Map(self.model.Remotes, func(remote *models.Remote) *types.MenuItem {
+		return &types.MenuItem{
+			Label: remote.Name,
+			OnPress: func() error {
+				return self.pushAux(pushOpts{
+					upstreamRemote: remote.Name,
+					upstreamBranch: currentBranch.Name,
+				})
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PushToRemote,
+		Items: menuItems,
+	})
+}
+
+// pushToAllRemotes pushes the branch to each of its push remotes in turn. One
+// remote rejecting the push doesn't stop us pushing to the others, and
+// afterwards we tell the user how it went for each remote.
+// We push by remote name so that git uses every pushurl the remote has.
+func (self *SyncController) pushToAllRemotes(currentBranch *models.Branch) error {
+	remotes := self.pushRemotes(currentBranch)
+
+	return self.c.WithLoaderPanel(self.c.Tr.PushWait, func() error {
+		self.c.LogAction(self.c.Tr.Actions.PushToAllRemotes)
+
+		anyFailed := false
+		results := slices.Map(remotes, func(remote *models.Remote) string {
+			err := self.git.Sync.Push(git_commands.PushOpts{
+				UpstreamRemote: remote.Name,
+				UpstreamBranch: pushRefspec(currentBranch, remote.Name),
+			})
+			if err != nil {
+				anyFailed = true
+				return utils.ResolvePlaceholderString(self.c.Tr.FailedToPushToRemote, map[string]string{
+					"remote": remote.Name,
+					"error":  strings.TrimSpace(err.Error()),
+				})
+			}
+
+			return utils.ResolvePlaceholderString(self.c.Tr.PushedToRemote, map[string]string{
+				"remote": remote.Name,
+			})
+		})
+
+		if anyFailed {
+			_ = self.c.Alert(self.c.Tr.PushToAllRemotesFailed, strings.Join(results, "\n"))
+		} else {
+			self.c.Toast(self.c.Tr.PushedToAllRemotes)
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	})
+}
+
+// pushRemotes returns the remotes configured for pushing the branch to: its
+// push remote (from branch.<name>.pushRemote or remote.pushDefault) first,
+// followed by any other remotes that have a pushurl
+func (self *SyncController) pushRemotes(branch *models.Branch) []*models.Remote {
+	pushRemote := self.git.Config.GetPushRemote(branch.Name)
+
+	remotes, otherRemotes := slices.Partition(self.model.Remotes, func(remote *models.Remote) bool {
+		return remote.Name == pushRemote
+	})
+
+	return append(remotes, slices.Filter(otherRemotes, func(remote *models.Remote) bool {
+		return self.git.Config.HasPushUrl(remote.Name)
+	})...)
+}
+
+// pushRefspec returns what to push to the given remote: the branch's upstream
+// keeps its own name, even if that differs from the local branch's, and any
+// other remote gets a branch of the same name as ours.
+func pushRefspec(branch *models.Branch, remoteName string) string {
+	if branch.UpstreamRemote != remoteName || branch.UpstreamBranch == "" || branch.UpstreamBranch == branch.Name {
+		return branch.Name
+	}
+
+	return branch.Name + ":" + branch.UpstreamBranch
+}
+
 func (self *SyncController) pull(currentBranch *models.Branch) error {
-	action := self.c.Tr.Actions.Pull
+	return self.pullWithUpstream(currentBranch, PullFilesOptions{Action: self.c.Tr.Actions.Pull})
+}
 
+func (self *SyncController) pullWithUpstream(currentBranch *models.Branch, opts PullFilesOptions) error {
 	// if we have no upstream branch we need to set that first
 	if !currentBranch.IsTrackingRemote() {
 		return self.helpers.Upstream.PromptForUpstreamWithInitialContent(currentBranch, func(upstream string) error {
@@ -105,11 +283,57 @@ func (self *SyncController) pull(currentBranch *models.Branch) error {
 				return self.c.Error(err)
 			}
 
-			return self.PullAux(PullFilesOptions{Action: action})
+			return self.PullAux(opts)
 		})
 	}
 
-	return self.PullAux(PullFilesOptions{Action: action})
+	return self.PullAux(opts)
+}
+
+// createPullMenu lets the user pull differently to how their git config says
+// just this once. The item for what the git config says is selected to begin with.
+func (self *SyncController) createPullMenu(currentBranch *models.Branch) error {
+	action := self.c.Tr.Actions.Pull
+
+	selectedIdx := 1
+	if self.git.Config.GetPullRebase(currentBranch.Name) {
+		selectedIdx = 0
+	} else if self.git.Config.GetPullFastForwardOnly() {
+		selectedIdx = 2
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PullOptions,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.LcPullRebase, "git pull --rebase"},
+				OnPress: func() error {
+					return self.pullWithUpstream(currentBranch, PullFilesOptions{Action: action, Rebase: true})
+				},
+				Key: 'r',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcPullMerge, "git pull --no-rebase"},
+				OnPress: func() error {
+					return self.pullWithUpstream(currentBranch, PullFilesOptions{Action: action, NoRebase: true})
+				},
+				Key: 'm',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcPullFastForwardOnly, "git pull --ff-only"},
+				OnPress: func() error {
+					return self.pullWithUpstream(currentBranch, PullFilesOptions{Action: action, FastForwardOnly: true})
+				},
+				Key: 'o',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.LcFetchOnly, "git fetch"},
+				OnPress:      self.helpers.Fetch.Fetch,
+				Key:          'f',
+			},
+		},
+		SelectedIdx: selectedIdx,
+	})
 }
 
 func (self *SyncController) setCurrentBranchUpstream(upstream string) error {
@@ -134,6 +358,8 @@ type PullFilesOptions struct {
 	UpstreamRemote  string
 	UpstreamBranch  string
 	FastForwardOnly bool
+	Rebase          bool
+	NoRebase        bool
 	Action          string
 }
 
@@ -151,27 +377,46 @@ func (self *SyncController) pullWithLock(opts PullFilesOptions) error {
 			RemoteName:      opts.UpstreamRemote,
 			BranchName:      opts.UpstreamBranch,
 			FastForwardOnly: opts.FastForwardOnly,
+			Rebase:          opts.Rebase,
+			NoRebase:        opts.NoRebase,
 		},
 	)
 
+	if err != nil && opts.FastForwardOnly && strings.Contains(err.Error(), "Not possible to fast-forward") {
+		_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		return self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.CannotFastForwardTitle,
+			Prompt: self.c.Tr.CannotFastForwardPrompt,
+			HandleConfirm: func() error {
+				newOpts := opts
+				newOpts.FastForwardOnly = false
+				newOpts.Rebase = true
+
+				return self.PullAux(newOpts)
+			},
+		})
+	}
+
 	return self.helpers.MergeAndRebase.CheckMergeOrRebase(err)
 }
 
 type pushOpts struct {
-	force          bool
-	upstreamRemote string
-	upstreamBranch string
-	setUpstream    bool
+	force             bool
+	noForceIfIncludes bool
+	upstreamRemote    string
+	upstreamBranch    string
+	setUpstream       bool
 }
 
 func (self *SyncController) pushAux(opts pushOpts) error {
 	return self.c.WithLoaderPanel(self.c.Tr.PushWait, func() error {
 		self.c.LogAction(self.c.Tr.Actions.Push)
 		err := self.git.Sync.Push(git_commands.PushOpts{
-			Force:          opts.force,
-			UpstreamRemote: opts.upstreamRemote,
-			UpstreamBranch: opts.upstreamBranch,
-			SetUpstream:    opts.setUpstream,
+			Force:             opts.force,
+			NoForceIfIncludes: opts.noForceIfIncludes,
+			UpstreamRemote:    opts.upstreamRemote,
+			UpstreamBranch:    opts.upstreamBranch,
+			SetUpstream:       opts.setUpstream,
 		})
 		if err != nil {
 			if !opts.force && strings.Contains(err.Error(), "Updates were rejected") {
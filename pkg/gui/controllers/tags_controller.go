@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -40,6 +42,11 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Handler:     self.withSelectedTag(self.push),
 			Description: self.c.Tr.LcPushTag,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.EditTagMessage),
+			Handler:     self.withSelectedTag(self.editMessage),
+			Description: self.c.Tr.LcEditTagMessage,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.New),
 			Handler:     self.create,
@@ -111,6 +118,86 @@ func (self *TagsController) push(tag *models.Tag) error {
 	})
 }
 
+func (self *TagsController) editMessage(tag *models.Tag) error {
+	if !tag.IsAnnotated {
+		return self.c.ErrorMsg(self.c.Tr.EditLightweightTagMessageError)
+	}
+
+	message, err := self.git.Tag.GetMessage(tag.Name)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.EditTagMessageTitle,
+			map[string]string{
+				"tagName": tag.Name,
+			},
+		),
+		InitialContent: message,
+		HandleConfirm: func(response string) error {
+			self.c.LogAction(self.c.Tr.Actions.EditTagMessage)
+			if err := self.git.Tag.EditMessage(tag.Name, response); err != nil {
+				return self.c.Error(err)
+			}
+			if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.TAGS}}); err != nil {
+				return err
+			}
+
+			return self.offerToForcePush(tag.Name)
+		},
+	})
+}
+
+// offerToForcePush asks whether to force push an edited tag to the remotes that
+// have the old one, since they won't take the new one otherwise
+func (self *TagsController) offerToForcePush(tagName string) error {
+	if len(self.model.Remotes) == 0 {
+		return nil
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.CheckingRemotesForTagStatus, func() error {
+		remoteNames := []string{}
+		for _, remote := range self.model.Remotes {
+			exists, err := self.git.Tag.ExistsOnRemote(remote.Name, tagName)
+			if err != nil {
+				self.c.Log.Error(err)
+				continue
+			}
+			if exists {
+				remoteNames = append(remoteNames, remote.Name)
+			}
+		}
+
+		if len(remoteNames) == 0 {
+			return nil
+		}
+
+		return self.c.Confirm(types.ConfirmOpts{
+			Title: self.c.Tr.ForcePushTagTitle,
+			Prompt: utils.ResolvePlaceholderString(
+				self.c.Tr.ForcePushTagPrompt,
+				map[string]string{
+					"tagName": tagName,
+					"remotes": strings.Join(remoteNames, ", "),
+				},
+			),
+			HandleConfirm: func() error {
+				return self.c.WithWaitingStatus(self.c.Tr.PushingTagStatus, func() error {
+					self.c.LogAction(self.c.Tr.Actions.ForcePushTag)
+					for _, remoteName := range remoteNames {
+						if err := self.git.Tag.ForcePush(remoteName, tagName); err != nil {
+							return self.c.Error(err)
+						}
+					}
+					return nil
+				})
+			},
+		})
+	})
+}
+
 func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.helpers.Refs.CreateGitResetMenu(tag.Name)
 }
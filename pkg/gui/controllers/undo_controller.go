@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -92,7 +93,7 @@ func (self *UndoController) reflogUndo() error {
 				Prompt: fmt.Sprintf(self.c.Tr.HardResetAutostashPrompt, action.from),
 				HandleConfirm: func() error {
 					self.c.LogAction(self.c.Tr.Actions.Undo)
-					return self.hardResetWithAutoStash(action.from, hardResetOptions{
+					return self.helpers.WorkingTree.HardResetWithAutoStash(action.from, helpers.HardResetOptions{
 						EnvVars:       undoEnvVars,
 						WaitingStatus: undoingStatus,
 					})
@@ -143,7 +144,7 @@ func (self *UndoController) reflogRedo() error {
 				Prompt: fmt.Sprintf(self.c.Tr.HardResetAutostashPrompt, action.to),
 				HandleConfirm: func() error {
 					self.c.LogAction(self.c.Tr.Actions.Redo)
-					return self.hardResetWithAutoStash(action.to, hardResetOptions{
+					return self.helpers.WorkingTree.HardResetWithAutoStash(action.to, helpers.HardResetOptions{
 						EnvVars:       redoEnvVars,
 						WaitingStatus: redoingStatus,
 					})
@@ -224,51 +225,3 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 	}
 	return nil
 }
-
-type hardResetOptions struct {
-	WaitingStatus string
-	EnvVars       []string
-}
-
-// only to be used in the undo flow for now (does an autostash)
-func (self *UndoController) hardResetWithAutoStash(commitSha string, options hardResetOptions) error {
-	reset := func() error {
-		if err := self.helpers.Refs.ResetToRef(commitSha, "hard", options.EnvVars); err != nil {
-			return self.c.Error(err)
-		}
-		return nil
-	}
-
-	// if we have any modified tracked files we need to ask the user if they want us to stash for them
-	dirtyWorkingTree := self.helpers.WorkingTree.IsWorkingTreeDirty()
-	if dirtyWorkingTree {
-		// offer to autostash changes
-		return self.c.Confirm(types.ConfirmOpts{
-			Title:  self.c.Tr.AutoStashTitle,
-			Prompt: self.c.Tr.AutoStashPrompt,
-			HandleConfirm: func() error {
-				return self.c.WithWaitingStatus(options.WaitingStatus, func() error {
-					if err := self.git.Stash.Save(self.c.Tr.StashPrefix + commitSha); err != nil {
-						return self.c.Error(err)
-					}
-					if err := reset(); err != nil {
-						return err
-					}
-
-					err := self.git.Stash.Pop(0)
-					if err := self.c.Refresh(types.RefreshOptions{}); err != nil {
-						return err
-					}
-					if err != nil {
-						return self.c.Error(err)
-					}
-					return nil
-				})
-			},
-		})
-	}
-
-	return self.c.WithWaitingStatus(options.WaitingStatus, func() error {
-		return reset()
-	})
-}
@@ -64,6 +64,15 @@ func (self *FilesController) createResetMenu() error {
 			},
 			Key: 'c',
 		},
+		{
+			LabelColumns: []string{
+				self.c.Tr.LcCleanUntrackedFiles,
+				red.Sprint("git clean -fd -- <paths>"),
+			},
+			OnPress:   self.helpers.Clean.CreateCleanMenu,
+			Key:       'C',
+			OpensMenu: true,
+		},
 		{
 			LabelColumns: []string{
 				self.c.Tr.LcDiscardStagedChanges,
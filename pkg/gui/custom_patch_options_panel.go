@@ -2,6 +2,7 @@ package gui
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -28,12 +29,27 @@ func (gui *Gui) handleCreatePatchOptionsMenu() error {
 			OnPress: func() error { return gui.handleApplyPatch(true) },
 			Key:     'r',
 		},
+		{
+			Label:   "apply patch to working tree",
+			OnPress: func() error { return gui.handleApplyPatchToWorkingTree(false) },
+			Key:     'w',
+		},
+		{
+			Label:   "apply patch to working tree in reverse",
+			OnPress: func() error { return gui.handleApplyPatchToWorkingTree(true) },
+			Key:     'W',
+		},
+		{
+			Label:   "save patch as stash entry",
+			OnPress: gui.handleSavePatchAsStashEntry,
+			Key:     's',
+		},
 	}
 
 	if gui.git.Patch.PatchManager.CanRebase && gui.git.Status.WorkingTreeState() == enums.REBASE_MODE_NONE {
 		menuItems = append(menuItems, []*types.MenuItem{
 			{
-				Label:   fmt.Sprintf("remove patch from original commit (%s)", gui.git.Patch.PatchManager.To),
+				Label:   fmt.Sprintf("remove patch from original commit (%s)", gui.getPatchCommitSha()),
 				OnPress: gui.handleDeletePatchFromCommit,
 				Key:     'd',
 			},
@@ -47,11 +63,16 @@ func (gui *Gui) handleCreatePatchOptionsMenu() error {
 				OnPress: gui.handlePullPatchIntoNewCommit,
 				Key:     'n',
 			},
+			{
+				Label:   "move patch into new commit before the original commit",
+				OnPress: gui.handlePullPatchIntoNewCommitBefore,
+				Key:     'N',
+			},
 		}...)
 
 		if gui.currentContext().GetKey() == gui.State.Contexts.LocalCommits.GetKey() {
 			selectedCommit := gui.getSelectedLocalCommit()
-			if selectedCommit != nil && gui.git.Patch.PatchManager.To != selectedCommit.Sha {
+			if selectedCommit != nil && gui.getPatchCommitSha() != selectedCommit.Sha {
 				// adding this option to index 1
 				menuItems = append(
 					menuItems[:1],
@@ -75,6 +96,11 @@ func (gui *Gui) handleCreatePatchOptionsMenu() error {
 			OnPress: func() error { return gui.copyPatchToClipboard() },
 			Key:     'y',
 		},
+		{
+			Label:   "save patch to file",
+			OnPress: gui.handleSavePatchToFile,
+			Key:     'f',
+		},
 	}...)
 
 	return gui.c.Menu(types.CreateMenuOptions{Title: gui.c.Tr.PatchOptionsTitle, Items: menuItems})
@@ -82,13 +108,31 @@ func (gui *Gui) handleCreatePatchOptionsMenu() error {
 
 func (gui *Gui) getPatchCommitIndex() int {
 	for index, commit := range gui.State.Model.Commits {
-		if commit.Sha == gui.git.Patch.PatchManager.To {
+		if commit.Sha == gui.getPatchCommitSha() {
 			return index
 		}
 	}
 	return -1
 }
 
+// getPatchCommitSha returns the commit that the patch was built from. If the
+// patch spans several commits there is no such commit, and operations which
+// need one must be prevented via validatePatchFromSingleCommit
+func (gui *Gui) getPatchCommitSha() string {
+	commits := gui.git.Patch.PatchManager.Commits()
+	if len(commits) == 1 {
+		return commits[0]
+	}
+	return gui.git.Patch.PatchManager.To
+}
+
+func (gui *Gui) validatePatchFromSingleCommit() (bool, error) {
+	if gui.git.Patch.PatchManager.SpansMultipleCommits() {
+		return false, gui.c.ErrorMsg(gui.c.Tr.CantMoveMultiCommitPatch)
+	}
+	return true, nil
+}
+
 func (gui *Gui) validateNormalWorkingTreeState() (bool, error) {
 	if gui.git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
 		return false, gui.c.ErrorMsg(gui.c.Tr.CantPatchWhileRebasingError)
@@ -108,6 +152,10 @@ func (gui *Gui) handleDeletePatchFromCommit() error {
 		return err
 	}
 
+	if ok, err := gui.validatePatchFromSingleCommit(); !ok {
+		return err
+	}
+
 	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
 		return err
 	}
@@ -125,6 +173,10 @@ func (gui *Gui) handleMovePatchToSelectedCommit() error {
 		return err
 	}
 
+	if ok, err := gui.validatePatchFromSingleCommit(); !ok {
+		return err
+	}
+
 	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
 		return err
 	}
@@ -173,6 +225,10 @@ func (gui *Gui) handlePullPatchIntoNewCommit() error {
 		return err
 	}
 
+	if ok, err := gui.validatePatchFromSingleCommit(); !ok {
+		return err
+	}
+
 	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
 		return err
 	}
@@ -185,6 +241,27 @@ func (gui *Gui) handlePullPatchIntoNewCommit() error {
 	})
 }
 
+func (gui *Gui) handlePullPatchIntoNewCommitBefore() error {
+	if ok, err := gui.validateNormalWorkingTreeState(); !ok {
+		return err
+	}
+
+	if ok, err := gui.validatePatchFromSingleCommit(); !ok {
+		return err
+	}
+
+	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
+		return err
+	}
+
+	return gui.c.WithWaitingStatus(gui.c.Tr.RebasingStatus, func() error {
+		commitIndex := gui.getPatchCommitIndex()
+		gui.c.LogAction(gui.c.Tr.Actions.MovePatchIntoNewCommitBefore)
+		err := gui.git.Patch.PullPatchIntoNewCommitBefore(gui.State.Model.Commits, commitIndex)
+		return gui.helpers.MergeAndRebase.CheckMergeOrRebase(err)
+	})
+}
+
 func (gui *Gui) handleApplyPatch(reverse bool) error {
 	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
 		return err
@@ -201,6 +278,39 @@ func (gui *Gui) handleApplyPatch(reverse bool) error {
 	return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 }
 
+func (gui *Gui) handleApplyPatchToWorkingTree(reverse bool) error {
+	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
+		return err
+	}
+
+	action := gui.c.Tr.Actions.ApplyPatchToWorkingTree
+	if reverse {
+		action = gui.c.Tr.Actions.ApplyPatchToWorkingTreeInReverse
+	}
+	gui.c.LogAction(action)
+	if err := gui.git.Patch.PatchManager.ApplyPatchesToWorkingTree(reverse); err != nil {
+		return gui.c.ErrorMsg(fmt.Sprintf(gui.c.Tr.CannotApplyPatchToWorkingTree, err.Error()))
+	}
+	return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
+
+func (gui *Gui) handleSavePatchAsStashEntry() error {
+	if err := gui.returnFocusFromPatchExplorerIfNecessary(); err != nil {
+		return err
+	}
+
+	return gui.c.Prompt(types.PromptOpts{
+		Title: gui.c.Tr.StashChanges,
+		HandleConfirm: func(stashComment string) error {
+			gui.c.LogAction(gui.c.Tr.Actions.SavePatchAsStashEntry)
+			if err := gui.git.Patch.SavePatchAsStashEntry(stashComment); err != nil {
+				return gui.c.Error(err)
+			}
+			return gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH}})
+		},
+	})
+}
+
 func (gui *Gui) copyPatchToClipboard() error {
 	patch := gui.git.Patch.PatchManager.RenderAggregatedPatchColored(true)
 
@@ -213,3 +323,22 @@ func (gui *Gui) copyPatchToClipboard() error {
 
 	return nil
 }
+
+func (gui *Gui) handleSavePatchToFile() error {
+	return gui.c.Prompt(types.PromptOpts{
+		Title:          gui.c.Tr.SavePatchToFileTitle,
+		InitialContent: "lazygit.patch",
+		HandleConfirm: func(path string) error {
+			patch := strings.TrimRight(gui.git.Patch.PatchManager.RenderAggregatedPatchColored(true), "\n") + "\n"
+
+			gui.c.LogAction(gui.c.Tr.Actions.SavePatchToFile)
+			if err := gui.os.CreateFileWithContent(path, patch); err != nil {
+				return gui.c.Error(err)
+			}
+
+			gui.c.Toast(fmt.Sprintf(gui.c.Tr.PatchSavedToFile, path))
+
+			return nil
+		},
+	})
+}
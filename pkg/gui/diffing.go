@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -102,8 +103,8 @@ func (gui *Gui) diffStr() string {
 	file := gui.currentlySelectedFilename()
 	if file != "" {
 		output += " -- " + file
-	} else if gui.State.Modes.Filtering.Active() {
-		output += " -- " + gui.State.Modes.Filtering.GetPath()
+	} else if gui.State.Modes.Filtering.HasPaths() {
+		output += " -- " + strings.Join(git_commands.FilterPathspecs(gui.State.Modes.Filtering.GetPaths()), " ")
 	}
 
 	return output
@@ -66,7 +66,8 @@ func (gui *Gui) handleEditorKeypress(textArea *gocui.TextArea, key gocui.Key, ch
 // we've just copy+pasted the editor from gocui to here so that we can also re-
 // render the commit message length on each keypress
 func (gui *Gui) commitMessageEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
-	matched := gui.handleEditorKeypress(v.TextArea, key, ch, mod, true)
+	matched := gui.handleCommitMessageHistoryKeypress(v.TextArea, key, mod) ||
+		gui.handleEditorKeypress(v.TextArea, key, ch, mod, true)
 
 	// This function is called again on refresh as part of the general resize popup call,
 	// but we need to call it here so that when we go to render the text area it's not
@@ -77,6 +78,7 @@ func (gui *Gui) commitMessageEditor(v *gocui.View, key gocui.Key, ch rune, mod g
 	}
 	v.RenderTextArea()
 	gui.RenderCommitLength()
+	gui.renderCommitMessageWarnings()
 
 	return matched
 }
@@ -86,10 +88,12 @@ func (gui *Gui) defaultEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.M
 
 	v.RenderTextArea()
 
-	if gui.findSuggestions != nil {
+	// the prompt may have been closed, and its suggestions func unset, by the
+	// time we get to finding suggestions, so we hold on to it here
+	if findSuggestionsFn := gui.findSuggestions; findSuggestionsFn != nil {
 		input := v.TextArea.GetContent()
 		gui.suggestionsAsyncHandler.Do(func() func() {
-			suggestions := gui.findSuggestions(input)
+			suggestions := findSuggestionsFn(input)
 			return func() { gui.setSuggestions(suggestions) }
 		})
 	}
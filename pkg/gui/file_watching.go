@@ -116,10 +116,8 @@ func (gui *Gui) watchFilesForChanges() {
 					// for some reason we pick up chmod events when they don't actually happen
 					continue
 				}
-				// only refresh if we're not already
-				if !gui.State.IsRefreshingFiles {
-					_ = gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
-				}
+				// there's likely to be a flurry of these
+				_ = gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Debounce: true})
 
 			// watch for errors
 			case err := <-gui.fileWatcher.Watcher.Errors:
@@ -93,3 +93,11 @@ func (gui *Gui) getSetTextareaTextFn(getView func() *gocui.View) func(string) {
 		view.RenderTextArea()
 	}
 }
+
+func (gui *Gui) getSetTextareaCursorFn(getView func() *gocui.View) func(int, int) {
+	return func(x int, y int) {
+		view := getView()
+		view.TextArea.SetCursor2D(x, y)
+		view.RenderTextArea()
+	}
+}
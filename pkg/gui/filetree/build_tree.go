@@ -46,6 +46,75 @@ func BuildTreeFromFiles(files []*models.File) *Node[models.File] {
 	return root
 }
 
+// UpdateTreeFromFiles builds the same tree as BuildTreeFromFiles, but diffs the
+// files against prevTree (which must have come from one of these two functions)
+// so that only the top-level directories whose files have come or gone are
+// built again. The nodes of the other directories are kept and just pointed at
+// the new files, which matters in big repos where a refresh typically changes
+// the status of a handful of files.
+func UpdateTreeFromFiles(prevTree *Node[models.File], files []*models.File) *Node[models.File] {
+	if prevTree == nil || len(files) == 0 {
+		return BuildTreeFromFiles(files)
+	}
+
+	prevChildrenByTopLevelName := map[string]*Node[models.File]{}
+	for _, child := range prevTree.Children {
+		prevChildrenByTopLevelName[split(child.Path)[0]] = child
+	}
+
+	topLevelNames := []string{}
+	filesByTopLevelName := map[string][]*models.File{}
+	for _, file := range files {
+		name := split(file.Name)[0]
+		if _, ok := filesByTopLevelName[name]; !ok {
+			topLevelNames = append(topLevelNames, name)
+		}
+		filesByTopLevelName[name] = append(filesByTopLevelName[name], file)
+	}
+
+	root := &Node[models.File]{}
+	for _, name := range topLevelNames {
+		files := filesByTopLevelName[name]
+		if prevChild, ok := prevChildrenByTopLevelName[name]; ok && repointLeaves(prevChild, files) {
+			root.Children = append(root.Children, prevChild)
+		} else {
+			root.Children = append(root.Children, BuildTreeFromFiles(files).Children...)
+		}
+	}
+
+	// the children were either sorted already or built by BuildTreeFromFiles,
+	// so it's only their order that needs sorting
+	root.SortChildren()
+
+	return root
+}
+
+// repointLeaves points the leaves of node at the given files, returning false
+// without changing anything if the files aren't exactly the node's leaves
+func repointLeaves(node *Node[models.File], files []*models.File) bool {
+	leaves := node.GetLeaves()
+	if len(leaves) != len(files) {
+		return false
+	}
+
+	filesByName := make(map[string]*models.File, len(files))
+	for _, file := range files {
+		filesByName[file.Name] = file
+	}
+
+	for _, leaf := range leaves {
+		if _, ok := filesByName[leaf.Path]; !ok {
+			return false
+		}
+	}
+
+	for _, leaf := range leaves {
+		leaf.File = filesByName[leaf.Path]
+	}
+
+	return true
+}
+
 func BuildFlatTreeFromCommitFiles(files []*models.CommitFile) *Node[models.CommitFile] {
 	rootAux := BuildTreeFromCommitFiles(files)
 	sortedFiles := rootAux.GetLeaves()
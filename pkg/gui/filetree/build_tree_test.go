@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -155,6 +156,91 @@ func TestBuildTreeFromFiles(t *testing.T) {
 	}
 }
 
+func TestUpdateTreeFromFiles(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		prevFiles []*models.File
+		files     []*models.File
+		// top-level paths of the nodes we expect to be kept from the previous tree
+		expectedReused []string
+	}{
+		{
+			name:           "no previous tree",
+			prevFiles:      nil,
+			files:          []*models.File{{Name: "dir1/a"}, {Name: "b"}},
+			expectedReused: []string{},
+		},
+		{
+			name:           "only statuses changed",
+			prevFiles:      []*models.File{{Name: "dir1/a"}, {Name: "dir2/dir3/b"}, {Name: "c"}},
+			files:          []*models.File{{Name: "dir1/a", HasStagedChanges: true}, {Name: "dir2/dir3/b"}, {Name: "c", Tracked: true}},
+			expectedReused: []string{"dir1", "dir2/dir3", "c"},
+		},
+		{
+			name:           "file added to a directory",
+			prevFiles:      []*models.File{{Name: "dir1/a"}, {Name: "dir2/dir3/b"}},
+			files:          []*models.File{{Name: "dir1/a"}, {Name: "dir2/dir3/b"}, {Name: "dir2/c"}},
+			expectedReused: []string{"dir1"},
+		},
+		{
+			name:           "file removed from a directory",
+			prevFiles:      []*models.File{{Name: "dir1/a"}, {Name: "dir1/b"}, {Name: "dir2/c"}},
+			files:          []*models.File{{Name: "dir1/a"}, {Name: "dir2/c"}},
+			expectedReused: []string{"dir2"},
+		},
+		{
+			name:           "new top-level directory",
+			prevFiles:      []*models.File{{Name: "dir2/a"}},
+			files:          []*models.File{{Name: "dir2/a"}, {Name: "dir1/b"}},
+			expectedReused: []string{"dir2"},
+		},
+		{
+			name:           "top-level directory gone",
+			prevFiles:      []*models.File{{Name: "dir1/a"}, {Name: "dir2/b"}},
+			files:          []*models.File{{Name: "dir2/b"}},
+			expectedReused: []string{"dir2"},
+		},
+		{
+			name:           "no files left",
+			prevFiles:      []*models.File{{Name: "dir1/a"}},
+			files:          []*models.File{},
+			expectedReused: []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			var prevTree *Node[models.File]
+			if s.prevFiles != nil {
+				prevTree = BuildTreeFromFiles(s.prevFiles)
+			}
+			prevChildren := map[string]*Node[models.File]{}
+			if prevTree != nil {
+				for _, child := range prevTree.Children {
+					prevChildren[child.Path] = child
+				}
+			}
+
+			result := UpdateTreeFromFiles(prevTree, s.files)
+			assert.EqualValues(t, BuildTreeFromFiles(s.files), result)
+
+			reused := []string{}
+			for _, child := range result.Children {
+				if prevChildren[child.Path] == child {
+					reused = append(reused, child.Path)
+				}
+			}
+			assert.ElementsMatch(t, s.expectedReused, reused)
+
+			// the kept nodes must point at the new files, not the old ones
+			for _, leaf := range result.GetLeaves() {
+				assert.True(t, lo.Contains(s.files, leaf.File), leaf.Path)
+			}
+		})
+	}
+}
+
 func TestBuildFlatTreeFromFiles(t *testing.T) {
 	scenarios := []struct {
 		name     string
@@ -29,6 +29,14 @@ func (self *CollapsedPaths) Collapse(path string) {
 	self.collapsedPaths.Add(path)
 }
 
+func (self *CollapsedPaths) Expand(path string) {
+	self.collapsedPaths.Remove(path)
+}
+
+func (self *CollapsedPaths) ExpandAll() {
+	self.collapsedPaths = set.New[string]()
+}
+
 func (self *CollapsedPaths) ToggleCollapsed(path string) {
 	if self.collapsedPaths.Includes(path) {
 		self.collapsedPaths.Remove(path)
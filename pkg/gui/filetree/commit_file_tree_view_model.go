@@ -87,6 +87,13 @@ func (self *CommitFileTreeViewModel) GetSelectedPath() string {
 	return node.GetPath()
 }
 
+// IsSelectedFile tells us whether the selected node is a file rather than a
+// directory
+func (self *CommitFileTreeViewModel) IsSelectedFile() bool {
+	node := self.GetSelected()
+	return node != nil && node.IsFile()
+}
+
 // duplicated from file_tree_view_model.go. Generics will help here
 func (self *CommitFileTreeViewModel) ToggleShowTree() {
 	selectedNode := self.GetSelected()
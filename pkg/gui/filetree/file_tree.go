@@ -3,6 +3,7 @@ package filetree
 import (
 	"fmt"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/sirupsen/logrus"
@@ -41,6 +42,9 @@ type IFileTree interface {
 	GetAllFiles() []*models.File
 	GetFilter() FileTreeDisplayFilter
 	GetRoot() *FileNode
+	CollapseAll()
+	ExpandAll()
+	ExpandToDefaultDepth()
 }
 
 type FileTree struct {
@@ -50,17 +54,25 @@ type FileTree struct {
 	log            *logrus.Entry
 	filter         FileTreeDisplayFilter
 	collapsedPaths *CollapsedPaths
+	// directories nested more deeply than this start out collapsed. 0 means
+	// that all directories start out expanded
+	defaultDepth int
+	// the directories we've already applied the default depth to, so that we
+	// don't undo the user expanding or collapsing them on the next refresh
+	knownDirPaths *set.Set[string]
 }
 
 var _ IFileTree = &FileTree{}
 
-func NewFileTree(getFiles func() []*models.File, log *logrus.Entry, showTree bool) *FileTree {
+func NewFileTree(getFiles func() []*models.File, log *logrus.Entry, showTree bool, defaultDepth int) *FileTree {
 	return &FileTree{
 		getFiles:       getFiles,
 		log:            log,
 		showTree:       showTree,
 		filter:         DisplayAll,
 		collapsedPaths: NewCollapsedPaths(),
+		defaultDepth:   defaultDepth,
+		knownDirPaths:  set.New[string](),
 	}
 }
 
@@ -98,6 +110,8 @@ func (self *FileTree) SetFilter(filter FileTreeDisplayFilter) {
 
 func (self *FileTree) ToggleShowTree() {
 	self.showTree = !self.showTree
+	// a flat tree can't be updated into a nested one
+	self.tree = nil
 	self.SetTree()
 }
 
@@ -146,10 +160,54 @@ func (self *FileTree) GetAllFiles() []*models.File {
 func (self *FileTree) SetTree() {
 	filesForDisplay := self.getFilesForDisplay()
 	if self.showTree {
-		self.tree = BuildTreeFromFiles(filesForDisplay)
+		self.tree = UpdateTreeFromFiles(self.tree, filesForDisplay)
 	} else {
 		self.tree = BuildFlatTreeFromFiles(filesForDisplay)
 	}
+
+	self.tree.ForEachDir(func(node *Node[models.File], depth int) {
+		if self.knownDirPaths.Includes(node.Path) {
+			return
+		}
+
+		self.knownDirPaths.Add(node.Path)
+		if self.defaultDepth > 0 && depth > self.defaultDepth {
+			self.collapsedPaths.Collapse(node.Path)
+		}
+	})
+}
+
+func (self *FileTree) CollapseAll() {
+	self.expandToDepth(0)
+}
+
+func (self *FileTree) ExpandAll() {
+	self.collapsedPaths.ExpandAll()
+}
+
+func (self *FileTree) ExpandToDefaultDepth() {
+	if self.defaultDepth == 0 {
+		self.ExpandAll()
+		return
+	}
+
+	self.expandToDepth(self.defaultDepth)
+}
+
+// expandToDepth expands the directories nested at most depth levels deep and
+// collapses the rest
+func (self *FileTree) expandToDepth(depth int) {
+	if self.tree == nil {
+		return
+	}
+
+	self.tree.ForEachDir(func(node *Node[models.File], nodeDepth int) {
+		if nodeDepth > depth {
+			self.collapsedPaths.Collapse(node.Path)
+		} else {
+			self.collapsedPaths.Expand(node.Path)
+		}
+	})
 }
 
 func (self *FileTree) IsCollapsed(path string) bool {
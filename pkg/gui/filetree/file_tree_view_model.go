@@ -7,6 +7,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -26,8 +27,8 @@ type FileTreeViewModel struct {
 
 var _ IFileTreeViewModel = &FileTreeViewModel{}
 
-func NewFileTreeViewModel(getFiles func() []*models.File, log *logrus.Entry, showTree bool) *FileTreeViewModel {
-	fileTree := NewFileTree(getFiles, log, showTree)
+func NewFileTreeViewModel(getFiles func() []*models.File, log *logrus.Entry, showTree bool, defaultDepth int) *FileTreeViewModel {
+	fileTree := NewFileTree(getFiles, log, showTree, defaultDepth)
 	listCursor := traits.NewListCursor(fileTree)
 	return &FileTreeViewModel{
 		IFileTree:   fileTree,
@@ -61,6 +62,34 @@ func (self *FileTreeViewModel) GetSelectedPath() string {
 	return node.GetPath()
 }
 
+// GetSelectedPaths returns the paths of all the selected nodes, including the
+// old name of a renamed file
+func (self *FileTreeViewModel) GetSelectedPaths() []string {
+	if self.Len() == 0 {
+		return nil
+	}
+
+	startIdx, endIdx := self.GetSelectionRange()
+	paths := []string{}
+	for i := startIdx; i <= endIdx; i++ {
+		node := self.Get(i)
+		if node.File != nil {
+			paths = append(paths, node.File.Names()...)
+		} else {
+			paths = append(paths, node.GetPath())
+		}
+	}
+
+	return lo.Uniq(paths)
+}
+
+// IsSelectedFile tells us whether the selected node is a file rather than a
+// directory
+func (self *FileTreeViewModel) IsSelectedFile() bool {
+	node := self.GetSelected()
+	return node != nil && node.IsFile()
+}
+
 func (self *FileTreeViewModel) SetTree() {
 	newFiles := self.GetAllFiles()
 	selectedNode := self.GetSelected()
@@ -155,3 +184,36 @@ func (self *FileTreeViewModel) ToggleShowTree() {
 		self.SetSelectedLineIdx(index)
 	}
 }
+
+func (self *FileTreeViewModel) CollapseAll() {
+	self.keepSelection(self.IFileTree.CollapseAll)
+}
+
+func (self *FileTreeViewModel) ExpandAll() {
+	self.keepSelection(self.IFileTree.ExpandAll)
+}
+
+func (self *FileTreeViewModel) ExpandToDefaultDepth() {
+	self.keepSelection(self.IFileTree.ExpandToDefaultDepth)
+}
+
+// keepSelection keeps the selected node selected across a change to which
+// directories are collapsed. If the node is now hidden inside a collapsed
+// directory, we select the nearest directory above it that's still visible.
+func (self *FileTreeViewModel) keepSelection(changeCollapsedPaths func()) {
+	selectedPath := self.GetSelectedPath()
+
+	changeCollapsedPaths()
+
+	if selectedPath != "" {
+		splitPath := split(selectedPath)
+		for i := len(splitPath); i > 0; i-- {
+			if index, found := self.GetIndexForPath(join(splitPath[:i])); found {
+				self.SetSelectedLineIdx(index)
+				return
+			}
+		}
+	}
+
+	self.RefreshSelectedIdx()
+}
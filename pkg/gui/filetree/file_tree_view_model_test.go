@@ -0,0 +1,169 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTreeViewModelCollapseAndExpand(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir1/file1"},
+		{Name: "dir1/sub/file2"},
+		{Name: "dir1/sub/file3"},
+		{Name: "dir2/file4"},
+		{Name: "file5"},
+	}
+
+	scenarios := []struct {
+		name             string
+		defaultDepth     int
+		selectedPath     string
+		action           func(*FileTreeViewModel)
+		expectedPaths    []string
+		expectedSelected string
+	}{
+		{
+			name:         "collapse all moves the selection to the nearest visible directory",
+			selectedPath: "dir1/sub/file2",
+			action:       func(self *FileTreeViewModel) { self.CollapseAll() },
+			expectedPaths: []string{
+				"dir1",
+				"dir2",
+				"file5",
+			},
+			expectedSelected: "dir1",
+		},
+		{
+			name:         "collapse all keeps a visible selection",
+			selectedPath: "file5",
+			action:       func(self *FileTreeViewModel) { self.CollapseAll() },
+			expectedPaths: []string{
+				"dir1",
+				"dir2",
+				"file5",
+			},
+			expectedSelected: "file5",
+		},
+		{
+			name:         "expand all keeps the selection",
+			selectedPath: "dir2",
+			action: func(self *FileTreeViewModel) {
+				self.CollapseAll()
+				self.ExpandAll()
+			},
+			expectedPaths: []string{
+				"dir1",
+				"dir1/sub",
+				"dir1/sub/file2",
+				"dir1/sub/file3",
+				"dir1/file1",
+				"dir2",
+				"dir2/file4",
+				"file5",
+			},
+			expectedSelected: "dir2",
+		},
+		{
+			name:         "expand to default depth collapses deeper directories",
+			defaultDepth: 1,
+			selectedPath: "dir1/sub/file3",
+			action:       func(self *FileTreeViewModel) { self.ExpandToDefaultDepth() },
+			expectedPaths: []string{
+				"dir1",
+				"dir1/sub",
+				"dir1/file1",
+				"dir2",
+				"dir2/file4",
+				"file5",
+			},
+			expectedSelected: "dir1/sub",
+		},
+		{
+			name:         "expand to default depth with no default depth expands everything",
+			selectedPath: "file5",
+			action: func(self *FileTreeViewModel) {
+				self.CollapseAll()
+				self.ExpandToDefaultDepth()
+			},
+			expectedPaths: []string{
+				"dir1",
+				"dir1/sub",
+				"dir1/sub/file2",
+				"dir1/sub/file3",
+				"dir1/file1",
+				"dir2",
+				"dir2/file4",
+				"file5",
+			},
+			expectedSelected: "file5",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			viewModel := NewFileTreeViewModel(func() []*models.File { return files }, utils.NewDummyLog(), true, s.defaultDepth)
+			viewModel.SetTree()
+			// so that we can select files that the default depth would hide
+			viewModel.ExpandAll()
+
+			index, found := viewModel.GetIndexForPath(s.selectedPath)
+			assert.True(t, found)
+			viewModel.SetSelectedLineIdx(index)
+
+			s.action(viewModel)
+
+			assert.EqualValues(t, s.expectedPaths, getPaths(viewModel))
+			assert.Equal(t, s.expectedSelected, viewModel.GetSelectedPath())
+		})
+	}
+}
+
+func TestFileTreeViewModelDefaultDepth(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir1/sub/file1"},
+		{Name: "dir1/file2"},
+	}
+	viewModel := NewFileTreeViewModel(func() []*models.File { return files }, utils.NewDummyLog(), true, 1)
+
+	viewModel.SetTree()
+	assert.EqualValues(t, []string{"dir1", "dir1/sub", "dir1/file2"}, getPaths(viewModel))
+
+	// expanding a directory by hand survives a refresh
+	viewModel.ToggleCollapsed("dir1/sub")
+	viewModel.SetTree()
+	assert.EqualValues(t, []string{"dir1", "dir1/sub", "dir1/sub/file1", "dir1/file2"}, getPaths(viewModel))
+
+	// but new directories start out collapsed
+	files = append(files, &models.File{Name: "dir1/other/file3"})
+	viewModel.SetTree()
+	assert.EqualValues(t, []string{"dir1", "dir1/other", "dir1/sub", "dir1/sub/file1", "dir1/file2"}, getPaths(viewModel))
+}
+
+func TestFileTreeViewModelGetSelectedPaths(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir1/file1"},
+		{Name: "dir1/file2", PreviousName: "old-file2"},
+		{Name: "file3"},
+	}
+	viewModel := NewFileTreeViewModel(func() []*models.File { return files }, utils.NewDummyLog(), true, 0)
+	viewModel.SetTree()
+	assert.EqualValues(t, []string{"dir1", "dir1/file1", "dir1/file2", "file3"}, getPaths(viewModel))
+
+	viewModel.SetSelectedLineIdx(3)
+	assert.EqualValues(t, []string{"file3"}, viewModel.GetSelectedPaths())
+
+	viewModel.SetRangeSelectStart(3)
+	viewModel.SetSelectedLineIdx(1)
+	assert.EqualValues(t, []string{"dir1/file1", "dir1/file2", "old-file2", "file3"}, viewModel.GetSelectedPaths())
+}
+
+func getPaths(viewModel *FileTreeViewModel) []string {
+	return slices.Map(viewModel.GetAllItems(), func(node *FileNode) string {
+		return node.GetPath()
+	})
+}
@@ -258,6 +258,23 @@ func (self *Node[T]) compressAux() *Node[T] {
 	return self
 }
 
+// ForEachDir calls cb for every directory below this node along with how deeply
+// it's nested in the rendered tree, starting at 1
+func (self *Node[T]) ForEachDir(cb func(node *Node[T], depth int)) {
+	self.forEachDirAux(cb, 1)
+}
+
+func (self *Node[T]) forEachDirAux(cb func(node *Node[T], depth int), depth int) {
+	for _, child := range self.Children {
+		if child.IsFile() {
+			continue
+		}
+
+		cb(child, depth)
+		child.forEachDirAux(cb, depth+1)
+	}
+}
+
 func (self *Node[T]) GetPathsMatching(test func(*Node[T]) bool) []string {
 	paths := []string{}
 
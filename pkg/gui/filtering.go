@@ -5,7 +5,7 @@ import (
 )
 
 func (gui *Gui) validateNotInFilterMode() bool {
-	if gui.State.Modes.Filtering.Active() {
+	if gui.State.Modes.Filtering.Active() || gui.State.Modes.Pickaxe.Active() {
 		_ = gui.c.Confirm(types.ConfirmOpts{
 			Title:         gui.c.Tr.MustExitFilterModeTitle,
 			Prompt:        gui.c.Tr.MustExitFilterModePrompt,
@@ -28,20 +28,99 @@ func (gui *Gui) outsideFilterMode(f func() error) func() error {
 }
 
 func (gui *Gui) exitFilterMode() error {
-	return gui.clearFiltering()
+	if gui.State.Modes.Pickaxe.Active() {
+		if err := gui.clearPickaxe(); err != nil {
+			return err
+		}
+	}
+
+	if gui.State.Modes.Filtering.Active() {
+		return gui.clearFiltering()
+	}
+
+	return nil
 }
 
 func (gui *Gui) clearFiltering() error {
 	gui.State.Modes.Filtering.Reset()
-	if gui.State.ScreenMode == SCREEN_HALF {
+
+	return gui.afterClearingFilter()
+}
+
+// resetFiltering is for the reset button of the filtering status. With both
+// filters on, we let the user pick which of them to stop.
+func (gui *Gui) resetFiltering() error {
+	if !gui.State.Modes.Filtering.HasPaths() || !gui.State.Modes.Filtering.HasAuthor() {
+		return gui.clearFiltering()
+	}
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: gui.c.Tr.FilteringMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   gui.c.Tr.LcExitFilterMode,
+				OnPress: gui.clearFilterPaths,
+			},
+			{
+				Label:   gui.c.Tr.LcExitFilterAuthorMode,
+				OnPress: gui.clearFilterAuthor,
+			},
+			{
+				Label:   gui.c.Tr.LcExitAllFilterModes,
+				OnPress: gui.clearFiltering,
+			},
+		},
+	})
+}
+
+func (gui *Gui) clearFilterPaths() error {
+	gui.State.Modes.Filtering.SetPaths(nil)
+
+	return gui.afterClearingFilter()
+}
+
+func (gui *Gui) clearFilterAuthor() error {
+	gui.State.Modes.Filtering.SetAuthor("")
+
+	return gui.afterClearingFilter()
+}
+
+func (gui *Gui) afterClearingFilter() error {
+	gui.State.Model.FilteredReflogCommits = nil
+
+	// the other filter may still be on
+	if !gui.State.Modes.Filtering.Active() && gui.State.ScreenMode == SCREEN_HALF {
 		gui.State.ScreenMode = SCREEN_NORMAL
 	}
 
 	return gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.COMMITS}})
 }
 
-func (gui *Gui) setFiltering(path string) error {
-	gui.State.Modes.Filtering.SetPath(path)
+func (gui *Gui) setFiltering(paths []string) error {
+	if len(paths) == 0 {
+		return gui.clearFilterPaths()
+	}
+
+	gui.State.Modes.Filtering.SetPaths(paths)
+
+	return gui.afterSettingFilter()
+}
+
+func (gui *Gui) setFilterAuthor(author string) error {
+	if author == "" {
+		return gui.clearFilterAuthor()
+	}
+
+	gui.State.Modes.Filtering.SetAuthor(author)
+
+	return gui.afterSettingFilter()
+}
+
+func (gui *Gui) afterSettingFilter() error {
+	// the filtered reflog commits are loaded incrementally, which only works
+	// for as long as the filter stays the same
+	gui.State.Model.FilteredReflogCommits = nil
+
 	if gui.State.ScreenMode == SCREEN_NORMAL {
 		gui.State.ScreenMode = SCREEN_HALF
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -28,30 +30,115 @@ func (gui *Gui) handleCreateFilteringMenuPanel() error {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label: fmt.Sprintf("%s '%s'", gui.c.Tr.LcFilterBy, fileName),
 			OnPress: func() error {
-				return gui.setFiltering(fileName)
+				return gui.setFiltering([]string{fileName})
 			},
 		})
 	}
 
 	menuItems = append(menuItems, &types.MenuItem{
 		Label: gui.c.Tr.LcFilterPathOption,
+		OnPress: func() error {
+			return gui.promptForFilterPaths("")
+		},
+	})
+
+	if gui.State.Modes.Filtering.HasPaths() {
+		menuItems = append(menuItems, []*types.MenuItem{
+			{
+				Label: gui.c.Tr.LcEditFilterPaths,
+				OnPress: func() error {
+					return gui.promptForFilterPaths(gui.State.Modes.Filtering.GetPathsString())
+				},
+			},
+			{
+				Label:   gui.c.Tr.LcExitFilterMode,
+				OnPress: gui.clearFilterPaths,
+			},
+		}...)
+	}
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: gui.c.Tr.LcFilterAuthorOption,
 		OnPress: func() error {
 			return gui.c.Prompt(types.PromptOpts{
-				FindSuggestionsFunc: gui.helpers.Suggestions.GetFilePathSuggestionsFunc(),
-				Title:               gui.c.Tr.EnterFileName,
+				FindSuggestionsFunc: gui.helpers.Suggestions.GetAllAuthorsSuggestionsFunc(),
+				Title:               gui.c.Tr.EnterFilterAuthor,
+				InitialContent:      gui.State.Modes.Filtering.GetAuthor(),
 				HandleConfirm: func(response string) error {
-					return gui.setFiltering(strings.TrimSpace(response))
+					return gui.setFilterAuthor(strings.TrimSpace(response))
 				},
 			})
 		},
 	})
 
-	if gui.State.Modes.Filtering.Active() {
+	if gui.State.Modes.Filtering.HasAuthor() {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   gui.c.Tr.LcExitFilterAuthorMode,
+			OnPress: gui.clearFilterAuthor,
+		})
+	}
+
+	menuItems = append(menuItems, []*types.MenuItem{
+		{
+			Label: gui.c.Tr.LcSearchCommitContents,
+			OnPress: func() error {
+				return gui.c.Prompt(types.PromptOpts{
+					Title: gui.c.Tr.SearchCommitContentsTitle,
+					HandleConfirm: func(response string) error {
+						return gui.setPickaxe(response, false)
+					},
+				})
+			},
+		},
+		{
+			Label: gui.c.Tr.LcSearchCommitContentsByRegex,
+			OnPress: func() error {
+				return gui.c.Prompt(types.PromptOpts{
+					Title: gui.c.Tr.SearchCommitContentsByRegexTitle,
+					HandleConfirm: func(response string) error {
+						return gui.setPickaxe(response, true)
+					},
+				})
+			},
+		},
+	}...)
+
+	if gui.State.Modes.Pickaxe.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
-			Label:   gui.c.Tr.LcExitFilterMode,
-			OnPress: gui.clearFiltering,
+			Label:   gui.c.Tr.LcStopSearchingCommitContents,
+			OnPress: gui.clearPickaxe,
 		})
 	}
 
 	return gui.c.Menu(types.CreateMenuOptions{Title: gui.c.Tr.FilteringMenuTitle, Items: menuItems})
 }
+
+func (gui *Gui) promptForFilterPaths(initialContent string) error {
+	return gui.c.Prompt(types.PromptOpts{
+		FindSuggestionsFunc: gui.getFilterPathSuggestionsFunc(),
+		Title:               gui.c.Tr.EnterFilterPaths,
+		InitialContent:      initialContent,
+		HandleConfirm: func(response string) error {
+			return gui.setFiltering(filtering.ParsePaths(response))
+		},
+	})
+}
+
+// suggests files for the last of the comma-separated paths being entered,
+// keeping the ones before it
+func (gui *Gui) getFilterPathSuggestionsFunc() func(string) []*types.Suggestion {
+	getFilePathSuggestions := gui.helpers.Suggestions.GetFilePathSuggestionsFunc()
+
+	return func(input string) []*types.Suggestion {
+		earlierPaths := ""
+		lastPath := input
+		if idx := strings.LastIndex(input, ","); idx != -1 {
+			earlierPaths = input[:idx+1] + " "
+			lastPath = strings.TrimSpace(input[idx+1:])
+		}
+
+		return slices.Map(getFilePathSuggestions(lastPath), func(suggestion *types.Suggestion) *types.Suggestion {
+			return &types.Suggestion{Value: earlierPaths + suggestion.Value, Label: suggestion.Label}
+		})
+	}
+}
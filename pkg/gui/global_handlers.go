@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
-	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -158,16 +157,29 @@ func (gui *Gui) scrollDownConfirmationPanel() error {
 	return nil
 }
 
-func (gui *Gui) handleRefresh() error {
-	return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+// copies the whole content of an alert or confirmation, e.g. a custom command's output
+func (gui *Gui) handleCopyConfirmationPanelToClipboard() error {
+	content := strings.TrimSpace(gui.Views.Confirmation.Buffer())
+
+	gui.c.LogAction(gui.c.Tr.Actions.CopyToClipboard)
+	if err := gui.os.CopyToClipboard(content); err != nil {
+		return gui.c.Error(err)
+	}
+
+	gui.c.Toast(gui.c.Tr.PopupContentCopiedToClipboard)
+
+	return nil
 }
 
-func (gui *Gui) backgroundFetch() (err error) {
-	err = gui.git.Sync.Fetch(git_commands.FetchOptions{Background: true})
+func (gui *Gui) handleRefresh() error {
+	// the only time we ask for pull requests again, see refreshBranches
+	gui.State.Model.PullRequestsRequested = false
 
-	_ = gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
+	return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
 
-	return err
+func (gui *Gui) backgroundFetch() error {
+	return gui.helpers.Fetch.BackgroundFetch()
 }
 
 func (gui *Gui) handleCopySelectedSideContextItemToClipboard() error {
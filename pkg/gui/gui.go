@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
@@ -21,8 +22,10 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/comparing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/pickaxe"
 	"github.com/jesseduffield/lazygit/pkg/gui/popup"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
@@ -101,6 +104,40 @@ type Gui struct {
 	viewPtmxMap map[string]*os.File
 	stopChan    chan struct{}
 
+	// closed to give up on loading the commits when a newer load supersedes it
+	stopLoadingCommitsChan  chan struct{}
+	stopLoadingCommitsMutex sync.Mutex
+
+	// what to do once the commits that are loading have all come in, for
+	// those actions that need all of them
+	loadingCommits          bool
+	afterCommitsLoaded      func() error
+	afterCommitsLoadedMutex sync.Mutex
+
+	// for the things we fill in after a refresh, in the background
+	submoduleStatusesLoader backgroundLoader
+	branchDivergencesLoader backgroundLoader
+	pullRequestsLoader      backgroundLoader
+	// failing to load pull requests is logged just once per session
+	logPullRequestsFailureOnce sync.Once
+	// how far branches have diverged from their base branches, which can't
+	// change as long as neither of them moves
+	divergenceCache *divergenceCache
+
+	refreshDebouncers      map[types.RefreshableView]*tasks.Debouncer
+	refreshDebouncersMutex sync.Mutex
+
+	// what we've rendered to the main views for commits and their files
+	renderCache *tasks.RenderCache
+
+	// we've used the normal main view's search to highlight what a pickaxe
+	// search matched
+	highlightingInMainView bool
+
+	// the commands we've run, oldest first
+	cmdHistory      []*oscommands.CmdLogEntry
+	cmdHistoryMutex sync.Mutex
+
 	// when lazygit is opened outside a git directory we want to open to the most
 	// recent repo with the recent repos popup showing
 	showRecentRepos bool
@@ -118,6 +155,11 @@ type Gui struct {
 	// this tells us whether our views have been initially set up
 	ViewsSetup bool
 
+	// the user config as it was before we applied the config file of the
+	// current repo, so that we can apply another repo's config over it when we
+	// switch repos
+	userConfigWithoutRepoConfig *config.UserConfig
+
 	Views Views
 
 	// if we've suspended the gui (e.g. because we've switched to a subprocess)
@@ -127,12 +169,23 @@ type Gui struct {
 
 	// Log of the commands that get run, to be displayed to the user.
 	CmdLog []string
+	// the command on the last line of the command log, if that's what is there,
+	// so that we can say how it went without repeating it
+	lastLoggedCmdStr string
 
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
 	suggestionsAsyncHandler *tasks.AsyncHandler
 
+	// the bindings whose keys are key sequences, and the key sequence the user
+	// is part-way through typing, if any
+	keySequenceCandidates []*keySequenceCandidate
+	pendingKeySequence    *pendingKeySequence
+
+	signatureStatuses *commitAttributeCache[string]
+	diffStats         *commitAttributeCache[*git_commands.DiffStats]
+
 	PopupHandler types.IPopupHandler
 
 	IsNewRepo bool
@@ -179,9 +232,8 @@ type GuiRepoState struct {
 	SplitMainPanel bool
 	LimitCommits   bool
 
-	IsRefreshingFiles bool
-	Searching         searchingState
-	StartupStage      StartupStage // Allows us to not load everything at once
+	Searching    searchingState
+	StartupStage StartupStage // Allows us to not load everything at once
 
 	ContextManager ContextManager
 	Contexts       *context.ContextTree
@@ -200,8 +252,16 @@ type GuiRepoState struct {
 	// panel without committing or if our commit failed
 	savedCommitMessage string
 
+	// how far back we've gone in the commit message history, where zero is
+	// the message being drafted, which we keep in commitMessageDraft
+	commitMessageHistoryIdx int
+	commitMessageDraft      string
+
 	ScreenMode WindowMaximisation
 
+	// the selected line of the status dashboard (see gui.statusPanelView)
+	StatusDashboardIdx int
+
 	CurrentPopupOpts *types.CreatePopupPanelOpts
 }
 
@@ -209,6 +269,9 @@ type searchingState struct {
 	view         *gocui.View
 	isSearching  bool
 	searchString string
+	// index of the match we were last on, so that we can tell when moving to
+	// the next or previous match wrapped around. -1 until we're on a match
+	prevMatchIndex int
 }
 
 // startup stages so we don't need to load everything at once
@@ -232,10 +295,23 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, reuseState bool) error {
 		return err
 	}
 
+	if err := gui.applyRepoConfig(); err != nil {
+		return err
+	}
+
+	// the cache is keyed by the commands we ran, which don't say which repo
+	// they ran in
+	gui.renderCache.Clear()
+	gui.renderCache.SetSize(gui.UserConfig.Gui.DiffCacheSize)
+
 	gui.resetState(startArgs, reuseState)
 
 	gui.resetControllers()
 
+	if err := gui.validateKeybindings(); err != nil {
+		return err
+	}
+
 	if err := gui.resetKeybindings(); err != nil {
 		return err
 	}
@@ -291,11 +367,14 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs, reuseState bool) {
 			ReflogCommits:         make([]*models.Commit, 0),
 			BisectInfo:            git_commands.NewNullBisectInfo(),
 			FilesTrie:             patricia.NewTrie(),
+			RemoteFetchTimes:      map[string]time.Time{},
 		},
 		Modes: &types.Modes{
 			Filtering:     filtering.New(startArgs.FilterPath),
 			CherryPicking: cherrypicking.New(),
 			Diffing:       diffing.New(),
+			Comparing:     comparing.New(),
+			Pickaxe:       pickaxe.New(),
 		},
 		ScreenMode: initialScreenMode,
 		// TODO: put contexts in the context manager
@@ -365,6 +444,8 @@ func NewGui(
 		statusManager:           &statusManager{},
 		viewBufferManagerMap:    map[string]*tasks.ViewBufferManager{},
 		viewPtmxMap:             map[string]*os.File{},
+		refreshDebouncers:       map[types.RefreshableView]*tasks.Debouncer{},
+		renderCache:             tasks.NewRenderCache(cmn.UserConfig.Gui.DiffCacheSize),
 		showRecentRepos:         showRecentRepos,
 		RepoPathStack:           &utils.StringStack{},
 		RepoStateMap:            map[Repo]*GuiRepoState{},
@@ -388,6 +469,22 @@ func NewGui(
 		InitialDir: initialDir,
 	}
 
+	// gui.git is swapped out when we switch repos, so we look it up on each load
+	gui.signatureStatuses = newCommitAttributeCache(
+		func(shas []string) (map[string]string, error) {
+			return gui.git.Commit.GetSignatureStatuses(shas)
+		},
+		// we treat commits whose status we couldn't get as unsigned
+		"N",
+	)
+	gui.diffStats = newCommitAttributeCache(
+		func(shas []string) (map[string]*git_commands.DiffStats, error) {
+			return gui.git.Commit.GetDiffStats(shas)
+		},
+		nil,
+	)
+	gui.divergenceCache = newDivergenceCache()
+
 	gui.watchFilesForChanges()
 
 	gui.PopupHandler = popup.NewPopupHandler(
@@ -410,6 +507,8 @@ func NewGui(
 	guiIO := oscommands.NewGuiIO(
 		cmn.Log,
 		gui.LogCommand,
+		gui.onCmdDone,
+		gui.wantsCmdDone,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
 	)
@@ -423,7 +522,15 @@ func NewGui(
 	gui.c = helperCommon
 
 	authors.SetCustomAuthors(gui.UserConfig.Gui.AuthorColors)
-	icons.SetIconEnabled(gui.UserConfig.Gui.ShowIcons)
+	nerdFontsVersion := gui.UserConfig.Gui.NerdFontsVersion
+	if nerdFontsVersion == "" && gui.UserConfig.Gui.ShowIcons {
+		// showIcons came before nerdFontsVersion, back when our icons were for version 2
+		nerdFontsVersion = "2"
+	}
+	if err := icons.SetNerdFontsVersion(nerdFontsVersion); err != nil {
+		return nil, err
+	}
+	icons.SetCustomIcons(gui.UserConfig.Gui.CustomIcons)
 	presentation.SetCustomBranches(gui.UserConfig.Gui.BranchColors)
 
 	return gui, nil
@@ -514,18 +621,11 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 	if err := gui.Config.ReloadUserConfig(); err != nil {
 		return nil
 	}
-	userConfig := gui.UserConfig
-	gui.g.SearchEscapeKey = keybindings.GetKey(userConfig.Keybinding.Universal.Return)
-	gui.g.NextSearchMatchKey = keybindings.GetKey(userConfig.Keybinding.Universal.NextMatch)
-	gui.g.PrevSearchMatchKey = keybindings.GetKey(userConfig.Keybinding.Universal.PrevMatch)
-
-	gui.g.ShowListFooter = userConfig.Gui.ShowListFooter
-
-	if userConfig.Gui.MouseEvents {
+	if gui.UserConfig.Gui.MouseEvents {
 		gui.g.Mouse = true
 	}
 
-	if err := gui.setColorScheme(); err != nil {
+	if err := gui.onUserConfigLoaded(); err != nil {
 		return err
 	}
 
@@ -535,6 +635,10 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 		return err
 	}
 
+	if startArgs.RepoPicker {
+		gui.showRecentRepos = true
+	}
+
 	// onNewRepo must be called after g.SetManager because SetManager deletes keybindings
 	if err := gui.onNewRepo(startArgs, false); err != nil {
 		return err
@@ -717,6 +821,19 @@ func (gui *Gui) showIntroPopupMessage(done chan struct{}) error {
 	})
 }
 
+// onUserConfigLoaded applies the options that gocui holds on to itself, so that
+// they take effect both on startup and when the config is reloaded
+func (gui *Gui) onUserConfigLoaded() error {
+	userConfig := gui.UserConfig
+	gui.g.SearchEscapeKey = keybindings.GetKey(userConfig.Keybinding.Universal.Return)
+	gui.g.NextSearchMatchKey = keybindings.GetKey(userConfig.Keybinding.Universal.NextMatch)
+	gui.g.PrevSearchMatchKey = keybindings.GetKey(userConfig.Keybinding.Universal.PrevMatch)
+
+	gui.g.ShowListFooter = userConfig.Gui.ShowListFooter
+
+	return gui.setColorScheme()
+}
+
 // setColorScheme sets the color scheme for the app based on the user config
 func (gui *Gui) setColorScheme() error {
 	userConfig := gui.UserConfig
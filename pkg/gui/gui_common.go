@@ -28,6 +28,10 @@ func (self *guiCommon) Refresh(opts types.RefreshOptions) error {
 	return self.gui.Refresh(opts)
 }
 
+func (self *guiCommon) AfterCommitsLoaded(f func() error) error {
+	return self.gui.runAfterCommitsLoaded(f)
+}
+
 func (self *guiCommon) PostRefreshUpdate(context types.Context) error {
 	return self.gui.postRefreshUpdate(context)
 }
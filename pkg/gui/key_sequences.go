@@ -0,0 +1,170 @@
+package gui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// how long we wait for the next key of a key sequence before giving up on it
+const keySequenceTimeout = time.Second
+
+// a key sequence (e.g. 'g' then 'p') which the user has started typing
+type pendingKeySequence struct {
+	// the keys pressed so far
+	keys []types.Key
+	// the bindings whose sequences start with the keys pressed so far, along
+	// with the handlers to call once their sequence is complete
+	candidates []*keySequenceCandidate
+	statusId   int
+	timer      *time.Timer
+}
+
+type keySequenceCandidate struct {
+	binding *types.Binding
+	handler func() error
+}
+
+// Only the first key of a key sequence is registered with gocui. Pressing it
+// leaves us waiting for the rest of the sequence: every other keybinding checks
+// whether its key continues the sequence before doing its own thing. If it
+// doesn't, we forget about the sequence and the key behaves as it normally would.
+func (gui *Gui) setKeySequenceBinding(binding *types.Binding, handler func() error) error {
+	sequence := binding.Key.(types.KeySequence)
+	candidate := &keySequenceCandidate{binding: binding, handler: handler}
+	gui.keySequenceCandidates = append(gui.keySequenceCandidates, candidate)
+
+	return gui.g.SetKeybinding(
+		binding.ViewName,
+		sequence[0],
+		binding.Modifier,
+		gui.wrappedHandler(gui.withKeySequences(sequence[0], func() error {
+			return gui.startKeySequence(sequence[0])
+		})),
+	)
+}
+
+// The later keys of a sequence may not be bound to anything in the sequence's
+// view, in which case gocui wouldn't tell us about them. So we bind them too,
+// after all other keybindings so that those get precedence, falling back to the
+// global binding for the key, if any, when we're not waiting on a sequence.
+func (gui *Gui) setKeySequenceContinuationBindings(bindings []*types.Binding) error {
+	for _, candidate := range gui.keySequenceCandidates {
+		binding := candidate.binding
+		for _, key := range binding.Key.(types.KeySequence)[1:] {
+			key := key
+			fallback := gui.globalHandlerForKey(bindings, key, binding)
+			handler := gui.withKeySequences(key, fallback)
+
+			if err := gui.g.SetKeybinding(binding.ViewName, key, binding.Modifier, gui.wrappedHandler(handler)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (gui *Gui) globalHandlerForKey(bindings []*types.Binding, key types.Key, sequenceBinding *types.Binding) func() error {
+	for _, binding := range bindings {
+		if _, ok := binding.Key.(types.KeySequence); ok {
+			continue
+		}
+
+		if binding.ViewName == "" && binding.Key == key && binding.Modifier == sequenceBinding.Modifier && binding.Handler != nil {
+			return gui.keybindingHandler(binding)
+		}
+	}
+
+	return func() error { return nil }
+}
+
+// withKeySequences wraps the handler of a key so that if we're waiting on the
+// rest of a key sequence, the key is first given the chance to continue it
+func (gui *Gui) withKeySequences(key types.Key, handler func() error) func() error {
+	return func() error {
+		if gui.pendingKeySequence != nil {
+			if handled, err := gui.continueKeySequence(key); handled {
+				return err
+			}
+		}
+
+		return handler()
+	}
+}
+
+func (gui *Gui) startKeySequence(key types.Key) error {
+	currentViewName := gui.currentViewName()
+	candidates := slices.Filter(gui.keySequenceCandidates, func(candidate *keySequenceCandidate) bool {
+		viewName := candidate.binding.ViewName
+		return (viewName == "" || viewName == currentViewName) &&
+			candidate.binding.Key.(types.KeySequence)[0] == key
+	})
+
+	return gui.awaitKeySequence([]types.Key{key}, candidates)
+}
+
+// returns true if the key was part of the pending key sequence
+func (gui *Gui) continueKeySequence(key types.Key) (bool, error) {
+	keys := append(gui.pendingKeySequence.keys, key)
+	candidates := slices.Filter(gui.pendingKeySequence.candidates, func(candidate *keySequenceCandidate) bool {
+		sequence := candidate.binding.Key.(types.KeySequence)
+		return len(sequence) >= len(keys) && sequence[len(keys)-1] == key
+	})
+
+	gui.cancelKeySequence()
+
+	if len(candidates) == 0 {
+		return false, nil
+	}
+
+	for _, candidate := range candidates {
+		if len(candidate.binding.Key.(types.KeySequence)) == len(keys) {
+			return true, candidate.handler()
+		}
+	}
+
+	return true, gui.awaitKeySequence(keys, candidates)
+}
+
+func (gui *Gui) awaitKeySequence(keys []types.Key, candidates []*keySequenceCandidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	pending := &pendingKeySequence{
+		keys:       keys,
+		candidates: candidates,
+		statusId: gui.statusManager.addStatus(
+			fmt.Sprintf(gui.c.Tr.PendingKeySequence, keybindings.LabelFromKey(types.KeySequence(keys))),
+		),
+	}
+	pending.timer = time.AfterFunc(keySequenceTimeout, func() {
+		gui.c.OnUIThread(func() error {
+			if gui.pendingKeySequence == pending {
+				gui.cancelKeySequence()
+			}
+			return nil
+		})
+	})
+	gui.pendingKeySequence = pending
+
+	gui.renderAppStatus()
+
+	return nil
+}
+
+func (gui *Gui) cancelKeySequence() {
+	if gui.pendingKeySequence == nil {
+		return
+	}
+
+	gui.pendingKeySequence.timer.Stop()
+	gui.statusManager.removeStatus(gui.pendingKeySequence.statusId)
+	gui.pendingKeySequence = nil
+
+	gui.renderAppStatus()
+}
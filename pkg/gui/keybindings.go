@@ -159,6 +159,12 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 			Description: self.c.Tr.LcOpenMenu,
 			Handler:     self.handleCreateOptionsMenu,
 		},
+		{
+			ViewName:    "",
+			Key:         opts.GetKey(opts.Config.Universal.OpenCommandPalette),
+			Handler:     self.noPopupPanel(self.handleCreateCommandPalette),
+			Description: self.c.Tr.LcOpenCommandPalette,
+		},
 		{
 			ViewName:    "status",
 			Key:         opts.GetKey(opts.Config.Universal.Edit),
@@ -183,6 +189,12 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 			Handler:     self.handleOpenConfig,
 			Description: self.c.Tr.OpenConfig,
 		},
+		{
+			ViewName:    "status",
+			Key:         opts.GetKey(opts.Config.Status.ReloadConfig),
+			Handler:     self.handleReloadConfig,
+			Description: self.c.Tr.LcReloadConfig,
+		},
 		{
 			ViewName:    "status",
 			Key:         opts.GetKey(opts.Config.Status.CheckForUpdate),
@@ -201,6 +213,50 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 			Handler:     self.handleShowAllBranchLogs,
 			Description: self.c.Tr.LcAllBranchesLogGraph,
 		},
+		{
+			ViewName: "status",
+			Tag:      "navigation",
+			Key:      opts.GetKey(opts.Config.Universal.PrevItem),
+			Handler:  self.handleStatusDashboardMove(-1),
+		},
+		{
+			ViewName: "status",
+			Tag:      "navigation",
+			Key:      opts.GetKey(opts.Config.Universal.PrevItemAlt),
+			Handler:  self.handleStatusDashboardMove(-1),
+		},
+		{
+			ViewName: "status",
+			Tag:      "navigation",
+			Key:      opts.GetKey(opts.Config.Universal.NextItem),
+			Handler:  self.handleStatusDashboardMove(1),
+		},
+		{
+			ViewName: "status",
+			Tag:      "navigation",
+			Key:      opts.GetKey(opts.Config.Universal.NextItemAlt),
+			Handler:  self.handleStatusDashboardMove(1),
+		},
+		{
+			ViewName:    "status",
+			Key:         opts.GetKey(opts.Config.Universal.Select),
+			Handler:     self.handleStatusDashboardPress,
+			Description: self.c.Tr.LcGoToDashboardItem,
+		},
+		{
+			ViewName:    "status",
+			Key:         opts.GetKey(opts.Config.Status.FlaggedFiles),
+			Handler:     self.helpers.WorkingTree.CreateFlaggedFilesMenu,
+			Description: self.c.Tr.LcViewFlaggedFiles,
+			OpensMenu:   true,
+		},
+		{
+			ViewName:    "status",
+			Key:         opts.GetKey(opts.Config.Status.StatusSpeedups),
+			Handler:     self.handleCreateStatusSpeedupsMenu,
+			Description: self.c.Tr.LcViewStatusSpeedups,
+			OpensMenu:   true,
+		},
 		{
 			ViewName:    "files",
 			Key:         opts.GetKey(opts.Config.Universal.CopyToClipboard),
@@ -420,6 +476,27 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 			Modifier: gocui.ModNone,
 			Handler:  self.handleFocusCommandLog,
 		},
+		{
+			ViewName:    "extras",
+			Key:         opts.GetKey(opts.Config.CommandLog.CopyCommand),
+			Handler:     self.handleCopyCommandFromHistory,
+			Description: self.c.Tr.LcCopyCommandToClipboard,
+			OpensMenu:   true,
+		},
+		{
+			ViewName:    "extras",
+			Key:         opts.GetKey(opts.Config.CommandLog.RunCommandAgain),
+			Handler:     self.handleRunCommandFromHistoryAgain,
+			Description: self.c.Tr.LcRunCommandAgain,
+			OpensMenu:   true,
+		},
+		{
+			ViewName:    "extras",
+			Key:         opts.GetKey(opts.Config.CommandLog.ShowCommandOutput),
+			Handler:     self.handleShowCommandOutputFromHistory,
+			Description: self.c.Tr.LcShowCommandOutput,
+			OpensMenu:   true,
+		},
 	}
 
 	mouseKeybindings := []*gocui.ViewMouseBinding{}
@@ -434,6 +511,13 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 		mouseKeybindings = append(mouseKeybindings, c.GetMouseKeybindings(opts)...)
 	}
 
+	mouseKeybindings = append(mouseKeybindings, &gocui.ViewMouseBinding{
+		ViewName:    "main",
+		Key:         gocui.MouseLeft,
+		Handler:     self.handleStatusDashboardClick,
+		FocusedView: "status",
+	})
+
 	for _, viewName := range []string{"status", "remotes", "tags", "localBranches", "remoteBranches", "files", "submodules", "reflogCommits", "commits", "commitFiles", "subCommits", "stash"} {
 		bindings = append(bindings, []*types.Binding{
 			{ViewName: viewName, Key: opts.GetKey(opts.Config.Universal.PrevBlock), Modifier: gocui.ModNone, Handler: self.previousSideWindow},
@@ -445,8 +529,10 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 		}...)
 	}
 
-	// Appends keybindings to jump to a particular sideView using numbers
-	windows := []string{"status", "files", "branches", "commits", "stash"}
+	// Appends keybindings to jump to a particular sideView using numbers. These
+	// go by the window rather than its position, so they still work when the
+	// windows are reordered, and they reveal hidden windows
+	windows := sideWindows
 
 	if len(config.Universal.JumpToBlock) != len(windows) {
 		log.Fatal("Jump to block keybindings cannot be set. Exactly 5 keybindings must be supplied.")
@@ -483,15 +569,10 @@ func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBi
 
 func (gui *Gui) resetKeybindings() error {
 	gui.g.DeleteAllKeybindings()
+	gui.cancelKeySequence()
+	gui.keySequenceCandidates = nil
 
-	bindings, mouseBindings := gui.GetInitialKeybindings()
-
-	// prepending because we want to give our custom keybindings precedence over default keybindings
-	customBindings, err := gui.CustomCommandsClient.GetCustomCommandKeybindings()
-	if err != nil {
-		log.Fatal(err)
-	}
-	bindings = append(customBindings, bindings...)
+	bindings, mouseBindings := gui.getKeybindings()
 
 	for _, binding := range bindings {
 		if err := gui.SetKeybinding(binding); err != nil {
@@ -499,6 +580,10 @@ func (gui *Gui) resetKeybindings() error {
 		}
 	}
 
+	if err := gui.setKeySequenceContinuationBindings(bindings); err != nil {
+		return err
+	}
+
 	for _, binding := range mouseBindings {
 		if err := gui.SetMouseKeybinding(binding); err != nil {
 			return err
@@ -519,6 +604,26 @@ func (gui *Gui) resetKeybindings() error {
 	return nil
 }
 
+func (gui *Gui) getKeybindings() ([]*types.Binding, []*gocui.ViewMouseBinding) {
+	bindings, mouseBindings := gui.GetInitialKeybindings()
+
+	// prepending because we want to give our custom keybindings precedence over default keybindings
+	customBindings, err := gui.CustomCommandsClient.GetCustomCommandKeybindings()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return append(customBindings, bindings...), mouseBindings
+}
+
+// we can't tell which keybinding the user meant if a key is bound to an action
+// and is also the start of a key sequence in the same view, or globally
+func (gui *Gui) validateKeybindings() error {
+	bindings, _ := gui.getKeybindings()
+
+	return keybindings.ValidateKeySequences(bindings)
+}
+
 func (gui *Gui) wrappedHandler(f func() error) func(g *gocui.Gui, v *gocui.View) error {
 	return func(g *gocui.Gui, v *gocui.View) error {
 		return f()
@@ -526,6 +631,16 @@ func (gui *Gui) wrappedHandler(f func() error) func(g *gocui.Gui, v *gocui.View)
 }
 
 func (gui *Gui) SetKeybinding(binding *types.Binding) error {
+	handler := gui.keybindingHandler(binding)
+
+	if _, ok := binding.Key.(types.KeySequence); ok {
+		return gui.setKeySequenceBinding(binding, handler)
+	}
+
+	return gui.g.SetKeybinding(binding.ViewName, binding.Key, binding.Modifier, gui.wrappedHandler(gui.withKeySequences(binding.Key, handler)))
+}
+
+func (gui *Gui) keybindingHandler(binding *types.Binding) func() error {
 	handler := binding.Handler
 	// TODO: move all mouse-ey stuff into new mouse approach
 	if gocui.IsMouseKey(binding.Key) {
@@ -539,7 +654,18 @@ func (gui *Gui) SetKeybinding(binding *types.Binding) error {
 		}
 	}
 
-	return gui.g.SetKeybinding(binding.ViewName, binding.Key, binding.Modifier, gui.wrappedHandler(handler))
+	if binding.GetDisabledReason != nil {
+		enabledHandler := handler
+		handler = func() error {
+			if reason := binding.GetDisabledReason(); reason != "" {
+				return gui.c.ErrorMsg(reason)
+			}
+
+			return enabledHandler()
+		}
+	}
+
+	return handler
 }
 
 // warning: mutates the binding
@@ -3,10 +3,13 @@ package keybindings
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"unicode/utf8"
 
+	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -154,6 +157,8 @@ func LabelFromKey(key types.Key) string {
 	keyInt := 0
 
 	switch key := key.(type) {
+	case types.KeySequence:
+		return strings.Join(slices.Map(key, LabelFromKey), " ")
 	case rune:
 		keyInt = int(key)
 	case gocui.Key:
@@ -167,17 +172,165 @@ func LabelFromKey(key types.Key) string {
 	return fmt.Sprintf("%c", keyInt)
 }
 
+// keys separated by spaces (e.g. "g p") make up a key sequence
 func GetKey(key string) types.Key {
+	result, err := ParseKey(key)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return result
+}
+
+// ParseKey is like GetKey, but returns an error for a key we don't recognise
+// rather than exiting
+func ParseKey(key string) (types.Key, error) {
+	if parts := strings.Fields(key); len(parts) > 1 {
+		sequence := types.KeySequence{}
+		for _, part := range parts {
+			partKey, err := ParseKey(part)
+			if err != nil {
+				return nil, err
+			}
+			sequence = append(sequence, partKey)
+		}
+		return sequence, nil
+	}
+
 	runeCount := utf8.RuneCountInString(key)
 	if runeCount > 1 {
 		binding := keyMap[strings.ToLower(key)]
 		if binding == nil {
-			log.Fatalf("Unrecognized key %s for keybinding. For permitted values see %s", strings.ToLower(key), constants.Links.Docs.CustomKeybindings)
-		} else {
-			return binding
+			return nil, fmt.Errorf("Unrecognized key %s for keybinding. For permitted values see %s", strings.ToLower(key), constants.Links.Docs.CustomKeybindings)
 		}
+		return binding, nil
 	} else if runeCount == 1 {
-		return []rune(key)[0]
+		return []rune(key)[0], nil
+	}
+	return nil, nil
+}
+
+// ValidateKeybindingConfig returns an error for the first key in the keybinding
+// config or the custom commands that we don't recognise, so that we can report
+// it without exiting
+func ValidateKeybindingConfig(keybindingConfig config.KeybindingConfig, customCommands []config.CustomCommand) error {
+	keys := append(
+		configKeys(reflect.ValueOf(keybindingConfig)),
+		slices.Map(customCommands, func(customCommand config.CustomCommand) string { return customCommand.Key })...,
+	)
+
+	for _, key := range keys {
+		if _, err := ParseKey(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configKeys returns every key in a section of the keybinding config, where
+// fields are either a key or a list of keys
+func configKeys(value reflect.Value) []string {
+	switch value.Kind() {
+	case reflect.String:
+		return []string{value.String()}
+	case reflect.Slice:
+		keys := []string{}
+		for i := 0; i < value.Len(); i++ {
+			keys = append(keys, configKeys(value.Index(i))...)
+		}
+		return keys
+	case reflect.Struct:
+		keys := []string{}
+		for i := 0; i < value.NumField(); i++ {
+			keys = append(keys, configKeys(value.Field(i))...)
+		}
+		return keys
 	}
+
 	return nil
 }
+
+// ValidateKeySequences returns an error listing every key sequence which can't
+// be reached because, within the same view, its first keys are already bound
+// to something else. Global keybindings apply in every view, so they're
+// compared against the keybindings of each view too.
+func ValidateKeySequences(bindings []*types.Binding) error {
+	clashes := []string{}
+	for _, sequenceBinding := range bindings {
+		sequence, ok := sequenceBinding.Key.(types.KeySequence)
+		if !ok {
+			continue
+		}
+
+		for _, binding := range bindings {
+			if binding == sequenceBinding || !sameOrGlobalView(binding.ViewName, sequenceBinding.ViewName) || binding.Modifier != sequenceBinding.Modifier {
+				continue
+			}
+
+			if keys := keysOf(binding.Key); len(keys) < len(sequence) && isKeyPrefix(keys, sequence) {
+				clashes = append(clashes, fmt.Sprintf(
+					"'%s' (%s) clashes with '%s' (%s) in the %s",
+					LabelFromKey(sequence),
+					sequenceBinding.Description,
+					LabelFromKey(binding.Key),
+					binding.Description,
+					viewsLabel(sequenceBinding.ViewName, binding.ViewName),
+				))
+			}
+		}
+	}
+
+	if len(clashes) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"A key can't be both a keybinding and the start of a key sequence. Clashing keybindings:\n%s\nFor more info see %s",
+		strings.Join(clashes, "\n"),
+		constants.Links.Docs.CustomKeybindings,
+	)
+}
+
+func keysOf(key types.Key) []types.Key {
+	if sequence, ok := key.(types.KeySequence); ok {
+		return sequence
+	}
+
+	return []types.Key{key}
+}
+
+// isKeyPrefix tells us whether the keys are the first keys of the sequence
+func isKeyPrefix(keys []types.Key, sequence types.KeySequence) bool {
+	if len(keys) > len(sequence) {
+		return false
+	}
+
+	for i, key := range keys {
+		if key != sequence[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameOrGlobalView(viewName string, otherViewName string) bool {
+	return viewName == otherViewName || viewName == "" || otherViewName == ""
+}
+
+func viewsLabel(viewName string, otherViewName string) string {
+	if viewName == otherViewName {
+		return viewLabel(viewName) + " view"
+	}
+
+	return fmt.Sprintf("%s and %s views", viewLabel(viewName), viewLabel(otherViewName))
+}
+
+func viewLabel(viewName string) string {
+	if viewName == "" {
+		return "global"
+	}
+
+	return viewName
+}
@@ -0,0 +1,124 @@
+package keybindings
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetKey(t *testing.T) {
+	scenarios := []struct {
+		key      string
+		expected types.Key
+	}{
+		{key: "", expected: nil},
+		{key: "g", expected: 'g'},
+		{key: "<c-a>", expected: gocui.KeyCtrlA},
+		{key: "g p", expected: types.KeySequence{'g', 'p'}},
+		{key: "<space>  <c-a> x", expected: types.KeySequence{gocui.KeySpace, gocui.KeyCtrlA, 'x'}},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.key, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, GetKey(s.key))
+		})
+	}
+}
+
+func TestLabelFromKey(t *testing.T) {
+	assert.Equal(t, "g space", LabelFromKey(types.KeySequence{'g', gocui.KeySpace}))
+}
+
+func TestValidateKeySequences(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		bindings      []*types.Binding
+		expectedClash []string
+	}{
+		{
+			testName: "no sequences",
+			bindings: []*types.Binding{
+				{ViewName: "files", Key: 'g', Description: "a"},
+				{ViewName: "files", Key: 'p', Description: "b"},
+			},
+		},
+		{
+			testName: "same key in a different view",
+			bindings: []*types.Binding{
+				{ViewName: "files", Key: types.KeySequence{'g', 'p'}, Description: "a"},
+				{ViewName: "branches", Key: 'g', Description: "b"},
+			},
+		},
+		{
+			testName: "view sequence clashing with a global key",
+			bindings: []*types.Binding{
+				{ViewName: "files", Key: types.KeySequence{'g', 'p'}, Description: "push"},
+				{ViewName: "", Key: 'g', Description: "go"},
+			},
+			expectedClash: []string{"'g p' (push) clashes with 'g' (go) in the files and global views"},
+		},
+		{
+			testName: "global sequence clashing with a view key",
+			bindings: []*types.Binding{
+				{ViewName: "", Key: types.KeySequence{'g', 'p'}, Description: "push"},
+				{ViewName: "files", Key: 'g', Description: "go"},
+			},
+			expectedClash: []string{"'g p' (push) clashes with 'g' (go) in the global and files views"},
+		},
+		{
+			testName: "sequences sharing a prefix",
+			bindings: []*types.Binding{
+				{ViewName: "files", Key: types.KeySequence{'g', 'p'}, Description: "a"},
+				{ViewName: "files", Key: types.KeySequence{'g', 'x'}, Description: "b"},
+			},
+		},
+		{
+			testName: "key is also a prefix",
+			bindings: []*types.Binding{
+				{ViewName: "files", Key: types.KeySequence{'g', 'p'}, Description: "push"},
+				{ViewName: "files", Key: 'g', Description: "go"},
+			},
+			expectedClash: []string{"'g p' (push) clashes with 'g' (go) in the files view"},
+		},
+		{
+			testName: "sequence is also a prefix",
+			bindings: []*types.Binding{
+				{ViewName: "", Key: types.KeySequence{'g', 'p', 'x'}, Description: "a"},
+				{ViewName: "", Key: types.KeySequence{'g', 'p'}, Description: "b"},
+			},
+			expectedClash: []string{"'g p x' (a) clashes with 'g p' (b) in the global view"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			err := ValidateKeySequences(s.bindings)
+			if len(s.expectedClash) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			for _, clash := range s.expectedClash {
+				assert.Contains(t, err.Error(), clash)
+			}
+		})
+	}
+}
+
+func TestValidateKeybindingConfig(t *testing.T) {
+	keybindingConfig := config.GetDefaultConfig().Keybinding
+	assert.NoError(t, ValidateKeybindingConfig(keybindingConfig, nil))
+
+	customCommands := []config.CustomCommand{{Key: "<c-a> <nope>"}}
+	assert.EqualError(t, ValidateKeybindingConfig(keybindingConfig, customCommands), "Unrecognized key <nope> for keybinding. For permitted values see "+constants.Links.Docs.CustomKeybindings)
+
+	keybindingConfig.Universal.JumpToBlock = []string{"1", "<f13>"}
+	assert.Error(t, ValidateKeybindingConfig(keybindingConfig, nil))
+}
@@ -186,7 +186,18 @@ func (gui *Gui) onInitialViewsCreationForRepo() error {
 		return err
 	}
 
-	return gui.loadNewRepo()
+	if err := gui.loadNewRepo(); err != nil {
+		return err
+	}
+
+	// this has to come after pushing the initial context, which would otherwise
+	// close the recent repos list straight away
+	if gui.showRecentRepos {
+		gui.showRecentRepos = false
+		return gui.handleCreateRecentReposMenu()
+	}
+
+	return nil
 }
 
 func (gui *Gui) onInitialViewsCreation() error {
@@ -225,13 +236,6 @@ func (gui *Gui) onInitialViewsCreation() error {
 		gui.showInitialPopups(popupTasks)
 	}
 
-	if gui.showRecentRepos {
-		if err := gui.handleCreateRecentReposMenu(); err != nil {
-			return err
-		}
-		gui.showRecentRepos = false
-	}
-
 	gui.Updater.CheckForNewUpdate(gui.onBackgroundUpdateCheckFinish, false)
 
 	gui.waitForIntro.Done()
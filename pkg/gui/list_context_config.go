@@ -2,7 +2,9 @@ package gui
 
 import (
 	"log"
+	"time"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -11,6 +13,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 func (gui *Gui) menuListContext() *context.MenuContext {
@@ -46,7 +49,7 @@ func (gui *Gui) branchesListContext() *context.BranchesContext {
 		func() []*models.Branch { return gui.State.Model.Branches },
 		gui.Views.Branches,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetBranchListDisplayStrings(gui.State.Model.Branches, gui.State.ScreenMode != SCREEN_NORMAL, gui.State.Modes.Diffing.Ref, gui.Tr)
+			return presentation.GetBranchListDisplayStrings(gui.State.Model.Branches, gui.State.ScreenMode != SCREEN_NORMAL, gui.State.Modes.Diffing.Ref, gui.State.Model.BranchDivergences, gui.State.Model.PullRequests, gui.Tr)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.branchesRenderToMain),
@@ -60,7 +63,7 @@ func (gui *Gui) remotesListContext() *context.RemotesContext {
 		func() []*models.Remote { return gui.State.Model.Remotes },
 		gui.Views.Remotes,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetRemoteListDisplayStrings(gui.State.Model.Remotes, gui.State.Modes.Diffing.Ref)
+			return presentation.GetRemoteListDisplayStrings(gui.State.Model.Remotes, gui.State.Modes.Diffing.Ref, gui.State.Model.RemoteFetchTimes, gui.Tr)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.remotesRenderToMain),
@@ -98,7 +101,7 @@ func (gui *Gui) tagsListContext() *context.TagsContext {
 		func() []*models.Tag { return gui.State.Model.Tags },
 		gui.Views.Tags,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetTagListDisplayStrings(gui.State.Model.Tags, gui.State.Modes.Diffing.Ref)
+			return presentation.GetTagListDisplayStrings(gui.State.Model.Tags, gui.State.Modes.Diffing.Ref, gui.c.Tr)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.tagsRenderToMain),
@@ -122,11 +125,15 @@ func (gui *Gui) branchCommitsListContext() *context.LocalCommitsContext {
 
 			showYouAreHereLabel := gui.State.Model.WorkingTreeStateAtLastCommitRefresh == enums.REBASE_MODE_REBASING
 
+			commits := gui.State.Model.Commits
+			visibleCommits := commits[utils.Min(startIdx, len(commits)):utils.Min(startIdx+length, len(commits))]
+
 			return presentation.GetCommitListDisplayStrings(
 				gui.Common,
 				gui.State.Model.Commits,
 				gui.State.ScreenMode != SCREEN_NORMAL,
 				gui.helpers.CherryPick.CherryPickedCommitShaSet(),
+				gui.State.Contexts.LocalCommits.GetMarkedShaSet(),
 				gui.State.Modes.Diffing.Ref,
 				gui.c.UserConfig.Gui.TimeFormat,
 				gui.c.UserConfig.Git.ParseEmoji,
@@ -134,6 +141,9 @@ func (gui *Gui) branchCommitsListContext() *context.LocalCommitsContext {
 				startIdx,
 				length,
 				gui.shouldShowGraph(),
+				gui.State.Contexts.LocalCommits.GetShowWholeGitGraph(),
+				gui.getSignatureStatuses(visibleCommits),
+				gui.getDiffStats(visibleCommits),
 				gui.State.Model.BisectInfo,
 				showYouAreHereLabel,
 			)
@@ -162,6 +172,7 @@ func (gui *Gui) subCommitsListContext() *context.SubCommitsContext {
 				gui.State.Model.SubCommits,
 				gui.State.ScreenMode != SCREEN_NORMAL,
 				gui.helpers.CherryPick.CherryPickedCommitShaSet(),
+				set.New[string](),
 				gui.State.Modes.Diffing.Ref,
 				gui.c.UserConfig.Gui.TimeFormat,
 				gui.c.UserConfig.Git.ParseEmoji,
@@ -169,6 +180,9 @@ func (gui *Gui) subCommitsListContext() *context.SubCommitsContext {
 				startIdx,
 				length,
 				gui.shouldShowGraph(),
+				false,
+				nil,
+				nil,
 				git_commands.NewNullBisectInfo(),
 				false,
 			)
@@ -181,7 +195,7 @@ func (gui *Gui) subCommitsListContext() *context.SubCommitsContext {
 }
 
 func (gui *Gui) shouldShowGraph() bool {
-	if gui.State.Modes.Filtering.Active() {
+	if gui.State.Modes.Filtering.Active() || gui.State.Modes.Pickaxe.Active() {
 		return false
 	}
 
@@ -260,7 +274,7 @@ func (gui *Gui) submodulesListContext() *context.SubmodulesContext {
 		func() []*models.SubmoduleConfig { return gui.State.Model.Submodules },
 		gui.Views.Submodules,
 		func(startIdx int, length int) [][]string {
-			return presentation.GetSubmoduleListDisplayStrings(gui.State.Model.Submodules)
+			return presentation.GetSubmoduleListDisplayStrings(gui.State.Model.Submodules, gui.State.Model.SubmoduleStatuses, gui.Tr)
 		},
 		nil,
 		gui.withDiffModeCheck(gui.submodulesRenderToMain),
@@ -286,6 +300,20 @@ func (gui *Gui) suggestionsListContext() *context.SuggestionsContext {
 	)
 }
 
+func (gui *Gui) blameListContext() *context.BlameContext {
+	return context.NewBlameContext(
+		func() []*models.BlameLine { return gui.State.Model.BlameLines },
+		gui.Views.Blame,
+		func(startIdx int, length int) [][]string {
+			return presentation.GetBlameLineListDisplayStrings(gui.State.Model.BlameLines, time.Now())
+		},
+		nil,
+		nil,
+		nil,
+		gui.c,
+	)
+}
+
 func (gui *Gui) getListContexts() []types.IListContext {
 	return []types.IListContext{
 		gui.State.Contexts.Menu,
@@ -301,5 +329,6 @@ func (gui *Gui) getListContexts() []types.IListContext {
 		gui.State.Contexts.CommitFiles,
 		gui.State.Contexts.Submodules,
 		gui.State.Contexts.Suggestions,
+		gui.State.Contexts.Blame,
 	}
 }
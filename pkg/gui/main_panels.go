@@ -17,10 +17,10 @@ func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
 		return gui.newStringTaskWithScroll(view, v.Str, v.OriginX, v.OriginY)
 
 	case *types.RunCommandTask:
-		return gui.newCmdTask(view, v.Cmd, v.Prefix)
+		return gui.newCmdTask(view, v.Cmd, v.Prefix, "")
 
 	case *types.RunPtyTask:
-		return gui.newPtyTask(view, v.Cmd, v.Prefix)
+		return gui.newPtyTask(view, v.Cmd, v.Prefix, v.Cacheable)
 	}
 
 	return nil
@@ -66,6 +66,8 @@ func (gui *Gui) RefreshMainView(opts *types.ViewUpdateOpts, context types.Contex
 		view.Title = opts.Title
 	}
 
+	gui.highlightInMainView(context, opts.Highlight)
+
 	if err := gui.runTaskForView(view, opts.Task); err != nil {
 		gui.c.Log.Error(err)
 		return nil
@@ -74,6 +76,34 @@ func (gui *Gui) RefreshMainView(opts *types.ViewUpdateOpts, context types.Contex
 	return nil
 }
 
+// the user can't search the normal main view, so we're free to use its search
+// highlighting to point things out in its content. The staging and patch
+// building views are searchable, so we leave their search alone.
+func (gui *Gui) highlightInMainView(context types.Context, str string) {
+	if context != gui.State.Contexts.Normal {
+		return
+	}
+
+	view := context.GetView()
+	if str == "" {
+		if gui.highlightingInMainView {
+			view.ClearSearch()
+			gui.highlightingInMainView = false
+		}
+		return
+	}
+
+	// searching jumps to the first match in what the view is currently showing,
+	// which is about to be replaced, so we stay where we are. The view has no
+	// select handler of its own, but gocui needs one when nothing matches.
+	ox, oy := view.Origin()
+	view.SetOnSelectItem(func(int, int, int) error { return nil })
+	_ = view.Search(str)
+	view.SetOnSelectItem(nil)
+	_ = view.SetOrigin(ox, oy)
+	gui.highlightingInMainView = true
+}
+
 func (gui *Gui) normalMainContextPair() types.MainContextPair {
 	return types.NewMainContextPair(
 		gui.State.Contexts.Normal,
@@ -31,24 +31,29 @@ func findConflicts(content string) []*mergeConflict {
 
 	var newConflict *mergeConflict
 	for i, line := range utils.SplitLines(content) {
+		// Marker-like lines that appear out of order (e.g. a '=======' in the
+		// ancestor section of a diff3 conflict after we've already seen one, or
+		// a '<<<<<<< ' inside a conflict) are treated as regular content.
 		switch determineLineType(line) {
 		case START:
-			newConflict = &mergeConflict{start: i, ancestor: -1}
+			if newConflict == nil {
+				newConflict = &mergeConflict{start: i, ancestor: -1, target: -1}
+			}
 		case ANCESTOR:
-			if newConflict != nil {
+			if newConflict != nil && !newConflict.hasAncestor() && newConflict.target < 0 {
 				newConflict.ancestor = i
 			}
 		case TARGET:
-			if newConflict != nil {
+			if newConflict != nil && newConflict.target < 0 {
 				newConflict.target = i
 			}
 		case END:
-			if newConflict != nil {
+			if newConflict != nil && newConflict.target >= 0 {
 				newConflict.end = i
 				conflicts = append(conflicts, newConflict)
+				// reset value to avoid any possible silent mutations in further iterations
+				newConflict = nil
 			}
-			// reset value to avoid any possible silent mutations in further iterations
-			newConflict = nil
 		default:
 			// line isn't a merge conflict marker so we just continue
 		}
@@ -71,7 +76,7 @@ func determineLineType(line string) LineType {
 	switch {
 	case strings.HasPrefix(trimmedLine, CONFLICT_START):
 		return START
-	case strings.HasPrefix(trimmedLine, "||||||| "):
+	case strings.HasPrefix(trimmedLine, "||||||| ") || trimmedLine == "|||||||":
 		return ANCESTOR
 	case trimmedLine == "=======":
 		return TARGET
@@ -54,6 +54,14 @@ func TestDetermineLineType(t *testing.T) {
 			line:     "||||||| adf33b9",
 			expected: ANCESTOR,
 		},
+		{
+			line:     "|||||||",
+			expected: ANCESTOR,
+		},
+		{
+			line:     "||||||||",
+			expected: NOT_A_MARKER,
+		},
 	}
 
 	for _, s := range scenarios {
@@ -15,6 +15,12 @@ func (c *mergeConflict) hasAncestor() bool {
 	return c.ancestor >= 0
 }
 
+// isAncestorLine tells us whether the line at the given index is part of the
+// common ancestor (base) section of a diff3-style conflict
+func (c *mergeConflict) isAncestorLine(i int) bool {
+	return c.hasAncestor() && c.ancestor < i && i < c.target
+}
+
 func (c *mergeConflict) isMarkerLine(i int) bool {
 	return i == c.start ||
 		i == c.ancestor ||
@@ -29,6 +35,8 @@ const (
 	MIDDLE
 	BOTTOM
 	ALL
+	// like ALL, but with the bottom hunk placed before the rest of the conflict
+	ALL_BOTTOM_FIRST
 )
 
 func (s Selection) isIndexToKeep(conflict *mergeConflict, i int) bool {
@@ -57,7 +65,7 @@ func (s Selection) bounds(c *mergeConflict) (int, int) {
 		return c.ancestor, c.target
 	case BOTTOM:
 		return c.target, c.end
-	case ALL:
+	case ALL, ALL_BOTTOM_FIRST:
 		return c.start, c.end
 	}
 
@@ -19,6 +19,8 @@ func ColoredConflictFile(state *State, hasFocus bool) string {
 		textStyle := theme.DefaultTextColor
 		if conflict.isMarkerLine(i) {
 			textStyle = style.FgRed
+		} else if conflict.isAncestorLine(i) {
+			textStyle = style.FgCyan
 		}
 
 		if hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.Selection()) {
@@ -175,8 +175,21 @@ func (s *State) ContentAfterConflictResolve(selection Selection) (bool, string,
 	}
 
 	content := ""
+	// when the bottom hunk goes first, we hold back the lines above it until
+	// we reach the end of the conflict
+	heldBackContent := ""
 	err := utils.ForEachLineInFile(s.path, func(line string, i int) {
-		if selection.isIndexToKeep(conflict, i) {
+		if i == conflict.end {
+			content += heldBackContent
+		}
+
+		if !selection.isIndexToKeep(conflict, i) {
+			return
+		}
+
+		if selection == ALL_BOTTOM_FIRST && conflict.start < i && i < conflict.target {
+			heldBackContent += line
+		} else {
 			content += line
 		}
 	})
@@ -1,6 +1,8 @@
 package mergeconflicts
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -112,6 +114,63 @@ baz
 				},
 			},
 		},
+		{
+			name: "diff3 conflict with unlabelled ancestor marker",
+			content: `<<<<<<< HEAD
+foo
+|||||||
+bar
+=======
+baz
+>>>>>>> branch
+`,
+			expected: []*mergeConflict{
+				{
+					start:    0,
+					ancestor: 2,
+					target:   4,
+					end:      6,
+				},
+			},
+		},
+		{
+			name: "diff3 conflicts with nested-looking markers in content",
+			content: `<<<<<<< HEAD
+>>>>>>> not the end
+foo
+||||||| base
+<<<<<<< not a start
+|||||||
+bar
+=======
+baz
+=======
+||||||| not an ancestor
+>>>>>>> branch
+
+<<<<<<< HEAD
+a
+||||||| base
+b
+=======
+c
+>>>>>>> branch
+`,
+			expected: []*mergeConflict{
+				{
+					start:    0,
+					ancestor: 3,
+					target:   7,
+					end:      11,
+				},
+				{
+					start:    13,
+					ancestor: 15,
+					target:   17,
+					end:      19,
+				},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -121,3 +180,66 @@ baz
 		})
 	}
 }
+
+func TestContentAfterConflictResolve(t *testing.T) {
+	content := `before
+<<<<<<< HEAD
+ours
+||||||| base
+base
+=======
+theirs
+>>>>>>> branch
+after
+`
+
+	type scenario struct {
+		name      string
+		selection Selection
+		expected  string
+	}
+
+	scenarios := []scenario{
+		{
+			name:      "top",
+			selection: TOP,
+			expected:  "before\nours\nafter\n",
+		},
+		{
+			name:      "middle",
+			selection: MIDDLE,
+			expected:  "before\nbase\nafter\n",
+		},
+		{
+			name:      "bottom",
+			selection: BOTTOM,
+			expected:  "before\ntheirs\nafter\n",
+		},
+		{
+			name:      "all",
+			selection: ALL,
+			expected:  "before\nours\nbase\ntheirs\nafter\n",
+		},
+		{
+			name:      "all, bottom first",
+			selection: ALL_BOTTOM_FIRST,
+			expected:  "before\ntheirs\nours\nbase\nafter\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+			assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+			state := NewState()
+			state.SetContent(content, path)
+
+			ok, result, err := state.ContentAfterConflictResolve(s.selection)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type modeStatus struct {
@@ -37,18 +38,33 @@ func (gui *Gui) modeStatuses() []modeStatus {
 			reset: gui.helpers.PatchBuilding.Reset,
 		},
 		{
-			isActive: gui.State.Modes.Filtering.Active,
+			isActive: gui.State.Modes.Comparing.Active,
+			description: func() string {
+				return gui.withResetButton(gui.compareStr(), style.FgMagenta)
+			},
+			reset: gui.exitCompareMode,
+		},
+		{
+			isActive: gui.State.Modes.Pickaxe.Active,
 			description: func() string {
+				text := gui.c.Tr.LcCommitsAddingOrRemoving
+				if gui.State.Modes.Pickaxe.IsRegex {
+					text = gui.c.Tr.LcCommitsWithChangesMatching
+				}
+
 				return gui.withResetButton(
-					fmt.Sprintf(
-						"%s '%s'",
-						gui.c.Tr.LcFilteringBy,
-						gui.State.Modes.Filtering.GetPath(),
-					),
+					fmt.Sprintf("%s '%s'", text, gui.State.Modes.Pickaxe.Text),
 					style.FgRed,
 				)
 			},
-			reset: gui.exitFilterMode,
+			reset: gui.clearPickaxe,
+		},
+		{
+			isActive: gui.State.Modes.Filtering.Active,
+			description: func() string {
+				return gui.withResetButton(gui.filteringStr(), style.FgRed)
+			},
+			reset: gui.resetFiltering,
 		},
 		{
 			isActive: gui.State.Modes.CherryPicking.Active,
@@ -101,3 +117,20 @@ func (gui *Gui) withResetButton(content string, textStyle style.TextStyle) strin
 		style.AttrUnderline.Sprint(gui.c.Tr.ResetInParentheses),
 	)
 }
+
+// filteringStr describes every filter that's on, given that they all narrow
+// down the same commits
+func (gui *Gui) filteringStr() string {
+	filtering := gui.State.Modes.Filtering
+	switch {
+	case filtering.HasPaths() && filtering.HasAuthor():
+		return utils.ResolvePlaceholderString(gui.c.Tr.LcFilteringByPathsAndAuthor, map[string]string{
+			"paths":  filtering.GetPathsString(),
+			"author": filtering.GetAuthor(),
+		})
+	case filtering.HasAuthor():
+		return fmt.Sprintf("%s '%s'", gui.c.Tr.LcFilteringByAuthor, filtering.GetAuthor())
+	default:
+		return fmt.Sprintf("%s '%s'", gui.c.Tr.LcFilteringBy, filtering.GetPathsString())
+	}
+}
@@ -0,0 +1,21 @@
+package comparing
+
+// Comparing is for diffing two commits that the user has marked, as opposed to
+// the diffing mode, which diffs whatever is selected against a fixed ref.
+// If From is blank we're not comparing anything, and if only To is blank we're
+// waiting for the user to mark the second commit.
+type Comparing struct {
+	From string
+	To   string
+	// whether to diff To against where it diverged from From, as in
+	// 'git diff From...To', rather than against From itself
+	SinceMergeBase bool
+}
+
+func New() Comparing {
+	return Comparing{}
+}
+
+func (self *Comparing) Active() bool {
+	return self.From != ""
+}
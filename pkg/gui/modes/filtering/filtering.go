@@ -1,25 +1,67 @@
 package filtering
 
+import (
+	"strings"
+)
+
+// Filtering narrows down the commits we show by any combination of criteria,
+// each of which can be set and cleared on its own
 type Filtering struct {
-	path string // the filename that gets passed to git log
+	paths  []string // the paths and glob patterns that get passed to git log
+	author string   // the regex that gets passed to git log --author
+}
+
+func New(paths string) Filtering {
+	return Filtering{paths: ParsePaths(paths)}
 }
 
-func New(path string) Filtering {
-	return Filtering{path: path}
+// ParsePaths splits up comma-separated paths and glob patterns, as the user
+// enters them
+func ParsePaths(paths string) []string {
+	result := []string{}
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path != "" {
+			result = append(result, path)
+		}
+	}
+	return result
 }
 
 func (m *Filtering) Active() bool {
-	return m.path != ""
+	return m.HasPaths() || m.HasAuthor()
 }
 
 func (m *Filtering) Reset() {
-	m.path = ""
+	m.paths = nil
+	m.author = ""
+}
+
+func (m *Filtering) HasPaths() bool {
+	return len(m.paths) > 0
+}
+
+func (m *Filtering) HasAuthor() bool {
+	return m.author != ""
+}
+
+func (m *Filtering) SetPaths(paths []string) {
+	m.paths = paths
+}
+
+func (m *Filtering) GetPaths() []string {
+	return m.paths
+}
+
+// GetPathsString gives the paths as the user would enter them
+func (m *Filtering) GetPathsString() string {
+	return strings.Join(m.paths, ", ")
 }
 
-func (m *Filtering) SetPath(path string) {
-	m.path = path
+func (m *Filtering) SetAuthor(author string) {
+	m.author = author
 }
 
-func (m *Filtering) GetPath() string {
-	return m.path
+func (m *Filtering) GetAuthor() string {
+	return m.author
 }
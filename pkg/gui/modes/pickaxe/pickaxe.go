@@ -0,0 +1,34 @@
+package pickaxe
+
+// Pickaxe is for searching the contents of commits, as opposed to their
+// messages: the commits panel only shows the commits that added or removed
+// Text (git log -S), or if IsRegex is set, the commits whose added or removed
+// lines match it (git log -G). If Text is blank we're not searching.
+type Pickaxe struct {
+	Text    string
+	IsRegex bool
+
+	// where the commits panel was before the search, so we can go back there
+	// once it's over
+	PrevSelectedLineIdx int
+	PrevOriginY         int
+}
+
+func New() Pickaxe {
+	return Pickaxe{}
+}
+
+func (self *Pickaxe) Active() bool {
+	return self.Text != ""
+}
+
+func (self *Pickaxe) Start(text string, isRegex bool, prevSelectedLineIdx int, prevOriginY int) {
+	self.Text = text
+	self.IsRegex = isRegex
+	self.PrevSelectedLineIdx = prevSelectedLineIdx
+	self.PrevOriginY = prevOriginY
+}
+
+func (self *Pickaxe) Reset() {
+	*self = New()
+}
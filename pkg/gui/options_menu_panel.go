@@ -69,8 +69,9 @@ func (gui *Gui) handleCreateOptionsMenu() error {
 
 				return binding.Handler()
 			},
-			Key:     binding.Key,
-			Tooltip: binding.Tooltip,
+			Key:            binding.Key,
+			Tooltip:        binding.Tooltip,
+			DisabledReason: getDisabledReason(binding),
 		}
 	})
 
@@ -80,3 +81,11 @@ func (gui *Gui) handleCreateOptionsMenu() error {
 		HideCancel: true,
 	})
 }
+
+func getDisabledReason(binding *types.Binding) string {
+	if binding.GetDisabledReason == nil {
+		return ""
+	}
+
+	return binding.GetDisabledReason()
+}
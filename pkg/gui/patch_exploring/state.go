@@ -1,7 +1,13 @@
 package patch_exploring
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -14,6 +20,29 @@ type State struct {
 	diff              string
 	patchParser       *patch.PatchParser
 	selectMode        selectMode
+
+	// lines which have been marked for staging in addition to the current
+	// selection, allowing non-contiguous lines to be staged in one go
+	markedLineIndices []int
+
+	// hunks which have been collapsed so that only their header is shown, keyed
+	// by the hunk's file and header so that they stay collapsed when moving
+	// between files.
+	collapsedHunks map[string]bool
+
+	// hunks which have been split into one hunk per group of changes, keyed by
+	// the original hunk's body. The diff we render is the one we were given
+	// with these hunks split.
+	splitHunks   map[string]bool
+	originalDiff string
+
+	// the width the diff is rendered at when it's shown side by side, or 0 if
+	// it's shown as a unified diff. When side by side, changed lines share rows
+	// of the view, so view and patch line indices differ.
+	sideBySideWidth int
+
+	// whether unified diffs are shown with a gutter of old and new line numbers
+	showLineNumbers bool
 }
 
 // these represent what select mode we're in
@@ -26,24 +55,32 @@ const (
 )
 
 func NewState(diff string, selectedLineIdx int, oldState *State, log *logrus.Entry) *State {
-	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 {
+	if oldState != nil && diff == oldState.originalDiff && selectedLineIdx == -1 {
 		// if we're here then we can return the old state. If selectedLineIdx was not -1
 		// then that would mean we were trying to click and potentiall drag a range, which
 		// is why in that case we continue below
 		return oldState
 	}
 
+	rangeStartLineIdx := 0
+	collapsedHunks := map[string]bool{}
+	splitHunks := map[string]bool{}
+	sideBySideWidth := 0
+	if oldState != nil {
+		rangeStartLineIdx = oldState.rangeStartLineIdx
+		collapsedHunks = oldState.collapsedHunks
+		splitHunks = oldState.splitHunks
+		sideBySideWidth = oldState.sideBySideWidth
+	}
+
+	originalDiff := diff
+	diff = splitDiff(originalDiff, splitHunks)
 	patchParser := patch.NewPatchParser(log, diff)
 
 	if len(patchParser.StageableLines) == 0 {
 		return nil
 	}
 
-	rangeStartLineIdx := 0
-	if oldState != nil {
-		rangeStartLineIdx = oldState.rangeStartLineIdx
-	}
-
 	selectMode := LINE
 	// if we have clicked from the outside to focus the main view we'll pass in a non-negative line index so that we can instantly select that line
 	if selectedLineIdx >= 0 {
@@ -59,13 +96,20 @@ func NewState(diff string, selectedLineIdx int, oldState *State, log *logrus.Ent
 		selectedLineIdx = patchParser.StageableLines[0]
 	}
 
-	return &State{
+	state := &State{
 		patchParser:       patchParser,
 		selectedLineIdx:   selectedLineIdx,
 		selectMode:        selectMode,
 		rangeStartLineIdx: rangeStartLineIdx,
 		diff:              diff,
+		collapsedHunks:    collapsedHunks,
+		splitHunks:        splitHunks,
+		originalDiff:      originalDiff,
+		sideBySideWidth:   sideBySideWidth,
 	}
+	state.selectedLineIdx = state.visibleLineIdx(selectedLineIdx, false)
+
+	return state
 }
 
 func (s *State) GetSelectedLineIdx() int {
@@ -76,6 +120,12 @@ func (s *State) GetDiff() string {
 	return s.diff
 }
 
+// IsCombinedDiff tells us whether the diff is the combined diff of a file with
+// merge conflicts, whose lines can't be staged
+func (s *State) IsCombinedDiff() bool {
+	return s.patchParser.IsCombinedDiff
+}
+
 func (s *State) ToggleSelectHunk() {
 	if s.selectMode == HUNK {
 		s.selectMode = LINE
@@ -116,7 +166,7 @@ func (s *State) SelectLine(newSelectedLineIdx int) {
 		newSelectedLineIdx = len(s.patchParser.PatchLines) - 1
 	}
 
-	s.selectedLineIdx = newSelectedLineIdx
+	s.selectedLineIdx = s.visibleLineIdx(newSelectedLineIdx, newSelectedLineIdx > s.selectedLineIdx)
 }
 
 func (s *State) SelectNewLineForRange(newSelectedLineIdx int) {
@@ -142,7 +192,7 @@ func (s *State) CycleHunk(forward bool) {
 	}
 
 	newHunk := s.patchParser.GetHunkContainingLine(s.selectedLineIdx, change)
-	s.selectedLineIdx = s.patchParser.GetNextStageableLineIndex(newHunk.FirstLineIdx)
+	s.selectedLineIdx = s.visibleLineIdx(s.patchParser.GetNextStageableLineIndex(newHunk.FirstLineIdx), false)
 }
 
 func (s *State) CycleLine(forward bool) {
@@ -165,29 +215,120 @@ func (s *State) SelectedRange() (int, int) {
 		return hunk.FirstLineIdx, hunk.LastLineIdx()
 	case RANGE:
 		if s.rangeStartLineIdx > s.selectedLineIdx {
-			return s.selectedLineIdx, s.rangeStartLineIdx
+			return s.expandToCollapsedHunks(s.selectedLineIdx, s.rangeStartLineIdx)
 		} else {
-			return s.rangeStartLineIdx, s.selectedLineIdx
+			return s.expandToCollapsedHunks(s.rangeStartLineIdx, s.selectedLineIdx)
 		}
 	case LINE:
-		return s.selectedLineIdx, s.selectedLineIdx
+		return s.expandToCollapsedHunks(s.selectedLineIdx, s.selectedLineIdx)
 	default:
 		// should never happen
 		return 0, 0
 	}
 }
 
+// ToggleMarkLine marks the line at the cursor, or unmarks it if it's marked
+// already. If the cursor is on a collapsed hunk, the whole hunk is toggled.
+func (s *State) ToggleMarkLine() {
+	firstLineIdx, lastLineIdx := s.expandToCollapsedHunks(s.selectedLineIdx, s.selectedLineIdx)
+	stageableLines := lo.Filter(s.patchParser.StageableLines, func(lineIdx int, _ int) bool {
+		return lineIdx >= firstLineIdx && lineIdx <= lastLineIdx
+	})
+
+	if lo.Every(s.markedLineIndices, stageableLines) {
+		s.markedLineIndices, _ = lo.Difference(s.markedLineIndices, stageableLines)
+	} else {
+		s.markedLineIndices = lo.Union(s.markedLineIndices, stageableLines)
+	}
+}
+
+func (s *State) MarkedLineIndices() []int {
+	return s.markedLineIndices
+}
+
+// SelectedLineIndices returns the marked lines if there are any, otherwise the
+// lines in the selected range
+func (s *State) SelectedLineIndices() []int {
+	if len(s.markedLineIndices) > 0 {
+		indices := append([]int{}, s.markedLineIndices...)
+		sort.Ints(indices)
+		return indices
+	}
+
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	return lo.RangeFrom(firstLineIdx, lastLineIdx-firstLineIdx+1)
+}
+
+// SelectedHunksRange returns the range spanning every hunk that overlaps with
+// the selected range
+func (s *State) SelectedHunksRange() (int, int) {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	firstHunk := s.patchParser.GetHunkContainingLine(firstLineIdx, 0)
+	lastHunk := s.patchParser.GetHunkContainingLine(lastLineIdx, 0)
+	return firstHunk.FirstLineIdx, lastHunk.LastLineIdx()
+}
+
 func (s *State) CurrentLineNumber() int {
 	return s.CurrentHunk().LineNumberOfLine(s.selectedLineIdx)
 }
 
+// SelectedLineNumberRange returns the line numbers, in the new version of the
+// file, of the first and last lines of the selection
+func (s *State) SelectedLineNumberRange() (int, int) {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	firstLineNumber := s.patchParser.GetHunkContainingLine(firstLineIdx, 0).LineNumberOfLine(firstLineIdx)
+	lastLineNumber := s.patchParser.GetHunkContainingLine(lastLineIdx, 0).LineNumberOfLine(lastLineIdx)
+	return firstLineNumber, lastLineNumber
+}
+
 func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int) string {
+func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int, showIntraLineDiff bool) string {
 	firstLineIdx, lastLineIdx := s.SelectedRange()
-	return s.patchParser.Render(isFocused, firstLineIdx, lastLineIdx, includedLineIndices)
+	if s.IsSideBySide() {
+		return s.patchParser.RenderSideBySide(s.visibleSideBySideRows(), s.sideBySideWidth, isFocused, firstLineIdx, lastLineIdx, lo.Union(includedLineIndices, s.markedLineIndices), showIntraLineDiff)
+	}
+
+	content := s.patchParser.Render(isFocused, firstLineIdx, lastLineIdx, lo.Union(includedLineIndices, s.markedLineIndices), showIntraLineDiff)
+
+	hiddenLines := s.hiddenLines()
+	if content == "" || (len(hiddenLines) == 0 && !s.showLineNumbers) {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	if s.showLineNumbers {
+		gutters := s.patchParser.LineNumberGutters()
+		lines = lo.Map(lines, func(line string, lineIdx int) string {
+			return style.FgBlackLighter.Sprint(gutters[lineIdx]) + line
+		})
+	}
+
+	return strings.Join(lo.Reject(lines, func(_ string, lineIdx int) bool {
+		return hiddenLines[lineIdx]
+	}), "\n")
+}
+
+// SetShowLineNumbers sets whether unified diffs are shown with line numbers.
+// Side-by-side diffs always show them.
+func (s *State) SetShowLineNumbers(show bool) {
+	s.showLineNumbers = show
+}
+
+// SelectNewLineNumber selects the line at the given line number in the new
+// version of the file, returning false if that line isn't part of the diff
+func (s *State) SelectNewLineNumber(lineNumber int) bool {
+	lineIdx := s.patchParser.LineIdxOfNewLineNumber(lineNumber)
+	if lineIdx == -1 {
+		return false
+	}
+
+	s.SetLineSelectMode()
+	s.SelectLine(lineIdx)
+
+	return true
 }
 
 func (s *State) PlainRenderSelected() string {
@@ -195,6 +336,40 @@ func (s *State) PlainRenderSelected() string {
 	return s.patchParser.RenderLinesPlain(firstLineIdx, lastLineIdx)
 }
 
+// RenderSelectedWithoutPrefixes returns the content of the selected lines
+// without their '+', '-' or ' ' prefixes. If newLinesOnly is true, deleted
+// lines are left out so that we get the lines as they are in the new version
+// of the file.
+func (s *State) RenderSelectedWithoutPrefixes(newLinesOnly bool) string {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	if newLinesOnly {
+		return s.patchParser.RenderNewLinesWithoutPrefixes(firstLineIdx, lastLineIdx)
+	}
+	return s.patchParser.RenderLinesWithoutPrefixes(firstLineIdx, lastLineIdx)
+}
+
+// RenderSelectedAsDiff returns the selected lines as a diff with a hunk for
+// each hunk that the selection spans
+func (s *State) RenderSelectedAsDiff() string {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	return s.patchParser.RenderLinesAsDiff(firstLineIdx, lastLineIdx)
+}
+
+// PlainRenderSelectedVisibleLines is like PlainRenderSelected but leaves out
+// the lines of collapsed hunks, matching what's shown in the view
+func (s *State) PlainRenderSelectedVisibleLines() string {
+	firstLineIdx, lastLineIdx := s.SelectedRange()
+	hiddenLines := s.hiddenLines()
+
+	result := ""
+	for lineIdx := firstLineIdx; lineIdx <= lastLineIdx; lineIdx++ {
+		if !hiddenLines[lineIdx] {
+			result += s.patchParser.RenderLinesPlain(lineIdx, lineIdx)
+		}
+	}
+	return result
+}
+
 func (s *State) SelectBottom() {
 	s.SetLineSelectMode()
 	s.SelectLine(len(s.patchParser.PatchLines) - 1)
@@ -208,5 +383,198 @@ func (s *State) SelectTop() {
 func (s *State) CalculateOrigin(currentOrigin int, bufferHeight int) int {
 	firstLineIdx, lastLineIdx := s.SelectedRange()
 
-	return calculateOrigin(currentOrigin, bufferHeight, firstLineIdx, lastLineIdx, s.GetSelectedLineIdx(), s.selectMode)
+	return calculateOrigin(currentOrigin, bufferHeight, s.ViewLineIdx(firstLineIdx), s.ViewLineIdx(lastLineIdx), s.ViewLineIdx(s.GetSelectedLineIdx()), s.selectMode)
+}
+
+// ToggleCollapseHunk collapses the current hunk so that only its header is
+// shown, or expands it again if it's already collapsed.
+func (s *State) ToggleCollapseHunk() {
+	hunk := s.CurrentHunk()
+	key := s.hunkKey(hunk)
+
+	s.SetLineSelectMode()
+	if s.collapsedHunks[key] {
+		delete(s.collapsedHunks, key)
+		s.selectedLineIdx = s.patchParser.GetNextStageableLineIndex(hunk.FirstLineIdx)
+	} else {
+		s.collapsedHunks[key] = true
+		s.selectedLineIdx = hunk.FirstLineIdx
+	}
+}
+
+// SplitHunk splits the current hunk into one hunk per group of changes so
+// that each group can be selected and staged by itself. Returns false if the
+// hunk only has one group of changes.
+func (s *State) SplitHunk() bool {
+	hunk := s.CurrentHunk()
+	if !hunk.CanSplit() {
+		return false
+	}
+
+	s.splitHunks[hunk.Body()] = true
+	s.diff = splitDiff(s.originalDiff, s.splitHunks)
+	s.patchParser = patch.NewPatchParser(s.patchParser.Log, s.diff)
+	// the first of the new hunks starts where the old one did
+	s.selectedLineIdx = s.patchParser.GetNextStageableLineIndex(hunk.FirstLineIdx)
+	s.rangeStartLineIdx = s.selectedLineIdx
+	s.markedLineIndices = nil
+
+	return true
+}
+
+func splitDiff(diff string, splitHunks map[string]bool) string {
+	if len(splitHunks) == 0 {
+		return diff
+	}
+
+	return patch.SplitHunks(diff, func(hunk *patch.PatchHunk) bool {
+		return splitHunks[hunk.Body()]
+	})
+}
+
+// SetSideBySideWidth shows the diff side by side at the given width, or as a
+// unified diff if the width is 0. Combined diffs are always shown unified
+// because their lines don't pair up.
+func (s *State) SetSideBySideWidth(width int) {
+	if s.patchParser.IsCombinedDiff {
+		width = 0
+	}
+
+	s.sideBySideWidth = width
+}
+
+func (s *State) IsSideBySide() bool {
+	return s.sideBySideWidth > 0
+}
+
+// ViewLineIdx converts a line index in the patch into the index of the line
+// in the view, which doesn't show the bodies of collapsed hunks. Lines within
+// a collapsed hunk map to the hunk's header.
+func (s *State) ViewLineIdx(lineIdx int) int {
+	if s.IsSideBySide() {
+		if hunk := s.collapsedHunkContaining(lineIdx); hunk != nil {
+			lineIdx = hunk.FirstLineIdx
+		}
+		_, rowIdx, _ := lo.FindIndexOf(s.visibleSideBySideRows(), func(row patch.SideBySideRow) bool {
+			return row.Contains(lineIdx)
+		})
+		return utils.Max(0, rowIdx)
+	}
+
+	viewLineIdx := lineIdx
+	for _, hunk := range s.patchParser.PatchHunks {
+		if hunk.FirstLineIdx < lineIdx && s.isCollapsed(hunk) {
+			viewLineIdx -= utils.Min(lineIdx, hunk.LastLineIdx()) - hunk.FirstLineIdx
+		}
+	}
+	return viewLineIdx
+}
+
+// PatchLineIdx converts the index of a line in the view into a line index in
+// the patch.
+func (s *State) PatchLineIdx(viewLineIdx int) int {
+	if s.IsSideBySide() {
+		rows := s.visibleSideBySideRows()
+		row := rows[utils.Clamp(viewLineIdx, 0, len(rows)-1)]
+		if row.Left == -1 {
+			return row.Right
+		}
+		return row.Left
+	}
+
+	hiddenLines := s.hiddenLines()
+	visibleLineCount := 0
+	for lineIdx := range s.patchParser.PatchLines {
+		if hiddenLines[lineIdx] {
+			continue
+		}
+		if visibleLineCount == viewLineIdx {
+			return lineIdx
+		}
+		visibleLineCount++
+	}
+
+	return len(s.patchParser.PatchLines) - 1
+}
+
+func (s *State) SelectedViewLineIdx() int {
+	return s.ViewLineIdx(s.selectedLineIdx)
+}
+
+// a hunk is identified by its file and its header, so that identical hunks
+// elsewhere don't share its state
+func (s *State) hunkKey(hunk *patch.PatchHunk) string {
+	fileHeader := ""
+	if len(s.patchParser.PatchLines) > 0 && s.patchParser.PatchLines[0].Kind == patch.PATCH_HEADER {
+		// e.g. 'diff --git a/filename b/filename'
+		fileHeader = s.patchParser.PatchLines[0].Content
+	}
+
+	return fileHeader + "\n" + s.patchParser.PatchLines[hunk.FirstLineIdx].Content
+}
+
+func (s *State) isCollapsed(hunk *patch.PatchHunk) bool {
+	return len(s.collapsedHunks) > 0 && s.collapsedHunks[s.hunkKey(hunk)]
+}
+
+// returns the collapsed hunk containing the given line, or nil if the line
+// isn't part of a collapsed hunk
+func (s *State) collapsedHunkContaining(lineIdx int) *patch.PatchHunk {
+	if len(s.collapsedHunks) == 0 {
+		return nil
+	}
+
+	hunk := s.patchParser.GetHunkContainingLine(lineIdx, 0)
+	if hunk == nil || lineIdx < hunk.FirstLineIdx || lineIdx > hunk.LastLineIdx() || !s.isCollapsed(hunk) {
+		return nil
+	}
+	return hunk
+}
+
+// the rows of the side-by-side diff, leaving out those of collapsed hunks
+func (s *State) visibleSideBySideRows() []patch.SideBySideRow {
+	hiddenLines := s.hiddenLines()
+	return lo.Filter(s.patchParser.SideBySideRows(), func(row patch.SideBySideRow, _ int) bool {
+		return !hiddenLines[row.Left] && !hiddenLines[row.Right]
+	})
+}
+
+// returns the lines which aren't shown because they belong to a collapsed
+// hunk, keyed by line index
+func (s *State) hiddenLines() map[int]bool {
+	hiddenLines := map[int]bool{}
+	for _, hunk := range s.patchParser.PatchHunks {
+		if s.isCollapsed(hunk) {
+			for lineIdx := hunk.FirstLineIdx + 1; lineIdx <= hunk.LastLineIdx(); lineIdx++ {
+				hiddenLines[lineIdx] = true
+			}
+		}
+	}
+	return hiddenLines
+}
+
+// visibleLineIdx returns the given line index if the line is visible. If it's
+// hidden within a collapsed hunk we return the line after the hunk if moving
+// forward (and there is one), and the hunk's header otherwise.
+func (s *State) visibleLineIdx(lineIdx int, forward bool) int {
+	hunk := s.collapsedHunkContaining(lineIdx)
+	if hunk == nil || lineIdx == hunk.FirstLineIdx {
+		return lineIdx
+	}
+
+	if forward && hunk.LastLineIdx() < len(s.patchParser.PatchLines)-1 {
+		return hunk.LastLineIdx() + 1
+	}
+	return hunk.FirstLineIdx
+}
+
+// when the selection touches a collapsed hunk, the whole hunk is selected
+func (s *State) expandToCollapsedHunks(firstLineIdx int, lastLineIdx int) (int, int) {
+	if hunk := s.collapsedHunkContaining(firstLineIdx); hunk != nil {
+		firstLineIdx = hunk.FirstLineIdx
+	}
+	if hunk := s.collapsedHunkContaining(lastLineIdx); hunk != nil {
+		lastLineIdx = hunk.LastLineIdx()
+	}
+	return firstLineIdx, lastLineIdx
 }
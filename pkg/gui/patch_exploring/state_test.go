@@ -0,0 +1,194 @@
+package patch_exploring
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const twoHunks = `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,3 +1,3 @@
+ apple
+-grape
++orange
+ pear
+@@ -10,3 +10,3 @@
+ lemon
+-lime
++kiwi
+ melon
+`
+
+// the same as twoHunks but with the first hunk having been staged
+const secondHunkOnly = `diff --git a/filename b/filename
+index 6d79956..1a2b3c4 100644
+--- a/filename
++++ b/filename
+@@ -10,3 +10,3 @@
+ lemon
+-lime
++kiwi
+ melon
+`
+
+func TestToggleCollapseHunk(t *testing.T) {
+	state := NewState(twoHunks, -1, nil, nil)
+	assert.Equal(t, 6, state.GetSelectedLineIdx())
+
+	state.ToggleCollapseHunk()
+
+	// the header of the collapsed hunk is selected
+	assert.Equal(t, 4, state.GetSelectedLineIdx())
+	firstLineIdx, lastLineIdx := state.SelectedRange()
+	assert.Equal(t, 4, firstLineIdx)
+	assert.Equal(t, 8, lastLineIdx)
+
+	rendered := strings.Split(state.RenderForLineIndices(false, nil, false), "\n")
+	assert.Equal(t, 10, len(rendered))
+	assert.Contains(t, rendered[4], "-1,3 +1,3 @@")
+	assert.Contains(t, rendered[5], "-10,3 +10,3 @@")
+
+	assert.Equal(t, 4, state.ViewLineIdx(4))
+	assert.Equal(t, 4, state.ViewLineIdx(6))
+	assert.Equal(t, 5, state.ViewLineIdx(9))
+	assert.Equal(t, 9, state.PatchLineIdx(5))
+	assert.Equal(t, 11, state.PatchLineIdx(7))
+
+	// moving skips over the lines of the collapsed hunk
+	state.CycleLine(true)
+	assert.Equal(t, 9, state.GetSelectedLineIdx())
+	state.CycleLine(false)
+	assert.Equal(t, 4, state.GetSelectedLineIdx())
+
+	state.ToggleCollapseHunk()
+	assert.Equal(t, 6, state.GetSelectedLineIdx())
+	assert.Equal(t, 14, len(strings.Split(state.RenderForLineIndices(false, nil, false), "\n")))
+}
+
+func TestCollapsedHunksPersistAcrossStates(t *testing.T) {
+	state := NewState(twoHunks, -1, nil, nil)
+	state.CycleHunk(true)
+	state.ToggleCollapseHunk()
+	assert.Equal(t, 9, state.GetSelectedLineIdx())
+
+	// the first hunk has gone but the second is unchanged
+	newState := NewState(secondHunkOnly, -1, state, nil)
+	assert.Equal(t, 4, newState.GetSelectedLineIdx())
+	assert.Equal(t, 5, len(strings.Split(newState.RenderForLineIndices(false, nil, false), "\n")))
+}
+
+const identicalHunks = `diff --git a/filename b/filename
+index 9320895..6d79956 100644
+--- a/filename
++++ b/filename
+@@ -1,3 +1,3 @@
+ apple
+-grape
++orange
+ pear
+@@ -10,3 +10,3 @@
+ apple
+-grape
++orange
+ pear
+`
+
+const otherFile = `diff --git a/other b/other
+index 9320895..6d79956 100644
+--- a/other
++++ b/other
+@@ -1,3 +1,3 @@
+ apple
+-grape
++orange
+ pear
+`
+
+func TestCollapsedHunksAreKeyedByFileAndHeader(t *testing.T) {
+	state := NewState(identicalHunks, -1, nil, nil)
+	state.ToggleCollapseHunk()
+
+	// the second hunk has the same content but stays expanded
+	assert.Equal(t, 10, len(strings.Split(state.RenderForLineIndices(false, nil, false), "\n")))
+
+	// and so does the hunk with the same header in another file
+	newState := NewState(otherFile, -1, state, nil)
+	assert.Equal(t, 6, newState.GetSelectedLineIdx())
+	assert.Equal(t, 9, len(strings.Split(newState.RenderForLineIndices(false, nil, false), "\n")))
+}
+
+const threeChangesInOneHunk = `diff --git a/filename b/filename
+index 3d4a5e2..b8f4e19 100644
+--- a/filename
++++ b/filename
+@@ -1,11 +1,11 @@
+ 1
+-2
++two
+ 3
+ 4
+-5
++five
+ 6
+ 7
+-8
++eight
+ 9
+ 10
+ 11
+`
+
+func TestSplitHunk(t *testing.T) {
+	state := NewState(threeChangesInOneHunk, -1, nil, nil)
+	state.ToggleSelectHunk()
+	state.SelectLine(10)
+
+	assert.True(t, state.SplitHunk())
+	assert.Equal(t, 6, state.GetSelectedLineIdx())
+	assert.Contains(t, state.GetDiff(), "@@ -3,5 +3,5 @@")
+
+	// hunk-wise selection now applies to the smaller hunks
+	firstLineIdx, lastLineIdx := state.SelectedRange()
+	assert.Equal(t, 4, firstLineIdx)
+	assert.Equal(t, 9, lastLineIdx)
+	state.CycleHunk(true)
+	firstLineIdx, lastLineIdx = state.SelectedRange()
+	assert.Equal(t, 10, firstLineIdx)
+	assert.Equal(t, 16, lastLineIdx)
+
+	// the smaller hunks can't be split any further
+	assert.False(t, state.SplitHunk())
+
+	// the hunk stays split when the state is rebuilt from the same diff
+	newState := NewState(threeChangesInOneHunk, 6, state, nil)
+	assert.Equal(t, state.GetDiff(), newState.GetDiff())
+}
+
+func TestSideBySideLineIndices(t *testing.T) {
+	state := NewState(twoHunks, -1, nil, nil)
+	state.SetSideBySideWidth(80)
+	assert.True(t, state.IsSideBySide())
+
+	// the deleted and added lines of each hunk share a row
+	assert.Equal(t, 12, len(strings.Split(state.RenderForLineIndices(false, nil, false), "\n")))
+	assert.Equal(t, 6, state.ViewLineIdx(6))
+	assert.Equal(t, 6, state.ViewLineIdx(7))
+	assert.Equal(t, 7, state.ViewLineIdx(8))
+	assert.Equal(t, 10, state.ViewLineIdx(12))
+	assert.Equal(t, 6, state.PatchLineIdx(6))
+	assert.Equal(t, 11, state.PatchLineIdx(10))
+
+	// collapsing the first hunk hides its rows
+	state.ToggleCollapseHunk()
+	assert.Equal(t, 4, state.ViewLineIdx(7))
+	assert.Equal(t, 9, state.PatchLineIdx(5))
+	assert.Equal(t, 9, len(strings.Split(state.RenderForLineIndices(false, nil, false), "\n")))
+
+	// the layout carries over to new states
+	newState := NewState(secondHunkOnly, -1, state, nil)
+	assert.True(t, newState.IsSideBySide())
+}
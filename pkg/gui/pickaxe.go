@@ -0,0 +1,61 @@
+package gui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+func (gui *Gui) setPickaxe(text string, isRegex bool) error {
+	if text == "" {
+		return nil
+	}
+
+	context := gui.State.Contexts.LocalCommits
+
+	// searching again from the results of the last search should still take
+	// us back to where we were before searching at all
+	prevSelectedLineIdx := context.GetSelectedLineIdx()
+	_, prevOriginY := context.GetView().Origin()
+	if gui.State.Modes.Pickaxe.Active() {
+		prevSelectedLineIdx = gui.State.Modes.Pickaxe.PrevSelectedLineIdx
+		prevOriginY = gui.State.Modes.Pickaxe.PrevOriginY
+	}
+
+	gui.State.Modes.Pickaxe.Start(text, isRegex, prevSelectedLineIdx, prevOriginY)
+
+	if err := gui.c.PushContext(context); err != nil {
+		return err
+	}
+
+	// so that nobody mistakes the unsearched commits for the results while
+	// we're waiting on them. There's no point waiting for the commits that
+	// are loading, given that we're about to load them again.
+	gui.stopLoadingCommits()
+	gui.Mutexes.LocalCommitsMutex.Lock()
+	gui.State.Model.Commits = nil
+	gui.Mutexes.LocalCommitsMutex.Unlock()
+	// selecting the first result up front rather than once they're in, so as
+	// not to undo any moving around done while the rest are loading
+	context.SetSelectedLineIdx(0)
+	if err := gui.c.PostRefreshUpdate(context); err != nil {
+		return err
+	}
+
+	return gui.c.WithWaitingStatus(gui.c.Tr.SearchingCommitContentsStatus, func() error {
+		return gui.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}})
+	})
+}
+
+// clearPickaxe also gives up on a search that's still running, given that
+// the commits are loaded again without it
+func (gui *Gui) clearPickaxe() error {
+	prevSelectedLineIdx := gui.State.Modes.Pickaxe.PrevSelectedLineIdx
+	prevOriginY := gui.State.Modes.Pickaxe.PrevOriginY
+	gui.State.Modes.Pickaxe.Reset()
+
+	return gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.COMMITS}, Then: func() {
+		context := gui.State.Contexts.LocalCommits
+		context.SetSelectedLineIdx(prevSelectedLineIdx)
+		_ = context.GetView().SetOrigin(0, prevOriginY)
+		_ = gui.c.PostRefreshUpdate(context)
+	}})
+}
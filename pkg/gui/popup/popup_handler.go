@@ -2,9 +2,11 @@ package popup
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	gctx "github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -71,6 +73,12 @@ func (self *PopupHandler) Error(err error) error {
 		return err
 	}
 
+	// the user already knows they cancelled, so there's nothing to alert them about
+	if errors.Is(err, oscommands.ErrCredentialRequestCancelled) {
+		self.Toast(self.Tr.CredentialRequestCancelled)
+		return nil
+	}
+
 	return self.ErrorMsg(err.Error())
 }
 
@@ -117,6 +125,7 @@ func (self *PopupHandler) Prompt(opts types.PromptOpts) error {
 		HandleConfirmPrompt: opts.HandleConfirm,
 		HandleClose:         opts.HandleClose,
 		FindSuggestionsFunc: opts.FindSuggestionsFunc,
+		SuggestionActions:   opts.SuggestionActions,
 		Mask:                opts.Mask,
 	})
 }
@@ -0,0 +1,55 @@
+package presentation
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+func GetBlameLineListDisplayStrings(blameLines []*models.BlameLine, now time.Time) [][]string {
+	lineNumberWidth := len(strconv.Itoa(len(blameLines)))
+
+	return slices.Map(blameLines, func(blameLine *models.BlameLine) []string {
+		return getBlameLineDisplayStrings(blameLine, now, lineNumberWidth)
+	})
+}
+
+func getBlameLineDisplayStrings(b *models.BlameLine, now time.Time, lineNumberWidth int) []string {
+	ageStyle := blameAgeStyle(now.Sub(time.Unix(b.UnixTimestamp, 0)))
+
+	sha := ""
+	if !b.IsUncommitted() {
+		sha = ageStyle.Sprint(b.ShortSha())
+	}
+
+	return []string{
+		sha,
+		authors.LongAuthor(b.AuthorName),
+		ageStyle.Sprint(utils.UnixToTimeAgo(b.UnixTimestamp)),
+		style.FgCyan.Sprint(fmt.Sprintf("%*d", lineNumberWidth, b.LineNumber)),
+		theme.DefaultTextColor.Sprint(b.Content),
+	}
+}
+
+// the more recent the change, the more the line stands out
+func blameAgeStyle(age time.Duration) style.TextStyle {
+	day := 24 * time.Hour
+
+	switch {
+	case age < 7*day:
+		return style.FgGreen
+	case age < 30*day:
+		return style.FgYellow
+	case age < 365*day:
+		return style.FgBlue
+	default:
+		return theme.DefaultTextColor
+	}
+}
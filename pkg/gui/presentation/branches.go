@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -15,15 +16,22 @@ import (
 
 var branchPrefixColorCache = make(map[string]style.TextStyle)
 
-func GetBranchListDisplayStrings(branches []*models.Branch, fullDescription bool, diffName string, tr *i18n.TranslationSet) [][]string {
+func GetBranchListDisplayStrings(
+	branches []*models.Branch,
+	fullDescription bool,
+	diffName string,
+	divergences map[string]*git_commands.Divergence,
+	pullRequests map[string]*models.PullRequest,
+	tr *i18n.TranslationSet,
+) [][]string {
 	return slices.Map(branches, func(branch *models.Branch) []string {
 		diffed := branch.Name == diffName
-		return getBranchDisplayStrings(branch, fullDescription, diffed, tr)
+		return getBranchDisplayStrings(branch, fullDescription, diffed, divergences[branch.Name], pullRequests[branch.Name], tr)
 	})
 }
 
 // getBranchDisplayStrings returns the display string of branch
-func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool, tr *i18n.TranslationSet) []string {
+func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool, divergence *git_commands.Divergence, pullRequest *models.PullRequest, tr *i18n.TranslationSet) []string {
 	displayName := b.Name
 	if b.DisplayName != "" {
 		displayName = b.DisplayName
@@ -37,6 +45,12 @@ func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool
 	coloredName := nameTextStyle.Sprint(displayName)
 	branchStatus := utils.WithPadding(ColoredBranchStatus(b, tr), 2)
 	coloredName = fmt.Sprintf("%s %s", coloredName, branchStatus)
+	if divergence != nil {
+		coloredName = fmt.Sprintf("%s %s", coloredName, ColoredDivergenceStatus(divergence))
+	}
+	if pullRequest != nil {
+		coloredName = fmt.Sprintf("%s %s", coloredName, ColoredPullRequestStatus(pullRequest))
+	}
 
 	recencyColor := style.FgCyan
 	if b.Recency == "  *" {
@@ -61,6 +75,24 @@ func getBranchDisplayStrings(b *models.Branch, fullDescription bool, diffed bool
 	return res
 }
 
+// ColoredPullRequestStatus shows the pull request's number, with a tick once
+// it's been approved or merged and a cross if it was closed without merging
+func ColoredPullRequestStatus(pullRequest *models.PullRequest) string {
+	number := fmt.Sprintf("#%d", pullRequest.Number)
+
+	switch pullRequest.State {
+	case models.PullRequestMerged:
+		return style.FgMagenta.Sprint(number + " ✓")
+	case models.PullRequestClosed:
+		return style.FgRed.Sprint(number + " ✗")
+	default:
+		if pullRequest.Approved {
+			return style.FgGreen.Sprint(number + " ✓")
+		}
+		return style.FgBlue.Sprint(number)
+	}
+}
+
 // GetBranchTextStyle branch color
 func GetBranchTextStyle(name string) style.TextStyle {
 	branchType := strings.Split(name, "/")[0]
@@ -121,6 +153,23 @@ func BranchStatus(branch *models.Branch, tr *i18n.TranslationSet) string {
 	return result
 }
 
+// ColoredDivergenceStatus shows how far a branch is ahead of and behind its
+// base branch, e.g. '(main ↑2↓5)'
+func ColoredDivergenceStatus(divergence *git_commands.Divergence) string {
+	counts := ""
+	if divergence.Ahead > 0 {
+		counts = fmt.Sprintf("↑%d", divergence.Ahead)
+	}
+	if divergence.Behind > 0 {
+		counts = fmt.Sprintf("%s↓%d", counts, divergence.Behind)
+	}
+	if counts == "" {
+		counts = "✓"
+	}
+
+	return style.FgBlue.Sprintf("(%s %s)", divergence.BaseBranch, counts)
+}
+
 func SetCustomBranches(customBranchColors map[string]string) {
 	branchPrefixColorCache = utils.SetCustomColors(customBranchColors)
 }
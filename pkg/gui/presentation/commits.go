@@ -38,6 +38,7 @@ func GetCommitListDisplayStrings(
 	commits []*models.Commit,
 	fullDescription bool,
 	cherryPickedCommitShaSet *set.Set[string],
+	markedCommitShaSet *set.Set[string],
 	diffName string,
 	timeFormat string,
 	parseEmoji bool,
@@ -45,6 +46,9 @@ func GetCommitListDisplayStrings(
 	startIdx int,
 	length int,
 	showGraph bool,
+	showRefDecorations bool,
+	signatureStatuses map[string]string,
+	diffStats map[string]*git_commands.DiffStats,
 	bisectInfo *git_commands.BisectInfo,
 	showYouAreHereLabel bool,
 ) [][]string {
@@ -104,11 +108,15 @@ func GetCommitListDisplayStrings(
 			common,
 			commit,
 			cherryPickedCommitShaSet,
+			markedCommitShaSet,
 			diffName,
 			timeFormat,
 			parseEmoji,
 			getGraphLine(unfilteredIdx),
 			fullDescription,
+			showRefDecorations,
+			signatureStatuses,
+			diffStats,
 			bisectStatus,
 			bisectInfo,
 			isYouAreHereCommit,
@@ -117,6 +125,34 @@ func GetCommitListDisplayStrings(
 	return lines
 }
 
+// statuses are the letters git uses for %G?. Commits without a signature, or
+// whose status we haven't loaded yet, get no indicator.
+func getSignatureStatusText(status string) string {
+	switch status {
+	case "G", "U":
+		return style.FgGreen.Sprint("✓")
+	case "B", "R":
+		return style.FgRed.Sprint("✗")
+	case "X", "Y":
+		// good signatures which have expired, or whose key has
+		return style.FgYellow.Sprint("!")
+	case "E":
+		return style.FgYellow.Sprint("?")
+	default:
+		return ""
+	}
+}
+
+// Commits which don't change anything, or whose stats we haven't loaded yet,
+// get no stats.
+func getDiffStatsText(stats *git_commands.DiffStats) string {
+	if stats == nil {
+		return ""
+	}
+
+	return style.FgGreen.Sprintf("+%d", stats.Insertions) + " " + style.FgRed.Sprintf("-%d", stats.Deletions)
+}
+
 func getbisectBounds(commits []*models.Commit, bisectInfo *git_commands.BisectInfo) *bisectBounds {
 	if !bisectInfo.Bisecting() {
 		return nil
@@ -250,11 +286,15 @@ func displayCommit(
 	common *common.Common,
 	commit *models.Commit,
 	cherryPickedCommitShaSet *set.Set[string],
+	markedCommitShaSet *set.Set[string],
 	diffName string,
 	timeFormat string,
 	parseEmoji bool,
 	graphLine string,
 	fullDescription bool,
+	showRefDecorations bool,
+	signatureStatuses map[string]string,
+	diffStats map[string]*git_commands.DiffStats,
 	bisectStatus BisectStatus,
 	bisectInfo *git_commands.BisectInfo,
 	isYouAreHereCommit bool,
@@ -268,7 +308,9 @@ func displayCommit(
 	}
 
 	tagString := ""
-	if fullDescription {
+	// when we're showing commits from all branches we need the branch names
+	// too, or there's no telling which branch a commit is on
+	if fullDescription || showRefDecorations {
 		if commit.ExtraInfo != "" {
 			tagString = style.FgMagenta.SetBold().Sprint(commit.ExtraInfo) + " "
 		}
@@ -293,11 +335,19 @@ func displayCommit(
 		authorFunc = authors.LongAuthor
 	}
 
-	cols := make([]string, 0, 7)
+	cols := make([]string, 0, 9)
+	markString := ""
+	if markedCommitShaSet.Includes(commit.Sha) {
+		markString = style.FgYellow.Sprint("*")
+	}
+	cols = append(cols, markString)
 	if icons.IsIconEnabled() {
 		cols = append(cols, shaColor.Sprint(icons.IconForCommit(commit)))
 	}
 	cols = append(cols, shaColor.Sprint(commit.ShortSha()))
+	if signatureStatuses != nil {
+		cols = append(cols, getSignatureStatusText(signatureStatuses[commit.Sha]))
+	}
 	cols = append(cols, bisectString)
 	if fullDescription {
 		cols = append(cols, style.FgBlue.Sprint(utils.UnixToDate(commit.UnixTimestamp, timeFormat)))
@@ -306,7 +356,22 @@ func displayCommit(
 		cols,
 		actionString,
 		authorFunc(commit.AuthorName),
-		graphLine+tagString+theme.DefaultTextColor.Sprint(name),
+	)
+	// the stats go before the name rather than after it so that they stay
+	// lined up, and so that it's long names that get cut off by the edge of
+	// the view rather than the stats
+	if diffStats != nil {
+		cols = append(cols, getDiffStatsText(diffStats[commit.Sha]))
+	}
+	nameColor := theme.DefaultTextColor
+	if commit.IsTODOCommand() {
+		// these aren't commits, so we want them to stand out from the ones
+		// around them
+		nameColor = actionColorMap(commit.Action)
+	}
+	cols = append(
+		cols,
+		graphLine+tagString+nameColor.Sprint(name),
 	)
 
 	return cols
@@ -377,6 +442,10 @@ func actionColorMap(str string) style.TextStyle {
 		return style.FgGreen
 	case "fixup":
 		return style.FgMagenta
+	case "exec", "break":
+		return style.FgBlue
+	case "label", "reset", "merge":
+		return style.FgBlue.SetBold()
 	default:
 		return style.FgYellow
 	}
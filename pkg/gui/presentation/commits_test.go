@@ -28,6 +28,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		commits                  []*models.Commit
 		fullDescription          bool
 		cherryPickedCommitShaSet *set.Set[string]
+		markedCommitShaSet       *set.Set[string]
 		diffName                 string
 		timeFormat               string
 		parseEmoji               bool
@@ -35,6 +36,9 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		startIdx                 int
 		length                   int
 		showGraph                bool
+		showRefDecorations       bool
+		signatureStatuses        map[string]string
+		diffStats                map[string]*git_commands.DiffStats
 		bisectInfo               *git_commands.BisectInfo
 		showYouAreHereLabel      bool
 		expected                 string
@@ -66,6 +70,89 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha2 commit2
 						`),
 		},
+		{
+			testName: "showing signature statuses",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1"},
+				{Name: "commit2", Sha: "sha2"},
+				{Name: "commit3", Sha: "sha3"},
+				{Name: "commit4", Sha: "sha4"},
+				{Name: "commit5", Sha: "sha5"},
+			},
+			startIdx:                 0,
+			length:                   5,
+			showGraph:                false,
+			signatureStatuses:        map[string]string{"sha1": "G", "sha2": "B", "sha3": "E", "sha5": "X"},
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			expected: formatExpected(`
+		sha1 ✓ commit1
+		sha2 ✗ commit2
+		sha3 ? commit3
+		sha4   commit4
+		sha5 ! commit5
+						`),
+		},
+		{
+			testName: "showing ref decorations",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", ExtraInfo: "(HEAD -> master, tag: v1.0)", Tags: []string{"v1.0"}},
+				{Name: "commit2", Sha: "sha2", ExtraInfo: "(feature)"},
+				{Name: "commit3", Sha: "sha3"},
+			},
+			startIdx:                 0,
+			length:                   3,
+			showGraph:                false,
+			showRefDecorations:       true,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			expected: formatExpected(`
+		sha1 (HEAD -> master, tag: v1.0) commit1
+		sha2 (feature) commit2
+		sha3 commit3
+						`),
+		},
+		{
+			testName: "marked commits",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1"},
+				{Name: "commit2", Sha: "sha2"},
+				{Name: "commit3", Sha: "sha3"},
+			},
+			startIdx:                 0,
+			length:                   3,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			markedCommitShaSet:       set.NewFromSlice([]string{"sha1", "sha3"}),
+			expected: formatExpected(`
+		* sha1 commit1
+		  sha2 commit2
+		* sha3 commit3
+						`),
+		},
+		{
+			testName: "showing diff stats",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1"},
+				{Name: "commit2", Sha: "sha2"},
+				{Name: "commit3", Sha: "sha3"},
+			},
+			startIdx:  0,
+			length:    3,
+			showGraph: false,
+			diffStats: map[string]*git_commands.DiffStats{
+				"sha1": {FilesChanged: 3, Insertions: 12, Deletions: 4},
+				"sha2": {FilesChanged: 1, Insertions: 0, Deletions: 100},
+			},
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			expected: formatExpected(`
+		sha1 +12 -4  commit1
+		sha2 +0 -100 commit2
+		sha3         commit3
+						`),
+		},
 		{
 			testName: "showing graph",
 			commits: []*models.Commit{
@@ -266,11 +353,17 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		s := s
 		if !focusing || s.focus {
 			t.Run(s.testName, func(t *testing.T) {
+				markedCommitShaSet := s.markedCommitShaSet
+				if markedCommitShaSet == nil {
+					markedCommitShaSet = set.New[string]()
+				}
+
 				result := GetCommitListDisplayStrings(
 					common,
 					s.commits,
 					s.fullDescription,
 					s.cherryPickedCommitShaSet,
+					markedCommitShaSet,
 					s.diffName,
 					s.timeFormat,
 					s.parseEmoji,
@@ -278,6 +371,9 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.startIdx,
 					s.length,
 					s.showGraph,
+					s.showRefDecorations,
+					s.signatureStatuses,
+					s.diffStats,
 					s.bisectInfo,
 					s.showYouAreHereLabel,
 				)
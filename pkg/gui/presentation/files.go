@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
 )
 
 const (
@@ -33,7 +34,9 @@ func RenderFileTree(
 	return renderAux(tree.GetRoot().Raw(), tree.CollapsedPaths(), "", -1, func(node *filetree.Node[models.File], depth int) string {
 		fileNode := filetree.NewFileNode(node)
 
-		return getFileLine(fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), fileNameAtDepth(node, depth), diffName, submoduleConfigs, node.File)
+		isCollapsed := tree.IsCollapsed(node.GetPath())
+
+		return getFileLine(fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), fileNameAtDepth(node, depth), diffName, submoduleConfigs, node.File, isCollapsed)
 	})
 }
 
@@ -60,7 +63,9 @@ func RenderCommitFileTree(
 			status = patch.PART
 		}
 
-		return getCommitFileLine(commitFileNameAtDepth(node, depth), diffName, node.File, status)
+		isCollapsed := tree.IsCollapsed(node.GetPath())
+
+		return getCommitFileLine(commitFileNameAtDepth(node, depth), diffName, node.File, status, isCollapsed)
 	})
 }
 
@@ -118,7 +123,7 @@ func renderAux[T any](
 	return arr
 }
 
-func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File) string {
+func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File, isCollapsed bool) string {
 	// potentially inefficient to be instantiating these color
 	// objects with each render
 	partiallyModifiedColor := style.FgYellow
@@ -158,7 +163,8 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 	isDirectory := file == nil
 
 	if icons.IsIconEnabled() {
-		output += restColor.Sprintf("%s ", icons.IconForFile(name, isSubmodule, isDirectory))
+		icon := icons.IconForFile(name, isSubmodule, isDirectory, isCollapsed)
+		output += renderFileIcon(icon, restColor, name == diffName)
 	}
 
 	output += restColor.Sprint(utils.EscapeSpecialChars(name))
@@ -167,10 +173,18 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil && file.SkipWorktree {
+		output += theme.DefaultTextColor.Sprint(" (skip-worktree)")
+	}
+
+	if file != nil && file.AssumeUnchanged {
+		output += theme.DefaultTextColor.Sprint(" (assume-unchanged)")
+	}
+
 	return output
 }
 
-func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus) string {
+func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus, isCollapsed bool) string {
 	var colour style.TextStyle
 	if diffName == name {
 		colour = theme.DiffTerminalColor
@@ -196,13 +210,29 @@ func getCommitFileLine(name string, diffName string, commitFile *models.CommitFi
 	isDirectory := commitFile == nil
 
 	if icons.IsIconEnabled() {
-		output += colour.Sprintf("%s ", icons.IconForFile(name, isSubmodule, isDirectory))
+		icon := icons.IconForFile(name, isSubmodule, isDirectory, isCollapsed)
+		output += renderFileIcon(icon, colour, name == diffName)
 	}
 
 	output += colour.Sprint(name)
 	return output
 }
 
+// renderFileIcon shows the icon in its own colour, unless it doesn't have one
+// or the file is highlighted for diffing. Icons get two cells so that the
+// names line up: a narrow icon is followed by a space, which also leaves room
+// for fonts that draw it two cells wide, while a wide one (e.g. an emoji in
+// the custom icons) fills both cells itself.
+func renderFileIcon(icon icons.IconProperties, textStyle style.TextStyle, isDiffName bool) string {
+	iconStyle := textStyle
+	if icon.Color != "" && !isDiffName {
+		iconStyle = theme.GetTextStyle([]string{icon.Color}, false)
+	}
+
+	padding := strings.Repeat(" ", utils.Max(2-runewidth.StringWidth(icon.Icon), 0))
+	return iconStyle.Sprint(icon.Icon) + textStyle.Sprint(padding)
+}
+
 func getColorForChangeStatus(changeStatus string) style.TextStyle {
 	switch changeStatus {
 	case "A":
@@ -7,7 +7,9 @@ import (
 	"github.com/gookit/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/xo/terminfo"
@@ -69,7 +71,7 @@ M  file1
 	for _, s := range scenarios {
 		s := s
 		t.Run(s.name, func(t *testing.T) {
-			viewModel := filetree.NewFileTree(func() []*models.File { return s.files }, utils.NewDummyLog(), true)
+			viewModel := filetree.NewFileTree(func() []*models.File { return s.files }, utils.NewDummyLog(), true, 0)
 			viewModel.SetTree()
 			for _, path := range s.collapsedPaths {
 				viewModel.ToggleCollapsed(path)
@@ -80,6 +82,37 @@ M  file1
 	}
 }
 
+func TestRenderFileTreeWithIcons(t *testing.T) {
+	assert.NoError(t, icons.SetNerdFontsVersion("3"))
+	icons.SetCustomIcons(config.CustomIconsConfig{
+		Extensions: map[string]config.IconProperties{".txt": {Icon: "T", Color: "red"}, ".md": {Icon: "📝"}},
+	})
+	defer func() {
+		_ = icons.SetNerdFontsVersion("")
+		icons.SetCustomIcons(config.CustomIconsConfig{})
+	}()
+
+	files := []*models.File{
+		{Name: "dir1/file.go", ShortStatus: "M ", HasUnstagedChanges: true},
+		{Name: "dir2/file.cs", ShortStatus: "M ", HasUnstagedChanges: true},
+		{Name: "dir2/file.txt", ShortStatus: "M ", HasUnstagedChanges: true},
+		{Name: "dir2/notes.md", ShortStatus: "M ", HasUnstagedChanges: true},
+	}
+
+	viewModel := filetree.NewFileTree(func() []*models.File { return files }, utils.NewDummyLog(), true, 0)
+	viewModel.SetTree()
+	viewModel.ToggleCollapsed("dir1")
+
+	assert.EqualValues(t, []string{
+		"► \uf114 dir1",
+		"▼ \uf115 dir2",
+		"  M  \U000f031b file.cs",
+		"  M  T file.txt",
+		// a wide icon takes the space of a narrow one and the space after it
+		"  M  📝notes.md",
+	}, RenderFileTree(viewModel, "", nil))
+}
+
 func TestRenderCommitFileTree(t *testing.T) {
 	scenarios := []struct {
 		name           string
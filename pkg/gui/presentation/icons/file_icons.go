@@ -6,328 +6,353 @@ import (
 
 // https://github.com/ogham/exa/blob/master/src/output/icons.rs
 const (
-	DEFAULT_FILE_ICON      = "\uf15b" // 
-	DEFAULT_SUBMODULE_ICON = "\uf1d3" // 
-	DEFAULT_DIRECTORY_ICON = "\uf114" // 
+	DEFAULT_FILE_ICON       = "\uf15b" // 
+	DEFAULT_SUBMODULE_ICON  = "\uf1d3" // 
+	DEFAULT_DIRECTORY_ICON  = "\uf114" // 
+	EXPANDED_DIRECTORY_ICON = "\uf115" // 
 )
 
-var nameIconMap = map[string]string{
-	".Trash":             "\uf1f8", // 
-	".atom":              "\ue764", // 
-	".bashprofile":       "\ue615", // 
-	".bashrc":            "\uf489", // 
-	".idea":              "\ue7b5", // 
-	".git":               "\uf1d3", // 
-	".gitattributes":     "\uf1d3", // 
-	".gitconfig":         "\uf1d3", // 
-	".github":            "\uf408", // 
-	".gitignore":         "\uf1d3", // 
-	".gitmodules":        "\uf1d3", // 
-	".rvm":               "\ue21e", // 
-	".vimrc":             "\ue62b", // 
-	".vscode":            "\ue70c", // 
-	".zshrc":             "\uf489", // 
-	"Cargo.lock":         "\ue7a8", // 
-	"Cargo.toml":         "\ue7a8", // 
-	"bin":                "\ue5fc", // 
-	"config":             "\ue5fc", // 
-	"docker-compose.yml": "\uf308", // 
-	"Dockerfile":         "\uf308", // 
-	"ds_store":           "\uf179", // 
-	"gitignore_global":   "\uf1d3", // 
-	"go.mod":             "\ue626", // 
-	"go.sum":             "\ue626", // 
-	"gradle":             "\ue256", // 
-	"gruntfile.coffee":   "\ue611", // 
-	"gruntfile.js":       "\ue611", // 
-	"gruntfile.ls":       "\ue611", // 
-	"gulpfile.coffee":    "\ue610", // 
-	"gulpfile.js":        "\ue610", // 
-	"gulpfile.ls":        "\ue610", // 
-	"hidden":             "\uf023", // 
-	"include":            "\ue5fc", // 
-	"lib":                "\uf121", // 
-	"localized":          "\uf179", // 
-	"Makefile":           "\uf489", // 
-	"node_modules":       "\ue718", // 
-	"npmignore":          "\ue71e", // 
-	"PKGBUILD":           "\uf303", // 
-	"rubydoc":            "\ue73b", // 
-	"yarn.lock":          "\ue718", // 
+var nameIconMap = map[string]IconProperties{
+	".Trash":             {Icon: "\uf1f8"},                   // 
+	".atom":              {Icon: "\ue764", Color: "#66595C"}, // 
+	".bashprofile":       {Icon: "\ue615", Color: "#6D8086"}, // 
+	".bashrc":            {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".idea":              {Icon: "\ue7b5", Color: "#FE2857"}, // 
+	".git":               {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".gitattributes":     {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".gitconfig":         {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".github":            {Icon: "\uf408"},                   // 
+	".gitignore":         {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".gitmodules":        {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".rvm":               {Icon: "\ue21e", Color: "#701516"}, // 
+	".vimrc":             {Icon: "\ue62b", Color: "#019833"}, // 
+	".vscode":            {Icon: "\ue70c", Color: "#007ACC"}, // 
+	".zshrc":             {Icon: "\uf489", Color: "#4EAA25"}, // 
+	"Cargo.lock":         {Icon: "\ue7a8", Color: "#DEA584"}, // 
+	"Cargo.toml":         {Icon: "\ue7a8", Color: "#DEA584"}, // 
+	"bin":                {Icon: "\ue5fc"},                   // 
+	"config":             {Icon: "\ue5fc"},                   // 
+	"docker-compose.yml": {Icon: "\uf308", Color: "#458EE6"}, // 
+	"Dockerfile":         {Icon: "\uf308", Color: "#458EE6"}, // 
+	"ds_store":           {Icon: "\uf179", Color: "#A2AAAD"}, // 
+	"gitignore_global":   {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	"go.mod":             {Icon: "\ue626", Color: "#00ADD8"}, // 
+	"go.sum":             {Icon: "\ue626", Color: "#00ADD8"}, // 
+	"gradle":             {Icon: "\ue256", Color: "#CC3E44"}, // 
+	"gruntfile.coffee":   {Icon: "\ue611", Color: "#E37933"}, // 
+	"gruntfile.js":       {Icon: "\ue611", Color: "#E37933"}, // 
+	"gruntfile.ls":       {Icon: "\ue611", Color: "#E37933"}, // 
+	"gulpfile.coffee":    {Icon: "\ue610", Color: "#CC3E44"}, // 
+	"gulpfile.js":        {Icon: "\ue610", Color: "#CC3E44"}, // 
+	"gulpfile.ls":        {Icon: "\ue610", Color: "#CC3E44"}, // 
+	"hidden":             {Icon: "\uf023"},                   // 
+	"include":            {Icon: "\ue5fc"},                   // 
+	"lib":                {Icon: "\uf121", Color: "#E37933"}, // 
+	"localized":          {Icon: "\uf179", Color: "#A2AAAD"}, // 
+	"Makefile":           {Icon: "\uf489", Color: "#4EAA25"}, // 
+	"node_modules":       {Icon: "\ue718", Color: "#E8274B"}, // 
+	"npmignore":          {Icon: "\ue71e", Color: "#E8274B"}, // 
+	"PKGBUILD":           {Icon: "\uf303", Color: "#0F94D2"}, // 
+	"rubydoc":            {Icon: "\ue73b", Color: "#701516"}, // 
+	"yarn.lock":          {Icon: "\ue718", Color: "#E8274B"}, // 
 }
 
-var extIconMap = map[string]string{
-	".ai":             "\ue7b4", // 
-	".android":        "\ue70e", // 
-	".apk":            "\ue70e", // 
-	".apple":          "\uf179", // 
-	".avi":            "\uf03d", // 
-	".avif":           "\uf1c5", // 
-	".avro":           "\ue60b", // 
-	".awk":            "\uf489", // 
-	".bash":           "\uf489", // 
-	".bash_history":   "\uf489", // 
-	".bash_profile":   "\uf489", // 
-	".bashrc":         "\uf489", // 
-	".bat":            "\uf17a", // 
-	".bats":           "\uf489", // 
-	".bmp":            "\uf1c5", // 
-	".bz":             "\uf410", // 
-	".bz2":            "\uf410", // 
-	".c":              "\ue61e", // 
-	".c++":            "\ue61d", // 
-	".cab":            "\ue70f", // 
-	".cc":             "\ue61d", // 
-	".cfg":            "\ue615", // 
-	".class":          "\ue256", // 
-	".clj":            "\ue768", // 
-	".cljs":           "\ue76a", // 
-	".cls":            "\uf034", // 
-	".cmd":            "\ue70f", // 
-	".coffee":         "\uf0f4", // 
-	".conf":           "\ue615", // 
-	".cp":             "\ue61d", // 
-	".cpio":           "\uf410", // 
-	".cpp":            "\ue61d", // 
-	".cs":             "\uf81a", // 
-	".csh":            "\uf489", // 
-	".cshtml":         "\uf1fa", // 
-	".csproj":         "\uf81a", // 
-	".css":            "\ue749", // 
-	".csv":            "\uf1c3", // 
-	".csx":            "\uf81a", // 
-	".cxx":            "\ue61d", // 
-	".d":              "\ue7af", // 
-	".dart":           "\ue798", // 
-	".db":             "\uf1c0", // 
-	".deb":            "\ue77d", // 
-	".diff":           "\uf440", // 
-	".djvu":           "\uf02d", // 
-	".dll":            "\ue70f", // 
-	".doc":            "\uf1c2", // 
-	".docx":           "\uf1c2", // 
-	".ds_store":       "\uf179", // 
-	".DS_store":       "\uf179", // 
-	".dump":           "\uf1c0", // 
-	".ebook":          "\ue28b", // 
-	".ebuild":         "\uf30d", // 
-	".editorconfig":   "\ue615", // 
-	".ejs":            "\ue618", // 
-	".elm":            "\ue62c", // 
-	".env":            "\uf462", // 
-	".eot":            "\uf031", // 
-	".epub":           "\ue28a", // 
-	".erb":            "\ue73b", // 
-	".erl":            "\ue7b1", // 
-	".ex":             "\ue62d", // 
-	".exe":            "\uf17a", // 
-	".exs":            "\ue62d", // 
-	".fish":           "\uf489", // 
-	".flac":           "\uf001", // 
-	".flv":            "\uf03d", // 
-	".font":           "\uf031", // 
-	".fs":             "\ue7a7", // 
-	".fsi":            "\ue7a7", // 
-	".fsx":            "\ue7a7", // 
-	".gdoc":           "\uf1c2", // 
-	".gem":            "\ue21e", // 
-	".gemfile":        "\ue21e", // 
-	".gemspec":        "\ue21e", // 
-	".gform":          "\uf298", // 
-	".gif":            "\uf1c5", // 
-	".git":            "\uf1d3", // 
-	".gitattributes":  "\uf1d3", // 
-	".gitignore":      "\uf1d3", // 
-	".gitmodules":     "\uf1d3", // 
-	".go":             "\ue626", // 
-	".gradle":         "\ue256", // 
-	".groovy":         "\ue775", // 
-	".gsheet":         "\uf1c3", // 
-	".gslides":        "\uf1c4", // 
-	".guardfile":      "\ue21e", // 
-	".gz":             "\uf410", // 
-	".h":              "\uf0fd", // 
-	".hbs":            "\ue60f", // 
-	".hpp":            "\uf0fd", // 
-	".hs":             "\ue777", // 
-	".htm":            "\uf13b", // 
-	".html":           "\uf13b", // 
-	".hxx":            "\uf0fd", // 
-	".ico":            "\uf1c5", // 
-	".image":          "\uf1c5", // 
-	".iml":            "\ue7b5", // 
-	".ini":            "\uf17a", // 
-	".ipynb":          "\ue606", // 
-	".iso":            "\ue271", // 
-	".j2c":            "\uf1c5", // 
-	".j2k":            "\uf1c5", // 
-	".jad":            "\ue256", // 
-	".jar":            "\ue256", // 
-	".java":           "\ue256", // 
-	".jfi":            "\uf1c5", // 
-	".jfif":           "\uf1c5", // 
-	".jif":            "\uf1c5", // 
-	".jl":             "\ue624", // 
-	".jmd":            "\uf48a", // 
-	".jp2":            "\uf1c5", // 
-	".jpe":            "\uf1c5", // 
-	".jpeg":           "\uf1c5", // 
-	".jpg":            "\uf1c5", // 
-	".jpx":            "\uf1c5", // 
-	".js":             "\ue74e", // 
-	".json":           "\ue60b", // 
-	".jsx":            "\ue7ba", // 
-	".jxl":            "\uf1c5", // 
-	".ksh":            "\uf489", // 
-	".kt":             "\ue634", // 
-	".kts":            "\ue634", // 
-	".latex":          "\uf034", // 
-	".less":           "\ue758", // 
-	".lhs":            "\ue777", // 
-	".license":        "\uf718", // 
-	".localized":      "\uf179", // 
-	".lock":           "\uf023", // 
-	".log":            "\uf18d", // 
-	".lua":            "\ue620", // 
-	".lz":             "\uf410", // 
-	".lz4":            "\uf410", // 
-	".lzh":            "\uf410", // 
-	".lzma":           "\uf410", // 
-	".lzo":            "\uf410", // 
-	".m":              "\ue61e", // 
-	".mm":             "\ue61d", // 
-	".m4a":            "\uf001", // 
-	".markdown":       "\uf48a", // 
-	".md":             "\uf48a", // 
-	".mjs":            "\ue74e", // 
-	".mk":             "\uf489", // 
-	".mkd":            "\uf48a", // 
-	".mkv":            "\uf03d", // 
-	".mobi":           "\ue28b", // 
-	".mov":            "\uf03d", // 
-	".mp3":            "\uf001", // 
-	".mp4":            "\uf03d", // 
-	".msi":            "\ue70f", // 
-	".mustache":       "\ue60f", // 
-	".nix":            "\uf313", // 
-	".node":           "\uf898", // 
-	".npmignore":      "\ue71e", // 
-	".odp":            "\uf1c4", // 
-	".ods":            "\uf1c3", // 
-	".odt":            "\uf1c2", // 
-	".ogg":            "\uf001", // 
-	".ogv":            "\uf03d", // 
-	".otf":            "\uf031", // 
-	".part":           "\uf43a", // 
-	".patch":          "\uf440", // 
-	".pdf":            "\uf1c1", // 
-	".php":            "\ue73d", // 
-	".pl":             "\ue769", // 
-	".png":            "\uf1c5", // 
-	".ppt":            "\uf1c4", // 
-	".pptx":           "\uf1c4", // 
-	".procfile":       "\ue21e", // 
-	".properties":     "\ue60b", // 
-	".ps1":            "\uf489", // 
-	".psd":            "\ue7b8", // 
-	".pxm":            "\uf1c5", // 
-	".py":             "\ue606", // 
-	".pyc":            "\ue606", // 
-	".r":              "\uf25d", // 
-	".rakefile":       "\ue21e", // 
-	".rar":            "\uf410", // 
-	".razor":          "\uf1fa", // 
-	".rb":             "\ue21e", // 
-	".rdata":          "\uf25d", // 
-	".rdb":            "\ue76d", // 
-	".rdoc":           "\uf48a", // 
-	".rds":            "\uf25d", // 
-	".readme":         "\uf48a", // 
-	".rlib":           "\ue7a8", // 
-	".rmd":            "\uf48a", // 
-	".rpm":            "\ue7bb", // 
-	".rs":             "\ue7a8", // 
-	".rspec":          "\ue21e", // 
-	".rspec_parallel": "\ue21e", // 
-	".rspec_status":   "\ue21e", // 
-	".rss":            "\uf09e", // 
-	".rtf":            "\uf718", // 
-	".ru":             "\ue21e", // 
-	".rubydoc":        "\ue73b", // 
-	".sass":           "\ue603", // 
-	".scala":          "\ue737", // 
-	".scss":           "\ue749", // 
-	".sh":             "\uf489", // 
-	".shell":          "\uf489", // 
-	".slim":           "\ue73b", // 
-	".sln":            "\ue70c", // 
-	".so":             "\uf17c", // 
-	".sql":            "\uf1c0", // 
-	".sqlite3":        "\ue7c4", // 
-	".sty":            "\uf034", // 
-	".styl":           "\ue600", // 
-	".stylus":         "\ue600", // 
-	".svg":            "\uf1c5", // 
-	".swift":          "\ue755", // 
-	".tar":            "\uf410", // 
-	".taz":            "\uf410", // 
-	".tbz":            "\uf410", // 
-	".tbz2":           "\uf410", // 
-	".tex":            "\uf034", // 
-	".tgz":            "\uf410", // 
-	".tiff":           "\uf1c5", // 
-	".tlz":            "\uf410", // 
-	".toml":           "\ue615", // 
-	".torrent":        "\ue275", // 
-	".ts":             "\ue628", // 
-	".tsv":            "\uf1c3", // 
-	".tsx":            "\ue7ba", // 
-	".ttf":            "\uf031", // 
-	".twig":           "\ue61c", // 
-	".txt":            "\uf15c", // 
-	".txz":            "\uf410", // 
-	".tz":             "\uf410", // 
-	".tzo":            "\uf410", // 
-	".video":          "\uf03d", // 
-	".vim":            "\ue62b", // 
-	".vue":            "\ufd42", // ﵂
-	".war":            "\ue256", // 
-	".wav":            "\uf001", // 
-	".webm":           "\uf03d", // 
-	".webp":           "\uf1c5", // 
-	".windows":        "\uf17a", // 
-	".woff":           "\uf031", // 
-	".woff2":          "\uf031", // 
-	".xhtml":          "\uf13b", // 
-	".xls":            "\uf1c3", // 
-	".xlsx":           "\uf1c3", // 
-	".xml":            "\uf121", // 
-	".xul":            "\uf121", // 
-	".xz":             "\uf410", // 
-	".yaml":           "\uf481", // 
-	".yml":            "\uf481", // 
-	".zip":            "\uf410", // 
-	".zsh":            "\uf489", // 
-	".zsh-theme":      "\uf489", // 
-	".zshrc":          "\uf489", // 
-	".zst":            "\uf410", // 
+var extIconMap = map[string]IconProperties{
+	".ai":             {Icon: "\ue7b4", Color: "#CBCB41"}, // 
+	".android":        {Icon: "\ue70e", Color: "#34A853"}, // 
+	".apk":            {Icon: "\ue70e", Color: "#34A853"}, // 
+	".apple":          {Icon: "\uf179", Color: "#A2AAAD"}, // 
+	".avi":            {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".avif":           {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".avro":           {Icon: "\ue60b", Color: "#CBCB41"}, // 
+	".awk":            {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".bash":           {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".bash_history":   {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".bash_profile":   {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".bashrc":         {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".bat":            {Icon: "\uf17a", Color: "#00A4EF"}, // 
+	".bats":           {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".bmp":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".bz":             {Icon: "\uf410", Color: "#ECA517"}, // 
+	".bz2":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".c":              {Icon: "\ue61e", Color: "#599EFF"}, // 
+	".c++":            {Icon: "\ue61d", Color: "#F34B7D"}, // 
+	".cab":            {Icon: "\ue70f", Color: "#00A4EF"}, // 
+	".cc":             {Icon: "\ue61d", Color: "#F34B7D"}, // 
+	".cfg":            {Icon: "\ue615", Color: "#6D8086"}, // 
+	".class":          {Icon: "\ue256", Color: "#CC3E44"}, // 
+	".clj":            {Icon: "\ue768", Color: "#8DC149"}, // 
+	".cljs":           {Icon: "\ue76a", Color: "#519ABA"}, // 
+	".cls":            {Icon: "\uf034", Color: "#3D6117"}, // 
+	".cmd":            {Icon: "\ue70f", Color: "#00A4EF"}, // 
+	".coffee":         {Icon: "\uf0f4", Color: "#CBCB41"}, // 
+	".conf":           {Icon: "\ue615", Color: "#6D8086"}, // 
+	".cp":             {Icon: "\ue61d", Color: "#F34B7D"}, // 
+	".cpio":           {Icon: "\uf410", Color: "#ECA517"}, // 
+	".cpp":            {Icon: "\ue61d", Color: "#F34B7D"}, // 
+	".cs":             {Icon: "\uf81a", Color: "#596706"}, // 
+	".csh":            {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".cshtml":         {Icon: "\uf1fa", Color: "#512BD4"}, // 
+	".csproj":         {Icon: "\uf81a", Color: "#596706"}, // 
+	".css":            {Icon: "\ue749", Color: "#42A5F5"}, // 
+	".csv":            {Icon: "\uf1c3", Color: "#207245"}, // 
+	".csx":            {Icon: "\uf81a", Color: "#596706"}, // 
+	".cxx":            {Icon: "\ue61d", Color: "#F34B7D"}, // 
+	".d":              {Icon: "\ue7af", Color: "#427819"}, // 
+	".dart":           {Icon: "\ue798", Color: "#03589C"}, // 
+	".db":             {Icon: "\uf1c0", Color: "#DAD8D8"}, // 
+	".deb":            {Icon: "\ue77d", Color: "#A80030"}, // 
+	".diff":           {Icon: "\uf440"},                   // 
+	".djvu":           {Icon: "\uf02d"},                   // 
+	".dll":            {Icon: "\ue70f", Color: "#00A4EF"}, // 
+	".doc":            {Icon: "\uf1c2", Color: "#185ABD"}, // 
+	".docx":           {Icon: "\uf1c2", Color: "#185ABD"}, // 
+	".ds_store":       {Icon: "\uf179", Color: "#A2AAAD"}, // 
+	".DS_store":       {Icon: "\uf179", Color: "#A2AAAD"}, // 
+	".dump":           {Icon: "\uf1c0", Color: "#DAD8D8"}, // 
+	".ebook":          {Icon: "\ue28b"},                   // 
+	".ebuild":         {Icon: "\uf30d"},                   // 
+	".editorconfig":   {Icon: "\ue615", Color: "#6D8086"}, // 
+	".ejs":            {Icon: "\ue618", Color: "#CBCB41"}, // 
+	".elm":            {Icon: "\ue62c", Color: "#519ABA"}, // 
+	".env":            {Icon: "\uf462", Color: "#FAF743"}, // 
+	".eot":            {Icon: "\uf031"},                   // 
+	".epub":           {Icon: "\ue28a"},                   // 
+	".erb":            {Icon: "\ue73b", Color: "#701516"}, // 
+	".erl":            {Icon: "\ue7b1", Color: "#B83998"}, // 
+	".ex":             {Icon: "\ue62d", Color: "#A074C4"}, // 
+	".exe":            {Icon: "\uf17a", Color: "#00A4EF"}, // 
+	".exs":            {Icon: "\ue62d", Color: "#A074C4"}, // 
+	".fish":           {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".flac":           {Icon: "\uf001", Color: "#00AFFF"}, // 
+	".flv":            {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".font":           {Icon: "\uf031"},                   // 
+	".fs":             {Icon: "\ue7a7", Color: "#519ABA"}, // 
+	".fsi":            {Icon: "\ue7a7", Color: "#519ABA"}, // 
+	".fsx":            {Icon: "\ue7a7", Color: "#519ABA"}, // 
+	".gdoc":           {Icon: "\uf1c2", Color: "#185ABD"}, // 
+	".gem":            {Icon: "\ue21e", Color: "#701516"}, // 
+	".gemfile":        {Icon: "\ue21e", Color: "#701516"}, // 
+	".gemspec":        {Icon: "\ue21e", Color: "#701516"}, // 
+	".gform":          {Icon: "\uf298"},                   // 
+	".gif":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".git":            {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".gitattributes":  {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".gitignore":      {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".gitmodules":     {Icon: "\uf1d3", Color: "#F54D27"}, // 
+	".go":             {Icon: "\ue626", Color: "#00ADD8"}, // 
+	".gradle":         {Icon: "\ue256", Color: "#CC3E44"}, // 
+	".groovy":         {Icon: "\ue775", Color: "#4A687C"}, // 
+	".gsheet":         {Icon: "\uf1c3", Color: "#207245"}, // 
+	".gslides":        {Icon: "\uf1c4", Color: "#CB4A32"}, // 
+	".guardfile":      {Icon: "\ue21e", Color: "#701516"}, // 
+	".gz":             {Icon: "\uf410", Color: "#ECA517"}, // 
+	".h":              {Icon: "\uf0fd", Color: "#A074C4"}, // 
+	".hbs":            {Icon: "\ue60f", Color: "#E37933"}, // 
+	".hpp":            {Icon: "\uf0fd", Color: "#A074C4"}, // 
+	".hs":             {Icon: "\ue777", Color: "#A074C4"}, // 
+	".htm":            {Icon: "\uf13b", Color: "#E44D26"}, // 
+	".html":           {Icon: "\uf13b", Color: "#E44D26"}, // 
+	".hxx":            {Icon: "\uf0fd", Color: "#A074C4"}, // 
+	".ico":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".image":          {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".iml":            {Icon: "\ue7b5", Color: "#FE2857"}, // 
+	".ini":            {Icon: "\uf17a", Color: "#00A4EF"}, // 
+	".ipynb":          {Icon: "\ue606", Color: "#FFBC03"}, // 
+	".iso":            {Icon: "\ue271"},                   // 
+	".j2c":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".j2k":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jad":            {Icon: "\ue256", Color: "#CC3E44"}, // 
+	".jar":            {Icon: "\ue256", Color: "#CC3E44"}, // 
+	".java":           {Icon: "\ue256", Color: "#CC3E44"}, // 
+	".jfi":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jfif":           {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jif":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jl":             {Icon: "\ue624", Color: "#A270BA"}, // 
+	".jmd":            {Icon: "\uf48a"},                   // 
+	".jp2":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jpe":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jpeg":           {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jpg":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".jpx":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".js":             {Icon: "\ue74e", Color: "#CBCB41"}, // 
+	".json":           {Icon: "\ue60b", Color: "#CBCB41"}, // 
+	".jsx":            {Icon: "\ue7ba", Color: "#20C2E3"}, // 
+	".jxl":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".ksh":            {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".kt":             {Icon: "\ue634", Color: "#7F52FF"}, // 
+	".kts":            {Icon: "\ue634", Color: "#7F52FF"}, // 
+	".latex":          {Icon: "\uf034", Color: "#3D6117"}, // 
+	".less":           {Icon: "\ue758", Color: "#563D7C"}, // 
+	".lhs":            {Icon: "\ue777", Color: "#A074C4"}, // 
+	".license":        {Icon: "\uf718"},                   // 
+	".localized":      {Icon: "\uf179", Color: "#A2AAAD"}, // 
+	".lock":           {Icon: "\uf023"},                   // 
+	".log":            {Icon: "\uf18d"},                   // 
+	".lua":            {Icon: "\ue620", Color: "#51A0CF"}, // 
+	".lz":             {Icon: "\uf410", Color: "#ECA517"}, // 
+	".lz4":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".lzh":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".lzma":           {Icon: "\uf410", Color: "#ECA517"}, // 
+	".lzo":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".m":              {Icon: "\ue61e", Color: "#599EFF"}, // 
+	".mm":             {Icon: "\ue61d", Color: "#F34B7D"}, // 
+	".m4a":            {Icon: "\uf001", Color: "#00AFFF"}, // 
+	".markdown":       {Icon: "\uf48a"},                   // 
+	".md":             {Icon: "\uf48a"},                   // 
+	".mjs":            {Icon: "\ue74e", Color: "#CBCB41"}, // 
+	".mk":             {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".mkd":            {Icon: "\uf48a"},                   // 
+	".mkv":            {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".mobi":           {Icon: "\ue28b"},                   // 
+	".mov":            {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".mp3":            {Icon: "\uf001", Color: "#00AFFF"}, // 
+	".mp4":            {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".msi":            {Icon: "\ue70f", Color: "#00A4EF"}, // 
+	".mustache":       {Icon: "\ue60f", Color: "#E37933"}, // 
+	".nix":            {Icon: "\uf313", Color: "#7EBAE4"}, // 
+	".node":           {Icon: "\uf898", Color: "#8CC84B"}, // 
+	".npmignore":      {Icon: "\ue71e", Color: "#E8274B"}, // 
+	".odp":            {Icon: "\uf1c4", Color: "#CB4A32"}, // 
+	".ods":            {Icon: "\uf1c3", Color: "#207245"}, // 
+	".odt":            {Icon: "\uf1c2", Color: "#185ABD"}, // 
+	".ogg":            {Icon: "\uf001", Color: "#00AFFF"}, // 
+	".ogv":            {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".otf":            {Icon: "\uf031"},                   // 
+	".part":           {Icon: "\uf43a"},                   // 
+	".patch":          {Icon: "\uf440"},                   // 
+	".pdf":            {Icon: "\uf1c1", Color: "#B30B00"}, // 
+	".php":            {Icon: "\ue73d", Color: "#A074C4"}, // 
+	".pl":             {Icon: "\ue769", Color: "#519ABA"}, // 
+	".png":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".ppt":            {Icon: "\uf1c4", Color: "#CB4A32"}, // 
+	".pptx":           {Icon: "\uf1c4", Color: "#CB4A32"}, // 
+	".procfile":       {Icon: "\ue21e", Color: "#701516"}, // 
+	".properties":     {Icon: "\ue60b", Color: "#CBCB41"}, // 
+	".ps1":            {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".psd":            {Icon: "\ue7b8", Color: "#519ABA"}, // 
+	".pxm":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".py":             {Icon: "\ue606", Color: "#FFBC03"}, // 
+	".pyc":            {Icon: "\ue606", Color: "#FFBC03"}, // 
+	".r":              {Icon: "\uf25d", Color: "#2266BA"}, // 
+	".rakefile":       {Icon: "\ue21e", Color: "#701516"}, // 
+	".rar":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".razor":          {Icon: "\uf1fa", Color: "#512BD4"}, // 
+	".rb":             {Icon: "\ue21e", Color: "#701516"}, // 
+	".rdata":          {Icon: "\uf25d", Color: "#2266BA"}, // 
+	".rdb":            {Icon: "\ue76d"},                   // 
+	".rdoc":           {Icon: "\uf48a"},                   // 
+	".rds":            {Icon: "\uf25d", Color: "#2266BA"}, // 
+	".readme":         {Icon: "\uf48a"},                   // 
+	".rlib":           {Icon: "\ue7a8", Color: "#DEA584"}, // 
+	".rmd":            {Icon: "\uf48a"},                   // 
+	".rpm":            {Icon: "\ue7bb", Color: "#EE0000"}, // 
+	".rs":             {Icon: "\ue7a8", Color: "#DEA584"}, // 
+	".rspec":          {Icon: "\ue21e", Color: "#701516"}, // 
+	".rspec_parallel": {Icon: "\ue21e", Color: "#701516"}, // 
+	".rspec_status":   {Icon: "\ue21e", Color: "#701516"}, // 
+	".rss":            {Icon: "\uf09e", Color: "#FB9D3B"}, // 
+	".rtf":            {Icon: "\uf718"},                   // 
+	".ru":             {Icon: "\ue21e", Color: "#701516"}, // 
+	".rubydoc":        {Icon: "\ue73b", Color: "#701516"}, // 
+	".sass":           {Icon: "\ue603", Color: "#F55385"}, // 
+	".scala":          {Icon: "\ue737", Color: "#CC3E44"}, // 
+	".scss":           {Icon: "\ue749", Color: "#42A5F5"}, // 
+	".sh":             {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".shell":          {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".slim":           {Icon: "\ue73b", Color: "#701516"}, // 
+	".sln":            {Icon: "\ue70c", Color: "#007ACC"}, // 
+	".so":             {Icon: "\uf17c"},                   // 
+	".sql":            {Icon: "\uf1c0", Color: "#DAD8D8"}, // 
+	".sqlite3":        {Icon: "\ue7c4", Color: "#DAD8D8"}, // 
+	".sty":            {Icon: "\uf034", Color: "#3D6117"}, // 
+	".styl":           {Icon: "\ue600", Color: "#8DC149"}, // 
+	".stylus":         {Icon: "\ue600", Color: "#8DC149"}, // 
+	".svg":            {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".swift":          {Icon: "\ue755", Color: "#E37933"}, // 
+	".tar":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".taz":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".tbz":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".tbz2":           {Icon: "\uf410", Color: "#ECA517"}, // 
+	".tex":            {Icon: "\uf034", Color: "#3D6117"}, // 
+	".tgz":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".tiff":           {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".tlz":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".toml":           {Icon: "\ue615", Color: "#6D8086"}, // 
+	".torrent":        {Icon: "\ue275"},                   // 
+	".ts":             {Icon: "\ue628", Color: "#519ABA"}, // 
+	".tsv":            {Icon: "\uf1c3", Color: "#207245"}, // 
+	".tsx":            {Icon: "\ue7ba", Color: "#20C2E3"}, // 
+	".ttf":            {Icon: "\uf031"},                   // 
+	".twig":           {Icon: "\ue61c", Color: "#8DC149"}, // 
+	".txt":            {Icon: "\uf15c"},                   // 
+	".txz":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".tz":             {Icon: "\uf410", Color: "#ECA517"}, // 
+	".tzo":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".video":          {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".vim":            {Icon: "\ue62b", Color: "#019833"}, // 
+	".vue":            {Icon: "\ufd42", Color: "#8DC149"}, // ﵂
+	".war":            {Icon: "\ue256", Color: "#CC3E44"}, // 
+	".wav":            {Icon: "\uf001", Color: "#00AFFF"}, // 
+	".webm":           {Icon: "\uf03d", Color: "#FD971F"}, // 
+	".webp":           {Icon: "\uf1c5", Color: "#A074C4"}, // 
+	".windows":        {Icon: "\uf17a", Color: "#00A4EF"}, // 
+	".woff":           {Icon: "\uf031"},                   // 
+	".woff2":          {Icon: "\uf031"},                   // 
+	".xhtml":          {Icon: "\uf13b", Color: "#E44D26"}, // 
+	".xls":            {Icon: "\uf1c3", Color: "#207245"}, // 
+	".xlsx":           {Icon: "\uf1c3", Color: "#207245"}, // 
+	".xml":            {Icon: "\uf121", Color: "#E37933"}, // 
+	".xul":            {Icon: "\uf121", Color: "#E37933"}, // 
+	".xz":             {Icon: "\uf410", Color: "#ECA517"}, // 
+	".yaml":           {Icon: "\uf481", Color: "#6D8086"}, // 
+	".yml":            {Icon: "\uf481", Color: "#6D8086"}, // 
+	".zip":            {Icon: "\uf410", Color: "#ECA517"}, // 
+	".zsh":            {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".zsh-theme":      {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".zshrc":          {Icon: "\uf489", Color: "#4EAA25"}, // 
+	".zst":            {Icon: "\uf410", Color: "#ECA517"}, // 
 }
 
-func IconForFile(name string, isSubmodule bool, isDirectory bool) string {
+// IconForFile returns the icon for a file, directory or submodule. A directory's
+// icon shows whether it's collapsed in the file tree.
+func IconForFile(name string, isSubmodule bool, isDirectory bool, isCollapsed bool) IconProperties {
 	base := filepath.Base(name)
-	if icon, ok := nameIconMap[base]; ok {
-		return icon
+	ext := filepath.Ext(name)
+
+	if isSubmodule {
+		return IconProperties{Icon: DEFAULT_SUBMODULE_ICON}
 	}
 
-	ext := filepath.Ext(name)
-	if icon, ok := extIconMap[ext]; ok {
+	if icon, ok := customNameIconMap[base]; ok {
 		return icon
 	}
 
-	if isSubmodule {
-		return DEFAULT_SUBMODULE_ICON
-	} else if isDirectory {
-		return DEFAULT_DIRECTORY_ICON
+	if !isDirectory {
+		if icon, ok := customExtIconMap[ext]; ok {
+			return icon
+		}
 	}
-	return DEFAULT_FILE_ICON
+
+	if icon, ok := nameIconMap[base]; ok {
+		return withNerdFontsVersion(icon)
+	}
+
+	if isDirectory {
+		if isCollapsed {
+			return IconProperties{Icon: DEFAULT_DIRECTORY_ICON}
+		}
+		return IconProperties{Icon: EXPANDED_DIRECTORY_ICON}
+	}
+
+	if icon, ok := extIconMap[ext]; ok {
+		return withNerdFontsVersion(icon)
+	}
+
+	return IconProperties{Icon: DEFAULT_FILE_ICON}
+}
+
+func withNerdFontsVersion(icon IconProperties) IconProperties {
+	icon.Icon = forNerdFontsVersion(icon.Icon)
+	return icon
 }
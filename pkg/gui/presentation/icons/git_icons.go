@@ -30,37 +30,37 @@ var remoteIcons = []remoteIcon{
 
 func IconForBranch(branch *models.Branch) string {
 	if branch.DetachedHead {
-		return DETACHED_HEAD_ICON
+		return forNerdFontsVersion(DETACHED_HEAD_ICON)
 	}
-	return BRANCH_ICON
+	return forNerdFontsVersion(BRANCH_ICON)
 }
 
 func IconForRemoteBranch(branch *models.RemoteBranch) string {
-	return BRANCH_ICON
+	return forNerdFontsVersion(BRANCH_ICON)
 }
 
 func IconForTag(tag *models.Tag) string {
-	return TAG_ICON
+	return forNerdFontsVersion(TAG_ICON)
 }
 
 func IconForCommit(commit *models.Commit) string {
 	if len(commit.Parents) > 1 {
-		return MERGE_COMMIT_ICON
+		return forNerdFontsVersion(MERGE_COMMIT_ICON)
 	}
-	return COMMIT_ICON
+	return forNerdFontsVersion(COMMIT_ICON)
 }
 
 func IconForRemote(remote *models.Remote) string {
 	for _, r := range remoteIcons {
 		for _, url := range remote.Urls {
 			if strings.Contains(url, r.domain) {
-				return r.icon
+				return forNerdFontsVersion(r.icon)
 			}
 		}
 	}
-	return DEFAULT_REMOTE_ICON
+	return forNerdFontsVersion(DEFAULT_REMOTE_ICON)
 }
 
 func IconForStash(stash *models.StashEntry) string {
-	return STASH_ICON
+	return forNerdFontsVersion(STASH_ICON)
 }
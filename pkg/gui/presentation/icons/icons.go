@@ -1,11 +1,75 @@
 package icons
 
-var isIconEnabled = false
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
+)
+
+// IconProperties is an icon along with the colour to show it in. An empty
+// colour means the icon takes on the colour of the text it's shown next to.
+type IconProperties struct {
+	Icon  string
+	Color string
+}
+
+var (
+	isIconEnabled    = false
+	nerdFontsVersion = ""
+)
 
 func IsIconEnabled() bool {
 	return isIconEnabled
 }
 
-func SetIconEnabled(showIcons bool) {
-	isIconEnabled = showIcons
+// SetNerdFontsVersion turns icons on for the given major version of nerd fonts,
+// or off if the version is empty. Our icons are from version 2, so for version
+// 3 we swap out the ones that moved.
+func SetNerdFontsVersion(version string) error {
+	if version != "" && version != "2" && version != "3" {
+		return fmt.Errorf("Unsupported nerdFontsVersion '%s', must be one of '2' or '3'", version)
+	}
+
+	isIconEnabled = version != ""
+	nerdFontsVersion = version
+	return nil
+}
+
+// version 3 moved the material design icons to a different range of codepoints
+var nerdFontsV3Icons = map[string]string{
+	"\ufb2b": "\U000f062c", // שׂ -> 󰘬
+	"\ufc16": "\U000f0718", // ﰖ -> 󰜘
+	"\ufb2c": "\U000f062d", // שּׁ -> 󰘭
+	"\uf7a1": "\U000f02a2", //  -> 󰊢
+	"\ufd03": "\U000f0805", // ﴃ -> 󰠅
+	"\uf81a": "\U000f031b", //  -> 󰌛
+	"\uf718": "\U000f0219", //  -> 󰈙
+	"\uf898": "\U000f0399", //  -> 󰎙
+	"\ufd42": "\U000f0844", // ﵂ -> 󰡄
+}
+
+func forNerdFontsVersion(icon string) string {
+	if nerdFontsVersion == "3" {
+		if v3Icon, ok := nerdFontsV3Icons[icon]; ok {
+			return v3Icon
+		}
+	}
+
+	return icon
+}
+
+var (
+	customNameIconMap = map[string]IconProperties{}
+	customExtIconMap  = map[string]IconProperties{}
+)
+
+// SetCustomIcons adds to or overrides our file icons with the ones in the user's config
+func SetCustomIcons(customIcons config.CustomIconsConfig) {
+	toIconProperties := func(properties config.IconProperties, _ string) IconProperties {
+		return IconProperties{Icon: properties.Icon, Color: properties.Color}
+	}
+
+	customNameIconMap = lo.MapValues(customIcons.Filenames, toIconProperties)
+	customExtIconMap = lo.MapValues(customIcons.Extensions, toIconProperties)
 }
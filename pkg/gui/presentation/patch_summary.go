@@ -0,0 +1,56 @@
+package presentation
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// GetPatchSummaryLines returns a line for each file included in the custom
+// patch, showing whether the whole file or only part of it is included and how
+// many lines the file's part of the patch adds and removes. If showCommits is
+// true each line is prefixed with the commit the file's changes are from.
+func GetPatchSummaryLines(files []*patch.IncludedFile, showCommits bool) []string {
+	return slices.Map(files, func(file *patch.IncludedFile) string {
+		return getPatchSummaryLine(file, showCommits)
+	})
+}
+
+func getPatchSummaryLine(file *patch.IncludedFile, showCommits bool) string {
+	added, removed := countChangedLines(file.Patch)
+
+	nameStyle := style.FgYellow
+	if file.Status == patch.WHOLE {
+		nameStyle = style.FgGreen
+	}
+
+	output := ""
+	if showCommits {
+		output += style.FgYellow.Sprint(utils.ShortSha(file.Commit)) + " "
+	}
+	output += nameStyle.Sprint(utils.EscapeSpecialChars(file.Name))
+	output += " " + style.FgGreen.Sprint(fmt.Sprintf("+%d", added))
+	output += " " + style.FgRed.Sprint(fmt.Sprintf("-%d", removed))
+
+	return output
+}
+
+// countChangedLines counts the added and removed lines of a patch, leaving out
+// the lines of its headers
+func countChangedLines(patchText string) (int, int) {
+	added := 0
+	removed := 0
+	for _, line := range patch.NewPatchParser(nil, patchText).PatchLines {
+		switch line.Kind {
+		case patch.ADDITION:
+			added++
+		case patch.DELETION:
+			removed++
+		}
+	}
+
+	return added, removed
+}
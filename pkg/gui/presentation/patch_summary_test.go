@@ -0,0 +1,58 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPatchSummaryLines(t *testing.T) {
+	files := []*patch.IncludedFile{
+		{
+			Commit: "1234567890abcdef",
+			Name:   "dir/file1",
+			Status: patch.WHOLE,
+			Patch: `diff --git a/dir/file1 b/dir/file1
+index 9320895..6d79956 100644
+--- a/dir/file1
++++ b/dir/file1
+@@ -1,3 +1,4 @@
+ apple
+-grape
++orange
++kiwi
+ pear
+`,
+		},
+		{
+			Commit: "abcdef1234567890",
+			Name:   "file2",
+			Status: patch.PART,
+			Patch: `diff --git a/file2 b/file2
+index e48a11c..80a73f1 100644
+--- a/file2
++++ b/file2
+@@ -1,2 +1,1 @@
+-lemon
+ lime
+`,
+		},
+	}
+
+	assert.Equal(t,
+		[]string{
+			"dir/file1 +2 -1",
+			"file2 +0 -1",
+		},
+		GetPatchSummaryLines(files, false),
+	)
+
+	assert.Equal(t,
+		[]string{
+			"12345678 dir/file1 +2 -1",
+			"abcdef12 file2 +0 -1",
+		},
+		GetPatchSummaryLines(files, true),
+	)
+}
@@ -1,22 +1,29 @@
 package presentation
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/jesseduffield/generics/slices"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
-func GetRemoteListDisplayStrings(remotes []*models.Remote, diffName string) [][]string {
+func GetRemoteListDisplayStrings(remotes []*models.Remote, diffName string, fetchTimes map[string]time.Time, tr *i18n.TranslationSet) [][]string {
 	return slices.Map(remotes, func(remote *models.Remote) []string {
 		diffed := remote.Name == diffName
-		return getRemoteDisplayStrings(remote, diffed)
+		fetchTime, fetched := fetchTimes[remote.Name]
+		return getRemoteDisplayStrings(remote, diffed, fetchTime, fetched, tr)
 	})
 }
 
 // getRemoteDisplayStrings returns the display string of branch
-func getRemoteDisplayStrings(r *models.Remote, diffed bool) []string {
+func getRemoteDisplayStrings(r *models.Remote, diffed bool, fetchTime time.Time, fetched bool, tr *i18n.TranslationSet) []string {
 	branchCount := len(r.Branches)
 
 	textStyle := theme.DefaultTextColor
@@ -29,5 +36,17 @@ func getRemoteDisplayStrings(r *models.Remote, diffed bool) []string {
 		res = append(res, textStyle.Sprint(icons.IconForRemote(r)))
 	}
 	res = append(res, textStyle.Sprint(r.Name), style.FgBlue.Sprintf("%d branches", branchCount))
+
+	pushesTo := ""
+	if r.HasSeparatePushUrls() {
+		pushesTo = style.FgMagenta.Sprint(fmt.Sprintf(tr.RemotePushesTo, strings.Join(r.PushUrls, ", ")))
+	}
+	res = append(res, pushesTo)
+
+	fetchedAgo := ""
+	if fetched {
+		fetchedAgo = style.FgCyan.Sprint(fmt.Sprintf(tr.RemoteFetchedAgo, utils.UnixToTimeAgo(fetchTime.Unix())))
+	}
+	res = append(res, fetchedAgo)
 	return res
 }
@@ -1,17 +1,53 @@
 package presentation
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 )
 
-func GetSubmoduleListDisplayStrings(submodules []*models.SubmoduleConfig) [][]string {
+func GetSubmoduleListDisplayStrings(submodules []*models.SubmoduleConfig, statuses map[string]*git_commands.SubmoduleStatus, tr *i18n.TranslationSet) [][]string {
 	return slices.Map(submodules, func(submodule *models.SubmoduleConfig) []string {
-		return getSubmoduleDisplayStrings(submodule)
+		return getSubmoduleDisplayStrings(submodule, statuses[submodule.Path], tr)
 	})
 }
 
-func getSubmoduleDisplayStrings(s *models.SubmoduleConfig) []string {
-	return []string{theme.DefaultTextColor.Sprint(s.Name)}
+func getSubmoduleDisplayStrings(s *models.SubmoduleConfig, status *git_commands.SubmoduleStatus, tr *i18n.TranslationSet) []string {
+	// nested submodules go under their parent
+	name := strings.Repeat("  ", s.Depth()) + s.Name
+
+	return []string{theme.DefaultTextColor.Sprint(name), getSubmoduleStatusText(status, tr)}
+}
+
+// submodules whose status we haven't loaded yet get no indicators
+func getSubmoduleStatusText(status *git_commands.SubmoduleStatus, tr *i18n.TranslationSet) string {
+	if status == nil {
+		return ""
+	}
+
+	if !status.Initialized {
+		return style.FgRed.Sprint(tr.SubmoduleUninitialized)
+	}
+
+	indicators := []string{}
+	if status.HasConflicts {
+		indicators = append(indicators, style.FgRed.Sprint(tr.SubmoduleConflicted))
+	}
+	if status.OutOfSync {
+		indicators = append(indicators, style.FgYellow.Sprint(tr.SubmoduleOutOfSync))
+	}
+	if status.Dirty {
+		indicators = append(indicators, style.FgYellow.Sprint(tr.SubmoduleDirty))
+	}
+	if status.Ahead > 0 || status.Behind > 0 {
+		indicators = append(indicators, style.FgYellow.Sprint(fmt.Sprintf("↑%d↓%d", status.Ahead, status.Behind)))
+	}
+
+	return strings.Join(indicators, " ")
 }
@@ -5,27 +5,35 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 )
 
-func GetTagListDisplayStrings(tags []*models.Tag, diffName string) [][]string {
+func GetTagListDisplayStrings(tags []*models.Tag, diffName string, tr *i18n.TranslationSet) [][]string {
 	return slices.Map(tags, func(tag *models.Tag) []string {
 		diffed := tag.Name == diffName
-		return getTagDisplayStrings(tag, diffed)
+		return getTagDisplayStrings(tag, diffed, tr)
 	})
 }
 
 // getTagDisplayStrings returns the display string of branch
-func getTagDisplayStrings(t *models.Tag, diffed bool) []string {
+func getTagDisplayStrings(t *models.Tag, diffed bool, tr *i18n.TranslationSet) []string {
 	textStyle := theme.DefaultTextColor
 	if diffed {
 		textStyle = theme.DiffTerminalColor
 	}
-	res := make([]string, 0, 2)
+	res := make([]string, 0, 4)
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForTag(t)))
 	}
-	descriptionColor := style.FgYellow
-	res = append(res, textStyle.Sprint(t.Name), descriptionColor.Sprint(t.Description()))
+	res = append(res, textStyle.Sprint(t.Name), getSignatureStatusText(t.SignatureStatus))
+
+	// a lightweight tag has no message of its own, so what we have is the
+	// message of the commit it points to
+	description := style.FgYellow.Sprint(t.Description())
+	if !t.IsAnnotated {
+		description = style.FgBlue.Sprint(tr.LightweightTagIndicator) + " " + theme.DefaultTextColor.Sprint(t.Description())
+	}
+	res = append(res, description)
 	return res
 }
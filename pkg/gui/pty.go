@@ -4,6 +4,7 @@
 package gui
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
@@ -42,16 +43,28 @@ func (gui *Gui) onResize() error {
 // which is just an io.Reader. the pty package lets us wrap a command in a
 // pseudo-terminal meaning we'll get the behaviour we want from the underlying
 // command.
-func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string, cacheable bool) error {
 	width, _ := gui.Views.Main.Size()
 	pager := gui.git.Config.GetPager(width)
+	cmdStr := strings.Join(cmd.Args, " ")
 
 	if pager == "" {
 		// if we're not using a custom pager we don't need to use a pty
-		return gui.newCmdTask(view, cmd, prefix)
+		cacheKey := ""
+		if cacheable {
+			cacheKey = cmdStr
+		}
+		return gui.newCmdTask(view, cmd, prefix, cacheKey)
 	}
 
-	cmdStr := strings.Join(cmd.Args, " ")
+	cacheKey := ""
+	if cacheable {
+		// the pager's output depends on how much room it has
+		cacheKey = fmt.Sprintf("%s|%d|%s", pager, width, cmdStr)
+		if gui.renderFromCache(view, cacheKey, cmdStr) {
+			return nil
+		}
+	}
 
 	cmd.Env = append(cmd.Env, "GIT_PAGER="+pager)
 
@@ -82,7 +95,7 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 		gui.Mutexes.PtyMutex.Unlock()
 	}
 
-	if err := manager.NewTask(manager.NewCmdTask(start, prefix, height+oy+10, onClose), cmdStr); err != nil {
+	if err := manager.NewTask(manager.NewCmdTask(start, prefix, height+oy+10, onClose, gui.cacheRenderedOutput(cacheKey)), cmdStr); err != nil {
 		return err
 	}
 
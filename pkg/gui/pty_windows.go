@@ -5,6 +5,7 @@ package gui
 
 import (
 	"os/exec"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
 )
@@ -13,6 +14,11 @@ func (gui *Gui) onResize() error {
 	return nil
 }
 
-func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
-	return gui.newCmdTask(view, cmd, prefix)
+func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string, cacheable bool) error {
+	cacheKey := ""
+	if cacheable {
+		cacheKey = strings.Join(cmd.Args, " ")
+	}
+
+	return gui.newCmdTask(view, cmd, prefix, cacheKey)
 }
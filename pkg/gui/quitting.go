@@ -72,6 +72,10 @@ func (gui *Gui) quit() error {
 		return gui.createUpdateQuitConfirmation()
 	}
 
+	return gui.helpers.DetachedHead.WithDetachedCommitsCheck(gui.confirmQuit)
+}
+
+func (gui *Gui) confirmQuit() error {
 	if gui.c.UserConfig.ConfirmOnQuit {
 		return gui.c.Confirm(types.ConfirmOpts{
 			Title:  "",
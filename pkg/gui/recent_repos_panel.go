@@ -10,11 +10,14 @@ import (
 	"github.com/jesseduffield/generics/slices"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/env"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 func (gui *Gui) getCurrentBranch(path string) string {
@@ -59,16 +62,28 @@ func (gui *Gui) getCurrentBranch(path string) string {
 	return gui.c.Tr.LcBranchUnknown
 }
 
-func (gui *Gui) handleCreateRecentReposMenu() error {
-	// we'll show an empty panel if there are no recent repos
-	recentRepoPaths := []string{}
-	if len(gui.c.GetAppState().RecentRepos) > 0 {
-		// we skip the first one because we're currently in it
-		recentRepoPaths = gui.c.GetAppState().RecentRepos[1:]
+// getRecentRepoPaths returns the pinned repos followed by the rest of the recent
+// repos, most recently opened first, leaving out the repo we're currently in
+func (gui *Gui) getRecentRepoPaths() []string {
+	appState := gui.c.GetAppState()
+
+	currentRepo := ""
+	if len(appState.RecentRepos) > 0 {
+		currentRepo = appState.RecentRepos[0]
 	}
 
+	paths := append(append([]string{}, appState.PinnedRepos...), appState.RecentRepos...)
+	return lo.Without(lo.Uniq(paths), currentRepo)
+}
+
+func (gui *Gui) isRepoPinned(path string) bool {
+	return lo.Contains(gui.c.GetAppState().PinnedRepos, path)
+}
+
+func (gui *Gui) handleCreateRecentReposMenu() error {
 	currentBranches := sync.Map{}
 
+	recentRepoPaths := gui.getRecentRepoPaths()
 	wg := sync.WaitGroup{}
 	wg.Add(len(recentRepoPaths))
 
@@ -81,28 +96,111 @@ func (gui *Gui) handleCreateRecentReposMenu() error {
 
 	wg.Wait()
 
-	menuItems := slices.Map(recentRepoPaths, func(path string) *types.MenuItem {
-		branchName, _ := currentBranches.Load(path)
-		if icons.IsIconEnabled() {
-			branchName = icons.BRANCH_ICON + " " + fmt.Sprintf("%v", branchName)
+	// the repos are re-read each time given that they can be pinned or removed
+	// while the list is open
+	findSuggestions := func(input string) []*types.Suggestion {
+		paths := gui.getRecentRepoPaths()
+		if input != "" {
+			paths = utils.FuzzySearch(input, paths)
 		}
 
-		return &types.MenuItem{
-			LabelColumns: []string{
+		return slices.Map(paths, func(path string) *types.Suggestion {
+			branchName, ok := currentBranches.Load(path)
+			if !ok {
+				branchName = gui.c.Tr.LcBranchUnknown
+			}
+			if icons.IsIconEnabled() {
+				branchName = icons.IconForBranch(&models.Branch{}) + " " + fmt.Sprintf("%v", branchName)
+			}
+
+			label := fmt.Sprintf("%s %s %s",
 				filepath.Base(path),
 				style.FgCyan.Sprint(branchName),
 				style.FgMagenta.Sprint(path),
+			)
+			if gui.isRepoPinned(path) {
+				label += " " + style.FgYellow.Sprint("("+gui.c.Tr.LcPinnedRepo+")")
+			}
+
+			return &types.Suggestion{Value: path, Label: label}
+		})
+	}
+
+	return gui.c.Prompt(types.PromptOpts{
+		Title:               gui.c.Tr.RecentRepos,
+		FindSuggestionsFunc: findSuggestions,
+		SuggestionActions: []*types.SuggestionAction{
+			{
+				Key:         keybindings.GetKey(gui.c.UserConfig.Keybinding.Status.TogglePinnedRepo),
+				Description: gui.c.Tr.LcTogglePinnedRepo,
+				Handler:     gui.toggleRecentRepoPinned,
 			},
-			OnPress: func() error {
-				// if we were in a submodule, we want to forget about that stack of repos
-				// so that hitting escape in the new repo does nothing
-				gui.RepoPathStack.Clear()
-				return gui.dispatchSwitchToRepo(path, false)
+			{
+				Key:         keybindings.GetKey(gui.c.UserConfig.Keybinding.Universal.Remove),
+				Description: gui.c.Tr.LcRemoveRecentRepo,
+				Handler:     gui.removeRecentRepo,
 			},
-		}
+		},
+		HandleConfirm: func(str string) error {
+			// a suggestion gives us a path, whereas typed text needs to be
+			// resolved to the best match
+			path := str
+			if !lo.Contains(gui.getRecentRepoPaths(), path) {
+				suggestions := findSuggestions(str)
+				if len(suggestions) == 0 {
+					return gui.c.ErrorMsg(gui.c.Tr.NoMatchingMenuItem)
+				}
+				path = suggestions[0].Value
+			}
+
+			return gui.openRecentRepo(path)
+		},
 	})
+}
+
+func (gui *Gui) openRecentRepo(path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return gui.c.Confirm(types.ConfirmOpts{
+			Title:  gui.c.Tr.RepoNotFoundTitle,
+			Prompt: fmt.Sprintf(gui.c.Tr.RepoNotFoundPrompt, path),
+			HandleConfirm: func() error {
+				if err := gui.removeRecentRepo(path); err != nil {
+					return err
+				}
+				return gui.handleCreateRecentReposMenu()
+			},
+		})
+	}
 
-	return gui.c.Menu(types.CreateMenuOptions{Title: gui.c.Tr.RecentRepos, Items: menuItems})
+	// if we were in a submodule, we want to forget about that stack of repos
+	// so that hitting escape in the new repo does nothing
+	gui.RepoPathStack.Clear()
+	return gui.dispatchSwitchToRepo(path, false)
+}
+
+func (gui *Gui) toggleRecentRepoPinned(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	appState := gui.c.GetAppState()
+	if gui.isRepoPinned(path) {
+		appState.PinnedRepos = lo.Without(appState.PinnedRepos, path)
+	} else {
+		appState.PinnedRepos = append(appState.PinnedRepos, path)
+	}
+	return gui.c.SaveAppState()
+}
+
+func (gui *Gui) removeRecentRepo(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	appState := gui.c.GetAppState()
+	appState.RecentRepos = lo.Without(appState.RecentRepos, path)
+	appState.PinnedRepos = lo.Without(appState.PinnedRepos, path)
+	return gui.c.SaveAppState()
 }
 
 func (gui *Gui) handleShowAllBranchLogs() error {
@@ -118,6 +216,33 @@ func (gui *Gui) handleShowAllBranchLogs() error {
 	})
 }
 
+// switchToWorktree switches to the worktree at the given path, landing in the
+// same panel that we were in
+func (gui *Gui) switchToWorktree(path string) error {
+	// as with switching to a recent repo, escape shouldn't take us back here
+	gui.RepoPathStack.Clear()
+
+	contextKey := gui.currentSideContext().GetKey()
+	prevState := gui.State
+	if err := gui.dispatchSwitchToRepo(path, false); err != nil {
+		return err
+	}
+
+	if gui.State == prevState {
+		// we didn't switch, e.g. because the path doesn't exist any more
+		return nil
+	}
+
+	for _, context := range gui.State.Contexts.Flatten() {
+		if context.GetKey() == contextKey {
+			gui.State.ContextManager.ContextStack = []types.Context{context}
+			break
+		}
+	}
+
+	return nil
+}
+
 func (gui *Gui) dispatchSwitchToRepo(path string, reuse bool) error {
 	env.UnsetGitDirEnvs()
 	originalPath, err := os.Getwd()
@@ -8,10 +8,10 @@ func (gui *Gui) reflogCommitsRenderToMain() error {
 	if commit == nil {
 		task = types.NewRenderStringTask("No reflog history")
 	} else {
-		cmdObj := gui.git.Commit.ShowCmdObj(commit.Sha, gui.State.Modes.Filtering.GetPath(),
+		cmdObj := gui.git.Commit.ShowCmdObj(commit.Sha, gui.State.Modes.Filtering.GetPaths(),
 			gui.IgnoreWhitespaceInDiffView)
 
-		task = types.NewRunPtyTask(cmdObj.GetCmd())
+		task = types.NewCacheableRunPtyTask(cmdObj.GetCmd())
 	}
 
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
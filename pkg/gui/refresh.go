@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/generics/slices"
@@ -17,6 +18,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/tasks"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
@@ -54,7 +56,26 @@ func getModeName(mode types.RefreshMode) string {
 	}
 }
 
+// not refreshing staging/patch-building unless explicitly requested because we only need
+// to refresh those while focused.
+var defaultRefreshScopes = []types.RefreshableView{
+	types.COMMITS,
+	types.BRANCHES,
+	types.FILES,
+	types.STASH,
+	types.REFLOG,
+	types.TAGS,
+	types.REMOTES,
+	types.STATUS,
+	types.BISECT_INFO,
+}
+
 func (gui *Gui) Refresh(options types.RefreshOptions) error {
+	if options.Debounce {
+		gui.debounceRefresh(options.Scope)
+		return nil
+	}
+
 	if options.Scope == nil {
 		gui.c.Log.Infof(
 			"refreshing all scopes in %s mode",
@@ -73,23 +94,18 @@ func (gui *Gui) Refresh(options types.RefreshOptions) error {
 	f := func() {
 		var scopeSet *set.Set[types.RefreshableView]
 		if len(options.Scope) == 0 {
-			// not refreshing staging/patch-building unless explicitly requested because we only need
-			// to refresh those while focused.
-			scopeSet = set.NewFromSlice([]types.RefreshableView{
-				types.COMMITS,
-				types.BRANCHES,
-				types.FILES,
-				types.STASH,
-				types.REFLOG,
-				types.TAGS,
-				types.REMOTES,
-				types.STATUS,
-				types.BISECT_INFO,
-			})
+			scopeSet = set.NewFromSlice(defaultRefreshScopes)
 		} else {
 			scopeSet = set.NewFromSlice(options.Scope)
 		}
 
+		// cached main view content is keyed by a command naming commits by their
+		// sha, so it only goes stale if e.g. the git config changed, which a
+		// full refresh is for
+		if len(options.Scope) == 0 {
+			gui.renderCache.Clear()
+		}
+
 		refresh := func(f func()) {
 			wg.Add(1)
 			func() {
@@ -182,6 +198,30 @@ func (gui *Gui) refreshReflogCommitsConsideringStartup() {
 	}
 }
 
+// debounceRefresh collapses the refreshes of each scope that are asked for in
+// quick succession, like when a build tool writes lots of files at once
+func (gui *Gui) debounceRefresh(scopes []types.RefreshableView) {
+	if len(scopes) == 0 {
+		scopes = defaultRefreshScopes
+	}
+
+	gui.refreshDebouncersMutex.Lock()
+	defer gui.refreshDebouncersMutex.Unlock()
+
+	for _, scope := range scopes {
+		debouncer, ok := gui.refreshDebouncers[scope]
+		if !ok {
+			scope := scope
+			delay := time.Duration(gui.c.UserConfig.Refresher.DebounceDelay) * time.Millisecond
+			debouncer = tasks.NewDebouncer(delay, func() {
+				_ = gui.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{scope}})
+			})
+			gui.refreshDebouncers[scope] = debouncer
+		}
+		debouncer.Request()
+	}
+}
+
 // whenever we change commits, we should update branches because the upstream/downstream
 // counts can change. Whenever we change branches we should probably also change commits
 // e.g. in the case of switching branches.
@@ -219,26 +259,138 @@ func (gui *Gui) refreshCommits() {
 	wg.Wait()
 }
 
+// refreshCommitsWithLimit returns as soon as we have the commits down to the
+// selected one, and loads the rest in the background, showing them a chunk at a
+// time. A load that's still going when the next one starts is given up on.
 func (gui *Gui) refreshCommitsWithLimit() error {
+	return <-gui.startLoadingCommits()
+}
+
+// startLoadingCommits holds onto the LocalCommitsMutex until all the commits
+// have been loaded. Anything on the UI thread needing all of them should use
+// runAfterCommitsLoaded rather than wait on it, so that the UI doesn't freeze
+// in the meantime. The returned channel gets the result once
+// refreshCommitsWithLimit would return.
+func (gui *Gui) startLoadingCommits() chan error {
+	stop := gui.stopLoadingCommits()
+
+	gui.afterCommitsLoadedMutex.Lock()
+	gui.loadingCommits = true
+	gui.afterCommitsLoadedMutex.Unlock()
+	stopped := func() bool {
+		select {
+		case <-stop:
+			return true
+		default:
+			return false
+		}
+	}
+
+	context := gui.State.Contexts.LocalCommits
+	// showing fewer commits than that would lose the selection
+	enoughCommits := context.GetSelectedLineIdx() + 1
+
+	result := make(chan error, 1)
 	gui.Mutexes.LocalCommitsMutex.Lock()
-	defer gui.Mutexes.LocalCommitsMutex.Unlock()
+	go utils.Safe(func() {
+		defer gui.Mutexes.LocalCommitsMutex.Unlock()
+
+		finishedLoading := make(chan struct{})
+		defer close(finishedLoading)
+		returnedEarly := false
+
+		commits, err := gui.git.Loaders.CommitLoader.GetCommits(
+			git_commands.GetCommitsOptions{
+				Limit:                context.GetLimitCommits(),
+				FilterPaths:          gui.State.Modes.Filtering.GetPaths(),
+				FilterAuthor:         gui.State.Modes.Filtering.GetAuthor(),
+				IncludeRebaseCommits: true,
+				RefName:              gui.refForLog(),
+				All:                  context.GetShowWholeGitGraph(),
+				Pickaxe:              gui.State.Modes.Pickaxe.Text,
+				PickaxeIsRegex:       gui.State.Modes.Pickaxe.IsRegex,
+				Stop:                 stop,
+				ChunkSize:            gui.c.UserConfig.Git.Log.ChunkSize,
+				OnChunk: func(commits []*models.Commit) {
+					if stopped() || len(commits) < enoughCommits {
+						return
+					}
+
+					gui.State.Model.Commits = commits
+					err := gui.c.PostRefreshUpdate(context)
+
+					if !returnedEarly {
+						returnedEarly = true
+						_ = gui.c.WithWaitingStatus(gui.c.Tr.LcLoadingCommits, func() error {
+							<-finishedLoading
+							return nil
+						})
+						result <- err
+					}
+				},
+			},
+		)
+		if err == nil && !stopped() {
+			gui.State.Model.Commits = commits
+			gui.State.Model.WorkingTreeStateAtLastCommitRefresh = gui.git.Status.WorkingTreeState()
+			err = gui.c.PostRefreshUpdate(context)
+		}
 
-	commits, err := gui.git.Loaders.CommitLoader.GetCommits(
-		git_commands.GetCommitsOptions{
-			Limit:                gui.State.Contexts.LocalCommits.GetLimitCommits(),
-			FilterPath:           gui.State.Modes.Filtering.GetPath(),
-			IncludeRebaseCommits: true,
-			RefName:              gui.refForLog(),
-			All:                  gui.State.Contexts.LocalCommits.GetShowWholeGitGraph(),
-		},
-	)
-	if err != nil {
-		return err
+		if !returnedEarly {
+			result <- err
+		} else if err != nil {
+			gui.c.OnUIThread(func() error {
+				return gui.c.Error(err)
+			})
+		}
+
+		// whatever's waiting on the commits will wait for the load that
+		// superseded this one instead
+		if !stopped() {
+			gui.finishLoadingCommits()
+		}
+	})
+
+	return result
+}
+
+// runAfterCommitsLoaded runs f straight away if all the commits are loaded, and
+// otherwise once they are
+func (gui *Gui) runAfterCommitsLoaded(f func() error) error {
+	gui.afterCommitsLoadedMutex.Lock()
+	if gui.loadingCommits {
+		gui.afterCommitsLoaded = f
+		gui.afterCommitsLoadedMutex.Unlock()
+		return nil
 	}
-	gui.State.Model.Commits = commits
-	gui.State.Model.WorkingTreeStateAtLastCommitRefresh = gui.git.Status.WorkingTreeState()
+	gui.afterCommitsLoadedMutex.Unlock()
 
-	return gui.c.PostRefreshUpdate(gui.State.Contexts.LocalCommits)
+	return f()
+}
+
+func (gui *Gui) finishLoadingCommits() {
+	gui.afterCommitsLoadedMutex.Lock()
+	defer gui.afterCommitsLoadedMutex.Unlock()
+
+	gui.loadingCommits = false
+	if f := gui.afterCommitsLoaded; f != nil {
+		gui.afterCommitsLoaded = nil
+		gui.c.OnUIThread(f)
+	}
+}
+
+// stopLoadingCommits gives up on any commits that are still loading, and
+// returns the channel to close to give up on the next load
+func (gui *Gui) stopLoadingCommits() chan struct{} {
+	gui.stopLoadingCommitsMutex.Lock()
+	defer gui.stopLoadingCommitsMutex.Unlock()
+
+	if gui.stopLoadingCommitsChan != nil {
+		close(gui.stopLoadingCommitsChan)
+	}
+	gui.stopLoadingCommitsChan = make(chan struct{})
+
+	return gui.stopLoadingCommitsChan
 }
 
 func (gui *Gui) refreshCommitFilesContext() error {
@@ -246,7 +398,7 @@ func (gui *Gui) refreshCommitFilesContext() error {
 	to := ref.RefName()
 	from, reverse := gui.State.Modes.Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
 
-	files, err := gui.git.Loaders.CommitFileLoader.GetFilesInDiff(from, to, reverse)
+	files, err := gui.git.Loaders.CommitFileLoader.GetFilesInDiff(from, to, reverse, gui.State.Modes.Filtering.GetPaths())
 	if err != nil {
 		return gui.c.Error(err)
 	}
@@ -289,6 +441,12 @@ func (gui *Gui) refreshStateSubmoduleConfigs() error {
 
 	gui.State.Model.Submodules = configs
 
+	if len(configs) > 0 {
+		gui.submoduleStatusesLoader.run(gui.loadSubmoduleStatuses)
+	} else {
+		gui.submoduleStatusesLoader.stop()
+	}
+
 	return nil
 }
 
@@ -297,12 +455,12 @@ func (gui *Gui) refreshStateSubmoduleConfigs() error {
 func (gui *Gui) refreshBranches() {
 	reflogCommits := gui.State.Model.FilteredReflogCommits
 	if gui.State.Modes.Filtering.Active() {
-		// in filter mode we filter our reflog commits to just those containing the path
+		// in filter mode we filter our reflog commits to just those matching the filter
 		// however we need all the reflog entries to populate the recencies of our branches
 		// which allows us to order them correctly. So if we're filtering we'll just
 		// manually load all the reflog commits here
 		var err error
-		reflogCommits, _, err = gui.git.Loaders.ReflogCommitLoader.GetReflogCommits(nil, "")
+		reflogCommits, _, err = gui.git.Loaders.ReflogCommitLoader.GetReflogCommits(nil, nil, "")
 		if err != nil {
 			gui.c.Log.Error(err)
 		}
@@ -319,16 +477,26 @@ func (gui *Gui) refreshBranches() {
 		gui.c.Log.Error(err)
 	}
 
+	if gui.c.UserConfig.Gui.ShowDivergenceFromBaseBranch {
+		gui.branchDivergencesLoader.run(func(stop chan struct{}) { gui.loadBranchDivergences(branches, stop) })
+	}
+
+	// the hosting service's API is slow and rate-limited, so rather than asking
+	// it on every refresh we ask once per repo, and again when the user
+	// refreshes explicitly. We keep showing the pull requests we already have
+	// until the new ones are in
+	if gui.c.UserConfig.Git.ShowPullRequestStatus && !gui.State.Model.PullRequestsRequested {
+		model := gui.State.Model
+		model.PullRequestsRequested = true
+		gui.pullRequestsLoader.run(func(stop chan struct{}) { gui.loadPullRequests(model, branches, stop) })
+	}
+
 	gui.refreshStatus()
 }
 
 func (gui *Gui) refreshFilesAndSubmodules() error {
 	gui.Mutexes.RefreshingFilesMutex.Lock()
-	gui.State.IsRefreshingFiles = true
-	defer func() {
-		gui.State.IsRefreshingFiles = false
-		gui.Mutexes.RefreshingFilesMutex.Unlock()
-	}()
+	defer gui.Mutexes.RefreshingFilesMutex.Unlock()
 
 	if err := gui.refreshStateSubmoduleConfigs(); err != nil {
 		return err
@@ -373,6 +541,18 @@ func (gui *Gui) refreshMergeState() error {
 	return nil
 }
 
+func sameFiles(a []*models.File, b []*models.File) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (gui *Gui) refreshStateFiles() error {
 	state := gui.State
 
@@ -409,7 +589,7 @@ func (gui *Gui) refreshStateFiles() error {
 	}
 
 	files := gui.git.Loaders.FileLoader.
-		GetStatusFiles(git_commands.GetStatusFileOptions{})
+		GetStatusFiles(git_commands.GetStatusFileOptions{PreviousFiles: gui.State.Model.Files})
 
 	conflictFileCount := 0
 	for _, file := range files {
@@ -437,8 +617,11 @@ func (gui *Gui) refreshStateFiles() error {
 		fileTreeViewModel.SetFilter(filetree.DisplayAll)
 	}
 
-	state.Model.Files = files
-	fileTreeViewModel.SetTree()
+	// if nothing's changed there's no need to build the tree again
+	if !sameFiles(state.Model.Files, files) {
+		state.Model.Files = files
+		fileTreeViewModel.SetTree()
+	}
 	fileTreeViewModel.RWMutex.Unlock()
 
 	if err := gui.fileWatcher.addFilesToFileWatcher(files); err != nil {
@@ -459,14 +642,15 @@ func (gui *Gui) refreshReflogCommits() error {
 	// pulling state into its own variable incase it gets swapped out for another state
 	// and we get an out of bounds exception
 	state := gui.State
-	var lastReflogCommit *models.Commit
-	if len(state.Model.ReflogCommits) > 0 {
-		lastReflogCommit = state.Model.ReflogCommits[0]
-	}
 
-	refresh := func(stateCommits *[]*models.Commit, filterPath string) error {
+	refresh := func(stateCommits *[]*models.Commit, filterPaths []string, filterAuthor string) error {
+		var lastReflogCommit *models.Commit
+		if len(*stateCommits) > 0 {
+			lastReflogCommit = (*stateCommits)[0]
+		}
+
 		commits, onlyObtainedNewReflogCommits, err := gui.git.Loaders.ReflogCommitLoader.
-			GetReflogCommits(lastReflogCommit, filterPath)
+			GetReflogCommits(lastReflogCommit, filterPaths, filterAuthor)
 		if err != nil {
 			return gui.c.Error(err)
 		}
@@ -479,12 +663,12 @@ func (gui *Gui) refreshReflogCommits() error {
 		return nil
 	}
 
-	if err := refresh(&state.Model.ReflogCommits, ""); err != nil {
+	if err := refresh(&state.Model.ReflogCommits, nil, ""); err != nil {
 		return err
 	}
 
 	if gui.State.Modes.Filtering.Active() {
-		if err := refresh(&state.Model.FilteredReflogCommits, state.Modes.Filtering.GetPath()); err != nil {
+		if err := refresh(&state.Model.FilteredReflogCommits, state.Modes.Filtering.GetPaths(), state.Modes.Filtering.GetAuthor()); err != nil {
 			return err
 		}
 	} else {
@@ -528,7 +712,7 @@ func (gui *Gui) refreshRemotes() error {
 
 func (gui *Gui) refreshStashEntries() error {
 	gui.State.Model.StashEntries = gui.git.Loaders.StashLoader.
-		GetStashEntries(gui.State.Modes.Filtering.GetPath())
+		GetStashEntries(gui.State.Modes.Filtering.GetPaths())
 
 	return gui.postRefreshUpdate(gui.State.Contexts.Stash)
 }
@@ -559,6 +743,12 @@ func (gui *Gui) refreshStatus() {
 	status += fmt.Sprintf("%s → %s ", repoName, name)
 
 	gui.setViewContent(gui.Views.Status, status)
+
+	if gui.isShowingStatusDashboard() {
+		if err := gui.renderStatusDashboard(); err != nil {
+			gui.c.Log.Error(err)
+		}
+	}
 }
 
 func (gui *Gui) refreshStagingPanel(focusOpts types.OnFocusOpts) error {
@@ -677,6 +867,15 @@ func (gui *Gui) refreshPatchBuildingPanel(opts types.OnFocusOpts) error {
 		return err
 	}
 
+	patchSummary := presentation.GetPatchSummaryLines(
+		gui.git.Patch.PatchManager.IncludedFiles(),
+		gui.git.Patch.PatchManager.SpansMultipleCommits(),
+	)
+	secondaryContent := strings.Join(patchSummary, "\n")
+	if secondaryDiff != "" {
+		secondaryContent += "\n\n" + secondaryDiff
+	}
+
 	context := gui.State.Contexts.CustomPatchBuilder
 
 	oldState := context.GetState()
@@ -698,7 +897,7 @@ func (gui *Gui) refreshPatchBuildingPanel(opts types.OnFocusOpts) error {
 			Title: gui.Tr.Patch,
 		},
 		Secondary: &types.ViewUpdateOpts{
-			Task:  types.NewRenderStringWithoutScrollTask(secondaryDiff),
+			Task:  types.NewRenderStringWithoutScrollTask(secondaryContent),
 			Title: gui.Tr.CustomPatch,
 		},
 	})
@@ -729,10 +928,16 @@ func (gui *Gui) refreshSubCommitsWithLimit() error {
 
 	context := gui.State.Contexts.SubCommits
 
+	filterPaths := gui.State.Modes.Filtering.GetPaths()
+	if filterPath := context.GetFilterPath(); filterPath != "" {
+		filterPaths = []string{filterPath}
+	}
+
 	commits, err := gui.git.Loaders.CommitLoader.GetCommits(
 		git_commands.GetCommitsOptions{
 			Limit:                context.GetLimitCommits(),
-			FilterPath:           gui.State.Modes.Filtering.GetPath(),
+			FilterPaths:          filterPaths,
+			FilterAuthor:         gui.State.Modes.Filtering.GetAuthor(),
 			IncludeRebaseCommits: false,
 			RefName:              context.GetRef().FullRefName(),
 		},
@@ -0,0 +1,70 @@
+package gui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+func (gui *Gui) handleReloadConfig() error {
+	if err := gui.reloadUserConfig(); err != nil {
+		return gui.c.Error(err)
+	}
+
+	gui.c.Toast(gui.c.Tr.ConfigReloaded)
+
+	return gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
+
+// reloadUserConfig re-reads the user's config files and applies the repo config
+// over them again. If the new config is invalid we go back to the one we had,
+// so that we're never left with a mix of old and new keybindings.
+func (gui *Gui) reloadUserConfig() error {
+	previousUserConfig := *gui.UserConfig
+	previousUserConfigWithoutRepoConfig := gui.userConfigWithoutRepoConfig
+
+	err := gui.loadUserConfig()
+	if err == nil {
+		err = gui.validateUserConfig()
+	}
+	if err != nil {
+		gui.userConfigWithoutRepoConfig = previousUserConfigWithoutRepoConfig
+		gui.setUserConfig(&previousUserConfig)
+		return err
+	}
+
+	if err := gui.resetKeybindings(); err != nil {
+		return err
+	}
+
+	// options like the pager change what we'd render
+	gui.renderCache.Clear()
+	gui.renderCache.SetSize(gui.UserConfig.Gui.DiffCacheSize)
+
+	return gui.onUserConfigLoaded()
+}
+
+func (gui *Gui) loadUserConfig() error {
+	// we read the files into a new config so that the app config's own one
+	// isn't swapped out from under whatever reads it
+	userConfig, err := gui.Config.LoadUserConfig()
+	if err != nil {
+		return err
+	}
+
+	gui.userConfigWithoutRepoConfig = userConfig
+	return gui.applyRepoConfig()
+}
+
+// validateUserConfig checks everything that would otherwise make us exit when
+// we go to set up the keybindings
+func (gui *Gui) validateUserConfig() error {
+	if err := keybindings.ValidateKeybindingConfig(gui.UserConfig.Keybinding, gui.UserConfig.CustomCommands); err != nil {
+		return err
+	}
+
+	if _, err := gui.CustomCommandsClient.GetCustomCommandKeybindings(); err != nil {
+		return err
+	}
+
+	return gui.validateKeybindings()
+}
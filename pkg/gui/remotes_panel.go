@@ -16,7 +16,16 @@ func (gui *Gui) remotesRenderToMain() error {
 	if remote == nil {
 		task = types.NewRenderStringTask("No remotes")
 	} else {
-		task = types.NewRenderStringTask(fmt.Sprintf("%s\nUrls:\n%s", style.FgGreen.Sprint(remote.Name), strings.Join(remote.Urls, "\n")))
+		content := fmt.Sprintf("%s\nUrls:\n%s", style.FgGreen.Sprint(remote.Name), strings.Join(remote.Urls, "\n"))
+		// we only show the push urls when they differ, since git pushes to the
+		// fetch urls otherwise
+		if remote.HasSeparatePushUrls() {
+			content += fmt.Sprintf("\nPush urls:\n%s", strings.Join(remote.PushUrls, "\n"))
+		}
+		if remote.Prune {
+			content += "\nPrunes on fetch"
+		}
+		task = types.NewRenderStringTask(content)
 	}
 
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
@@ -0,0 +1,115 @@
+package gui
+
+import (
+	"os"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// applyRepoConfig applies the .lazygit.yml of the repo we've just opened over
+// the user config. If it has options that run commands and we haven't asked
+// the user whether they trust the repo yet, we leave those options out until
+// they say they do.
+func (gui *Gui) applyRepoConfig() error {
+	if gui.userConfigWithoutRepoConfig == nil {
+		userConfig, err := gui.UserConfig.Clone()
+		if err != nil {
+			return err
+		}
+		gui.userConfigWithoutRepoConfig = userConfig
+	}
+
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	repoConfig, err := config.LoadRepoConfig(repoPath)
+	if err != nil {
+		return err
+	}
+
+	if repoConfig == nil {
+		userConfig, err := gui.userConfigWithoutRepoConfig.Clone()
+		if err != nil {
+			return err
+		}
+		gui.setUserConfig(userConfig)
+		return nil
+	}
+
+	trusted, asked := gui.isRepoConfigTrusted(repoPath)
+	if err := gui.setRepoConfig(repoConfig, trusted); err != nil {
+		return err
+	}
+
+	if !repoConfig.RunsCommands() || asked {
+		return nil
+	}
+
+	gui.c.OnUIThread(func() error {
+		return gui.askToTrustRepoConfig(repoPath, repoConfig)
+	})
+
+	return nil
+}
+
+// isRepoConfigTrusted returns whether we can apply the options that run
+// commands in the repo's config, and whether the user has already decided
+func (gui *Gui) isRepoConfigTrusted(repoPath string) (bool, bool) {
+	if lo.Contains(gui.userConfigWithoutRepoConfig.TrustedRepos, repoPath) {
+		return true, true
+	}
+
+	trusted, asked := gui.Config.GetAppState().TrustedRepoConfigs[repoPath]
+	return trusted, asked
+}
+
+func (gui *Gui) askToTrustRepoConfig(repoPath string, repoConfig *config.RepoConfig) error {
+	recordAnswer := func(trusted bool) error {
+		appState := gui.Config.GetAppState()
+		if appState.TrustedRepoConfigs == nil {
+			appState.TrustedRepoConfigs = map[string]bool{}
+		}
+		appState.TrustedRepoConfigs[repoPath] = trusted
+		return gui.Config.SaveAppState()
+	}
+
+	return gui.c.Confirm(types.ConfirmOpts{
+		Title:  gui.c.Tr.TrustRepoConfigTitle,
+		Prompt: gui.c.Tr.TrustRepoConfigPrompt,
+		HandleConfirm: func() error {
+			if err := recordAnswer(true); err != nil {
+				return err
+			}
+
+			if err := gui.setRepoConfig(repoConfig, true); err != nil {
+				return err
+			}
+
+			// the repo's custom commands come with keybindings
+			return gui.resetKeybindings()
+		},
+		HandleClose: func() error {
+			return recordAnswer(false)
+		},
+	})
+}
+
+func (gui *Gui) setRepoConfig(repoConfig *config.RepoConfig, trusted bool) error {
+	userConfig, err := repoConfig.ApplyTo(gui.userConfigWithoutRepoConfig, trusted)
+	if err != nil {
+		return err
+	}
+
+	gui.setUserConfig(userConfig)
+	return nil
+}
+
+// setUserConfig updates the user config in place, because the rest of the app
+// holds on to the pointer to it
+func (gui *Gui) setUserConfig(userConfig *config.UserConfig) {
+	*gui.UserConfig = *userConfig
+}
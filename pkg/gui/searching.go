@@ -36,6 +36,7 @@ func (gui *Gui) handleSearch() error {
 		return nil
 	}
 
+	gui.State.Searching.prevMatchIndex = -1
 	if err := view.Search(gui.State.Searching.searchString); err != nil {
 		return err
 	}
@@ -60,10 +61,11 @@ func (gui *Gui) onSelectItemWrapper(innerFunc func(int) error) func(int, int, in
 		_ = gui.renderString(
 			gui.Views.Search,
 			fmt.Sprintf(
-				"matches for '%s' (%d of %d) %s",
+				"matches for '%s' (%d of %d%s) %s",
 				gui.State.Searching.searchString,
 				index+1,
 				total,
+				gui.searchWrapText(index, total),
 				theme.OptionsFgColor.Sprintf(
 					"%s: next match, %s: previous match, %s: exit search mode",
 					keybindings.Label(keybindingConfig.Universal.NextMatch),
@@ -79,6 +81,25 @@ func (gui *Gui) onSelectItemWrapper(innerFunc func(int) error) func(int, int, in
 	}
 }
 
+// searchWrapText tells the user when going to the next or previous match took
+// them back around to the other end of the view, which is easy to miss when the
+// matches are far apart
+func (gui *Gui) searchWrapText(index int, total int) string {
+	prevIndex := gui.State.Searching.prevMatchIndex
+	gui.State.Searching.prevMatchIndex = index
+
+	switch {
+	case prevIndex == -1:
+		return ""
+	case prevIndex == total-1 && index == 0:
+		return ", continuing from the top"
+	case prevIndex == 0 && index == total-1:
+		return ", continuing from the bottom"
+	default:
+		return ""
+	}
+}
+
 func (gui *Gui) onSearchEscape() error {
 	gui.State.Searching.isSearching = false
 	if gui.State.Searching.view != nil {
@@ -3,7 +3,6 @@ package custom_commands
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
-	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -12,7 +11,7 @@ import (
 // Client is the entry point to this package. It returns a list of keybindings based on the config's user-defined custom commands.
 // See https://github.com/jesseduffield/lazygit/blob/master/docs/Custom_Command_Keybindings.md for more info.
 type Client struct {
-	customCommands    []config.CustomCommand
+	c                 *types.HelperCommon
 	handlerCreator    *HandlerCreator
 	keybindingCreator *KeybindingCreator
 }
@@ -27,10 +26,9 @@ func NewClient(
 	sessionStateLoader := NewSessionStateLoader(contexts, helpers)
 	handlerCreator := NewHandlerCreator(c, os, git, sessionStateLoader)
 	keybindingCreator := NewKeybindingCreator(contexts)
-	customCommands := c.UserConfig.CustomCommands
 
 	return &Client{
-		customCommands:    customCommands,
+		c:                 c,
 		keybindingCreator: keybindingCreator,
 		handlerCreator:    handlerCreator,
 	}
@@ -38,13 +36,20 @@ func NewClient(
 
 func (self *Client) GetCustomCommandKeybindings() ([]*types.Binding, error) {
 	bindings := []*types.Binding{}
-	for _, customCommand := range self.customCommands {
+	// reading these from the config each time because a repo's config can add
+	// custom commands once the repo is trusted
+	for _, customCommand := range self.c.UserConfig.CustomCommands {
 		handler := self.handlerCreator.call(customCommand)
-		binding, err := self.keybindingCreator.call(customCommand, handler)
+		commandBindings, err := self.keybindingCreator.call(customCommand, handler)
 		if err != nil {
 			return nil, err
 		}
-		bindings = append(bindings, binding)
+
+		getDisabledReason := self.handlerCreator.getDisabledReasonFn(customCommand)
+		for _, binding := range commandBindings {
+			binding.GetDisabledReason = getDisabledReason
+		}
+		bindings = append(bindings, commandBindings...)
 	}
 
 	return bindings, nil
@@ -1,6 +1,9 @@
 package custom_commands
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -148,12 +151,23 @@ func (self *HandlerCreator) menuPromptFromCommand(prompt *config.CustomCommandPr
 		return self.c.Error(err)
 	}
 
+	message = skipLines(message, prompt.SkipLines)
+
 	// Need to make a menu out of what the cmd has displayed
-	candidates, err := self.menuGenerator.call(message, prompt.Filter, prompt.ValueFormat, prompt.LabelFormat)
+	var candidates []*commandMenuEntry
+	if prompt.Columns != nil {
+		candidates, err = self.menuGenerator.callWithColumns(message, prompt.Columns)
+	} else {
+		candidates, err = self.menuGenerator.call(message, prompt.Filter, prompt.ValueFormat, prompt.LabelFormat)
+	}
 	if err != nil {
 		return self.c.Error(err)
 	}
 
+	if prompt.Filterable {
+		return self.filterableMenuPrompt(prompt.Title, candidates, wrappedF)
+	}
+
 	menuItems := slices.Map(candidates, func(candidate *commandMenuEntry) *types.MenuItem {
 		return &types.MenuItem{
 			LabelColumns: []string{candidate.label},
@@ -166,6 +180,72 @@ func (self *HandlerCreator) menuPromptFromCommand(prompt *config.CustomCommandPr
 	return self.c.Menu(types.CreateMenuOptions{Title: prompt.Title, Items: menuItems})
 }
 
+// filterableMenuPrompt shows the candidates as suggestions which get narrowed
+// down as the user types. Confirming the text itself picks the best match.
+func (self *HandlerCreator) filterableMenuPrompt(title string, candidates []*commandMenuEntry, wrappedF func(string) error) error {
+	findSuggestions := func(input string) []*types.Suggestion {
+		matches := candidates
+		if input != "" {
+			matches = utils.FuzzySearchItems(input, candidates, func(candidate *commandMenuEntry) string {
+				return utils.Decolorise(candidate.label)
+			})
+		}
+
+		return slices.Map(matches, func(candidate *commandMenuEntry) *types.Suggestion {
+			return &types.Suggestion{Value: candidate.value, Label: candidate.label}
+		})
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               title,
+		FindSuggestionsFunc: findSuggestions,
+		HandleConfirm: func(str string) error {
+			// a suggestion's value is passed through as is, whereas typed text
+			// needs to be resolved to the closest matching candidate
+			if slices.Some(candidates, func(candidate *commandMenuEntry) bool { return candidate.value == str }) {
+				return wrappedF(str)
+			}
+
+			suggestions := findSuggestions(str)
+			if len(suggestions) == 0 {
+				return self.c.ErrorMsg(self.c.Tr.NoMatchingMenuItem)
+			}
+			return wrappedF(suggestions[0].Value)
+		},
+	})
+}
+
+// getDisabledReasonFn returns a function which resolves the command's enabledIf
+// template against the current state, or nil if the command is always enabled
+func (self *HandlerCreator) getDisabledReasonFn(customCommand config.CustomCommand) func() string {
+	if customCommand.EnabledIf == "" {
+		return nil
+	}
+
+	return func() string {
+		resolveTemplate := self.getResolveTemplateFn(map[string]string{}, []string{}, self.sessionStateLoader.call())
+
+		enabledStr, err := resolveTemplate(customCommand.EnabledIf)
+		if err != nil {
+			return err.Error()
+		}
+
+		if enabled, err := strconv.ParseBool(strings.TrimSpace(enabledStr)); err == nil && enabled {
+			return ""
+		}
+
+		if customCommand.DisabledReason == "" {
+			return fmt.Sprintf(self.c.Tr.CustomCommandDisabled, customCommand.EnabledIf)
+		}
+
+		reason, err := resolveTemplate(customCommand.DisabledReason)
+		if err != nil {
+			return err.Error()
+		}
+		return reason
+	}
+}
+
 type CustomCommandObjects struct {
 	*SessionState
 	PromptResponses []string
@@ -183,9 +263,26 @@ func (self *HandlerCreator) getResolveTemplateFn(form map[string]string, promptR
 		"quote": self.os.Quote,
 	}
 
-	return func(templateStr string) (string, error) { return utils.ResolveTemplate(templateStr, objects, funcs) }
+	return func(templateStr string) (string, error) {
+		result, err := utils.ResolveTemplate(templateStr, objects, funcs)
+		if err != nil {
+			// a missing key is already an error, but text/template's message
+			// doesn't say that it's because the prompt with that key hasn't been
+			// answered yet: prompts are resolved right before they're shown, so
+			// the form only has the responses to the prompts before this one
+			if match := missingFormKeyRegex.FindStringSubmatch(err.Error()); match != nil {
+				return "", fmt.Errorf(self.c.Tr.CustomCommandUnansweredFormKey, match[1], match[1])
+			}
+			return "", err
+		}
+		return result, nil
+	}
 }
 
+// the form is the only map exposed to templates, so this is the error we get
+// when referencing a form key that hasn't been set yet
+var missingFormKeyRegex = regexp.MustCompile(`map has no entry for key "(.*)"`)
+
 func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, sessionState *SessionState, promptResponses []string, form map[string]string) error {
 	resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState)
 	cmdStr, err := resolveTemplate(customCommand.Command)
@@ -204,26 +301,49 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 		loadingText = self.c.Tr.LcRunningCustomCommandStatus
 	}
 
+	output := customCommand.Output
+	if output == "" && customCommand.ShowOutput {
+		output = "popup"
+	}
+
 	return self.c.WithWaitingStatus(loadingText, func() error {
 		self.c.LogAction(self.c.Tr.Actions.CustomCommand)
 
 		if customCommand.Stream {
 			cmdObj.StreamOutput()
 		}
-		output, err := cmdObj.RunWithOutput()
+		// only stdout is shown on success; stderr ends up in the error on failure
+		stdout, _, err := cmdObj.RunWithOutputs()
 		if err != nil {
 			return self.c.Error(err)
 		}
 
-		if customCommand.ShowOutput {
-			if strings.TrimSpace(output) == "" {
-				output = self.c.Tr.EmptyOutput
+		switch output {
+		case "popup":
+			if strings.TrimSpace(stdout) == "" {
+				stdout = self.c.Tr.EmptyOutput
 			}
-			if err = self.c.Alert(cmdStr, output); err != nil {
+			if err = self.c.Alert(cmdStr, stdout); err != nil {
 				return self.c.Error(err)
 			}
-			return self.c.Refresh(types.RefreshOptions{})
+		case "mainView":
+			if err := self.c.Refresh(types.RefreshOptions{}); err != nil {
+				return err
+			}
+
+			// rendering after the refresh so that the refresh doesn't replace our output
+			self.c.OnUIThread(func() error {
+				return self.c.RenderToMainViews(types.RefreshMainOpts{
+					Pair: self.c.MainViewPairs().Normal,
+					Main: &types.ViewUpdateOpts{
+						Title: cmdStr,
+						Task:  types.NewRenderStringTask(stdout),
+					},
+				})
+			})
+			return nil
 		}
+
 		return self.c.Refresh(types.RefreshOptions{})
 	})
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 // KeybindingCreator takes a custom command along with its handler and returns a corresponding keybinding
@@ -23,42 +24,56 @@ func NewKeybindingCreator(contexts *context.ContextTree) *KeybindingCreator {
 	}
 }
 
-func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler func() error) (*types.Binding, error) {
+func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler func() error) ([]*types.Binding, error) {
 	if customCommand.Context == "" {
 		return nil, formatContextNotProvidedError(customCommand)
 	}
 
-	viewName, err := self.getViewNameAndContexts(customCommand)
+	viewNames, err := self.getViewNamesAndContexts(customCommand)
 	if err != nil {
 		return nil, err
 	}
 
+	if !lo.Contains(permittedOutputs, customCommand.Output) {
+		return nil, formatUnknownOutputError(customCommand)
+	}
+
 	description := customCommand.Description
 	if description == "" {
 		description = customCommand.Command
 	}
 
-	return &types.Binding{
-		ViewName:    viewName,
-		Key:         keybindings.GetKey(customCommand.Key),
-		Modifier:    gocui.ModNone,
-		Handler:     handler,
-		Description: description,
-	}, nil
+	return slices.Map(viewNames, func(viewName string) *types.Binding {
+		return &types.Binding{
+			ViewName:    viewName,
+			Key:         keybindings.GetKey(customCommand.Key),
+			Modifier:    gocui.ModNone,
+			Handler:     handler,
+			Description: description,
+		}
+	}), nil
 }
 
-func (self *KeybindingCreator) getViewNameAndContexts(customCommand config.CustomCommand) (string, error) {
-	if customCommand.Context == "global" {
-		return "", nil
-	}
+// the context field can list several contexts, separated by commas
+func (self *KeybindingCreator) getViewNamesAndContexts(customCommand config.CustomCommand) ([]string, error) {
+	contextKeys := lo.Uniq(lo.Compact(slices.Map(strings.Split(customCommand.Context, ","), strings.TrimSpace)))
+
+	viewNames := []string{}
+	for _, contextKey := range contextKeys {
+		if contextKey == "global" {
+			viewNames = append(viewNames, "")
+			continue
+		}
 
-	ctx, ok := self.contextForContextKey(types.ContextKey(customCommand.Context))
-	if !ok {
-		return "", formatUnknownContextError(customCommand)
+		ctx, ok := self.contextForContextKey(types.ContextKey(contextKey))
+		if !ok {
+			return nil, formatUnknownContextError(customCommand, contextKey)
+		}
+
+		viewNames = append(viewNames, ctx.GetViewName())
 	}
 
-	viewName := ctx.GetViewName()
-	return viewName, nil
+	return lo.Uniq(viewNames), nil
 }
 
 func (self *KeybindingCreator) contextForContextKey(contextKey types.ContextKey) (types.Context, bool) {
@@ -71,12 +86,18 @@ func (self *KeybindingCreator) contextForContextKey(contextKey types.ContextKey)
 	return nil, false
 }
 
-func formatUnknownContextError(customCommand config.CustomCommand) error {
+var permittedOutputs = []string{"", "popup", "mainView", "none"}
+
+func formatUnknownOutputError(customCommand config.CustomCommand) error {
+	return fmt.Errorf("Error when setting custom command keybindings: unknown output: %s. Key: %s, Command: %s.\nPermitted outputs: popup, mainView, none", customCommand.Output, customCommand.Key, customCommand.Command)
+}
+
+func formatUnknownContextError(customCommand config.CustomCommand, contextKey string) error {
 	allContextKeyStrings := slices.Map(context.AllContextKeys, func(key types.ContextKey) string {
 		return string(key)
 	})
 
-	return fmt.Errorf("Error when setting custom command keybindings: unknown context: %s. Key: %s, Command: %s.\nPermitted contexts: %s", customCommand.Context, customCommand.Key, customCommand.Command, strings.Join(allContextKeyStrings, ", "))
+	return fmt.Errorf("Error when setting custom command keybindings: unknown context: %s. Key: %s, Command: %s.\nPermitted contexts: %s", contextKey, customCommand.Key, customCommand.Command, strings.Join(allContextKeyStrings, ", "))
 }
 
 func formatContextNotProvidedError(customCommand config.CustomCommand) error {
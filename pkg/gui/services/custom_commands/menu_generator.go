@@ -3,13 +3,16 @@ package custom_commands
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type MenuGenerator struct {
@@ -73,6 +76,60 @@ func (self *MenuGenerator) call(commandOutput, filter, valueFormat, labelFormat
 	return candidates, err
 }
 
+// callWithColumns splits each line of the command's output into columns, taking
+// the value and label from the columns specified by the user
+func (self *MenuGenerator) callWithColumns(commandOutput string, columns *config.CustomCommandMenuColumns) ([]*commandMenuEntry, error) {
+	if columns.Value < 1 {
+		return nil, errors.New("columns.value must be a column number starting from 1")
+	}
+	if columns.Label < 0 {
+		return nil, errors.New("columns.label must be a column number starting from 1")
+	}
+
+	labelColumn := columns.Label
+	if labelColumn == 0 {
+		labelColumn = columns.Value
+	}
+
+	candidates := []*commandMenuEntry{}
+	for _, line := range strings.Split(commandOutput, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var fields []string
+		if columns.Delimiter == "" {
+			fields = strings.Fields(line)
+		} else {
+			fields = strings.Split(line, columns.Delimiter)
+		}
+
+		if len(fields) < utils.Max(columns.Value, labelColumn) {
+			return nil, fmt.Errorf("line %q has fewer than %d columns", line, utils.Max(columns.Value, labelColumn))
+		}
+
+		candidates = append(candidates, &commandMenuEntry{
+			value: strings.TrimSpace(fields[columns.Value-1]),
+			label: strings.TrimSpace(fields[labelColumn-1]),
+		})
+	}
+
+	return candidates, nil
+}
+
+// skipLines drops the first n lines of the command's output, e.g. to get rid of a header
+func skipLines(commandOutput string, n int) string {
+	if n <= 0 {
+		return commandOutput
+	}
+
+	lines := strings.SplitN(commandOutput, "\n", n+1)
+	if len(lines) <= n {
+		return ""
+	}
+	return lines[n]
+}
+
 func (self *MenuGenerator) generateMenuCandidate(
 	line string,
 	regex *regexp.Regexp,
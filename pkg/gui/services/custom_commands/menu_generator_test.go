@@ -3,6 +3,7 @@ package custom_commands
 import (
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -63,3 +64,70 @@ func TestMenuGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestMenuGeneratorWithColumns(t *testing.T) {
+	type scenario struct {
+		testName string
+		cmdOut   string
+		columns  *config.CustomCommandMenuColumns
+		test     func([]*commandMenuEntry, error)
+	}
+
+	scenarios := []scenario{
+		{
+			"Tab separated value and label",
+			"PROJ-1\tFix the thing\nPROJ-2\tAdd another thing\n",
+			&config.CustomCommandMenuColumns{Delimiter: "\t", Value: 1, Label: 2},
+			func(actualEntry []*commandMenuEntry, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, actualEntry, 2)
+				assert.EqualValues(t, "PROJ-1", actualEntry[0].value)
+				assert.EqualValues(t, "Fix the thing", actualEntry[0].label)
+				assert.EqualValues(t, "PROJ-2", actualEntry[1].value)
+				assert.EqualValues(t, "Add another thing", actualEntry[1].label)
+			},
+		},
+		{
+			"Whitespace separated with label defaulting to value",
+			"  abc123   first\n def456 second",
+			&config.CustomCommandMenuColumns{Value: 2},
+			func(actualEntry []*commandMenuEntry, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, actualEntry, 2)
+				assert.EqualValues(t, "first", actualEntry[0].value)
+				assert.EqualValues(t, "first", actualEntry[0].label)
+				assert.EqualValues(t, "second", actualEntry[1].value)
+			},
+		},
+		{
+			"Missing column",
+			"a,b\nc",
+			&config.CustomCommandMenuColumns{Delimiter: ",", Value: 2},
+			func(actualEntry []*commandMenuEntry, err error) {
+				assert.EqualError(t, err, `line "c" has fewer than 2 columns`)
+			},
+		},
+		{
+			"Invalid value column",
+			"a,b",
+			&config.CustomCommandMenuColumns{Delimiter: ","},
+			func(actualEntry []*commandMenuEntry, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			s.test(NewMenuGenerator(utils.NewDummyCommon()).callWithColumns(s.cmdOut, s.columns))
+		})
+	}
+}
+
+func TestSkipLines(t *testing.T) {
+	assert.EqualValues(t, "a\nb", skipLines("a\nb", 0))
+	assert.EqualValues(t, "b\nc", skipLines("a\nb\nc", 1))
+	assert.EqualValues(t, "", skipLines("a\nb", 2))
+	assert.EqualValues(t, "", skipLines("a", 3))
+}
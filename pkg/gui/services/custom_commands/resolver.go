@@ -22,6 +22,9 @@ func (self *Resolver) resolvePrompt(
 	result := &config.CustomCommandPrompt{
 		ValueFormat: prompt.ValueFormat,
 		LabelFormat: prompt.LabelFormat,
+		Columns:     prompt.Columns,
+		SkipLines:   prompt.SkipLines,
+		Filterable:  prompt.Filterable,
 	}
 
 	result.Title, err = resolveTemplate(prompt.Title)
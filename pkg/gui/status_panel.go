@@ -6,12 +6,17 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/generics/slices"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 func runeCount(str string) int {
@@ -75,6 +80,10 @@ func formatWorkingTreeState(rebaseMode enums.RebaseMode) string {
 }
 
 func (gui *Gui) statusRenderToMain() error {
+	if gui.c.UserConfig.Gui.StatusPanelView == "dashboard" {
+		return gui.renderStatusDashboard()
+	}
+
 	dashboardString := strings.Join(
 		[]string{
 			lazygitTitle(),
@@ -96,6 +105,206 @@ func (gui *Gui) statusRenderToMain() error {
 	})
 }
 
+// statusDashboardItem is a line of the status dashboard, which summarises the
+// state of the repo. Pressing it takes you to where you can deal with it.
+type statusDashboardItem struct {
+	label   string
+	onPress func() error
+}
+
+// statusDashboardItems only uses what we've already loaded, so that the
+// dashboard doesn't cost any git calls when it's refreshed
+func (gui *Gui) statusDashboardItems() []statusDashboardItem {
+	model := gui.State.Model
+	goTo := func(context types.Context) func() error {
+		return func() error { return gui.c.PushContext(context) }
+	}
+
+	// the branches only tell us about the worktrees that have a branch checked
+	// out, so worktrees with a detached head aren't counted
+	worktreePaths := lo.Uniq(lo.FilterMap(model.Branches, func(branch *models.Branch, _ int) (string, bool) {
+		return branch.WorktreePath, branch.WorktreePath != ""
+	}))
+	branchesAhead := lo.CountBy(model.Branches, func(branch *models.Branch) bool {
+		return branch.HasCommitsToPush()
+	})
+	conflictedFiles := lo.CountBy(model.Files, func(file *models.File) bool {
+		return file.HasMergeConflicts
+	})
+
+	inProgress := statusDashboardItem{
+		label: fmt.Sprintf(gui.c.Tr.DashboardInProgress, gui.c.Tr.DashboardNothingInProgress),
+	}
+	if workingTreeState := gui.git.Status.WorkingTreeState(); workingTreeState != enums.REBASE_MODE_NONE {
+		inProgress = statusDashboardItem{
+			label:   fmt.Sprintf(gui.c.Tr.DashboardInProgress, formatWorkingTreeState(workingTreeState)),
+			onPress: gui.helpers.MergeAndRebase.CreateRebaseOptionsMenu,
+		}
+	} else if model.BisectInfo.Started() {
+		inProgress = statusDashboardItem{
+			label:   fmt.Sprintf(gui.c.Tr.DashboardInProgress, gui.c.Tr.DashboardBisecting),
+			onPress: goTo(gui.State.Contexts.LocalCommits),
+		}
+	}
+
+	lastFetch := gui.c.Tr.DashboardNeverFetched
+	if lastFetchTime, ok := gui.git.Status.LastFetchTime(); ok {
+		lastFetch = fmt.Sprintf(gui.c.Tr.DashboardLastFetch, utils.UnixToTimeAgo(lastFetchTime.Unix()))
+	}
+
+	return []statusDashboardItem{
+		{
+			label:   fmt.Sprintf(gui.c.Tr.DashboardStashes, len(model.StashEntries)),
+			onPress: goTo(gui.State.Contexts.Stash),
+		},
+		{
+			label:   fmt.Sprintf(gui.c.Tr.DashboardWorktrees, len(worktreePaths)+1),
+			onPress: goTo(gui.State.Contexts.Branches),
+		},
+		{
+			label:   fmt.Sprintf(gui.c.Tr.DashboardBranchesAhead, branchesAhead),
+			onPress: goTo(gui.State.Contexts.Branches),
+		},
+		{
+			label:   fmt.Sprintf(gui.c.Tr.DashboardConflictedFiles, conflictedFiles),
+			onPress: goTo(gui.State.Contexts.Files),
+		},
+		inProgress,
+		{
+			label:   lastFetch,
+			onPress: goTo(gui.State.Contexts.Remotes),
+		},
+		{
+			label: fmt.Sprintf(gui.c.Tr.DashboardStatusSpeedups,
+				gui.onOff(gui.git.Config.GetFsMonitor()), gui.onOff(gui.git.Config.GetUntrackedCache())),
+			onPress: gui.handleCreateStatusSpeedupsMenu,
+		},
+	}
+}
+
+func (gui *Gui) onOff(value bool) string {
+	if value {
+		return gui.c.Tr.DashboardOn
+	}
+	return gui.c.Tr.DashboardOff
+}
+
+func (gui *Gui) renderStatusDashboard() error {
+	items := gui.statusDashboardItems()
+	gui.State.StatusDashboardIdx = utils.Clamp(gui.State.StatusDashboardIdx, 0, len(items)-1)
+
+	lines := lo.Map(items, func(item statusDashboardItem, i int) string {
+		if i == gui.State.StatusDashboardIdx {
+			return theme.SelectedLineBgColor.Sprint(item.label)
+		}
+		return item.label
+	})
+
+	return gui.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: gui.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: gui.c.Tr.StatusTitle,
+			Task:  types.NewRenderStringTask(strings.Join(lines, "\n")),
+		},
+	})
+}
+
+func (gui *Gui) isShowingStatusDashboard() bool {
+	return gui.c.UserConfig.Gui.StatusPanelView == "dashboard" &&
+		gui.c.CurrentStaticContext().GetKey() == context.STATUS_CONTEXT_KEY
+}
+
+func (gui *Gui) handleStatusDashboardMove(change int) func() error {
+	return func() error {
+		if !gui.isShowingStatusDashboard() {
+			return nil
+		}
+
+		gui.State.StatusDashboardIdx += change
+		return gui.renderStatusDashboard()
+	}
+}
+
+func (gui *Gui) handleStatusDashboardPress() error {
+	if !gui.isShowingStatusDashboard() {
+		return nil
+	}
+
+	items := gui.statusDashboardItems()
+	if gui.State.StatusDashboardIdx >= len(items) || items[gui.State.StatusDashboardIdx].onPress == nil {
+		return nil
+	}
+
+	return items[gui.State.StatusDashboardIdx].onPress()
+}
+
+func (gui *Gui) handleStatusDashboardClick(opts gocui.ViewMouseBindingOpts) error {
+	if !gui.isShowingStatusDashboard() {
+		return nil
+	}
+
+	gui.State.StatusDashboardIdx = opts.Y
+	if err := gui.renderStatusDashboard(); err != nil {
+		return err
+	}
+
+	return gui.handleStatusDashboardPress()
+}
+
+// handleCreateStatusSpeedupsMenu offers to turn on (or off) the git features
+// that make git status fast in big repos
+func (gui *Gui) handleCreateStatusSpeedupsMenu() error {
+	afterSetting := func() error {
+		return gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STATUS}})
+	}
+
+	fsMonitor := gui.git.Config.GetFsMonitor()
+	fsMonitorLabel := gui.c.Tr.LcEnableFsMonitor
+	if fsMonitor {
+		fsMonitorLabel = gui.c.Tr.LcDisableFsMonitor
+	}
+
+	// we can only turn git's built-in monitor on or off, so we don't touch a
+	// hook like watchman that the user has set up
+	fsMonitorDisabledReason := ""
+	if hook := gui.git.Config.GetFsMonitorHook(); hook != "" {
+		fsMonitorDisabledReason = fmt.Sprintf(gui.c.Tr.FsMonitorHookSet, hook)
+	}
+
+	untrackedCache := gui.git.Config.GetUntrackedCache()
+	untrackedCacheLabel := gui.c.Tr.LcEnableUntrackedCache
+	if untrackedCache {
+		untrackedCacheLabel = gui.c.Tr.LcDisableUntrackedCache
+	}
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: gui.c.Tr.StatusSpeedupsTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: fsMonitorLabel,
+				OnPress: func() error {
+					gui.c.LogAction(gui.c.Tr.Actions.SetFsMonitor)
+					if err := gui.git.Status.SetFsMonitor(!fsMonitor); err != nil {
+						return gui.c.Error(err)
+					}
+					return afterSetting()
+				},
+				DisabledReason: fsMonitorDisabledReason,
+			},
+			{
+				Label: untrackedCacheLabel,
+				OnPress: func() error {
+					gui.c.LogAction(gui.c.Tr.Actions.SetUntrackedCache)
+					if err := gui.git.Status.SetUntrackedCache(!untrackedCache); err != nil {
+						return gui.c.Error(err)
+					}
+					return afterSetting()
+				},
+			},
+		},
+	})
+}
+
 func (gui *Gui) askForConfigFile(action func(file string) error) error {
 	confPaths := gui.Config.GetUserConfigPaths()
 	switch len(confPaths) {
@@ -27,10 +27,13 @@ func (gui *Gui) subCommitsRenderToMain() error {
 	if commit == nil {
 		task = types.NewRenderStringTask("No commits")
 	} else {
-		cmdObj := gui.git.Commit.ShowCmdObj(commit.Sha, gui.State.Modes.Filtering.GetPath(),
-			gui.IgnoreWhitespaceInDiffView)
+		filterPaths := gui.State.Modes.Filtering.GetPaths()
+		if filterPath := gui.State.Contexts.SubCommits.GetFilterPathForCommit(commit.Sha); filterPath != "" {
+			filterPaths = []string{filterPath}
+		}
+		cmdObj := gui.git.Commit.ShowCmdObj(commit.Sha, filterPaths, gui.IgnoreWhitespaceInDiffView)
 
-		task = types.NewRunPtyTask(cmdObj.GetCmd())
+		task = types.NewCacheableRunPtyTask(cmdObj.GetCmd())
 	}
 
 	return gui.c.RenderToMainViews(types.RefreshMainOpts{
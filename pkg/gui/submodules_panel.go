@@ -3,7 +3,9 @@ package gui
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -47,5 +49,37 @@ func (gui *Gui) enterSubmodule(submodule *models.SubmoduleConfig) error {
 	}
 	gui.RepoPathStack.Push(wd)
 
+	// we go into a nested submodule by way of its parents, so that returning
+	// takes us back up one level at a time
+	parentPaths := []string{}
+	for parent := submodule.ParentModule; parent != nil; parent = parent.ParentModule {
+		parentPaths = append([]string{filepath.Join(wd, parent.Path)}, parentPaths...)
+	}
+	for _, path := range parentPaths {
+		gui.RepoPathStack.Push(path)
+	}
+
 	return gui.dispatchSwitchToRepo(submodule.Path, true)
 }
+
+// loadSubmoduleStatuses works out the state of each submodule's checkout. This
+// takes a couple of git calls per submodule, so we do it in the background and
+// fill in the statuses once they're all in.
+func (gui *Gui) loadSubmoduleStatuses(stop chan struct{}) {
+	statuses, err := gui.git.Submodule.GetStatuses(stop)
+	if err != nil {
+		gui.c.Log.Error(err)
+		statuses = map[string]*git_commands.SubmoduleStatus{}
+	}
+
+	gui.c.OnUIThread(func() error {
+		// if the submodules have been reloaded in the meantime, that refresh
+		// will have kicked off a load of its own
+		if isStopped(stop) {
+			return nil
+		}
+
+		gui.State.Model.SubmoduleStatuses = statuses
+		return gui.c.PostRefreshUpdate(gui.State.Contexts.Submodules)
+	})
+}
@@ -7,6 +7,9 @@ func (gui *Gui) tagsRenderToMain() error {
 	tag := gui.State.Contexts.Tags.GetSelected()
 	if tag == nil {
 		task = types.NewRenderStringTask("No tags")
+	} else if tag.IsAnnotated {
+		cmdObj := gui.git.Tag.ShowCmdObj(tag.Name)
+		task = types.NewRunCommandTask(cmdObj.GetCmd())
 	} else {
 		cmdObj := gui.git.Branch.GetGraphCmdObj(tag.FullRefName())
 		task = types.NewRunCommandTask(cmdObj.GetCmd())
@@ -9,8 +9,15 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 )
 
-func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+// newCmdTask renders the command's output to the view. If given a cacheKey,
+// we'll render what we have cached for it rather than running the command, or
+// cache the output for next time.
+func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string, cacheKey string) error {
 	cmdStr := strings.Join(cmd.Args, " ")
+	if cacheKey != "" && gui.renderFromCache(view, cacheKey, cmdStr) {
+		return nil
+	}
+
 	gui.c.Log.WithField(
 		"command",
 		cmdStr,
@@ -35,13 +42,47 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 		return cmd, r
 	}
 
-	if err := manager.NewTask(manager.NewCmdTask(start, prefix, height+oy+10, nil), cmdStr); err != nil {
+	if err := manager.NewTask(manager.NewCmdTask(start, prefix, height+oy+10, nil, gui.cacheRenderedOutput(cacheKey)), cmdStr); err != nil {
 		gui.c.Log.Error(err)
 	}
 
 	return nil
 }
 
+// renderFromCache renders the cached output of a command, returning false if
+// there isn't any. We use the same task key as running the command would, so
+// that going between the two doesn't reset the view's origin.
+func (gui *Gui) renderFromCache(view *gocui.View, cacheKey string, taskKey string) bool {
+	output, ok := gui.renderCache.Get(cacheKey)
+	if !ok {
+		return false
+	}
+
+	manager := gui.getManager(view)
+
+	f := func(stop chan struct{}) error {
+		gui.setViewContent(view, output)
+		gui.render()
+		return nil
+	}
+
+	if err := manager.NewTask(f, taskKey); err != nil {
+		gui.c.Log.Error(err)
+	}
+
+	return true
+}
+
+func (gui *Gui) cacheRenderedOutput(cacheKey string) func(string) {
+	if cacheKey == "" {
+		return nil
+	}
+
+	return func(output string) {
+		gui.renderCache.Set(cacheKey, output)
+	}
+}
+
 func (gui *Gui) newStringTask(view *gocui.View, str string) error {
 	// using str so that if rendering the exact same thing we don't reset the origin
 	return gui.newStringTaskWithKey(view, str, str)
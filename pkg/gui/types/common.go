@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -51,6 +53,11 @@ type IGuiCommon interface {
 	GetAppState() *config.AppState
 	SaveAppState() error
 
+	// Runs the given function once all the commits have been loaded, straight
+	// away if they already have been. It replaces whatever was waiting to run
+	// before, and runs on the UI thread.
+	AfterCommitsLoaded(f func() error) error
+
 	// Runs the given function on the UI thread (this is for things like showing a popup asking a user for input).
 	// Only necessary to call if you're not already on the UI thread i.e. you're inside a goroutine.
 	// All controller handlers are executed on the UI thread.
@@ -82,6 +89,8 @@ type CreateMenuOptions struct {
 	Title      string
 	Items      []*MenuItem
 	HideCancel bool
+	// the index of the item to select when the menu opens
+	SelectedIdx int
 }
 
 type CreatePopupPanelOpts struct {
@@ -94,6 +103,7 @@ type CreatePopupPanelOpts struct {
 	HandleClose         func() error
 
 	FindSuggestionsFunc func(string) []*Suggestion
+	SuggestionActions   []*SuggestionAction
 	Mask                bool
 }
 
@@ -112,7 +122,9 @@ type PromptOpts struct {
 	Title               string
 	InitialContent      string
 	FindSuggestionsFunc func(string) []*Suggestion
-	HandleConfirm       func(string) error
+	// keybindings available while the suggestions panel is focused
+	SuggestionActions []*SuggestionAction
+	HandleConfirm     func(string) error
 	// CAPTURE THIS
 	HandleClose func() error
 	Mask        bool
@@ -135,6 +147,10 @@ type MenuItem struct {
 
 	// The tooltip will be displayed upon highlighting the menu item
 	Tooltip string
+
+	// If set, the item is greyed out and pressing it shows this reason rather
+	// than calling OnPress
+	DisabledReason string
 }
 
 type Model struct {
@@ -160,6 +176,27 @@ type Model struct {
 	RemoteBranches                      []*models.RemoteBranch
 	Tags                                []*models.Tag
 
+	// keyed by branch name. These are loaded in the background after the
+	// branches themselves, so may be missing or stale
+	BranchDivergences map[string]*git_commands.Divergence
+
+	// keyed by submodule path. Loaded in the background like the divergences
+	SubmoduleStatuses map[string]*git_commands.SubmoduleStatus
+
+	// keyed by remote name. Only covers the fetches made while lazygit is open
+	RemoteFetchTimes map[string]time.Time
+
+	// keyed by branch name. Fetched from the hosting service once per repo per
+	// session, and again on an explicit refresh, if git.showPullRequestStatus is
+	// on. Nil until they've come in
+	PullRequests map[string]*models.PullRequest
+	// whether PullRequests have been asked for (again) since the repo was opened
+	// or the user last refreshed
+	PullRequestsRequested bool
+
+	// the lines of the file shown in the blame view
+	BlameLines []*models.BlameLine
+
 	// for displaying suggestions while typing in a file name
 	FilesTrie *patricia.Trie
 }
@@ -4,4 +4,6 @@ type CheckoutRefOptions struct {
 	WaitingStatus string
 	EnvVars       []string
 	OnRefNotFound func(ref string) error
+	// check the branch out even if it's already checked out in another worktree
+	IgnoreOtherWorktrees bool
 }
@@ -89,6 +89,7 @@ type IListContext interface {
 	GetSelectedItemId() string
 
 	GetList() IList
+	CanSelectRange() bool
 
 	OnSearchSelect(selectedLineIdx int) error
 	FocusLine()
@@ -122,6 +123,7 @@ type IViewTrait interface {
 	PageDelta() int
 	SelectedLineIdx() int
 	SetHighlight(bool)
+	HighlightLines(startIdx int, endIdx int)
 }
 
 type OnFocusOpts struct {
@@ -167,6 +169,10 @@ type IListCursor interface {
 	SetSelectedLineIdx(value int)
 	MoveSelectedLine(delta int)
 	RefreshSelectedIdx()
+	SetRangeSelectStart(value int)
+	CancelRangeSelect()
+	IsSelectingRange() bool
+	GetSelectionRange() (int, int)
 }
 
 type IListPanelState interface {
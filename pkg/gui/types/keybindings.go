@@ -4,6 +4,10 @@ import "github.com/jesseduffield/gocui"
 
 type Key interface{} // FIXME: find out how to get `gocui.Key | rune`
 
+// KeySequence is a Key made up of several keys which need to be pressed one
+// after the other, e.g. 'g' then 'p'
+type KeySequence []Key
+
 // Binding - a keybinding mapping a key and modifier to a handler. The keypress
 // is only handled if the given view has focus, or handled globally if the view
 // is ""
@@ -19,6 +23,10 @@ type Binding struct {
 
 	// to be displayed if the keybinding is highlighted from within a menu
 	Tooltip string
+
+	// if this returns a non-empty string, the binding is unavailable: the handler
+	// isn't called and the reason is shown to the user instead
+	GetDisabledReason func() string
 }
 
 // A guard is a decorator which checks something before executing a handler
@@ -2,12 +2,16 @@ package types
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/comparing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/pickaxe"
 )
 
 type Modes struct {
 	Filtering     filtering.Filtering
 	CherryPicking *cherrypicking.CherryPicking
 	Diffing       diffing.Diffing
+	Comparing     comparing.Comparing
+	Pickaxe       pickaxe.Pickaxe
 }
@@ -34,4 +34,8 @@ type RefreshOptions struct {
 	Then  func()
 	Scope []RefreshableView // e.g. []int{COMMITS, BRANCHES}. Leave empty to refresh everything
 	Mode  RefreshMode       // one of SYNC (default), ASYNC, and BLOCK_UI
+	// if set, the refresh is put off for a moment so that it can be collapsed
+	// with any others of the same scopes that come in meanwhile. Then and Mode
+	// are ignored.
+	Debounce bool
 }
@@ -24,6 +24,9 @@ type ViewUpdateOpts struct {
 	Title string
 
 	Task UpdateTask
+
+	// text to pick out wherever it appears in the view's content
+	Highlight string
 }
 
 type RefreshMainOpts struct {
@@ -86,6 +89,9 @@ func NewRunCommandTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunCommandTask {
 type RunPtyTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// the command's output only depends on the command itself, so we can reuse
+	// it when running the same command again
+	Cacheable bool
 }
 
 func (t *RunPtyTask) IsUpdateTask() {}
@@ -93,3 +99,9 @@ func (t *RunPtyTask) IsUpdateTask() {}
 func NewRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
 	return &RunPtyTask{Cmd: cmd}
 }
+
+// NewCacheableRunPtyTask is for commands showing something that can't change,
+// like a commit by its sha
+func NewCacheableRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
+	return &RunPtyTask{Cmd: cmd, Cacheable: true}
+}
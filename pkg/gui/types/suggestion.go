@@ -6,3 +6,12 @@ type Suggestion struct {
 	// label is what is actually displayed so it can e.g. contain color
 	Label string
 }
+
+// SuggestionAction is a keybinding that acts on the selected suggestion without
+// confirming the prompt, e.g. to remove it from the list of suggestions. The
+// suggestions are refreshed afterwards.
+type SuggestionAction struct {
+	Key         Key
+	Description string
+	Handler     func(value string) error
+}
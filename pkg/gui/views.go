@@ -25,6 +25,7 @@ type Views struct {
 	PatchBuilding          *gocui.View
 	PatchBuildingSecondary *gocui.View
 	MergeConflicts         *gocui.View
+	Blame                  *gocui.View
 
 	Options       *gocui.View
 	Confirmation  *gocui.View
@@ -79,6 +80,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.PatchBuilding, name: "patchBuilding"},
 		{viewPtr: &gui.Views.PatchBuildingSecondary, name: "patchBuildingSecondary"},
 		{viewPtr: &gui.Views.MergeConflicts, name: "mergeConflicts"},
+		{viewPtr: &gui.Views.Blame, name: "blame"},
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
@@ -183,6 +185,10 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.MergeConflicts.Highlight = false
 	gui.Views.MergeConflicts.Wrap = false
 
+	gui.Views.Blame.Title = gui.c.Tr.BlameTitle
+	gui.Views.Blame.FgColor = theme.GocuiDefaultTextColor
+	gui.Views.Blame.CanScrollPastBottom = gui.c.UserConfig.Gui.ScrollPastBottom
+
 	gui.Views.Limit.Title = gui.c.Tr.NotEnoughSpace
 	gui.Views.Limit.Wrap = true
 
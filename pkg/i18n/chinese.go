@@ -203,7 +203,6 @@ func chineseTranslationSet() TranslationSet {
 		ConflictsResolved:                   "已解决所有冲突。是否继续？",
 		RebasingTitle:                       "变基",
 		ConfirmRebase:                       "您确定要将分支 {{.checkedOutBranch}} 变基到 {{.selectedBranch}} 吗？",
-		ConfirmMerge:                        "您确定要将分支 {{.selectedBranch}} 合并到 {{.checkedOutBranch}} 吗？",
 		FwdNoUpstream:                       "此分支没有上游，无法快进",
 		FwdNoLocalUpstream:                  "此分支的远程未在本地注册，无法快进",
 		FwdCommitsToPush:                    "此分支带有尚未推送的提交，无法快进",
@@ -343,7 +342,6 @@ func chineseTranslationSet() TranslationSet {
 		Keybindings:                         "按键绑定",
 		LcRenameBranch:                      "重命名分支",
 		NewBranchNamePrompt:                 "输入分支的新名称",
-		RenameBranchWarning:                 "该分支正在跟踪远程仓库。此操作将仅会重命名本地分支名称，而不会重命名远程分支的名称。确定继续？",
 		LcOpenMenu:                          "打开菜单",
 		LcResetCherryPick:                   "重置已拣选（复制）的提交",
 		LcNextTab:                           "下一个标签",
@@ -169,7 +169,6 @@ func dutchTranslationSet() TranslationSet {
 		RebasingTitle:                       "Rebasen",
 		MergingTitle:                        "Mergen",
 		ConfirmRebase:                       "Weet je zeker dat je '{{.checkedOutBranch}}' op '{{.selectedBranch}}' wil rebasen?",
-		ConfirmMerge:                        "Weet je zeker dat je '{{.selectedBranch}}' in '{{.checkedOutBranch}}' wil mergen?",
 		FwdNoUpstream:                       "Kan niet de branch vooruitspoelen zonder upstream",
 		FwdCommitsToPush:                    "Je kan niet vooruitspoelen als de branch geen nieuwe commits heeft",
 		ErrorOccurred:                       "Er is iets fout gegaan! Zou je hier een issue aan willen maken",
@@ -298,7 +297,6 @@ func dutchTranslationSet() TranslationSet {
 		Keybindings:                         "Sneltoetsen",
 		LcRenameBranch:                      "hernoem branch",
 		NewBranchNamePrompt:                 "Noem een nieuwe branch naam",
-		RenameBranchWarning:                 "Deze branch volgt een remote. Deze actie zal alleen de locale branch name wijzigen niet de naam van de remote branch. Verder gaan?",
 		LcOpenMenu:                          "open menu",
 		LcResetCherryPick:                   "reset cherry-picked (gekopieerde) commits selectie",
 		LcNextTab:                           "volgende tabblad",
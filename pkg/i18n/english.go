@@ -32,6 +32,9 @@ type TranslationSet struct {
 	CredentialsPassword                 string
 	CredentialsPassphrase               string
 	CredentialsPIN                      string
+	CredentialsTwoFactorCode            string
+	UnknownHostTitle                    string
+	CredentialRequestCancelled          string
 	PassUnameWrong                      string
 	CommitChanges                       string
 	AmendLastCommit                     string
@@ -40,14 +43,37 @@ type TranslationSet struct {
 	NoCommitToAmend                     string
 	CommitChangesWithEditor             string
 	StatusTitle                         string
+	TrustRepoConfigTitle                string
+	TrustRepoConfigPrompt               string
+	DashboardStashes                    string
+	DashboardWorktrees                  string
+	DashboardBranchesAhead              string
+	DashboardConflictedFiles            string
+	DashboardInProgress                 string
+	DashboardNothingInProgress          string
+	DashboardBisecting                  string
+	DashboardLastFetch                  string
+	DashboardNeverFetched               string
+	DashboardStatusSpeedups             string
+	DashboardOn                         string
+	DashboardOff                        string
+	LcGoToDashboardItem                 string
 	GlobalTitle                         string
+	LcOpenCommandPalette                string
+	CommandPaletteTitle                 string
+	CommandPaletteContextNotOpen        string
+	PendingKeySequence                  string
 	LcNavigate                          string
 	LcMenu                              string
 	LcExecute                           string
 	LcToggleStaged                      string
 	LcToggleStagedAll                   string
 	LcToggleTreeView                    string
+	LcCollapseAllFiles                  string
+	LcExpandAllFiles                    string
+	LcExpandFilesToDefaultDepth         string
 	LcOpenMergeTool                     string
+	LcOpenMergeToolForFile              string
 	LcRefresh                           string
 	LcPush                              string
 	LcPull                              string
@@ -66,6 +92,10 @@ type TranslationSet struct {
 	AlreadyCheckedOutBranch             string
 	SureForceCheckout                   string
 	ForceCheckoutBranch                 string
+	DetachedCommitsTitle                string
+	LcCreateBranchAtHead                string
+	LcCreateTagAtHead                   string
+	LcLeaveDetachedCommitsBehind        string
 	BranchName                          string
 	NewBranchNameBranchOff              string
 	CantDeleteCheckOutBranch            string
@@ -82,6 +112,20 @@ type TranslationSet struct {
 	NoBranchesThisRepo                  string
 	CommitMessageConfirm                string
 	CommitWithoutMessageErr             string
+	CommitMessageWithWarnings           string
+	CommitSubjectTooLong                string
+	CommitNoBlankLineAfterSubject       string
+	CommitSubjectMismatch               string
+	InvalidCommitSubjectRegex           string
+	CommitSubjectMismatchTitle          string
+	CommitSubjectMismatchPrompt         string
+	AddCommitTrailer                    string
+	LcAddCommitTrailer                  string
+	AddCoAuthor                         string
+	LcAddCoAuthor                       string
+	LcAddIssueTrailer                   string
+	CoAuthorAlreadyAdded                string
+	IssueNumber                         string
 	CloseConfirm                        string
 	LcClose                             string
 	LcQuit                              string
@@ -95,6 +139,7 @@ type TranslationSet struct {
 	LcPickCommit                        string
 	LcRevertCommit                      string
 	LcRewordCommit                      string
+	LcRewordCommitsInEditor             string
 	LcDeleteCommit                      string
 	LcMoveDownCommit                    string
 	LcMoveUpCommit                      string
@@ -102,6 +147,13 @@ type TranslationSet struct {
 	LcAmendToCommit                     string
 	LcResetCommitAuthor                 string
 	SetAuthorPromptTitle                string
+	SetAuthorDatePromptTitle            string
+	LcChangeAuthorAndDate               string
+	LcChangeAuthorAndDateOfCommitsAbove string
+	ChangeAuthorAndDateTooltip          string
+	CantChangeAuthorWhileRebasing       string
+	RewriteMergedCommitsTitle           string
+	RewriteMergedCommitsPrompt          string
 	SureResetCommitAuthor               string
 	LcRenameCommitEditor                string
 	NoCommitsThisBranch                 string
@@ -110,6 +162,7 @@ type TranslationSet struct {
 	LcNavigateConflicts                 string
 	LcPickHunk                          string
 	LcPickAllHunks                      string
+	LcPickAllHunksBottomFirst           string
 	LcUndo                              string
 	LcUndoReflog                        string
 	LcRedoReflog                        string
@@ -129,15 +182,47 @@ type TranslationSet struct {
 	SureApplyStashEntry                 string
 	NoTrackedStagedFilesStash           string
 	NoFilesToStash                      string
+	NoMatchingMenuItem                  string
 	StashChanges                        string
 	LcRenameStash                       string
+	LcStashBranch                       string
+	StashBranchPrompt                   string
+	LcApplyStashOntoBranch              string
+	ApplyStashOntoBranchTitle           string
+	ApplyStashOntoBranchMenuTitle       string
+	ApplyStashOntoBranchAutoStashPrompt string
 	RenameStashPrompt                   string
 	OpenConfig                          string
 	EditConfig                          string
+	LcReloadConfig                      string
+	ConfigReloaded                      string
 	ForcePush                           string
 	ForcePushPrompt                     string
 	ForcePushDisabled                   string
 	UpdatesRejectedAndForcePushDisabled string
+	LcViewPushOptions                   string
+	PushOptions                         string
+	LcForcePushWithLease                string
+	LcForcePushWithLeaseIfIncludes      string
+	LcPushToOtherRemote                 string
+	LcPushToAllRemotes                  string
+	PushToRemote                        string
+	NoUpstreamToForcePushTo             string
+	ForceIfIncludesNotSupported         string
+	NoRemotes                           string
+	NoPushRemotesConfigured             string
+	PushedToAllRemotes                  string
+	PushToAllRemotesFailed              string
+	PushedToRemote                      string
+	FailedToPushToRemote                string
+	LcViewPullOptions                   string
+	PullOptions                         string
+	LcPullRebase                        string
+	LcPullMerge                         string
+	LcPullFastForwardOnly               string
+	LcFetchOnly                         string
+	CannotFastForwardTitle              string
+	CannotFastForwardPrompt             string
 	LcCheckForUpdate                    string
 	CheckingForUpdates                  string
 	UpdateAvailableTitle                string
@@ -154,12 +239,21 @@ type TranslationSet struct {
 	ConfirmQuitDuringUpdate             string
 	MergeToolTitle                      string
 	MergeToolPrompt                     string
+	MergeToolResolvedTitle              string
+	FileHasNoMergeConflicts             string
+	MergeToolResolvedPrompt             string
 	IntroPopupMessage                   string
 	GitconfigParseErr                   string
 	LcEditFile                          string
 	LcOpenFile                          string
+	LcOpenDiffTool                      string
 	LcIgnoreFile                        string
 	LcExcludeFile                       string
+	LcIgnoreFileInDirectory             string
+	IgnorePatternTitle                  string
+	IgnorePatternEmptyErr               string
+	IgnorePatternMatchedFiles           string
+	LcUntrackFile                       string
 	LcRefreshFiles                      string
 	LcMergeIntoCurrentBranch            string
 	ConfirmQuit                         string
@@ -188,6 +282,7 @@ type TranslationSet struct {
 	FoundConflictsTitle                 string
 	PickHunk                            string
 	PickAllHunks                        string
+	PickAllHunksBottomFirst             string
 	ViewMergeRebaseOptions              string
 	NotMergingOrRebasing                string
 	RecentRepos                         string
@@ -207,7 +302,17 @@ type TranslationSet struct {
 	ConflictsResolved                   string
 	RebasingTitle                       string
 	ConfirmRebase                       string
-	ConfirmMerge                        string
+	ConfirmRebaseOnto                   string
+	RebaseOntoRef                       string
+	LcRebaseOntoRef                     string
+	LcRebaseOntoRefFromUpstream         string
+	RebaseOntoRefPromptTitle            string
+	RebaseOntoUpstreamPromptTitle       string
+	MergeBranchMenuTitle                string
+	LcRegularMerge                      string
+	LcNonFastForwardMerge               string
+	LcSquashMerge                       string
+	SquashMergeNoChanges                string
 	FwdNoUpstream                       string
 	FwdNoLocalUpstream                  string
 	FwdCommitsToPush                    string
@@ -237,6 +342,28 @@ type TranslationSet struct {
 	LcScrollDownMainPanel               string
 	AmendCommitTitle                    string
 	AmendCommitPrompt                   string
+	AmendPushedCommitPrompt             string
+	NoStagedChangesToAmendWith          string
+	LcAbsorbStagedChanges               string
+	AbsorbStagedChanges                 string
+	AbsorbPrompt                        string
+	AbsorbSkippedHunks                  string
+	NoStagedChangesToAbsorb             string
+	NothingToAbsorb                     string
+	AbsorbNewOrRenamedFile              string
+	AbsorbBinaryFile                    string
+	AbsorbNoCommitOnBranch              string
+	SquashAbsorbedFixupsTitle           string
+	SquashAbsorbedFixupsPrompt          string
+	CreatingFixupCommitsStatus          string
+	LcCreateFixupCommitsByFile          string
+	CreateFixupCommitsByFile            string
+	CreateFixupCommits                  string
+	NoBranchCommitsToFixup              string
+	NoFilesToFixup                      string
+	FixupByFileNoCommitOnBranch         string
+	FixupByFileChangeTargetTooltip      string
+	LeaveFileStaged                     string
 	DeleteCommitTitle                   string
 	DeleteCommitPrompt                  string
 	SquashingStatus                     string
@@ -248,10 +375,31 @@ type TranslationSet struct {
 	CherryPickingStatus                 string
 	UndoingStatus                       string
 	RedoingStatus                       string
+	ResettingStatus                     string
 	CheckingOutStatus                   string
 	CommittingStatus                    string
 	CommitFiles                         string
 	SubCommitsDynamicTitle              string
+	FileHistoryDynamicTitle             string
+	LcViewFileHistory                   string
+	LcCheckoutFileVersion               string
+	OnlyAvailableInFileHistory          string
+	LcViewSkipWorktreeOptions           string
+	LcMarkSkipWorktree                  string
+	LcUnmarkSkipWorktree                string
+	LcMarkAssumeUnchanged               string
+	LcUnmarkAssumeUnchanged             string
+	NoTrackedFilesToFlag                string
+	LcViewFlaggedFiles                  string
+	FlaggedFilesTitle                   string
+	NoFlaggedFiles                      string
+	LcViewStatusSpeedups                string
+	StatusSpeedupsTitle                 string
+	LcEnableFsMonitor                   string
+	LcDisableFsMonitor                  string
+	FsMonitorHookSet                    string
+	LcEnableUntrackedCache              string
+	LcDisableUntrackedCache             string
 	CommitFilesDynamicTitle             string
 	RemoteBranchesDynamicTitle          string
 	LcViewItemFiles                     string
@@ -260,6 +408,8 @@ type TranslationSet struct {
 	LcDiscardOldFileChange              string
 	DiscardFileChangesTitle             string
 	DiscardFileChangesPrompt            string
+	DiscardDirectoryChangesPrompt       string
+	CanOnlyDiscardFromLocalCommits      string
 	DisabledForGPG                      string
 	CreateRepo                          string
 	BareRepo                            string
@@ -275,6 +425,44 @@ type TranslationSet struct {
 	LcDiscardUnstagedChanges            string
 	LcDiscardAllChangesToAllFiles       string
 	LcDiscardAnyUnstagedChanges         string
+	LcCleanUntrackedFiles               string
+	LcTogglePinnedRepo                  string
+	LcRemoveRecentRepo                  string
+	LcPinnedRepo                        string
+	RepoNotFoundTitle                   string
+	RepoNotFoundPrompt                  string
+	NoHostingServiceToken               string
+	PullRequestStatusNotSupported       string
+	FailedToLoadPullRequests            string
+	LcOpenPullRequestInBrowser          string
+	LcCopyFileURL                       string
+	FileURLCopiedToClipboard            string
+	BlameTitle                          string
+	BlameDynamicTitle                   string
+	LcViewBlame                         string
+	LcGoToBlameCommit                   string
+	LcReblameFromParent                 string
+	LcExitBlame                         string
+	BlameLineNotCommitted               string
+	BlameCommitNotFound                 string
+	NoEarlierBlame                      string
+	BlamingStatus                       string
+	LcSearchCommitContents              string
+	LcSearchCommitContentsByRegex       string
+	LcStopSearchingCommitContents       string
+	SearchCommitContentsTitle           string
+	SearchCommitContentsByRegexTitle    string
+	SearchingCommitContentsStatus       string
+	LcCommitsAddingOrRemoving           string
+	LcCommitsWithChangesMatching        string
+	CleanUntrackedFilesTitle            string
+	LcIncludeIgnoredFiles               string
+	LcDeleteTickedPaths                 string
+	NothingTickedToClean                string
+	LcContainsGitRepository             string
+	CleanUntrackedFilesPrompt           string
+	CleanNestedReposTitle               string
+	CleanNestedReposPrompt              string
 	LcDiscardUntrackedFiles             string
 	LcDiscardStagedChanges              string
 	LcHardReset                         string
@@ -296,6 +484,7 @@ type TranslationSet struct {
 	LcStashStagedChanges                string
 	LcStashAllChangesKeepIndex          string
 	LcStashUnstagedChanges              string
+	LcStashSelectedPath                 string
 	LcStashIncludeUntrackedChanges      string
 	LcStashOptions                      string
 	NotARepository                      string
@@ -322,6 +511,15 @@ type TranslationSet struct {
 	LcNewRemoteUrl                      string
 	LcEditRemoteName                    string
 	LcEditRemoteUrl                     string
+	EditRemoteMenuTitle                 string
+	LcRenameRemote                      string
+	LcEditRemoteFetchUrl                string
+	LcSetRemotePushUrl                  string
+	LcEditRemotePushUrl                 string
+	LcRemoveRemotePushUrl               string
+	LcEnablePruneOnFetch                string
+	LcDisablePruneOnFetch               string
+	InvalidRemoteUrl                    string
 	LcRemoveRemote                      string
 	LcRemoveRemotePrompt                string
 	DeleteRemoteBranch                  string
@@ -343,10 +541,24 @@ type TranslationSet struct {
 	DeleteTagPrompt                     string
 	PushTagTitle                        string
 	LcPushTag                           string
+	LcEditTagMessage                    string
+	EditTagMessageTitle                 string
+	EditLightweightTagMessageError      string
+	ForcePushTagTitle                   string
+	ForcePushTagPrompt                  string
+	LightweightTagIndicator             string
 	LcCreateTag                         string
 	CreateTagTitle                      string
 	LcFetchRemote                       string
 	FetchingRemoteStatus                string
+	LcFetchAllRemotes                   string
+	FetchingAllRemotesStatus            string
+	NoRemotesToFetch                    string
+	FetchedRemote                       string
+	FailedToFetchRemote                 string
+	FetchAllRemotesFailed               string
+	RemoteFetchedAgo                    string
+	RemotePushesTo                      string
 	LcCheckoutCommit                    string
 	SureCheckoutThisCommit              string
 	LcGitFlowOptions                    string
@@ -356,6 +568,10 @@ type TranslationSet struct {
 	ExcludeTracked                      string
 	IgnoreTrackedPrompt                 string
 	ExcludeTrackedPrompt                string
+	UntrackFileNotTrackedErr            string
+	UntrackFileStagedChangesErr         string
+	UntrackFileIgnoreTitle              string
+	UntrackFileIgnorePrompt             string
 	LcViewResetToUpstreamOptions        string
 	LcNextScreenMode                    string
 	LcPrevScreenMode                    string
@@ -364,14 +580,39 @@ type TranslationSet struct {
 	Keybindings                         string
 	LcRenameBranch                      string
 	LcSetUnsetUpstream                  string
+	LcSetBaseBranch                     string
+	SetBaseBranchPrompt                 string
+	LcCreateWorktree                    string
+	LcCreateWorktreeFromRemoteBranch    string
+	CreateWorktreeMenuTitle             string
+	LcCreateWorktreeFromBranch          string
+	LcCreateDetachedWorktree            string
+	CreateWorktreeBranchPrompt          string
+	CreateWorktreeRefPrompt             string
+	CreateWorktreePathPrompt            string
+	CreateWorktreeBranchNotFound        string
+	SwitchToWorktreeTitle               string
+	SwitchToWorktreePrompt              string
+	BranchInOtherWorktreeTitle          string
+	LcSwitchToWorktreeAtPath            string
+	LcCheckoutIgnoringOtherWorktrees    string
 	NewGitFlowBranchPrompt              string
-	RenameBranchWarning                 string
+	RenameBranchOnRemoteTitle           string
+	RenameBranchOnRemotePrompt          string
+	RenameBranchOnRemoteFailed          string
 	LcOpenMenu                          string
 	LcResetCherryPick                   string
 	LcNextTab                           string
 	LcPrevTab                           string
 	LcCantUndoWhileRebasing             string
 	LcCantRedoWhileRebasing             string
+	LcRestoreToReflogEntry              string
+	RestoreToReflogEntry                string
+	RestoreToReflogEntryResetPrompt     string
+	RestoreToReflogEntryCheckoutPrompt  string
+	RestoreToReflogEntryBranchPrompt    string
+	RestoreToReflogEntryPreviewTitle    string
+	CantRestoreReflogEntryWhileRebasing string
 	MustStashWarning                    string
 	MustStashTitle                      string
 	ConfirmationTitle                   string
@@ -385,6 +626,14 @@ type TranslationSet struct {
 	LcFilterBy                          string
 	LcExitFilterMode                    string
 	LcFilterPathOption                  string
+	LcEditFilterPaths                   string
+	EnterFilterPaths                    string
+	LcFilterAuthorOption                string
+	EnterFilterAuthor                   string
+	LcExitFilterAuthorMode              string
+	LcExitAllFilterModes                string
+	LcFilteringByAuthor                 string
+	LcFilteringByPathsAndAuthor         string
 	EnterFileName                       string
 	FilteringMenuTitle                  string
 	MustExitFilterModeTitle             string
@@ -423,6 +672,8 @@ type TranslationSet struct {
 	LcViewCommits                       string
 	MinGitVersionError                  string
 	LcRunningCustomCommandStatus        string
+	CustomCommandUnansweredFormKey      string
+	CustomCommandDisabled               string
 	LcSubmoduleStashAndReset            string
 	LcAndResetSubmodules                string
 	LcEnterSubmodule                    string
@@ -443,9 +694,17 @@ type TranslationSet struct {
 	LcInitSubmodule                     string
 	LcSubmoduleUpdate                   string
 	LcUpdatingSubmoduleStatus           string
+	NestedSubmoduleError                string
+	SubmoduleUninitialized              string
+	SubmoduleOutOfSync                  string
+	SubmoduleConflicted                 string
+	SubmoduleDirty                      string
 	LcBulkInitSubmodules                string
 	LcBulkUpdateSubmodules              string
 	LcBulkDeinitSubmodules              string
+	LcBulkUpdateSubmodulesToBranches    string
+	LcUpdateSubmoduleToTrackedBranch    string
+	SubmoduleTrackedBranchPrompt        string
 	LcViewBulkSubmoduleOptions          string
 	LcBulkSubmoduleOptions              string
 	LcRunningCommand                    string
@@ -457,6 +716,7 @@ type TranslationSet struct {
 	SuggestionsTitle                    string
 	ExtrasTitle                         string
 	PushingTagStatus                    string
+	CheckingRemotesForTagStatus         string
 	PullRequestURLCopiedToClipboard     string
 	CommitDiffCopiedToClipboard         string
 	CommitSHACopiedToClipboard          string
@@ -465,15 +725,35 @@ type TranslationSet struct {
 	CommitAuthorCopiedToClipboard       string
 	PatchCopiedToClipboard              string
 	LcCopiedToClipboard                 string
+	LcCopyPopupContentToClipboard       string
+	PopupContentCopiedToClipboard       string
 	ErrCannotEditDirectory              string
+	ErrCannotDiffUntrackedFile          string
 	ErrStageDirWithInlineMergeConflicts string
 	ErrRepositoryMovedOrDeleted         string
 	CommandLog                          string
 	ToggleShowCommandLog                string
 	FocusCommandLog                     string
+	ViewCommandHistory                  string
+	CommandHistoryTitle                 string
+	CommandHistoryUser                  string
+	CommandHistoryBackground            string
+	CommandHistoryExitCode              string
+	CommandHistoryKilled                string
+	CommandHistoryFailedToStart         string
+	LcCopyCommandToClipboard            string
+	LcRunCommandAgain                   string
+	LcShowCommandOutput                 string
+	RunCommandAgainTitle                string
+	RunCommandAgainPrompt               string
+	LcRunningCommandAgainStatus         string
+	CommandOutputTitle                  string
+	NoCommandOutput                     string
 	CommandLogHeader                    string
 	RandomTip                           string
 	SelectParentCommitForMerge          string
+	ParentOnCurrentBranch               string
+	RevertConflictsPrompt               string
 	ToggleWhitespaceInDiffView          string
 	IgnoringWhitespaceInDiffView        string
 	ShowingWhitespaceInDiffView         string
@@ -494,6 +774,31 @@ type TranslationSet struct {
 	AbortTitle                          string
 	AbortPrompt                         string
 	LcOpenLogMenu                       string
+	LcVerifyCommitSignature             string
+	VerifyCommitSignatureTitle          string
+	LcToggleMarkCommit                  string
+	DeleteCommitsPrompt                 string
+	CantDropCommitsWhileRebasing        string
+	CantRebaseOverMergeCommit           string
+	LcMoveCommitsToBranch               string
+	MoveCommitsToBranchTitle            string
+	CantMoveMergeCommits                string
+	CantMoveCommitsWhileRebasing        string
+	CantMoveCommitsToCheckedOutBranch   string
+	MoveCommitsBranchNotFound           string
+	MoveCommitsCherryPickFailed         string
+	MoveCommitsDropFailed               string
+	LcAddExecTodo                       string
+	LcAddBreakTodo                      string
+	ExecTodoTitle                       string
+	NotAllowedForTodoCommand            string
+	CantMovePastRebaseMergesTodo        string
+	CantAddTodoToAppliedCommit          string
+	LcCompareCommits                    string
+	CompareCommitsMenuTitle             string
+	LcCompareCommitsDirectly            string
+	LcCompareCommitsSinceMergeBase      string
+	LcComparingFrom                     string
 	LogMenuTitle                        string
 	ToggleShowGitGraphAll               string
 	ShowGitGraph                        string
@@ -504,6 +809,7 @@ type TranslationSet struct {
 	ConfirmRevertCommit                 string
 	RewordInEditorTitle                 string
 	RewordInEditorPrompt                string
+	RewordCommitsInEditorPrompt         string
 	CheckoutPrompt                      string
 	HardResetAutostashPrompt            string
 	UpstreamGone                        string
@@ -512,8 +818,44 @@ type TranslationSet struct {
 	EmptyOutput                         string
 	Patch                               string
 	CustomPatch                         string
+	LcGoToPatchFile                     string
+	PatchFileFromOtherCommit            string
 	LcCommitsCopied                     string
 	LcCommitCopied                      string
+	EditPatchLine                       string
+	EditPatchLineTitle                  string
+	CanOnlyEditAddedLinesError          string
+	ToggleMarkLine                      string
+	StageInvertedSelection              string
+	StageSelectionSkippingWhitespace    string
+	AddInvertedSelectionToPatch         string
+	CommitSelection                     string
+	CommitSelectionTitle                string
+	CommitSelectionWithStagedPrompt     string
+	StashSelection                      string
+	StashSelectionHasStagedChangesError string
+	CannotApplyPatchToWorkingTree       string
+	ToggleCollapseHunk                  string
+	CantMoveMultiCommitPatch            string
+	PartialDeletionAppliedAsWhole       string
+	SavePatchToFileTitle                string
+	PatchSavedToFile                    string
+	SplitHunk                           string
+	ToggleDiffLayout                    string
+	CannotShowDiffSideBySide            string
+	GoToLine                            string
+	GoToLineTitle                       string
+	InvalidLineNumber                   string
+	LineNotInDiff                       string
+	CopySelectedLines                   string
+	CopySelectedLinesWithoutPrefixes    string
+	CopySelectedLinesAsDiff             string
+	CopySelectedNewLines                string
+	SelectedLinesCopiedToClipboard      string
+	HunkCannotBeSplit                   string
+	CannotDiscardLines                  string
+	CannotStageLinesOfBinaryFile        string
+	CannotStageLinesOfConflictedFile    string
 	Actions                             Actions
 	Bisect                              Bisect
 }
@@ -531,6 +873,13 @@ type Bisect struct {
 	CompleteTitle               string
 	CompletePrompt              string
 	CompletePromptIndeterminate string
+	RunCommand                  string
+	RunCommandTitle             string
+	RunningStatus               string
+	CancelRun                   string
+	CancelRunAndReset           string
+	RunCompletePrompt           string
+	RunCompleteIndeterminate    string
 }
 
 type Actions struct {
@@ -540,9 +889,13 @@ type Actions struct {
 	ForceCheckoutBranch               string
 	DeleteBranch                      string
 	Merge                             string
+	SquashMerge                       string
 	RebaseBranch                      string
 	RenameBranch                      string
+	RenameBranchOnRemote              string
 	SetUnsetUpstream                  string
+	SetBaseBranch                     string
+	CreateWorktree                    string
 	CreateBranch                      string
 	FastForwardBranch                 string
 	CherryPick                        string
@@ -551,20 +904,29 @@ type Actions struct {
 	SquashCommitDown                  string
 	FixupCommit                       string
 	RewordCommit                      string
+	RewordCommits                     string
 	DropCommit                        string
+	DropCommits                       string
+	MoveCommitsToBranch               string
+	AddExecTodo                       string
+	AddBreakTodo                      string
 	EditCommit                        string
 	AmendCommit                       string
 	ResetCommitAuthor                 string
 	SetCommitAuthor                   string
+	ChangeCommitAuthorAndDate         string
 	RevertCommit                      string
 	CreateFixupCommit                 string
 	SquashAllAboveFixupCommits        string
+	AbsorbStagedChanges               string
+	CreateFixupCommitsByFile          string
 	MoveCommitUp                      string
 	MoveCommitDown                    string
 	CopyCommitMessageToClipboard      string
 	CopyCommitDiffToClipboard         string
 	CopyCommitSHAToClipboard          string
 	CopyCommitURLToClipboard          string
+	CopyFileURLToClipboard            string
 	CopyCommitAuthorToClipboard       string
 	CopyCommitAttributeToClipboard    string
 	CopyPatchToClipboard              string
@@ -583,19 +945,31 @@ type Actions struct {
 	ExcludeFile                       string
 	ExcludeFileErr                    string
 	ExcludeGitIgnoreErr               string
+	UntrackFile                       string
+	ToggleSkipWorktree                string
+	SetFsMonitor                      string
+	SetUntrackedCache                 string
+	ToggleAssumeUnchanged             string
 	Commit                            string
 	EditFile                          string
 	Push                              string
+	PushToAllRemotes                  string
+	FetchAllRemotes                   string
 	Pull                              string
+	Fetch                             string
 	OpenFile                          string
+	OpenDiffTool                      string
 	StashAllChanges                   string
 	StashAllChangesKeepIndex          string
 	StashStagedChanges                string
 	StashUnstagedChanges              string
+	StashSelectedPath                 string
+	StashSelection                    string
 	StashIncludeUntrackedChanges      string
 	GitFlowFinish                     string
 	GitFlowStart                      string
 	CopyToClipboard                   string
+	RunCommandAgain                   string
 	CopySelectedTextToClipboard       string
 	RemovePatchFromCommit             string
 	MovePatchToSelectedCommit         string
@@ -609,6 +983,8 @@ type Actions struct {
 	ApplyPatch                        string
 	Stash                             string
 	RenameStash                       string
+	StashBranch                       string
+	ApplyStashOntoBranch              string
 	RemoveSubmodule                   string
 	ResetSubmodule                    string
 	AddSubmodule                      string
@@ -618,19 +994,26 @@ type Actions struct {
 	BulkUpdateSubmodules              string
 	BulkDeinitialiseSubmodules        string
 	UpdateSubmodule                   string
+	UpdateSubmoduleToTrackedBranch    string
+	BulkUpdateSubmodulesToBranches    string
+	SetSubmoduleTrackedBranch         string
 	CreateLightweightTag              string
 	CreateAnnotatedTag                string
 	DeleteTag                         string
 	PushTag                           string
+	EditTagMessage                    string
+	ForcePushTag                      string
 	NukeWorkingTree                   string
 	DiscardUnstagedFileChanges        string
 	RemoveUntrackedFiles              string
+	CleanUntrackedFiles               string
 	RemoveStagedFiles                 string
 	SoftReset                         string
 	MixedReset                        string
 	HardReset                         string
 	Undo                              string
 	Redo                              string
+	RestoreToReflogEntry              string
 	CopyPullRequestURL                string
 	OpenMergeTool                     string
 	OpenCommitInBrowser               string
@@ -639,6 +1022,13 @@ type Actions struct {
 	ResetBisect                       string
 	BisectSkip                        string
 	BisectMark                        string
+	BisectRun                         string
+	CommitSelection                   string
+	MovePatchIntoNewCommitBefore      string
+	ApplyPatchToWorkingTree           string
+	ApplyPatchToWorkingTreeInReverse  string
+	SavePatchAsStashEntry             string
+	SavePatchToFile                   string
 }
 
 const englishIntroPopupMessage = `
@@ -682,6 +1072,9 @@ func EnglishTranslationSet() TranslationSet {
 		CredentialsPassword:                 "Password",
 		CredentialsPassphrase:               "Enter passphrase for SSH key",
 		CredentialsPIN:                      "Enter PIN for SSH key",
+		CredentialsTwoFactorCode:            "Enter two-factor authentication code",
+		UnknownHostTitle:                    "Trust unknown SSH host?",
+		CredentialRequestCancelled:          "Cancelled",
 		PassUnameWrong:                      "Password, passphrase and/or username wrong",
 		CommitChanges:                       "commit changes",
 		AmendLastCommit:                     "amend last commit",
@@ -690,13 +1083,32 @@ func EnglishTranslationSet() TranslationSet {
 		NoCommitToAmend:                     "There's no commit to amend.",
 		CommitChangesWithEditor:             "commit changes using git editor",
 		StatusTitle:                         "Status",
+		TrustRepoConfigTitle:                "Trust repo config",
+		TrustRepoConfigPrompt:               "This repo's .lazygit.yml sets custom commands or other options that run commands. Do you trust it to run them? Until you do, only its other options are used.",
+		DashboardStashes:                    "Stashes: %d",
+		DashboardWorktrees:                  "Worktrees: %d",
+		DashboardBranchesAhead:              "Branches ahead of their upstream: %d",
+		DashboardConflictedFiles:            "Files with merge conflicts: %d",
+		DashboardInProgress:                 "In progress: %s",
+		DashboardNothingInProgress:          "nothing",
+		DashboardBisecting:                  "bisecting",
+		DashboardLastFetch:                  "Last fetch: %s ago",
+		DashboardNeverFetched:               "Last fetch: never",
+		DashboardStatusSpeedups:             "fsmonitor: %s, untracked cache: %s",
+		DashboardOn:                         "on",
+		DashboardOff:                        "off",
+		LcGoToDashboardItem:                 "go to selected item",
 		LcNavigate:                          "navigate",
 		LcMenu:                              "menu",
 		LcExecute:                           "execute",
 		LcToggleStaged:                      "toggle staged",
 		LcToggleStagedAll:                   "stage/unstage all",
 		LcToggleTreeView:                    "toggle file tree view",
+		LcCollapseAllFiles:                  "collapse all directories",
+		LcExpandAllFiles:                    "expand all directories",
+		LcExpandFilesToDefaultDepth:         "expand directories to the default depth",
 		LcOpenMergeTool:                     "open external merge tool (git mergetool)",
+		LcOpenMergeToolForFile:              "resolve file using external merge tool (git mergetool)",
 		LcRefresh:                           "refresh",
 		LcPush:                              "push",
 		LcPull:                              "pull",
@@ -715,6 +1127,10 @@ func EnglishTranslationSet() TranslationSet {
 		AlreadyCheckedOutBranch:             "You have already checked out this branch",
 		SureForceCheckout:                   "Are you sure you want force checkout? You will lose all local changes",
 		ForceCheckoutBranch:                 "Force Checkout Branch",
+		DetachedCommitsTitle:                "HEAD has commits that aren't on any branch",
+		LcCreateBranchAtHead:                "create branch at HEAD",
+		LcCreateTagAtHead:                   "create tag at HEAD",
+		LcLeaveDetachedCommitsBehind:        "leave them behind",
 		BranchName:                          "Branch name",
 		NewBranchNameBranchOff:              "New Branch Name (Branch is off of '{{.branchName}}')",
 		CantDeleteCheckOutBranch:            "You cannot delete the checked out branch!",
@@ -731,6 +1147,20 @@ func EnglishTranslationSet() TranslationSet {
 		NoBranchesThisRepo:                  "No branches for this repo",
 		CommitMessageConfirm:                "{{.keyBindClose}}: close, {{.keyBindNewLine}}: new line, {{.keyBindConfirm}}: confirm",
 		CommitWithoutMessageErr:             "You cannot commit without a commit message",
+		CommitMessageWithWarnings:           "Commit message ({{.warnings}})",
+		CommitSubjectTooLong:                "subject is longer than {{.maxLength}} characters",
+		CommitNoBlankLineAfterSubject:       "second line isn't blank",
+		CommitSubjectMismatch:               "subject doesn't match {{.regex}}",
+		InvalidCommitSubjectRegex:           "git.commit.subjectRegex is not a valid regex",
+		CommitSubjectMismatchTitle:          "Commit subject doesn't match",
+		CommitSubjectMismatchPrompt:         "The subject doesn't match {{.regex}}. Commit anyway?",
+		AddCommitTrailer:                    "Add trailer",
+		LcAddCommitTrailer:                  "add trailer",
+		AddCoAuthor:                         "Add co-author",
+		LcAddCoAuthor:                       "add co-author",
+		LcAddIssueTrailer:                   "add issue",
+		CoAuthorAlreadyAdded:                "This co-author has already been added",
+		IssueNumber:                         "Issue number:",
 		CloseConfirm:                        "{{.keyBindClose}}: close/cancel, {{.keyBindConfirm}}: confirm",
 		LcClose:                             "close",
 		LcQuit:                              "quit",
@@ -745,6 +1175,7 @@ func EnglishTranslationSet() TranslationSet {
 		LcPickCommit:                        "pick commit (when mid-rebase)",
 		LcRevertCommit:                      "revert commit",
 		LcRewordCommit:                      "reword commit",
+		LcRewordCommitsInEditor:             "reword commit and all commits above it with editor",
 		LcDeleteCommit:                      "delete commit",
 		LcMoveDownCommit:                    "move commit down one",
 		LcMoveUpCommit:                      "move commit up one",
@@ -752,6 +1183,13 @@ func EnglishTranslationSet() TranslationSet {
 		LcAmendToCommit:                     "amend commit with staged changes",
 		LcResetCommitAuthor:                 "reset commit author",
 		SetAuthorPromptTitle:                "Set author (must look like 'Name <Email>')",
+		SetAuthorDatePromptTitle:            "Set author date (leave empty to keep the current date)",
+		LcChangeAuthorAndDate:               "change author/date",
+		LcChangeAuthorAndDateOfCommitsAbove: "change author/date of this and all commits above it",
+		ChangeAuthorAndDateTooltip:          "Set the author and optionally the author date based on prompts, rewriting the commits in a single rebase",
+		CantChangeAuthorWhileRebasing:       "Can't change the author or date of commits while rebasing",
+		RewriteMergedCommitsTitle:           "Rewrite merged commits",
+		RewriteMergedCommitsPrompt:          "Some of the commits that would be rewritten have already been merged into a main branch. Are you sure you want to rewrite them?",
 		SureResetCommitAuthor:               "The author field of this commit will be updated to match the configured user. This also renews the author timestamp. Continue?",
 		LcRenameCommitEditor:                "reword commit with editor",
 		Error:                               "Error",
@@ -759,6 +1197,7 @@ func EnglishTranslationSet() TranslationSet {
 		LcNavigateConflicts:                 "navigate conflicts",
 		LcPickHunk:                          "pick hunk",
 		LcPickAllHunks:                      "pick all hunks",
+		LcPickAllHunksBottomFirst:           "pick all hunks (bottom first)",
 		LcUndo:                              "undo",
 		LcUndoReflog:                        "undo (via reflog) (experimental)",
 		LcRedoReflog:                        "redo (via reflog) (experimental)",
@@ -778,15 +1217,47 @@ func EnglishTranslationSet() TranslationSet {
 		SureApplyStashEntry:                 "Are you sure you want to apply this stash entry?",
 		NoTrackedStagedFilesStash:           "You have no tracked/staged files to stash",
 		NoFilesToStash:                      "You have no files to stash",
+		NoMatchingMenuItem:                  "No item matches the given text",
 		StashChanges:                        "Stash changes",
 		LcRenameStash:                       "rename stash",
+		LcStashBranch:                       "check out a new branch where the stash was made and pop the stash onto it",
+		StashBranchPrompt:                   "New branch name (branch is off the commit '{{.stashName}}' was made on):",
+		LcApplyStashOntoBranch:              "check out another branch and apply or pop the stash onto it",
+		ApplyStashOntoBranchTitle:           "Apply '{{.stashName}}' onto branch",
+		ApplyStashOntoBranchMenuTitle:       "Apply stash onto '{{.branchName}}'",
+		ApplyStashOntoBranchAutoStashPrompt: "You have uncommitted changes, which will be stashed before checking out '{{.branchName}}'. Continue?",
 		RenameStashPrompt:                   "Rename stash: {{.stashName}}",
 		OpenConfig:                          "open config file",
 		EditConfig:                          "edit config file",
+		LcReloadConfig:                      "reload config file",
+		ConfigReloaded:                      "Config reloaded",
 		ForcePush:                           "Force push",
 		ForcePushPrompt:                     "Your branch has diverged from the remote branch. Press 'esc' to cancel, or 'enter' to force push.",
 		ForcePushDisabled:                   "Your branch has diverged from the remote branch and you've disabled force pushing",
 		UpdatesRejectedAndForcePushDisabled: "Updates were rejected and you have disabled force pushing",
+		LcViewPushOptions:                   "view push options",
+		PushOptions:                         "Push options",
+		LcForcePushWithLease:                "force push with lease",
+		LcForcePushWithLeaseIfIncludes:      "force push with lease if it includes the remote's commits",
+		LcPushToOtherRemote:                 "push to another remote",
+		LcPushToAllRemotes:                  "push to all push remotes",
+		PushToRemote:                        "Push to remote",
+		NoUpstreamToForcePushTo:             "The branch has no upstream to force push to",
+		ForceIfIncludesNotSupported:         "--force-if-includes needs git 2.30 or newer",
+		NoRemotes:                           "There are no remotes",
+		NoPushRemotesConfigured:             "No push remotes are configured (remote.pushDefault, branch.<name>.pushRemote or remote.<name>.pushurl)",
+		PushedToAllRemotes:                  "Pushed to all remotes",
+		PushToAllRemotesFailed:              "Failed to push to some remotes",
+		PushedToRemote:                      "{{.remote}}: pushed",
+		FailedToPushToRemote:                "{{.remote}}: failed: {{.error}}",
+		LcViewPullOptions:                   "view pull options",
+		PullOptions:                         "Pull options",
+		LcPullRebase:                        "pull (rebase)",
+		LcPullMerge:                         "pull (merge)",
+		LcPullFastForwardOnly:               "pull (fast-forward only)",
+		LcFetchOnly:                         "fetch only",
+		CannotFastForwardTitle:              "Can't fast-forward",
+		CannotFastForwardPrompt:             "Your branch has diverged from its upstream, so it can't be fast-forwarded. Pull with rebase instead?",
 		LcCheckForUpdate:                    "check for update",
 		CheckingForUpdates:                  "Checking for updates...",
 		UpdateAvailableTitle:                "Update available!",
@@ -803,12 +1274,21 @@ func EnglishTranslationSet() TranslationSet {
 		ConfirmQuitDuringUpdate:             "An update is in progress. Are you sure you want to quit?",
 		MergeToolTitle:                      "Merge tool",
 		MergeToolPrompt:                     "Are you sure you want to open `git mergetool`?",
+		MergeToolResolvedTitle:              "Conflicts resolved",
+		FileHasNoMergeConflicts:             "This file has no merge conflicts",
+		MergeToolResolvedPrompt:             "There are no conflict markers left in '%s'. Stage it?",
 		IntroPopupMessage:                   englishIntroPopupMessage,
 		GitconfigParseErr:                   `Gogit failed to parse your gitconfig file due to the presence of unquoted '\' characters. Removing these should fix the issue.`,
 		LcEditFile:                          `edit file`,
 		LcOpenFile:                          `open file`,
+		LcOpenDiffTool:                      `open diff in external difftool (git difftool)`,
 		LcIgnoreFile:                        `add to .gitignore`,
 		LcExcludeFile:                       `add to .git/info/exclude`,
+		LcIgnoreFileInDirectory:             `add to %s`,
+		IgnorePatternTitle:                  "Pattern to ignore",
+		IgnorePatternEmptyErr:               "Pattern cannot be empty",
+		IgnorePatternMatchedFiles:           "%d untracked file(s) now ignored by '%s'",
+		LcUntrackFile:                       `stop tracking (keep file on disk)`,
 		LcRefreshFiles:                      `refresh files`,
 		LcMergeIntoCurrentBranch:            `merge into currently checked out branch`,
 		ConfirmQuit:                         `Are you sure you want to quit?`,
@@ -837,6 +1317,7 @@ func EnglishTranslationSet() TranslationSet {
 		FoundConflictsTitle:                 "Auto-merge failed",
 		PickHunk:                            "pick hunk",
 		PickAllHunks:                        "pick all hunks",
+		PickAllHunksBottomFirst:             "pick all hunks, with the bottom hunk first",
 		ViewMergeRebaseOptions:              "view merge/rebase options",
 		NotMergingOrRebasing:                "You are currently neither rebasing nor merging",
 		RecentRepos:                         "recent repositories",
@@ -854,10 +1335,24 @@ func EnglishTranslationSet() TranslationSet {
 		SecondaryTitle:                      "Secondary",
 		ReflogCommitsTitle:                  "Reflog",
 		GlobalTitle:                         "Global Keybindings",
+		LcOpenCommandPalette:                "search all actions",
+		CommandPaletteTitle:                 "Search actions",
+		CommandPaletteContextNotOpen:        "This action is only available once the '%s' panel is open",
+		PendingKeySequence:                  "%s (waiting for next key)",
 		ConflictsResolved:                   "all merge conflicts resolved. Continue?",
 		RebasingTitle:                       "Rebasing",
 		ConfirmRebase:                       "Are you sure you want to rebase '{{.checkedOutBranch}}' on top of '{{.selectedBranch}}'?",
-		ConfirmMerge:                        "Are you sure you want to merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'?",
+		ConfirmRebaseOnto:                   "Are you sure you want to rebase the commits of '{{.checkedOutBranch}}' after '{{.upstream}}' on top of '{{.newBase}}'?",
+		RebaseOntoRef:                       "Rebase onto ref",
+		LcRebaseOntoRef:                     "rebase checked-out branch onto ref",
+		LcRebaseOntoRefFromUpstream:         "rebase commits after a given ref onto ref (--onto)",
+		RebaseOntoRefPromptTitle:            "Rebase onto:",
+		RebaseOntoUpstreamPromptTitle:       "Rebase the commits after:",
+		MergeBranchMenuTitle:                "Merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'",
+		LcRegularMerge:                      "regular merge",
+		LcNonFastForwardMerge:               "merge with a merge commit (--no-ff)",
+		LcSquashMerge:                       "squash merge (--squash)",
+		SquashMergeNoChanges:                "Squash merging '{{.selectedBranch}}' didn't change anything, so there's nothing to commit",
 		FwdNoUpstream:                       "Cannot fast-forward a branch with no upstream",
 		FwdNoLocalUpstream:                  "Cannot fast-forward a branch whose remote is not registered locally",
 		FwdCommitsToPush:                    "Cannot fast-forward a branch with commits to push",
@@ -887,6 +1382,28 @@ func EnglishTranslationSet() TranslationSet {
 		LcScrollDownMainPanel:               "scroll down main panel",
 		AmendCommitTitle:                    "Amend Commit",
 		AmendCommitPrompt:                   "Are you sure you want to amend this commit with your staged files?",
+		AmendPushedCommitPrompt:             "This commit has already been pushed to {{.branches}}, so amending it will rewrite history that others may have built on. Are you sure you want to amend it with your staged files?",
+		NoStagedChangesToAmendWith:          "There are no staged changes to amend the commit with",
+		LcAbsorbStagedChanges:               "absorb staged changes into fixup commits",
+		AbsorbStagedChanges:                 "Absorb staged changes",
+		AbsorbPrompt:                        "The following fixup commits will be created, each containing the staged hunks listed next to the commit they fix up:",
+		AbsorbSkippedHunks:                  "These staged hunks will be left as they are:",
+		NoStagedChangesToAbsorb:             "There are no staged changes to absorb",
+		NothingToAbsorb:                     "None of the staged hunks could be matched to a commit on the current branch:",
+		AbsorbNewOrRenamedFile:              "new and renamed files can't be absorbed",
+		AbsorbBinaryFile:                    "binary files can't be absorbed",
+		AbsorbNoCommitOnBranch:              "no commit on the current branch last changed these lines",
+		SquashAbsorbedFixupsTitle:           "Squash fixup commits",
+		SquashAbsorbedFixupsPrompt:          "Do you want to squash the fixup commits into their commits now? This rebases everything above {{.commit}}.",
+		CreatingFixupCommitsStatus:          "creating fixup commits",
+		LcCreateFixupCommitsByFile:          "create fixup commits for staged files, by file",
+		CreateFixupCommitsByFile:            "Fixup staged files",
+		CreateFixupCommits:                  "Create fixup commits",
+		NoBranchCommitsToFixup:              "There are no commits on the current branch to fix up",
+		NoFilesToFixup:                      "None of the staged files have a commit to fix up",
+		FixupByFileNoCommitOnBranch:         "no commit on the current branch changed this file",
+		FixupByFileChangeTargetTooltip:      "Press enter to pick a different commit for this file, or to leave it staged.",
+		LeaveFileStaged:                     "leave staged",
 		DeleteCommitTitle:                   "Delete Commit",
 		DeleteCommitPrompt:                  "Are you sure you want to delete this commit?",
 		SquashingStatus:                     "squashing",
@@ -898,10 +1415,31 @@ func EnglishTranslationSet() TranslationSet {
 		CherryPickingStatus:                 "cherry-picking",
 		UndoingStatus:                       "undoing",
 		RedoingStatus:                       "redoing",
+		ResettingStatus:                     "resetting",
 		CheckingOutStatus:                   "checking out",
 		CommittingStatus:                    "committing",
 		CommitFiles:                         "Commit files",
 		SubCommitsDynamicTitle:              "Commits (%s)",
+		FileHistoryDynamicTitle:             "History of %s",
+		LcViewFileHistory:                   "view history of file",
+		LcCheckoutFileVersion:               "check out this version of the file",
+		OnlyAvailableInFileHistory:          "This is only available when viewing the history of a file",
+		LcViewSkipWorktreeOptions:           "view skip-worktree / assume-unchanged options",
+		LcMarkSkipWorktree:                  "mark as skip-worktree (ignore local changes)",
+		LcUnmarkSkipWorktree:                "unmark as skip-worktree",
+		LcMarkAssumeUnchanged:               "mark as assume-unchanged",
+		LcUnmarkAssumeUnchanged:             "unmark as assume-unchanged",
+		NoTrackedFilesToFlag:                "Only tracked files can be marked as skip-worktree or assume-unchanged",
+		LcViewFlaggedFiles:                  "view files marked as skip-worktree / assume-unchanged",
+		FlaggedFilesTitle:                   "Skip-worktree / assume-unchanged files (select to unmark)",
+		NoFlaggedFiles:                      "No files are marked as skip-worktree or assume-unchanged",
+		LcViewStatusSpeedups:                "view options for speeding up git status",
+		StatusSpeedupsTitle:                 "Speed up git status",
+		LcEnableFsMonitor:                   "enable fsmonitor, so that git status only looks at files that have changed",
+		LcDisableFsMonitor:                  "disable fsmonitor",
+		FsMonitorHookSet:                    "fsmonitor is using the hook '%s', which lazygit leaves alone",
+		LcEnableUntrackedCache:              "enable the untracked cache, so that git status skips directories that haven't changed",
+		LcDisableUntrackedCache:             "disable the untracked cache",
 		CommitFilesDynamicTitle:             "Diff files (%s)",
 		RemoteBranchesDynamicTitle:          "Remote branches (%s)",
 		LcViewItemFiles:                     "view selected item's files",
@@ -910,6 +1448,8 @@ func EnglishTranslationSet() TranslationSet {
 		LcDiscardOldFileChange:              "discard this commit's changes to this file",
 		DiscardFileChangesTitle:             "Discard file changes",
 		DiscardFileChangesPrompt:            "Are you sure you want to discard this commit's changes to this file? If this file was created in this commit, it will be deleted",
+		DiscardDirectoryChangesPrompt:       "Are you sure you want to discard this commit's changes to all files in this directory? Files created in this commit will be deleted",
+		CanOnlyDiscardFromLocalCommits:      "Changes can only be discarded from commits on the current branch",
 		DisabledForGPG:                      "Feature not available for users using GPG",
 		CreateRepo:                          "Not in a git repository. Create a new git repository? (y/n): ",
 		BareRepo:                            "You've attempted to open Lazygit in a bare repo but Lazygit does not yet support bare repos. Open most recent repo? (y/n) ",
@@ -926,6 +1466,44 @@ func EnglishTranslationSet() TranslationSet {
 		LcDiscardAllChangesToAllFiles:       "nuke working tree",
 		LcDiscardAnyUnstagedChanges:         "discard unstaged changes",
 		LcDiscardUntrackedFiles:             "discard untracked files",
+		LcCleanUntrackedFiles:               "pick untracked files to discard",
+		LcTogglePinnedRepo:                  "pin/unpin",
+		LcRemoveRecentRepo:                  "remove from list",
+		LcPinnedRepo:                        "pinned",
+		RepoNotFoundTitle:                   "Repository not found",
+		RepoNotFoundPrompt:                  "%s no longer exists. Remove it from the list?",
+		NoHostingServiceToken:               "No API token for %s. Set one in git.hostingServiceTokens, or log in with the gh/glab CLI",
+		PullRequestStatusNotSupported:       "Pull request status isn't supported for %s",
+		FailedToLoadPullRequests:            "Failed to load pull requests: %s",
+		LcOpenPullRequestInBrowser:          "open pull request #%d in browser",
+		LcCopyFileURL:                       "copy URL of file at this commit to clipboard",
+		FileURLCopiedToClipboard:            "File URL copied to clipboard",
+		BlameTitle:                          "Blame",
+		BlameDynamicTitle:                   "Blame: %s",
+		LcViewBlame:                         "view blame of file",
+		LcGoToBlameCommit:                   "go to the line's commit in the commits panel",
+		LcReblameFromParent:                 "blame the file as it was before the line's commit",
+		LcExitBlame:                         "exit blame",
+		BlameLineNotCommitted:               "This line hasn't been committed yet",
+		BlameCommitNotFound:                 "The line's commit isn't on the checked out branch",
+		NoEarlierBlame:                      "The line's commit added the file, so there is nothing earlier to blame",
+		BlamingStatus:                       "blaming",
+		LcSearchCommitContents:              "search commit contents for added or removed text (git log -S)",
+		LcSearchCommitContentsByRegex:       "search commit contents for changed lines matching a regex (git log -G)",
+		LcStopSearchingCommitContents:       "stop searching commit contents",
+		SearchCommitContentsTitle:           "Text added or removed:",
+		SearchCommitContentsByRegexTitle:    "Regex for changed lines:",
+		SearchingCommitContentsStatus:       "searching",
+		LcCommitsAddingOrRemoving:           "commits adding or removing",
+		LcCommitsWithChangesMatching:        "commits with changes matching",
+		CleanUntrackedFilesTitle:            "Discard untracked files",
+		LcIncludeIgnoredFiles:               "include ignored files",
+		LcDeleteTickedPaths:                 "delete %d ticked",
+		NothingTickedToClean:                "Nothing is ticked",
+		LcContainsGitRepository:             "git repository",
+		CleanUntrackedFilesPrompt:           "This will delete:\n\n%s",
+		CleanNestedReposTitle:               "Delete git repositories?",
+		CleanNestedReposPrompt:              "These are git repositories. Deleting them also deletes any of their commits, branches and stashes that haven't been pushed anywhere:\n\n%s\n\nAre you sure?",
 		LcDiscardStagedChanges:              "discard staged changes",
 		LcHardReset:                         "hard reset",
 		LcViewResetOptions:                  `view reset options`,
@@ -946,6 +1524,7 @@ func EnglishTranslationSet() TranslationSet {
 		LcStashStagedChanges:                "stash staged changes",
 		LcStashAllChangesKeepIndex:          "stash all changes and keep index",
 		LcStashUnstagedChanges:              "stash unstaged changes",
+		LcStashSelectedPath:                 "stash changes to selected files/directories",
 		LcStashIncludeUntrackedChanges:      "stash all changes including untracked files",
 		LcStashOptions:                      "Stash options",
 		NotARepository:                      "Error: must be run inside a git repository",
@@ -972,6 +1551,15 @@ func EnglishTranslationSet() TranslationSet {
 		LcNewRemoteUrl:                      `New remote url:`,
 		LcEditRemoteName:                    `Enter updated remote name for {{.remoteName}}:`,
 		LcEditRemoteUrl:                     `Enter updated remote url for {{.remoteName}}:`,
+		EditRemoteMenuTitle:                 "Edit remote '{{.remoteName}}'",
+		LcRenameRemote:                      "rename",
+		LcEditRemoteFetchUrl:                "edit url",
+		LcSetRemotePushUrl:                  "set separate push url",
+		LcEditRemotePushUrl:                 `Enter push url for {{.remoteName}}:`,
+		LcRemoveRemotePushUrl:               "remove push url (push to the fetch url again)",
+		LcEnablePruneOnFetch:                "prune remote branches that are gone when fetching",
+		LcDisablePruneOnFetch:               "stop pruning remote branches when fetching",
+		InvalidRemoteUrl:                    "'{{.url}}' is not a valid remote url",
 		LcRemoveRemote:                      `remove remote`,
 		LcRemoveRemotePrompt:                "Are you sure you want to remove remote",
 		DeleteRemoteBranch:                  "Delete Remote Branch",
@@ -993,10 +1581,24 @@ func EnglishTranslationSet() TranslationSet {
 		DeleteTagPrompt:                     "Are you sure you want to delete tag '{{.tagName}}'?",
 		PushTagTitle:                        "remote to push tag '{{.tagName}}' to:",
 		LcPushTag:                           "push tag",
+		LcEditTagMessage:                    "edit tag message",
+		EditTagMessageTitle:                 "Message for tag '{{.tagName}}':",
+		EditLightweightTagMessageError:      "Lightweight tags have no message of their own, so only annotated tags can be edited",
+		ForcePushTagTitle:                   "Force push tag",
+		ForcePushTagPrompt:                  "Tag '{{.tagName}}' also exists on {{.remotes}}. Force push the edited tag there?",
+		LightweightTagIndicator:             "(lightweight)",
 		LcCreateTag:                         "create tag",
 		CreateTagTitle:                      "Tag name:",
 		LcFetchRemote:                       "fetch remote",
 		FetchingRemoteStatus:                "fetching remote",
+		LcFetchAllRemotes:                   "fetch all remotes",
+		FetchingAllRemotesStatus:            "fetching all remotes",
+		NoRemotesToFetch:                    "There are no remotes to fetch",
+		FetchedRemote:                       "Fetched %s",
+		FailedToFetchRemote:                 "Failed to fetch %s: %s",
+		FetchAllRemotesFailed:               "Failed to fetch %s",
+		RemoteFetchedAgo:                    "fetched %s ago",
+		RemotePushesTo:                      "pushes to %s",
 		LcCheckoutCommit:                    "checkout commit",
 		SureCheckoutThisCommit:              "Are you sure you want to checkout this commit?",
 		LcGitFlowOptions:                    "show git-flow options",
@@ -1006,6 +1608,10 @@ func EnglishTranslationSet() TranslationSet {
 		IgnoreTrackedPrompt:                 "Are you sure you want to ignore a tracked file?",
 		ExcludeTracked:                      "Exclude tracked file",
 		ExcludeTrackedPrompt:                "Are you sure you want to exclude a tracked file?",
+		UntrackFileNotTrackedErr:            "Cannot stop tracking a file that isn't tracked",
+		UntrackFileStagedChangesErr:         "Cannot stop tracking a file whose staged changes differ from the working tree, as they would be lost from the index. Stage or unstage the file first.",
+		UntrackFileIgnoreTitle:              "Add to .gitignore",
+		UntrackFileIgnorePrompt:             "The file is no longer tracked. Do you also want to add '%s' to .gitignore?",
 		LcViewResetToUpstreamOptions:        "view upstream reset options",
 		LcNextScreenMode:                    "next screen mode (normal/half/fullscreen)",
 		LcPrevScreenMode:                    "prev screen mode",
@@ -1014,14 +1620,39 @@ func EnglishTranslationSet() TranslationSet {
 		Keybindings:                         "Keybindings",
 		LcRenameBranch:                      "rename branch",
 		LcSetUnsetUpstream:                  "set/unset upstream",
+		LcSetBaseBranch:                     "set the base branch that the branch's divergence is counted against",
+		SetBaseBranchPrompt:                 "Base branch for '%s' (leave empty to detect it automatically):",
+		LcCreateWorktree:                    "create worktree",
+		LcCreateWorktreeFromRemoteBranch:    "create worktree with a local branch tracking this one",
+		CreateWorktreeMenuTitle:             "Create worktree",
+		LcCreateWorktreeFromBranch:          "from a local or remote branch",
+		LcCreateDetachedWorktree:            "with a detached HEAD at a tag or commit",
+		CreateWorktreeBranchPrompt:          "Branch to check out in the new worktree:",
+		CreateWorktreeRefPrompt:             "Tag or commit to check out in the new worktree:",
+		CreateWorktreePathPrompt:            "Path of the new worktree:",
+		CreateWorktreeBranchNotFound:        "There's no local or remote branch called '{{.branchName}}'",
+		SwitchToWorktreeTitle:               "Switch to worktree",
+		SwitchToWorktreePrompt:              "Do you want to switch to the new worktree now?",
+		BranchInOtherWorktreeTitle:          "'{{.branchName}}' is checked out in another worktree",
+		LcSwitchToWorktreeAtPath:            "switch to worktree {{.path}}",
+		LcCheckoutIgnoringOtherWorktrees:    "check out here anyway (--ignore-other-worktrees)",
 		NewBranchNamePrompt:                 "Enter new branch name for branch",
-		RenameBranchWarning:                 "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
+		RenameBranchOnRemoteTitle:           "Rename on remote",
+		RenameBranchOnRemotePrompt:          "Also rename '%s' to '%s' on the remote?",
+		RenameBranchOnRemoteFailed:          "The branch was renamed locally, but renaming it on the remote failed:\n\n%s",
 		LcOpenMenu:                          "open menu",
 		LcResetCherryPick:                   "reset cherry-picked (copied) commits selection",
 		LcNextTab:                           "next tab",
 		LcPrevTab:                           "previous tab",
 		LcCantUndoWhileRebasing:             "Can't undo while rebasing",
 		LcCantRedoWhileRebasing:             "Can't redo while rebasing",
+		LcRestoreToReflogEntry:              "restore repo to this state",
+		RestoreToReflogEntry:                "Restore repo to this state",
+		RestoreToReflogEntryResetPrompt:     "Are you sure you want to hard reset the checked-out branch to '{{.sha}}'? The main view shows what this will change. An auto-stash will be performed if necessary.",
+		RestoreToReflogEntryCheckoutPrompt:  "Are you sure you want to checkout '{{.ref}}'? The main view shows what this will change.",
+		RestoreToReflogEntryBranchPrompt:    "Are you sure you want to checkout '{{.branch}}' and hard reset it to '{{.sha}}'? The main view shows what this will change. An auto-stash will be performed if necessary.",
+		RestoreToReflogEntryPreviewTitle:    "Changes from HEAD to reflog entry",
+		CantRestoreReflogEntryWhileRebasing: "Can't restore a reflog entry while rebasing",
 		MustStashWarning:                    "Pulling a patch out into the index requires stashing and unstashing your changes. If something goes wrong, you'll be able to access your files from the stash. Continue?",
 		MustStashTitle:                      "Must stash",
 		ConfirmationTitle:                   "Confirmation Panel",
@@ -1034,7 +1665,15 @@ func EnglishTranslationSet() TranslationSet {
 		LcOpenFilteringMenu:                 "view filter-by-path options",
 		LcFilterBy:                          "filter by",
 		LcExitFilterMode:                    "stop filtering by path",
-		LcFilterPathOption:                  "enter path to filter by",
+		LcFilterPathOption:                  "enter paths or glob patterns to filter by",
+		LcEditFilterPaths:                   "edit paths to filter by",
+		EnterFilterPaths:                    "Enter paths or glob patterns, separated by commas:",
+		LcFilterAuthorOption:                "enter author to filter by",
+		EnterFilterAuthor:                   "Author (regex):",
+		LcExitFilterAuthorMode:              "stop filtering by author",
+		LcExitAllFilterModes:                "stop filtering by path and author",
+		LcFilteringByAuthor:                 "filtering by author",
+		LcFilteringByPathsAndAuthor:         "filtering by '{{.paths}}' and author '{{.author}}'",
 		EnterFileName:                       "Enter path:",
 		FilteringMenuTitle:                  "Filtering",
 		MustExitFilterModeTitle:             "Command not available",
@@ -1074,6 +1713,8 @@ func EnglishTranslationSet() TranslationSet {
 		LcViewCommits:                       "view commits",
 		MinGitVersionError:                  "Git version must be at least 2.20 (i.e. from 2018 onwards). Please upgrade your git version. Alternatively raise an issue at https://github.com/jesseduffield/lazygit/issues for lazygit to be more backwards compatible.",
 		LcRunningCustomCommandStatus:        "running custom command",
+		CustomCommandUnansweredFormKey:      "Template refers to .Form.%s, but no earlier prompt has the key '%s'",
+		CustomCommandDisabled:               "This command is only available when the following is true: %s",
 		LcSubmoduleStashAndReset:            "stash uncommitted submodule changes and update",
 		LcAndResetSubmodules:                "and reset submodules",
 		LcEnterSubmodule:                    "enter submodule",
@@ -1094,9 +1735,17 @@ func EnglishTranslationSet() TranslationSet {
 		LcInitSubmodule:                     "initialize submodule",
 		LcSubmoduleUpdate:                   "update submodule",
 		LcUpdatingSubmoduleStatus:           "updating submodule",
+		NestedSubmoduleError:                "'%s' is nested in submodule '%s'. Enter that submodule to do this",
+		SubmoduleUninitialized:              "uninitialized",
+		SubmoduleOutOfSync:                  "out of sync",
+		SubmoduleConflicted:                 "conflicted",
+		SubmoduleDirty:                      "dirty",
 		LcBulkInitSubmodules:                "bulk init submodules",
 		LcBulkUpdateSubmodules:              "bulk update submodules",
 		LcBulkDeinitSubmodules:              "bulk deinit submodules",
+		LcBulkUpdateSubmodulesToBranches:    "bulk update submodules to the tip of their tracked branches",
+		LcUpdateSubmoduleToTrackedBranch:    "update submodule to the tip of its tracked branch",
+		SubmoduleTrackedBranchPrompt:        "Submodule '%s' doesn't track a branch yet. Branch to track:",
 		LcViewBulkSubmoduleOptions:          "view bulk submodule options",
 		LcBulkSubmoduleOptions:              "bulk submodule options",
 		LcRunningCommand:                    "running command",
@@ -1107,6 +1756,7 @@ func EnglishTranslationSet() TranslationSet {
 		SuggestionsTitle:                    "Suggestions (press %s to focus)",
 		ExtrasTitle:                         "Command Log",
 		PushingTagStatus:                    "pushing tag",
+		CheckingRemotesForTagStatus:         "checking remotes for tag",
 		PullRequestURLCopiedToClipboard:     "Pull request URL copied to clipboard",
 		CommitDiffCopiedToClipboard:         "Commit diff copied to clipboard",
 		CommitSHACopiedToClipboard:          "Commit SHA copied to clipboard",
@@ -1115,15 +1765,35 @@ func EnglishTranslationSet() TranslationSet {
 		CommitAuthorCopiedToClipboard:       "Commit author copied to clipboard",
 		PatchCopiedToClipboard:              "Patch copied to clipboard",
 		LcCopiedToClipboard:                 "copied to clipboard",
+		LcCopyPopupContentToClipboard:       "copy popup content to clipboard",
+		PopupContentCopiedToClipboard:       "Popup content copied to clipboard",
 		ErrCannotEditDirectory:              "Cannot edit directory: you can only edit individual files",
+		ErrCannotDiffUntrackedFile:          "Cannot open an untracked file in a difftool: git has nothing to compare it with",
 		ErrStageDirWithInlineMergeConflicts: "Cannot stage/unstage directory containing files with inline merge conflicts. Please fix up the merge conflicts first",
 		ErrRepositoryMovedOrDeleted:         "Cannot find repo. It might have been moved or deleted ¯\\_(ツ)_/¯",
 		CommandLog:                          "Command Log",
 		ToggleShowCommandLog:                "Toggle show/hide command log",
 		FocusCommandLog:                     "Focus command log",
+		ViewCommandHistory:                  "View command history",
+		CommandHistoryTitle:                 "Command history (newest first)",
+		CommandHistoryUser:                  "user",
+		CommandHistoryBackground:            "background",
+		CommandHistoryExitCode:              "exit %d",
+		CommandHistoryKilled:                "killed",
+		CommandHistoryFailedToStart:         "couldn't start",
+		LcCopyCommandToClipboard:            "copy command to clipboard",
+		LcRunCommandAgain:                   "run command again",
+		LcShowCommandOutput:                 "show output in main view",
+		RunCommandAgainTitle:                "Run command again",
+		RunCommandAgainPrompt:               "Are you sure you want to run '%s' again?",
+		LcRunningCommandAgainStatus:         "running command",
+		CommandOutputTitle:                  "Command output",
+		NoCommandOutput:                     "The command had no output",
 		CommandLogHeader:                    "You can hide/focus this panel by pressing '%s'\n",
 		RandomTip:                           "Random Tip",
 		SelectParentCommitForMerge:          "Select parent commit for merge",
+		ParentOnCurrentBranch:               "(current branch)",
+		RevertConflictsPrompt:               "Conflicts! Resolve them in the files panel and then commit to finish the revert. Go to the files panel now?",
 		ToggleWhitespaceInDiffView:          "Toggle whether or not whitespace changes are shown in the diff view",
 		IgnoringWhitespaceInDiffView:        "Whitespace will be ignored in the diff view",
 		ShowingWhitespaceInDiffView:         "Whitespace will be shown in the diff view",
@@ -1144,6 +1814,31 @@ func EnglishTranslationSet() TranslationSet {
 		AbortTitle:                          "Abort %s",
 		AbortPrompt:                         "Are you sure you want to abort the current %s?",
 		LcOpenLogMenu:                       "open log menu",
+		LcVerifyCommitSignature:             "verify commit signature",
+		VerifyCommitSignatureTitle:          "Signature",
+		LcToggleMarkCommit:                  "mark/unmark commit (to drop or move several commits at once, which you can also select by dragging)",
+		DeleteCommitsPrompt:                 "Are you sure you want to delete these %d commits?",
+		CantDropCommitsWhileRebasing:        "You can't drop several commits at once while rebasing. Finish or abort the rebase first",
+		CantRebaseOverMergeCommit:           "Can't do that because it would mean rebasing over a merge commit, which would lose the merge",
+		LcMoveCommitsToBranch:               "move commit (or marked or dragged over commits) to another branch",
+		MoveCommitsToBranchTitle:            "Move commits to branch:",
+		CantMoveMergeCommits:                "Merge commits can't be moved to another branch",
+		CantMoveCommitsWhileRebasing:        "You can't move commits to another branch while rebasing. Finish or abort the rebase first",
+		CantMoveCommitsToCheckedOutBranch:   "The commits are already on the checked out branch",
+		MoveCommitsBranchNotFound:           "There's no local branch called '%s'",
+		MoveCommitsCherryPickFailed:         "Couldn't cherry-pick the commits onto '%s', so nothing has been changed:\n\n%s",
+		MoveCommitsDropFailed:               "Couldn't remove the commits from this branch, so nothing has been moved:\n\n%s",
+		LcAddExecTodo:                       "run a command after commit (rebase 'exec')",
+		LcAddBreakTodo:                      "stop the rebase after commit (rebase 'break')",
+		ExecTodoTitle:                       "Command to run after this commit:",
+		NotAllowedForTodoCommand:            "That can't be done to a '%s' entry of the rebase",
+		LcCompareCommits:                    "mark commit to compare, or compare it with the marked one",
+		CompareCommitsMenuTitle:             "Compare commits",
+		LcCompareCommitsDirectly:            "changes from the first commit to the second",
+		LcCompareCommitsSinceMergeBase:      "changes on the second commit since it diverged from the first",
+		LcComparingFrom:                     "comparing from %s, mark another commit to compare it with",
+		CantAddTodoToAppliedCommit:          "This commit has already been rebased. You can only add to the entries of the rebase that haven't been applied yet",
+		CantMovePastRebaseMergesTodo:        "Can't move 'label', 'reset' or 'merge' entries, or move commits past them, because that would change which branch the commits end up on",
 		LogMenuTitle:                        "Commit Log Options",
 		ToggleShowGitGraphAll:               "toggle show whole git graph (pass the `--all` flag to `git log`)",
 		ShowGitGraph:                        "show git graph",
@@ -1154,6 +1849,7 @@ func EnglishTranslationSet() TranslationSet {
 		ConfirmRevertCommit:                 "Are you sure you want to revert {{.selectedCommit}}?",
 		RewordInEditorTitle:                 "Reword in editor",
 		RewordInEditorPrompt:                "Are you sure you want to reword this commit in your editor?",
+		RewordCommitsInEditorPrompt:         "Are you sure you want to reword this commit and the {{.count}} commit(s) above it in your editor? Leaving a message empty cancels the rewording of all of them.",
 		HardResetAutostashPrompt:            "Are you sure you want to hard reset to '%s'? An auto-stash will be performed if necessary.",
 		CheckoutPrompt:                      "Are you sure you want to checkout '%s'?",
 		UpstreamGone:                        "(upstream gone)",
@@ -1162,8 +1858,44 @@ func EnglishTranslationSet() TranslationSet {
 		EmptyOutput:                         "<empty output>",
 		Patch:                               "Patch",
 		CustomPatch:                         "Custom patch",
+		LcGoToPatchFile:                     "go to file in custom patch",
+		PatchFileFromOtherCommit:            "This file's changes are from another commit",
 		LcCommitsCopied:                     "commits copied",
 		LcCommitCopied:                      "commit copied",
+		EditPatchLine:                       "edit line",
+		EditPatchLineTitle:                  "Edit line",
+		CanOnlyEditAddedLinesError:          "Only added lines can be edited",
+		ToggleMarkLine:                      "mark/unmark line to be staged together",
+		StageInvertedSelection:              "toggle all lines in hunk except selected staged / unstaged",
+		StageSelectionSkippingWhitespace:    "toggle selection staged / unstaged, skipping whitespace-only changes",
+		AddInvertedSelectionToPatch:         "add all lines in hunk except selected to patch",
+		CommitSelection:                     "move selected lines into a new commit",
+		CommitSelectionTitle:                "Commit selected lines",
+		CommitSelectionWithStagedPrompt:     "This file already has staged changes, which will be included in the new commit along with the selected lines. Continue?",
+		StashSelection:                      "stash selected lines",
+		StashSelectionHasStagedChangesError: "This file has staged changes. Unstage or commit them before stashing some of its unstaged lines",
+		CannotApplyPatchToWorkingTree:       "Could not apply the patch to the working tree, probably because it conflicts with your uncommitted changes. Nothing was applied.\n\n%s",
+		ToggleCollapseHunk:                  "collapse/expand hunk",
+		CantMoveMultiCommitPatch:            "This patch contains changes from more than one commit, so it can only be applied. To move it out of its commit, first remove the changes from all but one of the commits.",
+		PartialDeletionAppliedAsWhole:       "Part of a deleted file can't be staged or unstaged on its own, so the whole deletion was used instead",
+		SavePatchToFileTitle:                "Save patch to file",
+		PatchSavedToFile:                    "Patch saved to %s",
+		SplitHunk:                           "split hunk",
+		ToggleDiffLayout:                    "toggle side-by-side diff",
+		CannotShowDiffSideBySide:            "This diff is shown unified because the view is too narrow or the file has merge conflicts",
+		GoToLine:                            "go to line",
+		GoToLineTitle:                       "Go to line number",
+		InvalidLineNumber:                   "'%s' isn't a line number",
+		LineNotInDiff:                       "Line %d of the file isn't part of this diff",
+		CopySelectedLines:                   "copy selected lines to clipboard",
+		CopySelectedLinesWithoutPrefixes:    "lines without '+' and '-' prefixes",
+		CopySelectedLinesAsDiff:             "diff of the selected lines",
+		CopySelectedNewLines:                "new version of the lines",
+		SelectedLinesCopiedToClipboard:      "Selected lines copied to clipboard",
+		HunkCannotBeSplit:                   "This hunk has only one group of changes so it can't be split",
+		CannotDiscardLines:                  "The selected lines can't be discarded because they no longer match the file in the working tree, for example because the file has been changed outside of lazygit. Refresh and try again.",
+		CannotStageLinesOfBinaryFile:        "Can't stage individual lines of a binary file",
+		CannotStageLinesOfConflictedFile:    "Can't stage individual lines of a file with merge conflicts. Stage the whole file once its conflicts are resolved.",
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                    "Checkout commit",
@@ -1172,9 +1904,13 @@ func EnglishTranslationSet() TranslationSet {
 			ForceCheckoutBranch:               "Force checkout branch",
 			DeleteBranch:                      "Delete branch",
 			Merge:                             "Merge",
+			SquashMerge:                       "Squash merge",
 			RebaseBranch:                      "Rebase branch",
 			RenameBranch:                      "Rename branch",
+			RenameBranchOnRemote:              "Rename branch on remote",
 			SetUnsetUpstream:                  "Set/unset upstream",
+			SetBaseBranch:                     "Set base branch",
+			CreateWorktree:                    "Create worktree",
 			CreateBranch:                      "Create branch",
 			CherryPick:                        "(Cherry-pick) Paste commits",
 			CheckoutFile:                      "Checkout file",
@@ -1182,20 +1918,29 @@ func EnglishTranslationSet() TranslationSet {
 			SquashCommitDown:                  "Squash commit down",
 			FixupCommit:                       "Fixup commit",
 			RewordCommit:                      "Reword commit",
+			RewordCommits:                     "Reword commits",
 			DropCommit:                        "Drop commit",
+			DropCommits:                       "Drop commits",
+			MoveCommitsToBranch:               "Move commits to branch",
+			AddExecTodo:                       "Add exec to rebase",
+			AddBreakTodo:                      "Add break to rebase",
 			EditCommit:                        "Edit commit",
 			AmendCommit:                       "Amend commit",
 			ResetCommitAuthor:                 "Reset commit author",
 			SetCommitAuthor:                   "Set commit author",
+			ChangeCommitAuthorAndDate:         "Change commit author and date",
 			RevertCommit:                      "Revert commit",
 			CreateFixupCommit:                 "Create fixup commit",
 			SquashAllAboveFixupCommits:        "Squash all above fixup commits",
+			AbsorbStagedChanges:               "Absorb staged changes",
+			CreateFixupCommitsByFile:          "Create fixup commits by file",
 			CreateLightweightTag:              "Create lightweight tag",
 			CreateAnnotatedTag:                "Create annotated tag",
 			CopyCommitMessageToClipboard:      "Copy commit message to clipboard",
 			CopyCommitDiffToClipboard:         "Copy commit diff to clipboard",
 			CopyCommitSHAToClipboard:          "Copy commit SHA to clipboard",
 			CopyCommitURLToClipboard:          "Copy commit URL to clipboard",
+			CopyFileURLToClipboard:            "Copy file URL to clipboard",
 			CopyCommitAuthorToClipboard:       "Copy commit author to clipboard",
 			CopyCommitAttributeToClipboard:    "Copy to clipboard",
 			CopyPatchToClipboard:              "Copy patch to clipboard",
@@ -1216,19 +1961,31 @@ func EnglishTranslationSet() TranslationSet {
 			ExcludeFile:                       "Exclude file",
 			ExcludeFileErr:                    "Cannot exclude .git/info/exclude",
 			ExcludeGitIgnoreErr:               "Cannot exclude .gitignore",
+			UntrackFile:                       "Untrack file",
+			ToggleSkipWorktree:                "Toggle skip-worktree",
+			SetFsMonitor:                      "Set fsmonitor",
+			SetUntrackedCache:                 "Set untracked cache",
+			ToggleAssumeUnchanged:             "Toggle assume-unchanged",
 			Commit:                            "Commit",
 			EditFile:                          "Edit file",
 			Push:                              "Push",
+			PushToAllRemotes:                  "Push to all push remotes",
+			FetchAllRemotes:                   "Fetch all remotes",
 			Pull:                              "Pull",
+			Fetch:                             "Fetch",
 			OpenFile:                          "Open file",
+			OpenDiffTool:                      "Open diff tool",
 			StashAllChanges:                   "Stash all changes",
 			StashAllChangesKeepIndex:          "Stash all changes and keep index",
 			StashStagedChanges:                "Stash staged changes",
 			StashUnstagedChanges:              "Stash unstaged changes",
+			StashSelectedPath:                 "Stash changes to selected paths",
+			StashSelection:                    "Stash selected lines",
 			StashIncludeUntrackedChanges:      "Stash all changes including untracked files",
 			GitFlowFinish:                     "Git flow finish",
 			GitFlowStart:                      "Git Flow start",
 			CopyToClipboard:                   "Copy to clipboard",
+			RunCommandAgain:                   "Run command again",
 			CopySelectedTextToClipboard:       "Copy selected text to clipboard",
 			RemovePatchFromCommit:             "Remove patch from commit",
 			MovePatchToSelectedCommit:         "Move patch to selected commit",
@@ -1242,6 +1999,8 @@ func EnglishTranslationSet() TranslationSet {
 			ApplyPatch:                        "Apply patch",
 			Stash:                             "Stash",
 			RenameStash:                       "Rename stash",
+			StashBranch:                       "Create branch from stash",
+			ApplyStashOntoBranch:              "Apply stash onto branch",
 			RemoveSubmodule:                   "Remove submodule",
 			ResetSubmodule:                    "Reset submodule",
 			AddSubmodule:                      "Add submodule",
@@ -1250,12 +2009,18 @@ func EnglishTranslationSet() TranslationSet {
 			BulkInitialiseSubmodules:          "Bulk initialise submodules",
 			BulkUpdateSubmodules:              "Bulk update submodules",
 			BulkDeinitialiseSubmodules:        "Bulk deinitialise submodules",
+			UpdateSubmoduleToTrackedBranch:    "Update submodule to tracked branch",
+			BulkUpdateSubmodulesToBranches:    "Bulk update submodules to tracked branches",
+			SetSubmoduleTrackedBranch:         "Set submodule tracked branch",
 			UpdateSubmodule:                   "Update submodule",
 			DeleteTag:                         "Delete tag",
 			PushTag:                           "Push tag",
+			EditTagMessage:                    "Edit tag message",
+			ForcePushTag:                      "Force push tag",
 			NukeWorkingTree:                   "Nuke working tree",
 			DiscardUnstagedFileChanges:        "Discard unstaged file changes",
 			RemoveUntrackedFiles:              "Remove untracked files",
+			CleanUntrackedFiles:               "Clean untracked files",
 			RemoveStagedFiles:                 "Remove staged files",
 			SoftReset:                         "Soft reset",
 			MixedReset:                        "Mixed reset",
@@ -1263,6 +2028,7 @@ func EnglishTranslationSet() TranslationSet {
 			FastForwardBranch:                 "Fast forward branch",
 			Undo:                              "Undo",
 			Redo:                              "Redo",
+			RestoreToReflogEntry:              "Restore to reflog entry",
 			CopyPullRequestURL:                "Copy pull request URL",
 			OpenMergeTool:                     "Open merge tool",
 			OpenCommitInBrowser:               "Open commit in browser",
@@ -1271,6 +2037,13 @@ func EnglishTranslationSet() TranslationSet {
 			ResetBisect:                       "Reset bisect",
 			BisectSkip:                        "Bisect skip",
 			BisectMark:                        "Bisect mark",
+			BisectRun:                         "Bisect run",
+			CommitSelection:                   "Commit selected lines",
+			MovePatchIntoNewCommitBefore:      "Move patch into new commit before the original commit",
+			ApplyPatchToWorkingTree:           "Apply patch to working tree",
+			ApplyPatchToWorkingTreeInReverse:  "Apply patch to working tree in reverse",
+			SavePatchAsStashEntry:             "Save patch as stash entry",
+			SavePatchToFile:                   "Save patch to file",
 		},
 		Bisect: Bisect{
 			Mark:                        "mark %s as %s",
@@ -1283,6 +2056,13 @@ func EnglishTranslationSet() TranslationSet {
 			CompleteTitle:               "Bisect complete",
 			CompletePrompt:              "Bisect complete! The following commit introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			CompletePromptIndeterminate: "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
+			RunCommand:                  "run a command to find the %s commit",
+			RunCommandTitle:             "Command to test each commit with (exit code 125 skips the commit)",
+			RunningStatus:               "running bisect",
+			CancelRun:                   "cancel bisect run",
+			CancelRunAndReset:           "cancel bisect run and reset bisect",
+			RunCompletePrompt:           "Bisect complete! The following commit introduced the change:\n\n%s\n\nPress enter to select it.",
+			RunCompleteIndeterminate:    "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nPress enter to select the first of them.",
 		},
 	}
 }
@@ -203,7 +203,6 @@ func japaneseTranslationSet() TranslationSet {
 		// ConflictsResolved:                   "all merge conflicts resolved. Continue?",
 		// RebasingTitle:                       "Rebasing",
 		// ConfirmRebase:                       "Are you sure you want to rebase '{{.checkedOutBranch}}' onto '{{.selectedBranch}}'?",
-		// ConfirmMerge:                        "Are you sure you want to merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'?",
 		// FwdNoUpstream:                       "Cannot fast-forward a branch with no upstream",
 		// FwdNoLocalUpstream:                  "Cannot fast-forward a branch whose remote is not registered locally",
 		// FwdCommitsToPush:                    "Cannot fast-forward a branch with commits to push",
@@ -347,8 +346,7 @@ func japaneseTranslationSet() TranslationSet {
 		Keybindings:         "キーバインド",
 		LcRenameBranch:      "ブランチ名を変更",
 		NewBranchNamePrompt: "新しいブランチ名を入力",
-		// RenameBranchWarning:                 "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
-		LcOpenMenu: "メニューを開く",
+		LcOpenMenu:          "メニューを開く",
 		// LcResetCherryPick:                   "reset cherry-picked (copied) commits selection",
 		LcNextTab:               "次のタブ",
 		LcPrevTab:               "前のタブ",
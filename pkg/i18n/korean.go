@@ -204,7 +204,6 @@ func koreanTranslationSet() TranslationSet {
 		ConflictsResolved:                   "모든 병합 충돌이 해결되었습니다. 계속 할까요?",
 		RebasingTitle:                       "리베이스 중",
 		ConfirmRebase:                       "정말로 '{{.checkedOutBranch}}' 을(를) '{{.selectedBranch}}'에 리베이스 하시겠습니까?",
-		ConfirmMerge:                        "정말로 '{{.selectedBranch}}' 을(를) '{{.checkedOutBranch}}'에 병합하시겠습니까?",
 		FwdNoUpstream:                       "Cannot fast-forward a branch with no upstream",
 		FwdNoLocalUpstream:                  "Cannot fast-forward a branch whose remote is not registered locally",
 		FwdCommitsToPush:                    "Cannot fast-forward a branch with commits to push",
@@ -348,7 +347,6 @@ func koreanTranslationSet() TranslationSet {
 		Keybindings:                  "키 바인딩",
 		LcRenameBranch:               "브랜치 이름 변경",
 		NewBranchNamePrompt:          "새로운 브랜치 이름 입력",
-		RenameBranchWarning:          "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
 		LcOpenMenu:                   "매뉴 열기",
 		LcResetCherryPick:            "reset cherry-picked (copied) commits selection",
 		LcNextTab:                    "이전 탭",
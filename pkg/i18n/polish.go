@@ -115,7 +115,6 @@ func polishTranslationSet() TranslationSet {
 		RebasingTitle:                       "Zmiana bazy",
 		MergingTitle:                        "Scalanie",
 		ConfirmRebase:                       "Czy napewno chcesz zmienić bazę '{{.checkedOutBranch}}' na '{{.selectedBranch}}'?",
-		ConfirmMerge:                        "Czy na pewno chcesz scalić '{{.selectedBranch}}' do '{{.checkedOutBranch}}'?",
 		FwdNoUpstream:                       "Nie można przewinąć gałęzi bez gałęzi nadrzędnej",
 		FwdCommitsToPush:                    "Nie można przewinąć gałęzi z commitami do wysłania",
 		ErrorOccurred:                       "Wystąpił błąd! Zgłoś problem na",
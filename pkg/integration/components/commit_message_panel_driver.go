@@ -15,6 +15,13 @@ func (self *CommitMessagePanelDriver) InitialText(expected *Matcher) *CommitMess
 	return self
 }
 
+// asserts on the title of the panel, which lists any warnings about the message
+func (self *CommitMessagePanelDriver) Title(expected *Matcher) *CommitMessagePanelDriver {
+	self.getViewDriver().Title(expected)
+
+	return self
+}
+
 func (self *CommitMessagePanelDriver) Type(value string) *CommitMessagePanelDriver {
 	self.t.typeContent(value)
 
@@ -27,6 +34,26 @@ func (self *CommitMessagePanelDriver) AddNewline() *CommitMessagePanelDriver {
 	return self
 }
 
+func (self *CommitMessagePanelDriver) OpenTrailersMenu() *CommitMessagePanelDriver {
+	self.t.press(self.t.keys.CommitMessage.TrailersMenu)
+
+	return self
+}
+
+// goes back to the previous message in the commit message history
+func (self *CommitMessagePanelDriver) SelectPreviousMessage() *CommitMessagePanelDriver {
+	self.t.press(self.t.keys.Universal.PrevItem)
+
+	return self
+}
+
+// goes forward to the next message in the commit message history
+func (self *CommitMessagePanelDriver) SelectNextMessage() *CommitMessagePanelDriver {
+	self.t.press(self.t.keys.Universal.NextItem)
+
+	return self
+}
+
 func (self *CommitMessagePanelDriver) Clear() *CommitMessagePanelDriver {
 	// clearing multiple times in case there's multiple lines
 	//  (the clear button only clears a single line at a time)
@@ -18,6 +18,14 @@ func (self *Git) TagNamesAt(ref string, expectedNames []string) *Git {
 	return self.assert(fmt.Sprintf(`git tag --sort=v:refname --points-at "%s"`, ref), strings.Join(expectedNames, "\n"))
 }
 
+func (self *Git) StagedDiff(expected string) *Git {
+	return self.assert("git diff --cached", expected)
+}
+
+func (self *Git) RemoteUrls(expected string) *Git {
+	return self.assert("git remote -v", expected)
+}
+
 func (self *Git) assert(cmdStr string, expected string) *Git {
 	self.assertWithRetries(func() (bool, string) {
 		output, err := self.shell.runCommandWithOutput(cmdStr)
@@ -25,7 +33,7 @@ func (self *Git) assert(cmdStr string, expected string) *Git {
 			return false, fmt.Sprintf("Unexpected error running command: `%s`. Error: %s", cmdStr, err.Error())
 		}
 		actual := strings.TrimSpace(output)
-		return actual == expected, fmt.Sprintf("Expected current branch name to be '%s', but got '%s'", expected, actual)
+		return actual == expected, fmt.Sprintf("Expected output of `%s` to be '%s', but got '%s'", cmdStr, expected, actual)
 	})
 
 	return self
@@ -331,6 +331,24 @@ func (self *ViewDriver) IsFocused() *ViewDriver {
 	return self
 }
 
+// asserts that the view is shown on the screen
+func (self *ViewDriver) IsVisible() *ViewDriver {
+	self.t.assertWithRetries(func() (bool, string) {
+		return self.getView().Visible, fmt.Sprintf("%s: Expected view to be visible, but it was not", self.context)
+	})
+
+	return self
+}
+
+// asserts that the view is not shown on the screen
+func (self *ViewDriver) IsInvisible() *ViewDriver {
+	self.t.assertWithRetries(func() (bool, string) {
+		return !self.getView().Visible, fmt.Sprintf("%s: Expected view to be invisible, but it was not", self.context)
+	})
+
+	return self
+}
+
 func (self *ViewDriver) Press(keyStr string) *ViewDriver {
 	self.IsFocused()
 
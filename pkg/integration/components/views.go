@@ -42,7 +42,7 @@ func (self *Views) patchExplorerViewByName(viewName string) *ViewDriver {
 			if state == nil {
 				return nil, errors.New("Expected patch explorer to be activated")
 			}
-			selectedContent := state.PlainRenderSelected()
+			selectedContent := state.PlainRenderSelectedVisibleLines()
 			// the above method returns a string with a trailing newline so we need to remove that before splitting
 			selectedLines := strings.Split(strings.TrimSuffix(selectedContent, "\n"), "\n")
 			return selectedLines, nil
@@ -54,7 +54,7 @@ func (self *Views) patchExplorerViewByName(viewName string) *ViewDriver {
 				return 0, 0, errors.New("Expected patch explorer to be activated")
 			}
 			startIdx, endIdx := state.SelectedRange()
-			return startIdx, endIdx, nil
+			return state.ViewLineIdx(startIdx), state.ViewLineIdx(endIdx), nil
 		},
 		func() (int, error) {
 			ctx := self.t.gui.ContextForView(viewName).(*context.PatchExplorerContext)
@@ -62,7 +62,7 @@ func (self *Views) patchExplorerViewByName(viewName string) *ViewDriver {
 			if state == nil {
 				return 0, errors.New("Expected patch explorer to be activated")
 			}
-			return state.GetSelectedLineIdx(), nil
+			return state.SelectedViewLineIdx(), nil
 		},
 	)
 }
@@ -141,6 +141,11 @@ func (self *Views) Information() *ViewDriver {
 	return self.regularView("information")
 }
 
+// the command log
+func (self *Views) Extras() *ViewDriver {
+	return self.regularView("extras")
+}
+
 func (self *Views) AppStatus() *ViewDriver {
 	return self.regularView("appStatus")
 }
@@ -177,6 +182,10 @@ func (self *Views) Stash() *ViewDriver {
 	return self.regularView("stash")
 }
 
+func (self *Views) Blame() *ViewDriver {
+	return self.regularView("blame")
+}
+
 func (self *Views) Staging() *ViewDriver {
 	return self.patchExplorerViewByName("staging")
 }
@@ -0,0 +1,51 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CancelRun = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cancel a git bisect run that's in progress and reset the bisect",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(5)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 05")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`mark .* as bad`)).Confirm()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`mark .* as good`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("run a command to find the bad commit")).Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Command to test each commit with")).
+					Type("sleep 60").
+					Confirm()
+
+				t.Views().Extras().Content(Contains("sleep 60"))
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Bisect")).
+					Select(Contains("cancel bisect run and reset bisect")).
+					Confirm()
+			})
+
+		t.Views().Information().Content(DoesNotContain("bisecting"))
+	},
+})
@@ -0,0 +1,59 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RunCommand = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Have git bisect run a command to find a bad commit, skipping a commit that can't be tested",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("commit 01").
+			EmptyCommit("commit 02").
+			CreateFileAndAdd("untestable", "").
+			Commit("commit 03").
+			RunCommand("git rm untestable").
+			Commit("commit 04").
+			EmptyCommit("commit 05").
+			CreateFileAndAdd("broken", "").
+			Commit("commit 06").
+			EmptyCommit("commit 07").
+			EmptyCommit("commit 08")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 08")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`mark .* as bad`)).Confirm()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`mark .* as good`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("run a command to find the bad commit")).Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Command to test each commit with")).
+					Type("if [ -f untestable ]; then exit 125; fi; test ! -f broken").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Bisect complete")).
+					Content(MatchesRegexp("(?s)commit 06.*Press enter to select it")).
+					Confirm()
+			}).
+			IsFocused().
+			SelectedLine(Contains("commit 06"))
+
+		t.Views().Information().Content(Contains("bisecting"))
+	},
+})
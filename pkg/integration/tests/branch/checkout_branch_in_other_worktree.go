@@ -0,0 +1,56 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CheckoutBranchInOtherWorktree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out a branch that's checked out in another worktree, first by switching to that worktree and then by ignoring it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("feature commit")
+		shell.Checkout("master")
+		shell.RunCommand("git worktree add ../linked feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("repo → master"))
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			NavigateToLine(Contains("feature")).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("'feature' is checked out in another worktree")).
+			Select(Contains("switch to worktree").Contains("linked")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("linked → feature"))
+
+		// we land in the panel we were in before
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("feature").IsSelected(),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("master")).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("'master' is checked out in another worktree")).
+			Select(Contains("check out here anyway")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("linked → master"))
+	},
+})
@@ -0,0 +1,58 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CheckoutWithDetachedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out a branch while on a detached HEAD with commits that no branch contains, saving them in a new branch first",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			Checkout("HEAD^0").
+			EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				MatchesRegexp(`\*.*HEAD`).IsSelected(),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("master")).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("HEAD has commits that aren't on any branch")).
+			Select(Contains("create branch at HEAD")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter new branch name for branch")).
+			Type("saved").
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("* master").IsSelected(),
+				Contains("saved"),
+			)
+
+		t.Git().CurrentBranchName("master")
+
+		t.Views().Branches().
+			NavigateToLine(Contains("saved")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,34 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CheckoutWithDetachedCommitsAlreadyOnBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out a branch while on a detached HEAD that another branch contains, which needs no warning",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			EmptyCommit("two").
+			Checkout("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				MatchesRegexp(`\*.*HEAD`).IsSelected(),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("master")).
+			PressPrimaryAction().
+			Lines(
+				Contains("* master").IsSelected(),
+			)
+
+		t.Git().CurrentBranchName("master")
+	},
+})
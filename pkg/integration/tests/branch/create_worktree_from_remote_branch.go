@@ -0,0 +1,60 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateWorktreeFromRemoteBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a worktree from a remote branch that doesn't exist locally and switch to it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature/one")
+		shell.EmptyCommit("feature commit")
+		shell.Checkout("master")
+		shell.CloneIntoRemote("origin")
+		shell.RunCommand("git branch -D feature/one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("repo → master"))
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			Press(keys.Branches.CreateWorktree)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create worktree")).
+			Select(Contains("from a local or remote branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Branch to check out in the new worktree:")).
+			Clear().
+			Type("origin/feature/one").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path of the new worktree:")).
+			InitialText(Contains("/feature-one")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Switch to worktree")).
+			Content(Equals("Do you want to switch to the new worktree now?")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("feature-one → feature/one"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature commit"),
+				Contains("one"),
+			)
+	},
+})
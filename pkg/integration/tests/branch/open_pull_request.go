@@ -0,0 +1,53 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenPullRequest = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open a pull request on a self-hosted gitea, picking the branch to open it into",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Services = map[string]string{
+			"git.mycompany.com": "gitea:https://git.mycompany.com",
+		}
+		config.UserConfig.OS.OpenLinkCommand = "echo {{link}} > ../pull_request_url"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("feature/new").
+			RunCommand("git remote add origin git@git.mycompany.com:team/repo.git").
+			RunCommand("git symbolic-ref refs/remotes/origin/HEAD refs/remotes/origin/develop")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature/new").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Branches.CreatePullRequest)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("feature/new →")).
+			InitialText(Equals("develop")).
+			Confirm()
+
+		t.FileSystem().FileContent("../pull_request_url", Equals("https://git.mycompany.com/team/repo/compare/develop...feature/new\n"))
+
+		t.Views().Branches().
+			Press(keys.Branches.CreatePullRequest)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("feature/new →")).
+			InitialText(Equals("develop")).
+			Clear().
+			Type("release/2.0").
+			Confirm()
+
+		t.FileSystem().FileContent("../pull_request_url", Equals("https://git.mycompany.com/team/repo/compare/release/2.0...feature/new\n"))
+	},
+})
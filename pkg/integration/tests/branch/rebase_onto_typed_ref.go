@@ -0,0 +1,96 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseOntoTypedRef = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rebase onto refs typed into a prompt, with and without --onto, asking again when the ref is invalid",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("feature").
+			EmptyCommit("feature one").
+			EmptyCommit("feature two").
+			Checkout("master").
+			EmptyCommit("master one").
+			EmptyCommit("master two").
+			Checkout("feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("feature two"),
+				Contains("feature one"),
+				Contains("base"),
+			).
+			Press(keys.Commits.RebaseOntoRef)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase onto ref")).
+			Select(Contains("rebase checked-out branch onto ref")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Rebase onto:")).
+			Type("nope").
+			Confirm()
+
+		t.ExpectToast(Contains("fatal"))
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Rebase onto:")).
+			InitialText(Equals("nope")).
+			Clear().
+			Type("master~1").
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Rebasing")).
+			Content(Equals("Are you sure you want to rebase 'feature' on top of 'master~1'?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature two"),
+				Contains("feature one"),
+				Contains("master one"),
+				Contains("base"),
+			).
+			Press(keys.Commits.RebaseOntoRef)
+
+		// move only the last commit onto master, leaving 'feature one' behind
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase onto ref")).
+			Select(Contains("(--onto)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Rebase onto:")).
+			Type("master").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Rebase the commits after:")).
+			Type("HEAD~1").
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Rebasing")).
+			Content(Equals("Are you sure you want to rebase the commits of 'feature' after 'HEAD~1' on top of 'master'?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature two"),
+				Contains("master two"),
+				Contains("master one"),
+				Contains("base"),
+			)
+	},
+})
@@ -0,0 +1,77 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowDivergenceFromBaseBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show how far each branch has diverged from its base branch, set the base branch by hand, and see the counts change when the base branch moves",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.ShowDivergenceFromBaseBranch = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("develop").
+			EmptyCommit("develop one").
+			Checkout("master").
+			NewBranch("feature").
+			EmptyCommit("feature one").
+			EmptyCommit("feature two").
+			Checkout("master").
+			EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").DoesNotContain("↑"),
+				Contains("feature").Contains("(master ↑2↓1)"),
+				Contains("develop").Contains("(master ↑1↓1)"),
+			).
+			NavigateToLine(Contains("feature")).
+			Press(keys.Branches.SetBaseBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Base branch for 'feature' (leave empty to detect it automatically):")).
+					InitialText(Equals("")).
+					Type("develop").
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("feature").Contains("(develop ↑2↓1)").IsSelected(),
+				Contains("develop").Contains("(master ↑1↓1)"),
+			).
+			Press(keys.Branches.SetBaseBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Base branch for 'feature' (leave empty to detect it automatically):")).
+					InitialText(Equals("develop")).
+					Clear().
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("feature").Contains("(master ↑2↓1)").IsSelected(),
+				Contains("develop").Contains("(master ↑1↓1)"),
+			)
+
+		t.Shell().EmptyCommit("three")
+
+		// in the branches view, the refresh key renames the branch
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.Refresh)
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("feature").Contains("(master ↑2↓2)"),
+				Contains("develop").Contains("(master ↑1↓2)"),
+			)
+	},
+})
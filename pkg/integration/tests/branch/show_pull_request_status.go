@@ -0,0 +1,99 @@
+package branch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowPullRequestStatus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the pull requests of branches, refreshing them along with the branches, and open a branch's pull request rather than creating another one",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// stands in for a github enterprise instance, living as long as lazygit
+		// does. The pull request in review is approved once the test says so.
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Variables map[string]string `json:"variables"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			reviewDecision := "REVIEW_REQUIRED"
+			if _, err := os.Stat("../approved"); err == nil {
+				reviewDecision = "APPROVED"
+			}
+			pullRequests := map[string]string{
+				"feature/approved":  `{"number": 12, "url": "https://git.mycompany.com/team/repo/pull/12", "state": "OPEN", "headRefName": "feature/approved", "isCrossRepository": false, "reviewDecision": "APPROVED"}`,
+				"feature/in-review": fmt.Sprintf(`{"number": 11, "url": "https://git.mycompany.com/team/repo/pull/11", "state": "OPEN", "headRefName": "feature/in-review", "isCrossRepository": false, "reviewDecision": %q}`, reviewDecision),
+			}
+
+			aliases := []string{}
+			for key, branchName := range body.Variables {
+				if strings.HasPrefix(key, "branch") {
+					aliases = append(aliases, fmt.Sprintf(`%q: {"nodes": [%s]}`, key, pullRequests[branchName]))
+				}
+			}
+			_, _ = w.Write([]byte(`{"data": {"repository": {` + strings.Join(aliases, ",") + `}}}`))
+		}))
+		serverURL, _ := url.Parse(server.URL)
+
+		config.UserConfig.Git.ShowPullRequestStatus = true
+		config.UserConfig.Git.HostingServiceTokens = map[string]string{serverURL.Host: "token"}
+		config.UserConfig.Services = map[string]string{"git.mycompany.com": "github:" + server.URL}
+		config.UserConfig.OS.OpenLinkCommand = "echo {{link}} > ../opened_url"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("feature/no-pull-request").
+			NewBranch("feature/in-review").
+			NewBranch("feature/approved").
+			RunCommand("git remote add origin git@git.mycompany.com:team/repo.git")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature/approved").Contains("#12 ✓").IsSelected(),
+				Contains("feature/in-review").Contains("#11").DoesNotContain("✓"),
+				Contains("feature/no-pull-request").DoesNotContain("#"),
+				Contains("master").DoesNotContain("#"),
+			).
+			Press(keys.Branches.CreatePullRequest)
+
+		t.FileSystem().FileContent("../opened_url", Equals("https://git.mycompany.com/team/repo/pull/12\n"))
+
+		t.Views().Branches().
+			NavigateToLine(Contains("feature/in-review")).
+			Press(keys.Branches.ViewPullRequestOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create pull request options")).
+			Select(Contains("open pull request #11 in browser")).
+			Confirm()
+
+		t.FileSystem().FileContent("../opened_url", Equals("https://git.mycompany.com/team/repo/pull/11\n"))
+
+		// the pull requests are asked for again when the branches are refreshed
+		t.Shell().CreateFile("../approved", "")
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.Refresh)
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature/approved").Contains("#12 ✓"),
+				Contains("feature/in-review").Contains("#11 ✓"),
+				Contains("feature/no-pull-request").DoesNotContain("#"),
+				Contains("master").DoesNotContain("#"),
+			)
+	},
+})
@@ -0,0 +1,60 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SquashMerge = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Squash merge a branch into the checked out branch, committing its changes as a single commit",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("base", "base").
+			Commit("base commit").
+			NewBranch("feature").
+			CreateFileAndAdd("one", "one").
+			Commit("add one").
+			CreateFileAndAdd("two", "two").
+			Commit("add two").
+			CreateFileAndAdd("three", "three").
+			Commit("add three").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			SelectNextItem().
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge 'feature' into 'master'")).
+			Select(Contains("squash merge")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("Squashed 'feature'\n\n- add one\n- add two\n- add three")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("Squashed 'feature'").IsSelected(),
+				Contains("base commit"),
+			)
+
+		t.Views().Main().
+			Content(Contains("- add one")).
+			Content(Contains("- add two")).
+			Content(Contains("add three"))
+
+		t.Views().Files().
+			IsEmpty()
+	},
+})
@@ -0,0 +1,52 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SquashMergeNoChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Squash merge a branch whose changes are already on the checked out branch",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("base", "base").
+			Commit("base commit").
+			NewBranch("feature").
+			Checkout("master").
+			CreateFileAndAdd("one", "one").
+			Commit("add one").
+			Checkout("feature").
+			CreateFileAndAdd("one", "one").
+			Commit("add one again").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			SelectNextItem().
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge 'feature' into 'master'")).
+			Select(Contains("squash merge")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Squash merging 'feature' didn't change anything, so there's nothing to commit")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("add one"),
+				Contains("base commit"),
+			)
+	},
+})
@@ -0,0 +1,88 @@
+package cherry_pick
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CherryPickRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cherry pick three contiguous commits from another branch in one go",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("first-branch").
+			NewBranch("second-branch").
+			Checkout("first-branch").
+			CreateFileAndAdd("file1", "one\n").
+			Commit("one").
+			Checkout("second-branch").
+			CreateFileAndAdd("file2", "two\n").
+			Commit("two").
+			CreateFileAndAdd("file3", "three\n").
+			Commit("three").
+			CreateFileAndAdd("file4", "four\n").
+			Commit("four").
+			CreateFileAndAdd("file5", "five\n").
+			Commit("five").
+			Checkout("first-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("first-branch"),
+				Contains("second-branch"),
+				Contains("master"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("five").IsSelected(),
+				Contains("four"),
+				Contains("three"),
+				Contains("two"),
+				Contains("base"),
+			).
+			// copy 'four', 'three' and 'two', leaving out 'five'
+			SelectNextItem().
+			Press(keys.Commits.CherryPickCopy).
+			SelectNextItem().
+			Press(keys.Commits.CherryPickCopy).
+			SelectNextItem().
+			Press(keys.Commits.CherryPickCopy)
+
+		t.Views().Information().Content(Contains("3 commits copied"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.PasteCommits).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Cherry-Pick")).
+					Content(Contains("Are you sure you want to cherry-pick the copied commits onto this branch?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("four"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+				Contains("base"),
+			)
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.FileSystem().PathNotPresent("file5")
+	},
+})
@@ -0,0 +1,116 @@
+package commit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// returns the lines 'line 1' through 'line 20', with the given lines replaced
+func absorbFileContent(replacements map[int]string) string {
+	lines := []string{}
+	for i := 1; i <= 20; i++ {
+		if replacement, ok := replacements[i]; ok {
+			lines = append(lines, replacement)
+		} else {
+			lines = append(lines, fmt.Sprintf("line %d", i))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+var AbsorbStagedChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorb staged hunks into fixup commits for the commits that last changed their lines, leaving hunks which belong to the main branch staged",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", absorbFileContent(nil))
+		shell.Commit("base")
+		shell.NewBranch("feature")
+		shell.UpdateFileAndAdd("file1", absorbFileContent(map[int]string{2: "two"}))
+		shell.Commit("change top")
+		shell.UpdateFileAndAdd("file1", absorbFileContent(map[int]string{2: "two", 18: "eighteen"}))
+		shell.Commit("change bottom")
+
+		shell.UpdateFileAndAdd("file1", absorbFileContent(map[int]string{2: "TWO", 10: "TEN", 18: "EIGHTEEN"}))
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Contains("change bottom"),
+				Contains("change top"),
+				Contains("base"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1"),
+			).
+			Press(keys.Files.AbsorbStagedChanges).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Absorb staged changes")).
+					Content(
+						Contains("change bottom: file1:15").
+							Contains("change top: file1:1").
+							Contains("file1:7: no commit on the current branch last changed these lines"),
+					).
+					Confirm()
+
+				t.Views().Commits().
+					Lines(
+						Contains("fixup! change top"),
+						Contains("fixup! change bottom"),
+						Contains("change bottom"),
+						Contains("change top"),
+						Contains("base"),
+					)
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Squash fixup commits")).
+					Content(Contains("Do you want to squash the fixup commits into their commits now?")).
+					Confirm()
+			})
+
+		t.Views().Commits().
+			Lines(
+				Contains("change bottom"),
+				Contains("change top"),
+				Contains("base"),
+			)
+
+		// only the hunk that no commit on the branch could be found for is left
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("+TEN").
+					DoesNotContain("+TWO").
+					DoesNotContain("+EIGHTEEN"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("change top")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1"),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("+TWO").
+					DoesNotContain("EIGHTEEN"),
+			)
+	},
+})
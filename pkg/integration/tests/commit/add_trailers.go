@@ -0,0 +1,108 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddTrailers = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add co-author and issue trailers to the commit message",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Commit.IssueTrailerTemplate = "Closes #{{issue}}"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+		shell.EmptyCommit("one")
+		shell.SetConfig("user.email", "John@example.com")
+		shell.SetConfig("user.name", "John Smith")
+		shell.EmptyCommit("two")
+		shell.SetConfig("user.email", "Jane@example.com")
+		shell.SetConfig("user.name", "Jane Smith")
+		shell.EmptyCommit("three")
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+		shell.EmptyCommit("four")
+
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("subject").
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("add co-author")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add co-author")).
+			// the current user isn't offered as a co-author
+			Lines(
+				Contains("Jane Smith <Jane@example.com>").IsSelected(),
+				Contains("John Smith <John@example.com>"),
+				Contains("cancel"),
+			).
+			Select(Contains("John Smith")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("subject\n\nCo-authored-by: John Smith <John@example.com>")).
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("add co-author")).
+			Confirm()
+
+		// we can't add the same co-author twice
+		t.ExpectPopup().Menu().
+			Title(Equals("Add co-author")).
+			Select(Contains("John Smith")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("This co-author has already been added")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("subject\n\nCo-authored-by: John Smith <John@example.com>")).
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("add issue")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Issue number:")).
+			Type("123").
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("subject\n\nCo-authored-by: John Smith <John@example.com>\nCloses #123")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("subject").IsSelected(),
+				Contains("four"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Co-authored-by: John Smith <John@example.com>")).
+			Content(Contains("Closes #123"))
+	},
+})
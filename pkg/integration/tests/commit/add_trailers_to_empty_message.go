@@ -0,0 +1,73 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddTrailersToEmptyMessage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add trailers to an empty commit message, keeping a blank subject line above them",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Commit.IssueTrailerTemplate = "Closes #{{issue}}"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.email", "John@example.com")
+		shell.SetConfig("user.name", "John Smith")
+		shell.EmptyCommit("one")
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("add issue")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Issue number:")).
+			Type("123").
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("\n\nCloses #123")).
+			OpenTrailersMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add trailer")).
+			Select(Contains("add co-author")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add co-author")).
+			Select(Contains("John Smith")).
+			Confirm()
+
+		// the cursor is left on the subject line
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("\n\nCloses #123\nCo-authored-by: John Smith <John@example.com>")).
+			Type("subject").
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("subject").IsSelected(),
+				Contains("one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Closes #123")).
+			Content(Contains("Co-authored-by: John Smith <John@example.com>"))
+	},
+})
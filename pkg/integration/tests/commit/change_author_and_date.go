@@ -0,0 +1,96 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ChangeAuthorAndDate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Change the author and date of a commit and the commits above it, and get warned before rewriting a commit on the main branch",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+
+		shell.SetConfig("user.email", "John@example.com")
+		shell.SetConfig("user.name", "John Smith")
+
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("JS").Contains("three").IsSelected(),
+				Contains("JS").Contains("two"),
+				Contains("BS").Contains("one"),
+			).
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("change author/date of this and all commits above it")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Set author")).
+					InitialText(Equals("John Smith <John@example.com>")).
+					Clear().
+					SuggestionLines(
+						Contains("John Smith"),
+						Contains("Bill Smith"),
+					).
+					Type("Jane Doe <jane@example.com>").
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Set author date")).
+					Type("2020-01-01 10:00:00 +0000").
+					Confirm()
+			}).
+			Lines(
+				Contains("JD").Contains("three"),
+				Contains("JD").Contains("two").IsSelected(),
+				Contains("BS").Contains("one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Author: Jane Doe <jane@example.com>").Contains("Date:   Wed Jan 1 10:00:00 2020 +0000"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("change author/date of this and all commits above it")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Set author")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Set author date")).
+					Type("2020-01-01 10:00:00 +0000").
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Rewrite merged commits")).
+					Content(Contains("already been merged into a main branch")).
+					Cancel()
+			}).
+			Lines(
+				Contains("JD").Contains("three"),
+				Contains("JD").Contains("two"),
+				Contains("BS").Contains("one").IsSelected(),
+			)
+	},
+})
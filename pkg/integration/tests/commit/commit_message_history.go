@@ -0,0 +1,65 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitMessageHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Go back through the messages of previous commits in the commit message panel",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("one", "one")
+		shell.CreateFile("two", "two")
+		shell.CreateFile("three", "three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("one")).
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("first commit").AddNewline().AddNewline().Type("body").
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+			).
+			NavigateToLine(Contains("two")).
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("fixup! first commit").
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("three"),
+			).
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("draft").
+			SelectPreviousMessage().
+			InitialText(Equals("first commit\n\nbody")).
+			// there is nothing further back, so we move the cursor up instead
+			SelectPreviousMessage().
+			SelectPreviousMessage().
+			InitialText(Equals("first commit\n\nbody")).
+			SelectNextMessage().
+			SelectNextMessage().
+			SelectNextMessage().
+			InitialText(Equals("draft")).
+			Cancel()
+	},
+})
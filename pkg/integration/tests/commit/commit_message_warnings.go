@@ -0,0 +1,58 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitMessageWarnings = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show warnings about the commit message as it's typed, and confirm committing a subject that doesn't match the regex",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Commit.MaxSubjectLength = 10
+		config.UserConfig.Git.Commit.SubjectRegex = `^(feat|fix): .+`
+		config.UserConfig.Git.Commit.ConfirmOnSubjectRegexMismatch = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Commit message")).
+			Type("fix: it").
+			Title(Equals("Commit message")).
+			Type(" properly").
+			Title(Equals("Commit message (subject is longer than 10 characters)")).
+			AddNewline().
+			Type("body").
+			Title(Equals("Commit message (subject is longer than 10 characters, second line isn't blank)")).
+			Clear().
+			Type("wip").
+			Title(Equals("Commit message (subject doesn't match ^(feat|fix): .+)")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Commit subject doesn't match")).
+			Content(Equals("The subject doesn't match ^(feat|fix): .+. Commit anyway?")).
+			Cancel()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("wip")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Commit subject doesn't match")).
+			Content(Contains("Commit anyway?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("wip"),
+			)
+	},
+})
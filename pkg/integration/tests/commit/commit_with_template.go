@@ -0,0 +1,38 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitWithTemplate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with the message prefilled from the repo's .gitmessage template",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitmessage", "\n\n# Explain why this change is needed\n")
+		shell.Commit("add template")
+		shell.CreateFile("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Contains("# Explain why this change is needed")).
+			Type("my summary").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("my summary"),
+				Contains("add template"),
+			)
+
+		t.Views().Commits().Focus()
+		t.Views().Main().Content(DoesNotContain("Explain why"))
+	},
+})
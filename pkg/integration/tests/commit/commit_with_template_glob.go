@@ -0,0 +1,41 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitWithTemplateGlob = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with the message prefilled from the template configured for the branch",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Commit.TemplateGlobs = map[string]string{
+			"feature/*": ".feature-template",
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitmessage", "\n\n# Default template\n")
+		shell.CreateFileAndAdd(".feature-template", "\n\n# Feature template\n")
+		shell.Commit("add templates")
+		shell.NewBranch("feature/login")
+		shell.CreateFile("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Contains("# Feature template")).
+			Type("add login").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("add login"),
+				Contains("add templates"),
+			)
+	},
+})
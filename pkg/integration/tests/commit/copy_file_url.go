@@ -0,0 +1,52 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CopyFileUrl = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Copy the URL of a file at a commit, and of the selected lines of it, from a self-hosted gitea",
+	ExtraCmdArgs: "",
+	Skip:         true, // skipping because CI doesn't have clipboard functionality
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Services = map[string]string{
+			"git.mycompany.com": "gitea:https://git.mycompany.com",
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+		shell.CreateFileAndAdd("dir/file", "one\ntwo\nthree\nfour\n")
+		shell.Commit("first commit")
+		shell.RunCommand("git remote add origin git@git.mycompany.com:team/repo.git")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			NavigateToLine(Contains("file")).
+			Press(keys.CommitFiles.CopyFileURL)
+
+		t.ExpectToast(Equals("File URL copied to clipboard"))
+		t.ExpectClipboard(Contains("https://git.mycompany.com/team/repo/src/commit/").Contains("/dir/file").DoesNotContain("#"))
+
+		t.Views().CommitFiles().
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLine(Contains("+one")).
+			NavigateToLine(Contains("+two")).
+			Press(keys.Main.ToggleDragSelect).
+			NavigateToLine(Contains("+three")).
+			Press(keys.CommitFiles.CopyFileURL)
+
+		t.ExpectClipboard(Contains("https://git.mycompany.com/team/repo/src/commit/").Contains("/dir/file#L2-L3"))
+	},
+})
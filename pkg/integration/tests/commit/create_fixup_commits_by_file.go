@@ -0,0 +1,98 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateFixupCommitsByFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a fixup commit for each staged file's last commit on the branch, changing the commit of one of the files before doing so",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("fileA", "a\n")
+		shell.CreateFileAndAdd("fileB", "b\n")
+		shell.CreateFileAndAdd("fileC", "c\n")
+		shell.Commit("base")
+		shell.NewBranch("feature")
+		shell.UpdateFileAndAdd("fileA", "a\na2\n")
+		shell.Commit("change a")
+		shell.UpdateFileAndAdd("fileB", "b\nb2\n")
+		shell.Commit("change b")
+
+		shell.UpdateFileAndAdd("fileA", "a\na2\na3\n")
+		shell.UpdateFileAndAdd("fileB", "b\nb2\nb3\n")
+		shell.UpdateFileAndAdd("fileC", "c\nc2\n")
+		shell.CreateFileAndAdd("fileD", "d\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CreateFixupCommitsByFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Fixup staged files")).
+					Lines(
+						Contains("Create fixup commits"),
+						Contains("fileA").Contains("change a"),
+						Contains("fileB").Contains("change b"),
+						Contains("fileC").Contains("leave staged (no commit on the current branch changed this file)"),
+						Contains("fileD").Contains("leave staged (new and renamed files can't be absorbed)"),
+						Contains("cancel"),
+					).
+					Select(Contains("fileD")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("fileD")).
+					Lines(
+						Contains("change b"),
+						Contains("change a"),
+						Contains("leave staged"),
+						Contains("cancel"),
+					).
+					Select(Contains("change a")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Fixup staged files")).
+					Lines(
+						Contains("Create fixup commits"),
+						Contains("fileA").Contains("change a"),
+						Contains("fileB").Contains("change b"),
+						Contains("fileC").Contains("leave staged"),
+						Contains("fileD").Contains("change a"),
+						Contains("cancel"),
+					).
+					Select(Contains("Create fixup commits")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Squash fixup commits")).
+					Content(Contains("Do you want to squash the fixup commits into their commits now?")).
+					Cancel()
+			}).
+			Lines(
+				Contains("fileC"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fixup! change a").IsSelected(),
+				Contains("fixup! change b"),
+				Contains("change b"),
+				Contains("change a"),
+				Contains("base"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("fileA"),
+				Contains("fileD"),
+			)
+	},
+})
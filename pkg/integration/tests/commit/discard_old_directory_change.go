@@ -0,0 +1,62 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardOldDirectoryChange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discarding a directory from an old commit discards the changes to every file within it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+		shell.CreateFileAndAdd("dir/existingFile", "original")
+		shell.CreateFileAndAdd("otherFile", "other")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("dir/existingFile", "changed")
+		shell.CreateFileAndAdd("dir/newFile", "new")
+		shell.UpdateFileAndAdd("otherFile", "other changed")
+		shell.Commit("commit to change")
+
+		shell.CreateFileAndAdd("file3", "file3")
+		shell.Commit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("third commit").IsSelected(),
+				Contains("commit to change"),
+				Contains("first commit"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("existingFile"),
+				Contains("newFile"),
+				Contains("otherFile"),
+			).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Discard file changes")).
+			Content(Contains("Are you sure you want to discard this commit's changes to all files in this directory?")).
+			Confirm()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("otherFile").IsSelected(),
+			)
+
+		t.FileSystem().PathNotPresent("dir/newFile")
+		t.FileSystem().FileContent("dir/existingFile", Equals("original"))
+	},
+})
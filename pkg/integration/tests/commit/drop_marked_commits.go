@@ -0,0 +1,68 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DropMarkedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark several commits that aren't next to each other and drop them all at once",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("one", "one").
+			Commit("one").
+			CreateFileAndAdd("wip-1", "wip").
+			Commit("wip").
+			CreateFileAndAdd("two", "two").
+			Commit("two").
+			CreateFileAndAdd("wip-2", "wip").
+			Commit("wip again").
+			CreateFileAndAdd("three", "three").
+			Commit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("wip again"),
+				Contains("two"),
+				Contains("wip"),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("wip again")).
+			Press(keys.Commits.ToggleMarkCommit).
+			NavigateToLine(Contains("wip").DoesNotContain("again")).
+			Press(keys.Commits.ToggleMarkCommit).
+			// marking and unmarking a commit leaves it unmarked
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.ToggleMarkCommit).
+			Press(keys.Commits.ToggleMarkCommit).
+			Lines(
+				Contains("three").DoesNotContain("*"),
+				Contains("* ").Contains("wip again"),
+				Contains("two").DoesNotContain("*"),
+				Contains("* ").Contains("wip"),
+				Contains("one").DoesNotContain("*").IsSelected(),
+			).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete Commit")).
+			Content(Equals("Are you sure you want to delete these 2 commits?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.Views().Files().
+			IsEmpty()
+	},
+})
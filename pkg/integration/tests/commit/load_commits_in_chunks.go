@@ -0,0 +1,37 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LoadCommitsInChunks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Load more commits than fit in one chunk, navigating while they load",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// so that loading the rest of the commits takes several chunks
+		config.UserConfig.Git.Log.ChunkSize = 100
+	},
+	SetupRepo: func(shell *Shell) {
+		// committing one at a time would take far too long
+		shell.RunShellCommand(`for i in $(seq 1 1000); do printf 'commit refs/heads/master\ncommitter CI <CI@example.com> %d +0000\ndata <<EOF\ncommit %05d\nEOF\n\n' $i $i; done | git fast-import --quiet`)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 01000")).
+			// passing the first couple of hundred commits starts loading the rest,
+			// which shouldn't stop us moving around in the meantime
+			NavigateToLine(Contains("commit 00790")).
+			Press(keys.Universal.NextItem).
+			Press(keys.Universal.NextItem).
+			SelectedLine(Contains("commit 00788")).
+			// if the rest are still loading, this takes us to the last of them
+			// once they're in
+			Press(keys.Universal.GotoBottom).
+			SelectedLine(Contains("commit 00001")).
+			Press(keys.Universal.PrevItem).
+			SelectedLine(Contains("commit 00002"))
+	},
+})
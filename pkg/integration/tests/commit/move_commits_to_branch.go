@@ -0,0 +1,72 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveCommitsToBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move the marked commits onto another branch without checking it out",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("base", "base").
+			Commit("base").
+			NewBranch("feature").
+			Checkout("master").
+			CreateFileAndAdd("feature-one", "one").
+			Commit("feature one").
+			CreateFileAndAdd("master", "master").
+			Commit("master change").
+			CreateFileAndAdd("feature-two", "two").
+			Commit("feature two").
+			CreateFile("unstaged", "unstaged")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("feature two").IsSelected(),
+				Contains("master change"),
+				Contains("feature one"),
+				Contains("base"),
+			).
+			Press(keys.Commits.ToggleMarkCommit).
+			NavigateToLine(Contains("feature one")).
+			Press(keys.Commits.ToggleMarkCommit).
+			Press(keys.Commits.MoveCommitsToBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Move commits to branch:")).
+			Type("feat").
+			ConfirmSuggestion(Equals("feature"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("master change"),
+				Contains("base"),
+			)
+
+		t.Git().CurrentBranchName("master")
+
+		t.Views().Files().
+			Lines(
+				Contains("?? unstaged"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("feature")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("feature two").IsSelected(),
+				Contains("feature one"),
+				Contains("base"),
+			)
+	},
+})
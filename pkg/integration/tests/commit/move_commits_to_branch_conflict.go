@@ -0,0 +1,65 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveCommitsToBranchConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Moving a commit that a later commit depends on leaves both branches as they were",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("base", "base").
+			Commit("base").
+			NewBranch("feature").
+			Checkout("master").
+			CreateFileAndAdd("file", "one").
+			Commit("add file").
+			UpdateFileAndAdd("file", "two").
+			Commit("change file")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("change file").IsSelected(),
+				Contains("add file"),
+				Contains("base"),
+			).
+			NavigateToLine(Contains("add file")).
+			Press(keys.Commits.MoveCommitsToBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Move commits to branch:")).
+			Type("feat").
+			ConfirmSuggestion(Equals("feature"))
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Couldn't remove the commits from this branch, so nothing has been moved")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("change file"),
+				Contains("add file"),
+				Contains("base"),
+			)
+
+		t.Git().CurrentBranchName("master")
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("feature")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("base").IsSelected(),
+			)
+	},
+})
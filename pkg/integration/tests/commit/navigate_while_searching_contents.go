@@ -0,0 +1,64 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NavigateWhileSearchingContents = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move around the commits while a search through their contents is still loading",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// the oldest commits are slow to search through, because we make
+		// diffing their file slow, so the first chunk of results comes in well
+		// before the last
+		shell.RunShellCommand(`{
+			for i in $(seq 1 10); do
+				printf 'commit refs/heads/master\ncommitter CI <CI@example.com> %d +0000\ndata <<EOF\nslow %02d\nEOF\nM 644 inline slow\ndata <<EOF\n' $i $i
+				yes needle | head -n $i
+				printf 'EOF\n\n'
+			done
+			for i in $(seq 1 1100); do
+				printf 'commit refs/heads/master\ncommitter CI <CI@example.com> %d +0000\ndata <<EOF\nfast %04d\nEOF\nM 644 inline fast\ndata <<EOF\n' $((i+10)) $i
+				yes needle | head -n $i
+				printf 'EOF\n\n'
+			done
+		} | git fast-import --quiet`)
+		shell.RunCommand("git reset --hard")
+		shell.CreateFile(".gitattributes", "slow diff=slow")
+		shell.SetConfig("diff.slow.textconv", "sleep 0.1; cat")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("search commit contents for added or removed text")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Text added or removed:")).
+			Type("needle").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("fast 1100")).
+			// moving around while the slow commits are being searched through
+			Press(keys.Universal.NextItem).
+			SelectedLine(Contains("fast 1099")).
+			Press(keys.Universal.NextItem).
+			SelectedLine(Contains("fast 1098"))
+
+		// waiting for the slow commits
+		t.Wait(3000)
+
+		// the results coming in haven't moved us
+		t.Views().Commits().
+			SelectedLine(Contains("fast 1098"))
+	},
+})
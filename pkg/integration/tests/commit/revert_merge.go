@@ -24,8 +24,8 @@ var RevertMerge = NewIntegrationTest(NewIntegrationTestArgs{
 		t.ExpectPopup().Menu().
 			Title(Equals("Select parent commit for merge")).
 			Lines(
-				Contains("first change"),
-				Contains("second-change-branch unrelated change"),
+				Contains("first change (current branch)"),
+				Contains("second-change-branch unrelated change").DoesNotContain("(current branch)"),
 				Contains("cancel"),
 			).
 			Select(Contains("first change")).
@@ -0,0 +1,77 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RevertWithConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reverts a commit whose changes were built upon, resolving the conflicts and committing to finish the revert",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("myfile", "two\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("myfile", "three\n")
+		shell.Commit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("third commit").IsSelected(),
+				Contains("second commit"),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("second commit")).
+			Press(keys.Commits.RevertCommit).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Revert commit")).
+					Content(MatchesRegexp(`Are you sure you want to revert \w+?`)).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Auto-merge failed")).
+					Content(Contains("commit to finish the revert")).
+					Confirm()
+			})
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU myfile"),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			// pick the version from before the reverted commit
+			SelectNextItem().
+			PressPrimaryAction()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("M  myfile"),
+			).
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("Revert second commit").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("Revert second commit"),
+				Contains("third commit"),
+				Contains("second commit"),
+				Contains("first commit"),
+			)
+
+		t.FileSystem().FileContent("myfile", Equals("one\n"))
+	},
+})
@@ -65,7 +65,7 @@ var Search = NewIntegrationTest(NewIntegrationTestArgs{
 			).
 			Press("n").
 			Tap(func() {
-				t.Views().Search().Content(Contains("matches for 'o' (1 of 3)"))
+				t.Views().Search().Content(Contains("matches for 'o' (1 of 3, continuing from the top)"))
 			}).
 			Lines(
 				Contains("four").IsSelected(),
@@ -95,7 +95,7 @@ var Search = NewIntegrationTest(NewIntegrationTestArgs{
 			).
 			Press("N").
 			Tap(func() {
-				t.Views().Search().Content(Contains("matches for 'o' (3 of 3)"))
+				t.Views().Search().Content(Contains("matches for 'o' (3 of 3, continuing from the bottom)"))
 			}).
 			Lines(
 				Contains("four"),
@@ -0,0 +1,81 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchContents = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search the contents of commits for text and for a regex, then go back to where we were",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("greeting", "hello world\n").
+			Commit("add greeting").
+			CreateFileAndAdd("other", "unrelated\n").
+			Commit("add other").
+			UpdateFileAndAdd("greeting", "goodbye world\n").
+			Commit("say goodbye").
+			UpdateFileAndAdd("other", "still unrelated\n").
+			Commit("change other")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("add other")).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("search commit contents for added or removed text")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Text added or removed:")).
+			Type("hello world").
+			Confirm()
+
+		t.Views().Information().Content(Contains("commits adding or removing 'hello world'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("say goodbye").IsSelected(),
+				Contains("add greeting"),
+			)
+
+		t.Views().Main().Content(Contains("-hello world"))
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("search commit contents for changed lines matching a regex")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Regex for changed lines:")).
+			Type("unrel.ted").
+			Confirm()
+
+		t.Views().Information().Content(Contains("commits with changes matching 'unrel.ted'"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("change other").IsSelected(),
+				Contains("add other"),
+			).
+			PressEscape().
+			Lines(
+				Contains("change other"),
+				Contains("say goodbye"),
+				Contains("add other").IsSelected(),
+				Contains("add greeting"),
+			)
+
+		t.Views().Information().Content(DoesNotContain("matching"))
+	},
+})
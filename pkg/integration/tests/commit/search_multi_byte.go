@@ -0,0 +1,83 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchMultiByte = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search for commits containing multi-byte and wide characters, with smart-casing",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("émile's fix")
+		shell.EmptyCommit("日本語のテスト")
+		shell.EmptyCommit("Émile's feature")
+		shell.EmptyCommit("plain")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("plain").IsSelected(),
+				Contains("Émile's feature"),
+				Contains("日本語のテスト"),
+				Contains("émile's fix"),
+			).
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("のテ").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'のテ' (1 of 1)"))
+			}).
+			Lines(
+				Contains("plain"),
+				Contains("Émile's feature"),
+				Contains("日本語のテスト").IsSelected(),
+				Contains("émile's fix"),
+			).
+			// all lowercase, so this matches regardless of case
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("émile").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'émile' (2 of 2)"))
+			}).
+			Lines(
+				Contains("plain"),
+				Contains("Émile's feature"),
+				Contains("日本語のテスト"),
+				Contains("émile's fix").IsSelected(),
+			).
+			Press("n").
+			Tap(func() {
+				t.Views().Search().Content(Contains("matches for 'émile' (1 of 2, continuing from the top)"))
+			}).
+			Lines(
+				Contains("plain"),
+				Contains("Émile's feature").IsSelected(),
+				Contains("日本語のテスト"),
+				Contains("émile's fix"),
+			).
+			// an uppercase character makes the search case-sensitive
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("Émile").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'Émile' (1 of 1)"))
+			}).
+			Lines(
+				Contains("plain"),
+				Contains("Émile's feature").IsSelected(),
+				Contains("日本語のテスト"),
+				Contains("émile's fix"),
+			)
+	},
+})
@@ -0,0 +1,57 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowAllBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the commits of all branches in the commits panel and checkout a commit from another branch",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("feature").
+			EmptyCommit("feature commit").
+			Checkout("master").
+			EmptyCommit("master commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("master commit").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.OpenLogMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Log Options")).
+			Select(Contains("toggle show whole git graph")).
+			Confirm()
+
+		t.Views().Commits().
+			LineCount(3).
+			NavigateToLine(Contains("(feature) feature commit")).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("checkout commit")).
+			Content(Contains("Are you sure you want to checkout this commit?")).
+			Confirm()
+
+		t.Git().CurrentBranchName("HEAD")
+
+		// we're still showing all branches after the checkout
+		t.Views().Commits().
+			LineCount(3).
+			ContainsLines(
+				Contains("(HEAD, feature) feature commit"),
+			).
+			ContainsLines(
+				Contains("(master) master commit"),
+			)
+	},
+})
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// the config dir sits alongside the 'actual' directory that the repo is in
+const userConfigPath = "../../used_config/config.yml"
+
+const reloadedConfig = `
+disableStartupPopups: true
+git:
+  autoRefresh: false
+  autoFetch: false
+customCommands:
+  - key: 'a'
+    context: 'files'
+    command: 'touch myfile'
+`
+
+const invalidConfig = `
+disableStartupPopups: true
+git:
+  autoRefresh: false
+  autoFetch: false
+customCommands:
+  - key: 'a'
+    context: 'files'
+    command: 'touch otherfile'
+  - key: '<not-a-key>'
+    context: 'files'
+    command: 'touch otherfile'
+`
+
+var ReloadConfig = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reload the config file from the status panel, keeping the old config if the new one is invalid",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+		shell.Commit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Shell().UpdateFile(userConfigPath, reloadedConfig)
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.ReloadConfig)
+
+		t.ExpectToast(Equals("Config reloaded"))
+
+		t.Views().Files().
+			IsEmpty().
+			Focus().
+			Press("a").
+			Lines(
+				Equals("?? myfile"),
+			)
+
+		t.Shell().
+			RunCommand("rm myfile").
+			UpdateFile(userConfigPath, invalidConfig)
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.ReloadConfig)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Unrecognized key <not-a-key>")).
+			Confirm()
+
+		// the custom command from the previous config is still bound
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.RefreshFiles).
+			IsEmpty().
+			Press("a").
+			Lines(
+				Equals("?? myfile"),
+			)
+	},
+})
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoConfig = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply the repo's .lazygit.yml, asking whether to trust it because it has a custom command",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".lazygit.yml", "gui:\n  showFileTree: false\ncustomCommands:\n  - key: 'a'\n    context: 'files'\n    command: 'touch myfile'\n")
+		shell.CreateDir("dir")
+		shell.CreateFileAndAdd("dir/file", "content")
+		shell.Commit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Trust repo config")).
+			Content(Contains("sets custom commands")).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty().
+			Focus().
+			Press("a").
+			Lines(
+				Equals("?? myfile"),
+			)
+
+		t.Shell().CreateFile("dir/other", "content")
+
+		// with the file tree, the new file would be shown inside its directory
+		t.Views().Files().
+			Press(keys.Files.RefreshFiles).
+			Lines(
+				Equals("?? dir/other"),
+				Equals("?? myfile"),
+			)
+	},
+})
@@ -0,0 +1,45 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var PickAllHunksBottomFirst = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Select the ancestor hunk of a diff3-style conflict, then pick all hunks with the bottom one first",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("merge.conflictStyle", "diff3")
+		shared.CreateMergeConflictFile(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("|||||||"),
+			).
+			SelectNextItem().
+			SelectedLines(
+				Contains("|||||||"),
+				Contains("Original"),
+				Contains("======="),
+			).
+			Press(keys.Main.PickBothHunksBottomFirst)
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.FileSystem().FileContent("file", Equals("\nThis\nIs\nThe\nSecond Change\nFirst Change\nOriginal\nFile\n"))
+	},
+})
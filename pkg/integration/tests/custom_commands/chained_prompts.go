@@ -0,0 +1,100 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ChainedPrompts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using the responses of earlier prompts in the title, initial value and command of later prompts",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("staging.txt", "staging-pod-1\nstaging-pod-2\n")
+		shell.CreateFile("production.txt", "production-pod-1\nproduction-pod-2\n")
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `echo {{.Form.Pod | quote}} {{.Form.Note | quote}} > output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Key:   "Env",
+						Type:  "menu",
+						Title: "Choose environment",
+						Options: []config.CustomCommandMenuOption{
+							{Value: "staging"},
+							{Value: "production"},
+						},
+					},
+					{
+						Key:     "Pod",
+						Type:    "menuFromCommand",
+						Title:   "Choose pod in {{.Form.Env}}",
+						Command: "cat {{.Form.Env}}.txt",
+						Columns: &config.CustomCommandMenuColumns{Value: 1},
+					},
+					{
+						Key:          "Note",
+						Type:         "input",
+						Title:        "Note for {{.Form.Pod}}",
+						InitialValue: "restarting {{.Form.Pod}}",
+					},
+				},
+			},
+			{
+				Key:     "b",
+				Context: "files",
+				Command: `echo {{.Form.Second | quote}} > output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Key:          "First",
+						Type:         "input",
+						Title:        "First",
+						InitialValue: "{{.Form.Second}}",
+					},
+					{
+						Key:   "Second",
+						Type:  "input",
+						Title: "Second",
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press("a")
+
+		t.ExpectPopup().Menu().Title(Equals("Choose environment")).Select(Contains("production")).Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose pod in production")).
+			Lines(
+				Equals("production-pod-1").IsSelected(),
+				Equals("production-pod-2"),
+				Contains("cancel"),
+			).
+			Select(Equals("production-pod-2")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Note for production-pod-2")).
+			InitialText(Equals("restarting production-pod-2")).
+			Confirm()
+
+		t.FileSystem().FileContent("output.txt", Equals("production-pod-2 restarting production-pod-2\n"))
+
+		t.Views().Files().
+			Press("b")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Template refers to .Form.Second, but no earlier prompt has the key 'Second'")).
+			Confirm()
+	},
+})
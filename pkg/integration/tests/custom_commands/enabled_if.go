@@ -0,0 +1,73 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EnabledIf = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a custom command in multiple contexts which is only available when a condition holds",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("blah")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:            "a",
+				Context:        "files, localBranches",
+				Command:        "touch myfile",
+				Description:    "touch myfile",
+				EnabledIf:      `{{ eq .CheckedOutBranch.Name "master" }}`,
+				DisabledReason: "Not available on {{ .CheckedOutBranch.Name }}",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("other"),
+			).
+			Press("a")
+
+		t.Views().Files().
+			Lines(
+				Contains("myfile"),
+			)
+
+		t.Views().Branches().
+			NavigateToLine(Contains("other")).
+			PressPrimaryAction().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Files().
+			Focus().
+			Press("a")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Not available on other")).
+			Confirm()
+
+		t.Views().Files().
+			Press(keys.Universal.OptionMenuAlt1)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Menu")).
+			Select(Contains("touch myfile")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Not available on other")).
+			Confirm()
+	},
+})
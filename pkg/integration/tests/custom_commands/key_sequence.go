@@ -0,0 +1,50 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var KeySequence = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using key sequences for a custom command and a default keybinding",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("blah")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Keybinding.Files.ToggleStagedAll = "Y a"
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "Y t",
+				Context: "files",
+				Command: "touch myfile",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("Y")
+
+		t.Views().AppStatus().Content(Equals("Y (waiting for next key)"))
+
+		t.Views().Files().
+			Press("t").
+			Lines(
+				Equals("?? myfile"),
+			).
+			Press("Y").
+			Press("a").
+			Lines(
+				Equals("A  myfile"),
+			).
+			Press("Y").
+			// not part of any sequence, so it toggles the file as it normally would
+			Press(keys.Universal.Select).
+			Lines(
+				Equals("?? myfile"),
+			)
+	},
+})
@@ -0,0 +1,74 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MenuFromCommandColumns = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using menuFromCommand prompt type with columns, skipped header lines and filtering",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("tickets.tsv", "KEY\tSUMMARY\nPROJ-1\tFix login\nPROJ-2\tAdd logout button\nPROJ-3\tTidy up docs\n")
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `echo "{{index .PromptResponses 0}} {{index .PromptResponses 1}}" > output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Type:      "menuFromCommand",
+						Title:     "Choose ticket",
+						Command:   `cat tickets.tsv`,
+						SkipLines: 1,
+						Columns:   &config.CustomCommandMenuColumns{Delimiter: "\t", Value: 1, Label: 2},
+					},
+					{
+						Type:       "menuFromCommand",
+						Title:      "Filter tickets",
+						Command:    `cat tickets.tsv`,
+						SkipLines:  1,
+						Columns:    &config.CustomCommandMenuColumns{Delimiter: "\t", Value: 1, Label: 2},
+						Filterable: true,
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press("a")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose ticket")).
+			Lines(
+				Equals("Fix login").IsSelected(),
+				Equals("Add logout button"),
+				Equals("Tidy up docs"),
+				Contains("cancel"),
+			).
+			Select(Equals("Add logout button")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Filter tickets")).
+			Type("docs").
+			SuggestionLines(
+				Equals("Tidy up docs"),
+			).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt"),
+				Contains("tickets.tsv"),
+			)
+
+		t.FileSystem().FileContent("output.txt", Equals("PROJ-2 PROJ-3\n"))
+	},
+})
@@ -0,0 +1,63 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowOutput = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Showing a custom command's output in a popup or the main view, and its stderr when it fails",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.EmptyCommit("second commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: "git log --format=%s",
+				Output:  "popup",
+			},
+			{
+				Key:     "b",
+				Context: "files",
+				Command: "git log --format=%s",
+				Output:  "mainView",
+			},
+			{
+				Key:     "c",
+				Context: "files",
+				Command: "echo 'this is stdout'; echo 'this is stderr' >&2; exit 1",
+				Output:  "mainView",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press("a")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("git log --format=%s")).
+			Content(Equals("second commit\nfirst commit\n")).
+			Confirm()
+
+		t.Views().Files().
+			Press("b")
+
+		t.Views().Main().
+			Title(Equals("git log --format=%s")).
+			Content(Contains("second commit\nfirst commit"))
+
+		t.Views().Files().
+			Press("c")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("this is stderr")).
+			Confirm()
+	},
+})
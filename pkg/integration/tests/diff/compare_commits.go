@@ -0,0 +1,80 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CompareCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark two commits to compare, view the files changed between them, and build a patch from the range",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.Commit("first commit")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("file1", "first line\nthird line\n")
+		shell.Commit("third commit")
+		shell.CreateFileAndAdd("file3", "file3 content\n")
+		shell.Commit("fourth commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("first commit")).
+			Press(keys.Commits.CompareCommits).
+			Tap(func() {
+				t.Views().Information().Content(Contains("comparing from"))
+			}).
+			NavigateToLine(Contains("third commit")).
+			Press(keys.Commits.CompareCommits).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Compare commits")).
+					Select(Contains("changes from the first commit to the second")).
+					Confirm()
+
+				t.Views().Information().Content(MatchesRegexp(`showing output for: git diff \w+\.\.\w+`))
+			})
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1"),
+				Contains("file2"),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("+third line"))
+			}).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("building patch"))
+
+		t.Views().PatchBuildingSecondary().Content(Contains("+third line"))
+
+		t.Common().SelectPatchOption(Contains("apply patch in reverse"))
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+			)
+
+		t.Views().CommitFiles().
+			IsFocused().
+			PressEscape()
+
+		// the first escape resets the patch, and the second one stops comparing
+		t.Views().Commits().
+			IsFocused().
+			PressEscape()
+
+		t.Views().Information().Content(Contains("showing output for: git diff"))
+
+		t.Views().Commits().
+			PressEscape()
+
+		t.Views().Information().Content(DoesNotContain("showing output for"))
+	},
+})
@@ -0,0 +1,104 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Blame = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Blame a file, search it, blame it from before a line's commit across a rename and go to a line's commit",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file.txt", "one\ntwo\nthree\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file.txt", "one\nTWO\nthree\n")
+		shell.Commit("shout two")
+		shell.RunCommand("git mv file.txt renamed.txt")
+		shell.Commit("rename file")
+		shell.UpdateFile("renamed.txt", "one\nTWO\nthree!\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("renamed.txt").IsSelected(),
+			).
+			Press(keys.Files.ViewBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Equals("Blame: renamed.txt")).
+			Lines(
+				Contains("CI").Contains("1 one").IsSelected(),
+				Contains("CI").Contains("2 TWO"),
+				Contains("Not Committed Yet").Contains("3 three!"),
+			).
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("three").
+					Confirm()
+			}).
+			SelectedLine(Contains("3 three!")).
+			// the line isn't committed so there's nothing to go to
+			PressEnter().
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("This line hasn't been committed yet")).
+					Confirm()
+			}).
+			NavigateToLine(Contains("2 TWO")).
+			Press(keys.Blame.ReblameFromParent).
+			Title(Contains("Blame: file.txt @ ")).
+			Lines(
+				Contains("1 one"),
+				Contains("2 two").IsSelected(),
+				Contains("3 three"),
+			).
+			// it's the file's first commit, so there's nothing earlier
+			Press(keys.Blame.ReblameFromParent).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("nothing earlier to blame")).
+					Confirm()
+			}).
+			PressEnter()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("add file"))
+
+		// and from the commit files panel
+		t.Views().Commits().
+			NavigateToLine(Contains("shout two")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file.txt").IsSelected(),
+			).
+			Press(keys.Files.ViewBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Contains("Blame: file.txt @ ")).
+			Lines(
+				Contains("1 one").IsSelected(),
+				Contains("2 TWO"),
+				Contains("3 three"),
+			).
+			PressEscape()
+
+		t.Views().CommitFiles().
+			IsFocused()
+
+		t.Views().Main().
+			IsVisible().
+			Content(Contains("+TWO"))
+	},
+})
@@ -0,0 +1,57 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CollapseAndExpandAll = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Collapse and expand all directories in the file tree, and expand them to the default depth",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.DefaultFileTreeDepth = 1
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+		shell.CreateDir("dir/sub")
+		shell.CreateFile("dir/file-one", "one")
+		shell.CreateFile("dir/sub/file-two", "two")
+		shell.CreateFile("file-three", "three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			// the subdirectory starts out collapsed because of the default depth
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("sub"),
+				Contains("file-one"),
+				Contains("file-three"),
+			).
+			Press(keys.Files.ExpandAll).
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("sub"),
+				Contains("file-two"),
+				Contains("file-one"),
+				Contains("file-three"),
+			).
+			NavigateToLine(Contains("file-two")).
+			Press(keys.Files.CollapseAll).
+			// the selection moves to the directory that now hides the file
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("file-three"),
+			).
+			Press(keys.Files.ExpandAll).
+			NavigateToLine(Contains("file-two")).
+			Press(keys.Files.ExpandToDefaultDepth).
+			Lines(
+				Contains("dir"),
+				Contains("sub").IsSelected(),
+				Contains("file-one"),
+				Contains("file-three"),
+			)
+	},
+})
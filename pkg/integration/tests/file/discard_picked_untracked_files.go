@@ -0,0 +1,104 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardPickedUntrackedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pick which untracked and ignored files to discard, including a nested repo",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitignore", "*.log\n")
+		shell.Commit("first commit")
+
+		shell.CreateFile("debug.log", "ignored")
+		shell.CreateDir("dir")
+		shell.CreateFile("dir/file", "untracked")
+		shell.CreateFile("keep", "untracked")
+		shell.CreateFile("remove", "untracked")
+		shell.RunCommand("git init nested")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("pick untracked files to discard")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard untracked files")).
+			Lines(
+				Contains("[ ]").Contains("include ignored files"),
+				Contains("delete 3 ticked"),
+				Contains("[x]").Contains("dir/").IsSelected(),
+				Contains("[x]").Contains("keep"),
+				Contains("[ ]").Contains("nested/").Contains("git repository"),
+				Contains("[x]").Contains("remove"),
+				Contains("cancel"),
+			).
+			Select(Contains("keep")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard untracked files")).
+			Lines(
+				Contains("[ ]").Contains("include ignored files"),
+				Contains("delete 2 ticked"),
+				Contains("[x]").Contains("dir/"),
+				Contains("[ ]").Contains("keep").IsSelected(),
+				Contains("[ ]").Contains("nested/").Contains("git repository"),
+				Contains("[x]").Contains("remove"),
+				Contains("cancel"),
+			).
+			Select(Contains("include ignored files")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard untracked files")).
+			Lines(
+				Contains("[x]").Contains("include ignored files").IsSelected(),
+				Contains("delete 3 ticked"),
+				Contains("[x]").Contains("debug.log"),
+				Contains("[x]").Contains("dir/"),
+				Contains("[ ]").Contains("keep"),
+				Contains("[ ]").Contains("nested/").Contains("git repository"),
+				Contains("[x]").Contains("remove"),
+				Contains("cancel"),
+			).
+			Select(Contains("nested/")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard untracked files")).
+			Select(Contains("delete 4 ticked")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Discard untracked files")).
+			Content(Equals("This will delete:\n\ndebug.log\ndir/\nnested/\nremove")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete git repositories?")).
+			Content(Contains("nested/")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("?? keep"),
+			)
+
+		t.FileSystem().PathNotPresent("debug.log")
+		t.FileSystem().PathNotPresent("dir")
+		t.FileSystem().PathNotPresent("nested")
+		t.FileSystem().PathNotPresent("remove")
+	},
+})
@@ -0,0 +1,79 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FileHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the history of a file across a rename and check out an old version of it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file.txt", "one\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file.txt", "two\n")
+		shell.Commit("update file")
+		shell.CreateFileAndAdd("other.txt", "other\n")
+		shell.Commit("add other file")
+		shell.RunCommand("git mv file.txt renamed.txt")
+		shell.Commit("rename file")
+		shell.UpdateFile("renamed.txt", "three\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("renamed.txt").IsSelected(),
+			).
+			Press(keys.Files.ViewFileHistory)
+
+		t.Views().SubCommits().
+			IsFocused().
+			Title(Equals("History of renamed.txt")).
+			Lines(
+				Contains("rename file").IsSelected(),
+				Contains("update file"),
+				Contains("add file"),
+			).
+			NavigateToLine(Contains("update file")).
+			Tap(func() {
+				// we show the diff of the file under the name it had back then
+				t.Views().Main().
+					Content(Contains("file.txt")).
+					Content(Contains("+two")).
+					Content(DoesNotContain("other.txt"))
+			}).
+			NavigateToLine(Contains("rename file")).
+			Press(keys.Commits.CheckoutFileVersion).
+			PressEscape()
+
+		// the uncommitted change is gone now
+		t.Views().Files().
+			IsFocused().
+			IsEmpty()
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("rename file")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			NavigateToLine(Contains("renamed.txt")).
+			Press(keys.Files.ViewFileHistory)
+
+		// checking out a version from before the rename restores the file
+		// under the name it had back then
+		t.Views().SubCommits().
+			IsFocused().
+			NavigateToLine(Contains("add file")).
+			Press(keys.Commits.CheckoutFileVersion)
+
+		t.Views().Files().
+			Lines(
+				Contains("A").Contains("file.txt"),
+			)
+	},
+})
@@ -47,6 +47,10 @@ var Gitignore = NewIntegrationTest(NewIntegrationTestArgs{
 			Tap(func() {
 				t.ExpectPopup().Menu().Title(Equals("ignore or exclude file")).Select(Contains("add to .git/info/exclude")).Confirm()
 
+				t.ExpectPopup().Prompt().Title(Equals("Pattern to ignore")).InitialText(Equals("toExclude")).Confirm()
+
+				t.ExpectToast(Equals("1 untracked file(s) now ignored by 'toExclude'"))
+
 				t.FileSystem().FileContent(".gitignore", Equals(""))
 				t.FileSystem().FileContent(".git/info/exclude", Contains("toExclude"))
 			}).
@@ -56,6 +60,8 @@ var Gitignore = NewIntegrationTest(NewIntegrationTestArgs{
 			Tap(func() {
 				t.ExpectPopup().Menu().Title(Equals("ignore or exclude file")).Select(Contains("add to .gitignore")).Confirm()
 
+				t.ExpectPopup().Prompt().Title(Equals("Pattern to ignore")).InitialText(Equals("toIgnore")).Confirm()
+
 				t.FileSystem().FileContent(".gitignore", Equals("toIgnore\n"))
 				t.FileSystem().FileContent(".git/info/exclude", Contains("toExclude"))
 			})
@@ -0,0 +1,77 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GitignorePattern = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Ignore files using an edited pattern, both in the root .gitignore and in the nearest nested one",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+		shell.CreateFileAndAdd(".gitignore", "")
+		shell.CreateFileAndAdd("dir/.gitignore", "")
+		shell.Commit("initial commit")
+
+		shell.CreateDir("dir/sub")
+		shell.CreateFile("dir/sub/a.log", "")
+		shell.CreateFile("dir/sub/b.log", "")
+		shell.CreateFile("dir/sub/c.txt", "")
+		shell.CreateFile("other.log", "")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("dir/sub").IsSelected(),
+				Contains("?? a.log"),
+				Contains("?? b.log"),
+				Contains("?? c.txt"),
+				Contains("?? other.log"),
+			).
+			NavigateToLine(Contains("a.log")).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("ignore or exclude file")).Select(Contains("add to dir/.gitignore")).Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Pattern to ignore")).
+					InitialText(Equals("sub/a.log")).
+					SuggestionLines(
+						Equals("sub/a.log"),
+						Equals("*.log"),
+						Equals("sub/*.log"),
+					).
+					ConfirmSuggestion(Equals("sub/*.log"))
+
+				t.ExpectToast(Equals("2 untracked file(s) now ignored by 'sub/*.log'"))
+
+				t.FileSystem().FileContent("dir/.gitignore", Equals("sub/*.log\n"))
+				t.FileSystem().FileContent(".gitignore", Equals(""))
+			}).
+			Lines(
+				Contains("dir"),
+				Contains("sub"),
+				Contains("?? c.txt").IsSelected(),
+				Contains("M .gitignore"),
+				Contains("?? other.log"),
+			).
+			NavigateToLine(Contains("sub")).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("ignore or exclude file")).Select(Contains("add to .gitignore")).Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Pattern to ignore")).
+					InitialText(Equals("dir/sub/")).
+					Confirm()
+
+				t.ExpectToast(Equals("1 untracked file(s) now ignored by 'dir/sub/'"))
+
+				t.FileSystem().FileContent(".gitignore", Equals("dir/sub/\n"))
+			})
+	},
+})
@@ -0,0 +1,49 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We can't run subprocesses in integration tests, so this only covers what
+// happens before the difftool would be opened
+var OpenDiffTool = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Opening a file with both staged and unstaged changes in a difftool asks which to open, and untracked files can't be opened",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file1", "one\ntwo\n")
+		shell.UpdateFile("file1", "one\ntwo\nthree\n")
+		shell.CreateFile("file2", "untracked\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("MM file1").IsSelected(),
+				Contains("?? file2"),
+			).
+			Press(keys.Universal.OpenDiffTool).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("open diff in external difftool (git difftool)")).
+					Lines(
+						Contains("Unstaged Changes"),
+						Contains("Staged Changes"),
+						Contains("cancel"),
+					).
+					Cancel()
+			}).
+			NavigateToLine(Contains("file2")).
+			Press(keys.Universal.OpenDiffTool).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Cannot open an untracked file in a difftool: git has nothing to compare it with")).
+					Confirm()
+			})
+	},
+})
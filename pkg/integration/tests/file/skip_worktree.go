@@ -0,0 +1,95 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SkipWorktree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark files and directories as skip-worktree or assume-unchanged, then unmark one from the status panel",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+		shell.CreateFileAndAdd("dir/file-one", "one\n")
+		shell.CreateFileAndAdd("dir/file-two", "two\n")
+		shell.CreateFileAndAdd("local-config", "original\n")
+		shell.CreateFileAndAdd("staged-file", "original\n")
+		shell.Commit("first commit")
+		shell.UpdateFile("dir/file-one", "changed\n")
+		shell.UpdateFile("local-config", "changed\n")
+		shell.UpdateFileAndAdd("staged-file", "changed\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains(" M file-one"),
+				Contains(" M local-config"),
+				Contains("M  staged-file"),
+			).
+			NavigateToLine(Contains("local-config")).
+			Press(keys.Files.ViewSkipWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("view skip-worktree / assume-unchanged options")).
+					Select(Contains("mark as skip-worktree")).
+					Confirm()
+			}).
+			Lines(
+				Contains("dir"),
+				Contains(" M file-one"),
+				Contains("M  staged-file").IsSelected(),
+			).
+			// staged changes still show up, so we get an indicator for the flag
+			Press(keys.Files.ViewSkipWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("view skip-worktree / assume-unchanged options")).
+					Select(Contains("mark as assume-unchanged")).
+					Confirm()
+			}).
+			Lines(
+				Contains("dir"),
+				Contains(" M file-one"),
+				Contains("M  staged-file (assume-unchanged)").IsSelected(),
+			).
+			NavigateToLine(Contains("dir")).
+			// marking a directory applies to all tracked files within it
+			Press(keys.Files.ViewSkipWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("view skip-worktree / assume-unchanged options")).
+					Select(Contains("mark as skip-worktree")).
+					Confirm()
+			}).
+			Lines(
+				Contains("M  staged-file (assume-unchanged)").IsSelected(),
+			)
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.FlaggedFiles).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Skip-worktree / assume-unchanged files (select to unmark)")).
+					Lines(
+						Contains("dir/file-one").Contains("skip-worktree").IsSelected(),
+						Contains("dir/file-two").Contains("skip-worktree"),
+						Contains("local-config").Contains("skip-worktree"),
+						Contains("staged-file").Contains("assume-unchanged"),
+						Contains("cancel"),
+					).
+					Select(Contains("local-config")).
+					Confirm()
+			})
+
+		t.Views().Files().
+			Lines(
+				Contains(" M local-config"),
+				Contains("M  staged-file (assume-unchanged)"),
+			)
+	},
+})
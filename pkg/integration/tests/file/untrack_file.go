@@ -0,0 +1,53 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UntrackFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stop tracking a file while keeping it on disk, and refuse to do so when staged changes would be lost",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("both-changed", "original\n")
+		shell.CreateFileAndAdd("tracked-file", "original\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("both-changed", "staged\n")
+		shell.UpdateFile("both-changed", "unstaged\n")
+		shell.UpdateFile("tracked-file", "changed\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("MM both-changed").IsSelected(),
+				Contains(" M tracked-file"),
+			).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("ignore or exclude file")).Select(Contains("stop tracking")).Confirm()
+
+				t.ExpectPopup().Alert().Title(Equals("Error")).Content(Contains("Cannot stop tracking a file whose staged changes differ")).Confirm()
+			}).
+			NavigateToLine(Contains("tracked-file")).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("ignore or exclude file")).Select(Contains("stop tracking")).Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Add to .gitignore")).
+					Content(Contains("Do you also want to add 'tracked-file' to .gitignore?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("?? .gitignore"),
+				Contains("MM both-changed"),
+				Contains("D  tracked-file"),
+			)
+
+		t.FileSystem().FileContent("tracked-file", Equals("changed\n"))
+		t.FileSystem().FileContent(".gitignore", Equals("tracked-file\n"))
+	},
+})
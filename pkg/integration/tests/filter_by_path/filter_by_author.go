@@ -0,0 +1,100 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FilterByAuthor = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by author alongside a path, then stop filtering by path while keeping the author filter",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("main.go", "package main")
+		shell.RunCommand(`git commit -m "add main" --author="Jane Doe <jane@example.com>"`)
+		shell.CreateFileAndAdd("README.md", "readme")
+		shell.RunCommand(`git commit -m "add readme" --author="Jane Doe <jane@example.com>"`)
+		shell.UpdateFileAndAdd("main.go", "package main\n\nfunc main() {}")
+		shell.RunCommand(`git commit -m "add func main" --author="John Smith <john@example.com>"`)
+		shell.UpdateFileAndAdd("main.go", "package main\n\nfunc main() {\n}")
+		shell.RunCommand(`git commit -m "reformat main" --author="Jane Doe <jane@example.com>"`)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("enter paths or glob patterns to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter paths or glob patterns, separated by commas:")).
+			Type("main.go").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("reformat main").IsSelected(),
+				Contains("add func main"),
+				Contains("add main"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("enter author to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Author (regex):")).
+			Type("Jane").
+			SuggestionLines(
+				Contains("Jane Doe <jane@example.com>"),
+			).
+			Confirm()
+
+		t.Views().Information().Content(Contains("filtering by 'main.go' and author 'Jane'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("reformat main").IsSelected(),
+				Contains("add main"),
+			).
+			// resetting the filtering status lets us choose which filter to stop
+			Press(keys.Universal.Return)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Lines(
+				Contains("stop filtering by path"),
+				Contains("stop filtering by author"),
+				Contains("stop filtering by path and author"),
+				Contains("cancel"),
+			).
+			Select(Contains("stop filtering by path").DoesNotContain("author")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("filtering by author 'Jane'"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("reformat main"),
+				Contains("add readme"),
+				Contains("add main"),
+			)
+
+		t.Views().ReflogCommits().
+			Focus().
+			Lines(
+				Contains("reformat main"),
+				Contains("add readme"),
+				Contains("add main"),
+			)
+	},
+})
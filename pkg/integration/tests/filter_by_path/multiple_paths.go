@@ -0,0 +1,82 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MultiplePaths = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by several paths and glob patterns, then edit the filter",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("pkg")
+		shell.CreateDir("pkg/commands")
+		shell.CreateDir("docs")
+		shell.CreateFileAndAdd("README.md", "readme")
+		shell.Commit("add readme")
+		shell.CreateFileAndAdd("pkg/commands/git.go", "package commands")
+		shell.Commit("add git")
+		shell.CreateFileAndAdd("docs/Config.md", "config")
+		shell.Commit("add docs")
+		shell.CreateFileAndAdd("main.go", "package main")
+		shell.UpdateFileAndAdd("README.md", "new readme")
+		shell.Commit("add main")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("enter paths or glob patterns to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter paths or glob patterns, separated by commas:")).
+			Type("pkg/commands/**, docs/").
+			Confirm()
+
+		t.Views().Information().Content(Contains("filtering by 'pkg/commands/**, docs/'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add docs").IsSelected(),
+				Contains("add git"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("edit paths to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter paths or glob patterns, separated by commas:")).
+			InitialText(Equals("pkg/commands/**, docs/")).
+			Clear().
+			Type("*.go").
+			Confirm()
+
+		t.Views().Information().Content(Contains("filtering by '*.go'"))
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add main").IsSelected(),
+				Contains("add git"),
+			).
+			PressEnter()
+
+		// the commit's other files are left out too
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("main.go"),
+			)
+	},
+})
@@ -32,11 +32,10 @@ func postFilterTest(t *TestDriver) {
 	t.Views().Main().
 		Content(Contains("filterFile").DoesNotContain("otherFile"))
 
-	// when you click into the commit itself, you see all files from that commit
+	// and only the filtered file when you click into the commit itself
 	t.Views().CommitFiles().
 		IsFocused().
 		Lines(
 			Contains(`filterFile`),
-			Contains(`otherFile`),
 		)
 }
@@ -21,11 +21,11 @@ var TypeFile = NewIntegrationTest(NewIntegrationTestArgs{
 
 		t.ExpectPopup().Menu().
 			Title(Equals("Filtering")).
-			Select(Contains("enter path to filter by")).
+			Select(Contains("enter paths or glob patterns to filter by")).
 			Confirm()
 
 		t.ExpectPopup().Prompt().
-			Title(Equals("Enter path:")).
+			Title(Equals("Enter paths or glob patterns, separated by commas:")).
 			Type("filterF").
 			SuggestionLines(Equals("filterFile")).
 			ConfirmFirstSuggestion()
@@ -0,0 +1,80 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddExecAndBreak = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add 'exec' and 'break' entries to a rebase, move a commit past them, and remove one again",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("pick").Contains("commit 03"),
+				Contains("pick").Contains("commit 02"),
+				MatchesRegexp("YOU ARE HERE.*commit 01").IsSelected(),
+			).
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Commits.AddBreakTodo).
+			Lines(
+				Contains("pick").Contains("commit 03"),
+				Contains("break").IsSelected(),
+				Contains("pick").Contains("commit 02"),
+				MatchesRegexp("YOU ARE HERE.*commit 01"),
+			).
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Commits.AddExecTodo).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to run after this commit:")).
+					Type("touch exec-ran").
+					Confirm()
+			}).
+			Lines(
+				Contains("pick").Contains("commit 03"),
+				Contains("break"),
+				Contains("exec").Contains("touch exec-ran").IsSelected(),
+				Contains("pick").Contains("commit 02"),
+				MatchesRegexp("YOU ARE HERE.*commit 01"),
+			).
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Commits.MoveDownCommit).
+			Lines(
+				Contains("break"),
+				Contains("pick").Contains("commit 03").IsSelected(),
+				Contains("exec").Contains("touch exec-ran"),
+				Contains("pick").Contains("commit 02"),
+				MatchesRegexp("YOU ARE HERE.*commit 01"),
+			).
+			NavigateToLine(Contains("break")).
+			Press(keys.Universal.Remove).
+			Lines(
+				Contains("pick").Contains("commit 03").IsSelected(),
+				Contains("exec").Contains("touch exec-ran"),
+				Contains("pick").Contains("commit 02"),
+				MatchesRegexp("YOU ARE HERE.*commit 01"),
+			).
+			Tap(func() {
+				t.Common().ContinueRebase()
+			}).
+			Lines(
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("exec-ran"),
+			)
+	},
+})
@@ -0,0 +1,79 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AmendCommitInMiddle = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Amends staged changes into a commit in the middle of the branch, refusing to do so while nothing is staged",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(5).
+			CreateFile("fixup-file", "fixup content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 05").IsSelected(),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Commits.AmendToCommit).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("There are no staged changes to amend the commit with")).
+					Confirm()
+			})
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("fixup-file").IsSelected(),
+			).
+			PressPrimaryAction()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 05"),
+				Contains("commit 04"),
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.AmendToCommit).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Amend Commit")).
+					Content(Equals("Are you sure you want to amend this commit with your staged files?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("commit 05"),
+				Contains("commit 04"),
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file03.txt"),
+				Contains("fixup-file"),
+			)
+
+		t.Views().Files().
+			IsEmpty()
+	},
+})
@@ -0,0 +1,42 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AmendPushedCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Amending a commit which has already been pushed to a main branch asks for confirmation with a warning",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.MainBranches = []string{"master"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(2).
+			CloneIntoRemote("origin").
+			SetBranchUpstream("master", "origin/master").
+			CreateFileAndAdd("fixup-file", "fixup content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 02").IsSelected(),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.AmendToCommit).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Amend Commit")).
+					Content(Contains("This commit has already been pushed to origin/master")).
+					Cancel()
+			})
+
+		t.Views().Files().
+			Lines(
+				Contains("fixup-file"),
+			)
+	},
+})
@@ -0,0 +1,79 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseMergesTodo = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the label, reset and merge entries of a rebase that recreates merges, and refuse to move commits past them",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("base", "base").
+			Commit("base commit").
+			NewBranch("feature").
+			CreateFileAndAdd("feature", "feature").
+			Commit("feature commit").
+			Checkout("master").
+			CreateFileAndAdd("master", "master").
+			Commit("master commit").
+			Merge("feature").
+			// the failing exec stops the rebase straight after the feature commit
+			RunShellCommandExpectError("GIT_SEQUENCE_EDITOR=true git rebase -i --rebase-merges --exec false HEAD~2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			TopLines(
+				Contains("exec").Contains("false"),
+				Contains("merge").Contains("feature: Merge branch 'feature'"),
+				Contains("exec").Contains("false"),
+				Contains("pick").Contains("master commit"),
+				Contains("reset").Contains("onto"),
+				Contains("label").Contains("feature"),
+			).
+			NavigateToLine(Contains("master commit")).
+			Press(keys.Commits.MoveDownCommit).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("Can't move 'label', 'reset' or 'merge' entries")).
+					Confirm()
+			}).
+			Press(keys.Commits.MoveUpCommit).
+			TopLines(
+				Contains("exec").Contains("false"),
+				Contains("merge").Contains("feature: Merge branch 'feature'"),
+				Contains("pick").Contains("master commit").IsSelected(),
+				Contains("exec").Contains("false"),
+				Contains("reset").Contains("onto"),
+				Contains("label").Contains("feature"),
+			).
+			Press(keys.Commits.MoveUpCommit).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("Can't move 'label', 'reset' or 'merge' entries")).
+					Confirm()
+			}).
+			NavigateToLine(Contains("reset")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("That can't be done to a 'reset' entry of the rebase")).
+					Confirm()
+			}).
+			TopLines(
+				Contains("exec").Contains("false"),
+				Contains("merge").Contains("feature: Merge branch 'feature'"),
+				Contains("pick").Contains("master commit"),
+				Contains("exec").Contains("false"),
+				Contains("reset").Contains("onto").IsSelected(),
+				Contains("label").Contains("feature"),
+			)
+	},
+})
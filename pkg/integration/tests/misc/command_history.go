@@ -0,0 +1,78 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the commands that have been run, and the output of one of them",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.HideBackgroundCommandsInCommandLog = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("myfile", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("?? myfile").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Contains("A  myfile").IsSelected(),
+			).
+			Press(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command Log")).
+			Select(Contains("View command history")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command history (newest first)")).
+			Lines(
+				Contains("user").Contains("git add").Contains("myfile").Contains("exit 0").IsSelected(),
+				Contains("cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Contains("git add")).
+			Select(Contains("show output in main view")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Command output")).
+			Content(Contains("git add").Contains("exit 0").Contains("The command had no output"))
+
+		// the command log says how it went too, and lets us do the same
+		t.Views().Files().
+			Press(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command Log")).
+			Select(Contains("Focus command log")).
+			Confirm()
+
+		t.Views().Extras().
+			IsFocused().
+			Content(MatchesRegexp(`git add.*myfile"\n\s+\d+ms, exit 0`).DoesNotContain("git status")).
+			Press(keys.CommandLog.ShowCommandOutput)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command history (newest first)")).
+			Lines(
+				Contains("git add").IsSelected(),
+				Contains("cancel"),
+			).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Command output")).
+			Content(Contains("The command had no output"))
+	},
+})
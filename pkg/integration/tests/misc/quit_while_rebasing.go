@@ -0,0 +1,32 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var QuitWhileRebasing = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Quit in the middle of a rebase, where HEAD is detached at a commit no branch contains, without being warned about it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.ConfirmOnQuit = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			EmptyCommit("two").
+			RunShellCommandExpectError("git rebase --exec false HEAD^").
+			EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Quit)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("")).
+			Content(Contains("Are you sure you want to quit?")).
+			Confirm()
+	},
+})
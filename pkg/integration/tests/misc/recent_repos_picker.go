@@ -0,0 +1,74 @@
+package misc
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RecentReposPicker = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open straight into the recent repos list, pin a repo, remove a repo that no longer exists, and switch to a repo by filtering",
+	ExtraCmdArgs: "--repo-picker",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// lazygit is started from within the repo
+		repo, err := os.Getwd()
+		if err != nil {
+			panic(err)
+		}
+		sibling := func(name string) string {
+			return filepath.Join(repo, "..", name)
+		}
+
+		config.GetAppState().RecentRepos = []string{repo, sibling("alpha"), sibling("beta")}
+		config.GetAppState().PinnedRepos = []string{sibling("gone")}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.RunCommand("git init ../alpha")
+		shell.RunCommand("git -C ../alpha commit --allow-empty -m alpha")
+		shell.RunCommand("git init ../beta")
+		shell.RunCommand("git -C ../beta commit --allow-empty -m beta")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.ExpectPopup().Prompt().
+			Title(Equals("recent repositories")).
+			SuggestionLines(
+				Contains("gone").Contains("(pinned)"),
+				Contains("alpha"),
+				Contains("beta"),
+			)
+
+		t.GlobalPress(keys.Universal.TogglePanel)
+
+		t.Views().Suggestions().
+			IsFocused().
+			NavigateToLine(Contains("beta")).
+			Press(keys.Status.TogglePinnedRepo).
+			Lines(
+				Contains("gone").Contains("(pinned)"),
+				Contains("beta").Contains("(pinned)"),
+				Contains("alpha"),
+			).
+			NavigateToLine(Contains("gone")).
+			PressEnter()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Repository not found")).
+			Content(Contains("gone no longer exists. Remove it from the list?")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("recent repositories")).
+			SuggestionLines(
+				Contains("beta").Contains("(pinned)"),
+				Contains("alpha"),
+			).
+			Type("alpha").
+			Confirm()
+
+		t.Views().Status().Content(Contains("alpha → master"))
+	},
+})
@@ -0,0 +1,83 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddFromMultipleCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Build a patch from files of two different commits and apply it to the working tree in reverse",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.CreateFileAndAdd("file2", "two\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "one\nuno\n")
+		shell.Commit("update file1")
+
+		shell.UpdateFileAndAdd("file2", "two\ndos\n")
+		shell.Commit("update file2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("update file2").IsSelected(),
+				Contains("update file1"),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file2").IsSelected(),
+			).
+			PressPrimaryAction().
+			Tap(func() {
+				t.Views().Information().Content(Contains("building patch"))
+
+				t.Views().Secondary().Content(Contains("+dos"))
+			}).
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("update file1")).
+			PressEnter()
+
+		// adding a file from another commit keeps what's already in the patch
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressPrimaryAction().
+			Tap(func() {
+				t.Views().Secondary().Content(Contains("+uno").Contains("+dos"))
+			}).
+			PressEscape()
+
+		t.Common().SelectPatchOption(Contains("remove patch from original commit"))
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("This patch contains changes from more than one commit")).
+			Confirm()
+
+		t.Common().SelectPatchOption(MatchesRegexp(`apply patch to working tree in reverse$`))
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains(" M file1"),
+				Contains(" M file2"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("one\n"))
+		t.FileSystem().FileContent("file2", Equals("two\n"))
+	},
+})
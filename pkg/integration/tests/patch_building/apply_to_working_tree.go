@@ -0,0 +1,54 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyToWorkingTree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a custom patch to the working tree without touching the index",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\n")
+		shell.Commit("add second line")
+
+		shell.UpdateFileAndAdd("file1", "first line\n")
+		shell.Commit("remove second line")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("remove second line").IsSelected(),
+				Contains("add second line"),
+				Contains("first commit"),
+			).
+			NavigateToLine(Contains("add second line")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M file1").IsSelected(),
+			).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("building patch"))
+
+		t.Common().SelectPatchOption(MatchesRegexp(`apply patch to working tree$`))
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains(" M file1").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("first line\nsecond line\n"))
+		t.Git().StagedDiff("")
+	},
+})
@@ -0,0 +1,50 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyToWorkingTreeWithConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a custom patch to a working tree with conflicting changes",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\n")
+		shell.Commit("add second line")
+
+		shell.UpdateFileAndAdd("file1", "first line\n")
+		shell.Commit("remove second line")
+
+		shell.UpdateFile("file1", "first line\nconflicting line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("add second line")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M file1").IsSelected(),
+			).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("building patch"))
+
+		t.Common().SelectPatchOption(MatchesRegexp(`apply patch to working tree$`))
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Could not apply the patch to the working tree")).
+			Confirm()
+
+		t.FileSystem().FileContent("file1", Equals("first line\nconflicting line\n"))
+		t.Git().StagedDiff("")
+	},
+})
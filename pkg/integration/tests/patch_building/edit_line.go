@@ -0,0 +1,80 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditLine = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit an added line in a custom patch before applying it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n1b\n1c\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "1a\n1b\ndebug\n1c\n2a\n")
+		shell.Commit("second commit")
+
+		shell.NewBranch("other")
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("master")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			NavigateToLine(Contains("+debug")).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Edit line")).
+					InitialText(Equals("debug")).
+					Clear().
+					Type("1b and a half").
+					Confirm()
+
+				t.Views().Secondary().ContainsLines(
+					Contains(`@@ -1,3 +1,4 @@`),
+					Contains(` 1a`),
+					Contains(` 1b`),
+					Contains(`+1b and a half`),
+					Contains(` 1c`),
+				)
+			}).
+			PressEscape()
+
+		t.Common().SelectPatchOption(MatchesRegexp(`apply patch$`))
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("M").Contains("file1").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("1a\n1b\n1b and a half\n1c\n"))
+	},
+})
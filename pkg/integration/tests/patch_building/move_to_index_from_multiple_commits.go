@@ -0,0 +1,102 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveToIndexFromMultipleCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move a patch built from files of two different commits to the index",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.CreateFileAndAdd("file2", "two\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "one\nuno\n")
+		shell.Commit("update file1")
+
+		shell.CreateFileAndAdd("file3", "three\n")
+		shell.Commit("add file3")
+
+		shell.UpdateFileAndAdd("file2", "two\ndos\n")
+		shell.Commit("update file2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("update file2").IsSelected(),
+				Contains("add file3"),
+				Contains("update file1"),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file2").IsSelected(),
+			).
+			PressPrimaryAction().
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("update file1")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressPrimaryAction().
+			Tap(func() {
+				t.Views().Secondary().Content(Contains("+uno").Contains("+dos"))
+			}).
+			PressEscape()
+
+		t.Common().SelectPatchOption(Contains("move patch out into index"))
+
+		t.Views().Files().
+			Lines(
+				Contains("M").Contains("file1"),
+				Contains("M").Contains("file2"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("one\nuno\n"))
+		t.FileSystem().FileContent("file2", Equals("two\ndos\n"))
+
+		// both commits are left empty and the commit in between is untouched
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("update file2"),
+				Contains("add file3"),
+				Contains("update file1").IsSelected(),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("(none)"),
+			).
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("add file3")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file3"),
+			)
+	},
+})
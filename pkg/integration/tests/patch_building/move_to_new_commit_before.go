@@ -0,0 +1,87 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveToNewCommitBefore = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move one hunk of a two-hunk diff into a new commit positioned before the original commit",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n1b\n1c\n1d\n1e\n1f\n1g\n1h\n1i\n1j\n")
+		shell.Commit("first commit")
+
+		// two changes far enough apart to produce two hunks
+		shell.UpdateFileAndAdd("file1", "aa\n1b\n1c\n1d\n1e\n1f\n1g\n1h\n1i\njj\n")
+		shell.Commit("second commit")
+
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("third commit").IsSelected(),
+				Contains("second commit"),
+				Contains("first commit"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLines(
+				Contains("-1a"),
+			).
+			Press(keys.Main.ToggleSelectHunk).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("building patch"))
+
+		t.Common().SelectPatchOption(Contains("move patch into new commit before the original commit"))
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("third commit"),
+				Contains("second commit").IsSelected(),
+				Contains(`Split from "second commit"`),
+				Contains("first commit"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(
+						Contains("+jj").
+							DoesNotContain("+aa"),
+					)
+			}).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(
+						Contains("+aa").
+							DoesNotContain("+jj"),
+					)
+			})
+
+		t.FileSystem().FileContent("file1", Equals("aa\n1b\n1c\n1d\n1e\n1f\n1g\n1h\n1i\njj\n"))
+	},
+})
@@ -0,0 +1,80 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PatchSummary = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "The secondary view shows a summary of the files in the patch which updates as lines are added, and we can go to any of those files",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n")
+		shell.CreateFileAndAdd("file2", "a\nb\nc\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "1\ntwo\n3\nfour\n")
+		shell.UpdateFileAndAdd("file2", "a\nB\nc\n")
+		shell.Commit("second commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			).
+			PressPrimaryAction().
+			NavigateToLine(Contains("file2")).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLines(Equals("-b")).
+			PressPrimaryAction()
+
+		t.Views().Secondary().
+			ContainsLines(
+				Equals("file1 +2 -1"),
+				Equals("file2 +0 -1"),
+			)
+
+		t.Views().PatchBuilding().
+			SelectNextItem().
+			PressPrimaryAction()
+
+		t.Views().Secondary().
+			ContainsLines(
+				Equals("file1 +2 -1"),
+				Equals("file2 +1 -1"),
+			)
+
+		t.Views().PatchBuilding().
+			Press(keys.Main.GoToPatchFile)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Custom patch")).
+			Select(Equals("file1 +2 -1")).
+			Confirm()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			Content(Contains("+four"))
+
+		t.Views().CommitFiles().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+			)
+	},
+})
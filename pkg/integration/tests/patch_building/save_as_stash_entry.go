@@ -0,0 +1,74 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SaveAsStashEntry = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Save a custom patch as a stash entry without touching the working tree or the commit",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("add second line")
+
+		shell.UpdateFileAndAdd("file1", "first line\n")
+		shell.Commit("remove second line")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("add second line")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M file1").IsSelected(),
+				Contains("A file2"),
+			).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("building patch"))
+
+		t.Common().SelectPatchOption(Contains("save patch as stash entry"))
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Stash changes")).
+			Type("parked patch").
+			Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("parked patch"),
+			)
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Commits().
+			Lines(
+				Contains("remove second line"),
+				Contains("add second line"),
+				Contains("first commit"),
+			)
+
+		t.Views().Stash().
+			Focus().
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Stash apply")).
+			Content(Contains("Are you sure you want to apply this stash entry?")).
+			Confirm()
+
+		t.FileSystem().FileContent("file1", Equals("first line\nsecond line\n"))
+		t.Git().StagedDiff("")
+	},
+})
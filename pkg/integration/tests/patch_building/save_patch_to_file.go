@@ -0,0 +1,59 @@
+package patch_building
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SavePatchToFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Save a custom patch to a file",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "first line\n")
+		shell.CreateFileAndAdd("file2", "unrelated\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFileAndAdd("file1", "first line\nsecond line\n")
+		shell.UpdateFileAndAdd("file2", "unrelated\nchange\n")
+		shell.Commit("update")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("update").IsSelected(),
+				Contains("first commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M file1").IsSelected(),
+				Contains("M file2"),
+			).
+			PressPrimaryAction()
+
+		t.Views().Information().Content(Contains("building patch"))
+
+		t.Common().SelectPatchOption(Contains("save patch to file"))
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Save patch to file")).
+			InitialText(Equals("lazygit.patch")).
+			Confirm()
+
+		t.ExpectToast(Equals("Patch saved to lazygit.patch"))
+
+		t.FileSystem().FileContent("lazygit.patch", Equals(`diff --git a/file1 b/file1
+index 08fe272..06fcdd7 100644
+--- a/file1
++++ b/file1
+@@ -1 +1,2 @@
+ first line
++second line
+`))
+	},
+})
@@ -0,0 +1,110 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreToEntry = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore the repo to the state of reflog entries after previewing the changes, resetting for commits and checking out and resetting the branch for branch switches",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.Commit("one")
+		shell.CreateFileAndAdd("file2", "two\n")
+		shell.Commit("two")
+		shell.CreateFileAndAdd("file3", "three\n")
+		shell.Commit("three")
+		shell.NewBranch("other")
+		// the branch has moved on since we checked it out
+		shell.CreateFileAndAdd("file4", "four\n")
+		shell.Commit("four")
+		shell.Checkout("master")
+		shell.HardReset("HEAD^^")
+		shell.UpdateFileAndAdd("file1", "one changed\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			Lines(
+				Contains("reset: moving to HEAD^^").IsSelected(),
+				Contains("checkout: moving from other to master"),
+				Contains("commit: four"),
+				Contains("checkout: moving from master to other"),
+				Contains("commit: three"),
+				Contains("commit: two"),
+				Contains("commit (initial): one"),
+			).
+			NavigateToLine(Contains("commit: three")).
+			Press(keys.Reflog.RestoreToEntry).
+			Tap(func() {
+				t.Views().Main().
+					Title(Equals("Changes from HEAD to reflog entry")).
+					Content(Contains("+two").Contains("+three"))
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Restore repo to this state")).
+					Content(Contains("hard reset the checked-out branch")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Autostash?")).
+					Content(Contains("You must stash and pop your changes")).
+					Confirm()
+			}).
+			TopLines(
+				Contains("reset: moving to").IsSelected(),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("one changed\n"))
+
+		// the changes are popped back unstaged
+		t.Views().Files().
+			Lines(
+				Contains(" M file1"),
+			)
+
+		t.Views().ReflogCommits().
+			NavigateToLine(Contains("checkout: moving from master to other")).
+			Press(keys.Reflog.RestoreToEntry).
+			Tap(func() {
+				t.Views().Main().
+					Content(DoesNotContain("+four"))
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Restore repo to this state")).
+					Content(Contains("checkout 'other' and hard reset it to")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Autostash?")).
+					Content(Contains("You must stash and pop your changes")).
+					Confirm()
+			})
+
+		t.Views().Branches().
+			Lines(
+				Contains("other"),
+				Contains("master"),
+			)
+
+		// the branch is back where it was when we checked it out
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("one changed\n"))
+	},
+})
@@ -0,0 +1,105 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditUrls = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit the fetch url of a remote, give it a separate push url, and then remove that again",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.RunCommand("git remote add origin https://example.com/old.git")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit remote 'origin'")).
+					Select(Contains("edit url")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Enter updated remote url for origin:")).
+					InitialText(Equals("https://example.com/old.git")).
+					Clear().
+					Type("https://example.com/new.git").
+					Confirm()
+
+				t.Git().RemoteUrls("origin\thttps://example.com/new.git (fetch)\norigin\thttps://example.com/new.git (push)")
+			}).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit remote 'origin'")).
+					Select(Contains("set separate push url")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Enter push url for origin:")).
+					InitialText(Equals("https://example.com/new.git")).
+					Clear().
+					Type("not a url").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("'not a url' is not a valid remote url")).
+					Confirm()
+			}).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit remote 'origin'")).
+					Select(Contains("set separate push url")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Enter push url for origin:")).
+					Clear().
+					Type("git@example.com:push.git").
+					Confirm()
+
+				t.Git().RemoteUrls("origin\thttps://example.com/new.git (fetch)\norigin\tgit@example.com:push.git (push)")
+
+				t.Views().Main().
+					Content(Contains("Push urls:\ngit@example.com:push.git"))
+			}).
+			Lines(
+				Contains("origin").Contains("pushes to git@example.com:push.git").IsSelected(),
+			).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit remote 'origin'")).
+					Select(Contains("prune remote branches")).
+					Confirm()
+
+				t.Views().Main().
+					Content(Contains("Prunes on fetch"))
+			}).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Edit remote 'origin'")).
+					Select(Contains("remove push url")).
+					Confirm()
+
+				t.Git().RemoteUrls("origin\thttps://example.com/new.git (fetch)\norigin\thttps://example.com/new.git (push)")
+
+				t.Views().Main().
+					Content(DoesNotContain("Push urls:"))
+			}).
+			Lines(
+				Contains("origin").DoesNotContain("pushes to").IsSelected(),
+			)
+	},
+})
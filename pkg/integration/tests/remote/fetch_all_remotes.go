@@ -0,0 +1,55 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchAllRemotes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch all remotes at once, where one of them is unreachable",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CloneIntoRemote("origin")
+		shell.HardReset("HEAD^")
+		// forget what we know about origin so that we have something to fetch
+		shell.RunCommand("git update-ref -d refs/remotes/origin/master")
+		shell.RunCommand("git remote add unreachable ../does-not-exist")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").DoesNotContain("fetched").IsSelected(),
+				Contains("unreachable").DoesNotContain("fetched"),
+			).
+			Press(keys.Remotes.FetchAllRemotes)
+
+		t.ExpectToast(Equals("Failed to fetch unreachable"))
+
+		t.Views().Remotes().
+			IsFocused().
+			Lines(
+				Contains("origin").Contains("fetched"),
+				Contains("unreachable").DoesNotContain("fetched"),
+			).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			)
+	},
+})
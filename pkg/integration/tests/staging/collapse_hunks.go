@@ -0,0 +1,74 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CollapseHunks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Collapse a hunk in the staging panel and stage it while collapsed",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// need to be working with a few lines so that git perceives it as two separate hunks
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11a\n12a\n13a\n14a\n15a")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1a\n2a\n3b\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11a\n12a\n13b\n14a\n15a")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-3a"),
+			).
+			Press(keys.Main.ToggleCollapseHunk).
+			SelectedLines(
+				Contains("@@ -1,6 +1,6 @@"),
+			).
+			ContainsLines(
+				Contains("@@ -1,6 +1,6 @@"),
+				Contains("@@ -10,6 +10,6 @@"),
+				Contains(" 10a"),
+			).
+			// moving down skips the collapsed lines
+			SelectNextItem().
+			SelectedLines(
+				Contains("@@ -10,6 +10,6 @@"),
+			).
+			SelectPreviousItem().
+			SelectedLines(
+				Contains("@@ -1,6 +1,6 @@"),
+			).
+			// staging the collapsed hunk stages all of it
+			PressPrimaryAction().
+			ContainsLines(
+				Contains("@@ -10,6 +10,6 @@"),
+				Contains(" 10a"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("-3a"),
+				Contains("+3b"),
+			)
+
+		t.Views().Staging().
+			Press(keys.Main.ToggleCollapseHunk).
+			SelectedLines(
+				Contains("@@ -10,6 +10,6 @@"),
+			).
+			Press(keys.Main.ToggleCollapseHunk).
+			SelectedLines(
+				Contains("-13a"),
+			)
+	},
+})
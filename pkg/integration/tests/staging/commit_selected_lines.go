@@ -0,0 +1,74 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitSelectedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move two of five added lines into a new commit, leaving the working tree and other staged files untouched",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "zero\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file1", "zero\none\ntwo\nthree\nfour\nfive\n")
+		shell.UpdateFileAndAdd("file2", "file2 content\nstaged line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file1").IsSelected(),
+				Contains("M  file2"),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+one")).
+			Press(keys.Main.ToggleDragSelect).
+			NavigateToLine(Contains("+two")).
+			Press(keys.Main.CommitSelection).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Commit selected lines")).
+					Type("two lines").
+					Confirm()
+			}).
+			ContainsLines(
+				Contains(" two"),
+				Contains("+three"),
+				Contains("+four"),
+				Contains("+five"),
+			).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file1").IsSelected(),
+				Contains("M  file2"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("zero\none\ntwo\nthree\nfour\nfive\n"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two lines").IsSelected(),
+				Contains("first commit"),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("+one").
+					Contains("+two").
+					DoesNotContain("+three").
+					DoesNotContain("staged line"),
+			)
+	},
+})
@@ -0,0 +1,57 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discard one of several added lines from the unstaged changes of a file which also has staged changes",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n")
+		shell.Commit("one")
+
+		shell.UpdateFileAndAdd("file1", "1\nstaged\n")
+		shell.UpdateFile("file1", "1\nstaged\none\ntwo\nthree")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("MM file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Equals("+one"),
+			).
+			SelectNextItem().
+			SelectedLines(
+				Equals("+two"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.Common().ConfirmDiscardLines()
+			}).
+			ContainsLines(
+				Equals(" staged"),
+				Equals("+one"),
+				Equals("+three"),
+				Equals(`\ No newline at end of file`),
+			).
+			Content(DoesNotContain("two"))
+
+		t.FileSystem().FileContent("file1", Equals("1\nstaged\none\nthree"))
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals(" 1"),
+				Equals("+staged"),
+			)
+	},
+})
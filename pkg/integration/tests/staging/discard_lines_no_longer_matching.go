@@ -0,0 +1,45 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardLinesNoLongerMatching = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Try to discard lines which have since been changed outside of lazygit",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1\none\ntwo\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Equals("+one"),
+			).
+			Tap(func() {
+				t.Shell().UpdateFile("file1", "1\nuno\ntwo\n")
+			}).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("The selected lines can't be discarded because they no longer match the file in the working tree")).
+					Confirm()
+			})
+
+		t.FileSystem().FileContent("file1", Equals("1\nuno\ntwo\n"))
+	},
+})
@@ -0,0 +1,67 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GoToLine = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show line numbers in the staging panel and jump to a line by its number",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.ShowLineNumbersInDiff = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Equals("      @@ -1,4 +1,4 @@"),
+				Equals(" 1    -1"),
+				Equals("    1 +one"),
+				Equals(" 2  2  2"),
+				Equals(" 3  3  3"),
+				Equals(" 4  4  4"),
+				Equals("      @@ -7,4 +7,4 @@"),
+				Equals(" 7  7  7"),
+				Equals(" 8  8  8"),
+				Equals(" 9  9  9"),
+				Equals("10    -10"),
+				Equals("   10 +ten"),
+			).
+			// the selected lines are what gets copied, so they don't include the line numbers
+			SelectedLines(Equals("-1")).
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line number")).
+					Type("10").
+					Confirm()
+			}).
+			SelectedLines(Equals("+ten"))
+
+		t.Views().Staging().
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line number")).
+					Type("42").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Line 42 of the file isn't part of this diff")).
+					Confirm()
+			})
+	},
+})
@@ -0,0 +1,43 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchAfterStaging = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Keep searching in the staging panel after staging a line",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("file1", "match one\nother\nmatch two\nmatch three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("match").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'match' (1 of 3)"))
+			}).
+			SelectedLine(Contains("+match one")).
+			PressPrimaryAction().
+			Content(DoesNotContain("+match one")).
+			Press(keys.Universal.NextMatch).
+			Tap(func() {
+				t.Views().Search().Content(Contains("matches for 'match' (2 of 3)"))
+			}).
+			SelectedLine(Contains("+match two"))
+	},
+})
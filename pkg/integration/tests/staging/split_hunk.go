@@ -0,0 +1,106 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SplitHunk = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Split a hunk into smaller hunks and stage them one at a time",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n")
+		shell.Commit("one")
+
+		// the changes are close enough together for git to show them as a single hunk
+		shell.UpdateFile("file1", "1\ntwo\n3\n4\nfive\n6\n7\neight\n9\n10\n11\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Equals("-2"),
+			).
+			Press(keys.Main.ToggleSelectHunk).
+			SelectedLines(
+				Contains("@@ -1,11 +1,11 @@"),
+				Equals(" 1"),
+				Equals("-2"),
+				Equals("+two"),
+				Equals(" 3"),
+				Equals(" 4"),
+				Equals("-5"),
+				Equals("+five"),
+				Equals(" 6"),
+				Equals(" 7"),
+				Equals("-8"),
+				Equals("+eight"),
+				Equals(" 9"),
+				Equals(" 10"),
+				Equals(" 11"),
+			).
+			Press(keys.Main.SplitHunk).
+			SelectedLines(
+				Contains("@@ -1,4 +1,4 @@"),
+				Equals(" 1"),
+				Equals("-2"),
+				Equals("+two"),
+				Equals(" 3"),
+				Equals(" 4"),
+			).
+			SelectNextItem().
+			SelectedLines(
+				Contains("@@ -3,5 +3,5 @@"),
+				Equals(" 3"),
+				Equals(" 4"),
+				Equals("-5"),
+				Equals("+five"),
+				Equals(" 6"),
+				Equals(" 7"),
+			).
+			SelectNextItem().
+			SelectedLines(
+				Contains("@@ -6,6 +6,6 @@"),
+				Equals(" 6"),
+				Equals(" 7"),
+				Equals("-8"),
+				Equals("+eight"),
+				Equals(" 9"),
+				Equals(" 10"),
+				Equals(" 11"),
+			).
+			// the smaller hunks can't be split any further
+			Press(keys.Main.SplitHunk).
+			Tap(func() {
+				t.ExpectToast(Equals("This hunk has only one group of changes so it can't be split"))
+			}).
+			SelectPreviousItem().
+			// stage the middle hunk
+			PressPrimaryAction().
+			Content(DoesNotContain("-5").DoesNotContain("+five")).
+			ContainsLines(
+				Equals("-2"),
+				Equals("+two"),
+			).
+			ContainsLines(
+				Equals("-8"),
+				Equals("+eight"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals("-5"),
+				Equals("+five"),
+			).
+			Content(DoesNotContain("two").DoesNotContain("eight"))
+	},
+})
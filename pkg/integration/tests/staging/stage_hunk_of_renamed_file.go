@@ -0,0 +1,62 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageHunkOfRenamedFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage one of the hunks of a file that has been renamed and modified",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("oldname", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n")
+		shell.Commit("one")
+
+		shell.RunShellCommand("mv oldname newname")
+		shell.UpdateFile("newname", "1\ntwo\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\neighteen\n19\n20\n")
+		shell.RunCommand("git add --intent-to-add newname")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("R oldname → newname").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Equals("-2")).
+			Press(keys.Main.ToggleSelectHunk).
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals("-2"),
+				Equals("+two"),
+			)
+
+		t.Views().Staging().
+			ContainsLines(
+				Equals("-18"),
+				Equals("+eighteen"),
+			)
+
+		t.Git().StagedDiff(`diff --git a/oldname b/newname
+similarity index 92%
+rename from oldname
+rename to newname
+index 0ff3bbb..a4164fb 100644
+--- a/oldname
++++ b/newname
+@@ -1,5 +1,5 @@
+ 1
+-2
++two
+ 3
+ 4
+ 5`)
+	},
+})
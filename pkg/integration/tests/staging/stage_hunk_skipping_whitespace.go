@@ -0,0 +1,61 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageHunkSkippingWhitespace = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage a hunk while leaving out the changes which only affect whitespace",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1\n  2\nthree\n4\n  5\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.ToggleSelectHunk).
+			SelectedLines(
+				Contains("@@ -1,5 +1,5 @@"),
+				Equals(" 1"),
+				Equals("-2"),
+				Equals("-3"),
+				Equals("+  2"),
+				Equals("+three"),
+				Equals(" 4"),
+				Equals("-5"),
+				Equals("+  5"),
+			).
+			Press(keys.Main.ApplySelectionSkippingWhitespace).
+			ContainsLines(
+				Equals("-2"),
+				Equals("+  2"),
+			).
+			ContainsLines(
+				Equals("-5"),
+				Equals("+  5"),
+			).
+			Content(DoesNotContain("+three"))
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals(" 2"),
+				Equals("-3"),
+				Equals("+three"),
+				Equals(" 4"),
+			).
+			Content(DoesNotContain("+  2").DoesNotContain("+  5"))
+	},
+})
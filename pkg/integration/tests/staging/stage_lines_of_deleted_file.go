@@ -0,0 +1,58 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesOfDeletedFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Staging some lines of a deleted file stages the whole deletion",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "apple\ngrape\npear\n")
+		shell.Commit("one")
+
+		shell.RunShellCommand("rm file1")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" D file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Equals("-apple")).
+			NavigateToLine(Equals("-grape")).
+			PressPrimaryAction()
+
+		t.ExpectToast(Contains("the whole deletion was used instead"))
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			ContainsLines(
+				Equals("-apple"),
+				Equals("-grape"),
+				Equals("-pear"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("D  file1"),
+			)
+
+		t.Git().StagedDiff(`diff --git a/file1 b/file1
+deleted file mode 100644
+index e6c83c5..0000000
+--- a/file1
++++ /dev/null
+@@ -1,3 +0,0 @@
+-apple
+-grape
+-pear`)
+	},
+})
@@ -0,0 +1,38 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesOfUntrackedBinaryFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Try to enter an untracked binary file in the staging panel",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("one")
+
+		shell.CreateFile("binary", "\x00\x01\x02\x03")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? binary").IsSelected(),
+			).
+			PressEnter()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Can't stage individual lines of a binary file")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? binary").IsSelected(),
+			)
+	},
+})
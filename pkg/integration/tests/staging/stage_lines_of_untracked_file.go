@@ -0,0 +1,60 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesOfUntrackedFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage some of the lines of an untracked file",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("one")
+
+		shell.CreateFile("file1", "one\ntwo\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Equals("+one"),
+			).
+			PressPrimaryAction().
+			SelectedLines(
+				Equals("+two"),
+			).
+			ContainsLines(
+				Equals(" one"),
+				Equals("+two"),
+				Equals("+three"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("new file mode"),
+			).
+			ContainsLines(
+				Equals("+one"),
+			).
+			Content(DoesNotContain("two").DoesNotContain("three"))
+
+		t.Views().Staging().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("AM file1").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,63 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesSideBySide = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage lines of a file shown as a side-by-side diff",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.DiffLayout = "sideBySide"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\ntwo\nthree\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\n2\nthree\nfour\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains("1  one").Contains("│").Contains("1  one"),
+				Contains("2 -two").Contains("│").Contains("2 +2"),
+				Contains("3  three").Contains("│").Contains("3  three"),
+				Contains("│").Contains("4 +four"),
+			).
+			SelectedLines(Contains("-two")).
+			// the added line shares a row with the deleted one
+			SelectNextItem().
+			SelectedLines(Contains("+2")).
+			PressPrimaryAction().
+			Tap(func() {
+				t.Views().StagingSecondary().
+					ContainsLines(
+						Contains("2  two").Contains("│").Contains("2  two"),
+						Contains("│").Contains("3 +2"),
+					)
+			}).
+			ContainsLines(
+				Contains("2 -two").Contains("│"),
+				Contains("3  2").Contains("│").Contains("2  2"),
+			).
+			// back to a unified diff
+			Press(keys.Main.ToggleDiffLayout).
+			ContainsLines(
+				Equals(" one"),
+				Equals("-two"),
+				Equals(" 2"),
+				Equals(" three"),
+				Equals("+four"),
+			)
+	},
+})
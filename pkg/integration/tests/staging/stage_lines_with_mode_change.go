@@ -0,0 +1,49 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesWithModeChange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Staging a line of a file whose mode has changed also stages the mode change",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("script", "one\ntwo\nthree\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("script", "1\ntwo\n3\n")
+		shell.RunShellCommand("chmod +x script")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M script").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Equals("-one")).
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Equals("-one"),
+			)
+
+		t.Git().StagedDiff(`diff --git a/script b/script
+old mode 100644
+new mode 100755
+index 4cb29ea..1946f04
+--- a/script
++++ b/script
+@@ -1,3 +1,2 @@
+-one
+ two
+ three`)
+	},
+})
@@ -0,0 +1,49 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesWithTenContext = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage individual lines when diffs are shown with ten context lines",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.DiffContextSize = 10
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n21\n22\n23\n24\n25\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n13\n14\n15\n16\n17\n18\n19\n20\n21\n22\n23\n24\n25\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains("@@ -2,21 +2,21 @@"),
+			).
+			SelectedLines(
+				Contains("-12"),
+			).
+			NavigateToLine(Contains("+twelve")).
+			PressPrimaryAction().
+			ContainsLines(
+				Contains("@@ -2,21 +2,20 @@"),
+			).
+			ContainsLines(
+				Contains(" 11"),
+				Contains("-12"),
+				Contains(" twelve"),
+				Contains(" 13"),
+			)
+	},
+})
@@ -0,0 +1,69 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLinesWithZeroContext = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage individual lines when diffs are shown without any context lines",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.DiffContextSize = 0
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1\n2\nthree\n4\n5\n6\n7\n8\nnine\n10\n11\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains("@@ -3 +3 @@"),
+				Contains("-3"),
+				Contains("+three"),
+				Contains("@@ -9 +9 @@"),
+				Contains("-9"),
+				Contains("+nine"),
+				Contains("@@ -10,0 +11 @@"),
+				Contains("+11"),
+			).
+			SelectedLines(Contains("-3")).
+			NavigateToLine(Equals("+nine")).
+			PressPrimaryAction().
+			ContainsLines(
+				Contains("@@ -9 +8,0 @@"),
+				Contains("-9"),
+			).
+			NavigateToLine(Equals("+11")).
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("@@ -9,0 +10 @@"),
+				Contains("+nine"),
+				Contains("@@ -10,0 +12 @@"),
+				Contains("+11"),
+			)
+
+		t.Views().Staging().
+			NavigateToLine(Equals("-3")).
+			PressPrimaryAction()
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("@@ -3 +2,0 @@"),
+				Contains("-3"),
+			)
+	},
+})
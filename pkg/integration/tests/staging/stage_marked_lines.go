@@ -0,0 +1,55 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageMarkedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark non-contiguous lines in the staging panel and stage them in one go",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\ntwo\nthree\nfour\nfive\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+two")).
+			Press(keys.Main.ToggleMarkLine).
+			NavigateToLine(Contains("+four")).
+			Press(keys.Main.ToggleMarkLine).
+			// only the line at the cursor gets marked, even when selecting a range
+			Press(keys.Main.ToggleDragSelect).
+			NavigateToLine(Contains("+five")).
+			Press(keys.Main.ToggleMarkLine).
+			// and marking it again unmarks it
+			Press(keys.Main.ToggleMarkLine).
+			PressPrimaryAction().
+			ContainsLines(
+				Contains(" two"),
+				Contains("+three"),
+				Contains(" four"),
+				Contains("+five"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("@@ -1 +1,3 @@"),
+				Contains(" one"),
+				Contains("+two"),
+				Contains("+four"),
+			)
+	},
+})
@@ -0,0 +1,73 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash two of five added lines, leaving the other lines and files in place",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "zero\n")
+		shell.CreateFileAndAdd("file2", "file2 content\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file1", "zero\none\ntwo\nthree\nfour\nfive\n")
+		shell.UpdateFileAndAdd("file2", "file2 content\nstaged line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file1").IsSelected(),
+				Contains("M  file2"),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+one")).
+			Press(keys.Main.ToggleDragSelect).
+			NavigateToLine(Contains("+two")).
+			Press(keys.Main.StashSelection).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Stash changes")).
+					Type("two lines").
+					Confirm()
+			}).
+			ContainsLines(
+				Contains(" zero"),
+				Contains("+three"),
+				Contains("+four"),
+				Contains("+five"),
+			).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file1").IsSelected(),
+				Contains("M  file2"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("zero\nthree\nfour\nfive\n"))
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("two lines").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("+one").
+					Contains("+two").
+					DoesNotContain("+three").
+					DoesNotContain("staged line"),
+			)
+	},
+})
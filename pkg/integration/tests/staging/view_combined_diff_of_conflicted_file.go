@@ -0,0 +1,65 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ViewCombinedDiffOfConflictedFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Enter a file whose merge conflicts have been resolved but not staged, which shows a read-only combined diff",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFile(shell)
+
+		// resolve the conflict without staging the file
+		shell.UpdateFile("file", "\nThis\nIs\nThe\nResolved\nFile\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains("@@@ -2,5 -2,5 +2,5 @@@"),
+				Equals("  This"),
+				Equals("  Is"),
+				Equals("  The"),
+				Equals("- First Change"),
+				Equals(" -Second Change"),
+				Equals("++Resolved"),
+				Equals("  File"),
+			).
+			SelectedLines(
+				Equals("- First Change"),
+			).
+			SelectNextItem().
+			SelectedLines(
+				Equals(" -Second Change"),
+			).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Can't stage individual lines of a file with merge conflicts. Stage the whole file once its conflicts are resolved.")).
+					Confirm()
+			}).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("Can't stage individual lines of a file with merge conflicts")).
+					Confirm()
+			}).
+			IsFocused()
+
+		t.FileSystem().FileContent("file", Equals("\nThis\nIs\nThe\nResolved\nFile\n"))
+	},
+})
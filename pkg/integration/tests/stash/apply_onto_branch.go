@@ -0,0 +1,68 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyOntoBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out another branch and pop a stash entry onto it, auto-stashing the uncommitted changes first",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("initial commit")
+		shell.NewBranch("feature")
+		shell.CreateFileAndAdd("feature-file", "feature\n")
+		shell.Commit("feature commit")
+		shell.Checkout("master")
+		shell.UpdateFile("file", "stashed\n")
+		shell.Stash("stash one")
+		shell.CreateFileAndAdd("dirty", "dirty\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.ApplyOntoBranch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply 'stash@{0}' onto branch")).
+					Select(Contains("feature")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply stash onto 'feature'")).
+					Select(Contains("pop")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Autostash?")).
+					Content(Contains("You have uncommitted changes, which will be stashed before checking out 'feature'.")).
+					Confirm()
+			}).
+			Lines(
+				Contains("Auto-stashing changes for feature"),
+			)
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature"),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature commit"),
+				Contains("initial commit"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file"),
+			)
+	},
+})
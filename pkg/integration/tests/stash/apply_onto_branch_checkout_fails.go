@@ -0,0 +1,97 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyOntoBranchCheckoutFails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Put the auto-stashed changes back when checking out the branch to apply a stash entry onto fails, and don't auto-stash when applying onto the current branch",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.CreateFileAndAdd("other", "one\n")
+		shell.Commit("initial commit")
+		shell.NewBranch("feature")
+		shell.CreateFileAndAdd("clash", "feature\n")
+		shell.Commit("feature commit")
+		shell.Checkout("master")
+		shell.UpdateFile("file", "stashed\n")
+		shell.Stash("stash one")
+		shell.UpdateFile("other", "dirty\n")
+		// checking out feature would overwrite this
+		shell.CreateFile("clash", "untracked\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.ApplyOntoBranch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply 'stash@{0}' onto branch")).
+					Select(Contains("feature")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply stash onto 'feature'")).
+					Select(Contains("apply")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Autostash?")).
+					Content(Contains("You have uncommitted changes")).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("would be overwritten by checkout")).
+					Confirm()
+			}).
+			Lines(
+				Contains("stash one"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("??").Contains("clash"),
+				Contains(" M").Contains("other"),
+			)
+
+		t.Views().Stash().
+			Focus().
+			Press(keys.Stash.ApplyOntoBranch).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply 'stash@{0}' onto branch")).
+					Select(Contains("master")).
+					Confirm()
+
+				// there's no checkout, so no need to auto-stash
+				t.ExpectPopup().Menu().
+					Title(Equals("Apply stash onto 'master'")).
+					Select(Contains("apply")).
+					Confirm()
+			}).
+			Lines(
+				Contains("stash one"),
+			)
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("feature"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("??").Contains("clash"),
+				Contains(" M").Contains("file"),
+				Contains(" M").Contains("other"),
+			)
+	},
+})
@@ -0,0 +1,64 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowFilesAfterDropping = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a stash entry's files after dropping the entry that was in its place, rather than the dropped entry's files",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file", "first content")
+		shell.Stash("first stash")
+		shell.CreateFileAndAdd("file", "second content")
+		shell.Stash("second stash")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("second stash").IsSelected(),
+				Contains("first stash"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("+second content"))
+
+		t.Views().CommitFiles().
+			PressEscape()
+
+		t.Views().Stash().
+			IsFocused().
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stash drop")).
+					Content(Contains("Are you sure you want to drop this stash entry?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("first stash").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("+first content"))
+	},
+})
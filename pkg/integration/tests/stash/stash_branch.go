@@ -0,0 +1,56 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a branch from a stash entry that no longer applies cleanly to HEAD",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file", "stashed\n")
+		shell.Stash("stash one")
+		shell.UpdateFileAndAdd("file", "committed\n")
+		shell.Commit("conflicting commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.StashBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("New branch name (branch is off the commit 'stash@{0}' was made on):")).
+					Type("stashed changes").
+					Confirm()
+			}).
+			IsEmpty()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file"),
+			)
+
+		t.Views().Main().
+			Content(Contains("-one").Contains("+stashed"))
+
+		t.Views().Branches().
+			Lines(
+				Contains("stashed-changes"),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("initial commit"),
+			)
+	},
+})
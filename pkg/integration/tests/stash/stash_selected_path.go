@@ -0,0 +1,55 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedPath = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash only the selected file, which is untracked, leaving the other changes in place",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-tracked", "content\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file-tracked", "new content\n")
+		shell.CreateFile("file-untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			IsEmpty()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file-tracked"),
+				Contains("file-untracked"),
+			).
+			NavigateToLine(Contains("file-untracked")).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("stash changes to selected files/directories")).Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("untracked file").Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file-tracked"),
+			)
+
+		t.FileSystem().PathNotPresent("file-untracked")
+		t.FileSystem().FileContent("file-tracked", Equals("new content\n"))
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("untracked file").IsSelected(),
+			).
+			Press(keys.Stash.PopStash)
+
+		t.ExpectPopup().Confirmation().Title(Equals("Stash pop")).Content(Contains("Are you sure")).Confirm()
+
+		t.FileSystem().FileContent("file-untracked", Equals("untracked content\n"))
+	},
+})
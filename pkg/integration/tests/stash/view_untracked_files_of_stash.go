@@ -0,0 +1,57 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewUntrackedFilesOfStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View and check out the untracked files stored in a stash entry",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("tracked", "content\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("tracked", "new content\n")
+		shell.CreateFile("untracked", "untracked content\n")
+		shell.RunCommand("git stash push --include-untracked -m 'with untracked'")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("with untracked").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("+new content").Contains("+untracked content"))
+
+		t.Views().Stash().
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("M tracked").IsSelected(),
+				Contains("A untracked"),
+			).
+			NavigateToLine(Contains("untracked"))
+
+		t.Views().Main().
+			Content(Contains("+untracked content"))
+
+		t.Views().CommitFiles().
+			Press(keys.CommitFiles.CheckoutCommitFile)
+
+		t.FileSystem().FileContent("untracked", Equals("untracked content\n"))
+
+		t.Views().Files().
+			Lines(
+				Contains("A  untracked"),
+			)
+	},
+})
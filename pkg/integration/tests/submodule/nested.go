@@ -0,0 +1,51 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Nested = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a nested submodule under its parent, update it, and enter it and return one level at a time",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.Clone("outer_repo")
+		shell.Clone("inner_repo")
+		shell.RunCommand("git submodule add ../outer_repo outer")
+		shell.RunCommand("git -C outer submodule add ../inner_repo inner")
+		shell.RunCommand("git -C outer commit -m add-inner")
+		shell.GitAddAll()
+		shell.Commit("add submodules")
+		shell.RunCommand("git -C outer submodule deinit --force inner")
+		shell.CreateFile("outer/untracked", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("outer").Contains("dirty").Contains("↑1↓0").IsSelected(),
+				Contains("  inner").Contains("uninitialized"),
+			).
+			NavigateToLine(Contains("inner")).
+			Press(keys.Submodules.Update).
+			Lines(
+				Contains("outer"),
+				Contains("  inner").DoesNotContain("uninitialized").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Status().Content(Contains("inner"))
+
+		t.Views().Files().IsFocused().PressEscape()
+
+		t.Views().Status().Content(Contains("outer"))
+
+		t.Views().Files().IsFocused().PressEscape()
+
+		t.Views().Status().Content(Contains("repo"))
+
+		t.Views().Submodules().IsFocused()
+	},
+})
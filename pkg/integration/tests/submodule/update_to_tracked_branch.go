@@ -0,0 +1,47 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UpdateToTrackedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Update a submodule to the tip of the branch it tracks, setting that branch first",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule")
+		shell.GitAddAll()
+		shell.Commit("add submodule")
+
+		// add a commit to the submodule's remote
+		shell.RunCommand("git clone ../other_repo ../other_clone")
+		shell.RunCommand("git -C ../other_clone commit --allow-empty -m new-commit")
+		shell.RunCommand("git -C ../other_clone push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("my_submodule").IsSelected(),
+			).
+			Press(keys.Submodules.UpdateToTrackedBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Submodule 'my_submodule' doesn't track a branch yet. Branch to track:")).
+					Type("master").
+					Confirm()
+
+				t.Views().Extras().Content(MatchesRegexp(`my_submodule: \w+ → \w+`))
+
+				t.Views().Main().Content(Contains("> new-commit"))
+			})
+
+		t.Views().Files().
+			Lines(
+				Contains(" M .gitmodules"),
+				MatchesRegexp(` M.*my_submodule \(submodule\)`),
+			)
+	},
+})
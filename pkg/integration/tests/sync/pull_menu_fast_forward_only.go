@@ -0,0 +1,60 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullMenuFastForwardOnly = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pull with --ff-only from the pull menu when the branch has diverged, and rebase instead",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "content2")
+		shell.Commit("two")
+		shell.EmptyCommit("three")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^^")
+		shell.EmptyCommit("four")
+
+		shell.SetConfig("pull.ff", "only")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↓2 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PullMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pull options")).
+			TopLines(
+				Contains("pull (rebase)"),
+				Contains("pull (merge)"),
+				Contains("pull (fast-forward only)").IsSelected(),
+			).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Can't fast-forward")).
+			Content(Equals("Your branch has diverged from its upstream, so it can't be fast-forwarded. Pull with rebase instead?")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("four"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
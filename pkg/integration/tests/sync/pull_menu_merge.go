@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullMenuMerge = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pull with a merge from the pull menu even though pull.rebase is set",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "content2")
+		shell.Commit("two")
+		shell.EmptyCommit("three")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^^")
+		shell.EmptyCommit("four")
+
+		shell.SetConfig("pull.rebase", "true")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Contains("four"),
+				Contains("one"),
+			)
+
+		t.Views().Status().Content(Contains("↓2 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PullMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pull options")).
+			TopLines(
+				Contains("pull (rebase)").Contains("git pull --rebase").IsSelected(),
+				Contains("pull (merge)").Contains("git pull --no-rebase"),
+				Contains("pull (fast-forward only)").Contains("git pull --ff-only"),
+				Contains("fetch only").Contains("git fetch"),
+			).
+			Select(Contains("pull (merge)")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("↑2 repo → master"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("Merge branch 'master' of ../origin"),
+				Contains("three"),
+				Contains("two"),
+				Contains("four"),
+				Contains("one"),
+			)
+	},
+})
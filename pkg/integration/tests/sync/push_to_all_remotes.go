@@ -0,0 +1,69 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushToAllRemotes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push to all push remotes from the push menu, where one of the remotes rejects the push",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+
+		// the backup remote has a commit that we don't have, so it rejects our push
+		shell.EmptyCommit("two")
+		shell.CloneIntoRemote("backup")
+		shell.HardReset("HEAD^")
+
+		shell.EmptyCommit("three")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// the other remote isn't configured for pushing, so we leave it alone
+		shell.CloneIntoRemote("other")
+		shell.SetConfig("remote.pushDefault", "origin")
+		shell.SetConfig("remote.backup.pushurl", "../backup")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.PushMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("push to all push remotes")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Failed to push to some remotes")).
+			Content(
+				Contains("origin: pushed").
+					Contains("backup: failed:").
+					DoesNotContain("other"),
+			).
+			Confirm()
+
+		t.Views().Status().Content(Contains("✓ repo → master"))
+
+		t.Views().Remotes().Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+				Contains("backup"),
+				Contains("other"),
+			).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(Contains("master")).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(
+				Contains("three"),
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushToAllRemotesNotConfigured = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pushing to all push remotes isn't possible when none are configured, however many remotes there are",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.CloneIntoRemote("backup")
+		shell.SetBranchUpstream("master", "origin/master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsFocused().Press(keys.Universal.PushMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("push to all push remotes")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("No push remotes are configured (remote.pushDefault, branch.<name>.pushRemote or remote.<name>.pushurl)")).
+			Confirm()
+	},
+})
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushToAllRemotesWithPushConfig = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push to all push remotes, where the branch's upstream has a different name and a push remote is configured",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.CloneIntoRemote("origin")
+		shell.CloneIntoRemote("fork")
+		shell.RunCommand("git push origin feature:main-feature")
+		shell.SetBranchUpstream("feature", "origin/main-feature")
+		shell.SetConfig("remote.pushDefault", "fork")
+		shell.SetConfig("remote.origin.pushurl", "../origin")
+
+		shell.EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsFocused().Press(keys.Universal.PushMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("push to all push remotes")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("✓ repo → feature"))
+
+		t.Views().Remotes().Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+				Contains("fork"),
+			).
+			PressEnter()
+
+		// the upstream keeps its own name
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("feature"),
+				Contains("main-feature"),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("main-feature")).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			).
+			PressEscape()
+
+		t.Views().RemoteBranches().IsFocused().PressEscape()
+
+		t.Views().Remotes().IsFocused().
+			NavigateToLine(Contains("fork")).
+			PressEnter()
+
+		// the push remote gets a branch of the same name as ours
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("feature"),
+				Contains("master"),
+			).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithCredentialPromptCancelled = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cancel the credential prompt when pushing, which aborts the push",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		shell.CopyHelpFile("pre-push", ".git/hooks/pre-push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Cancel()
+
+		t.ExpectToast(Equals("Cancelled"))
+
+		t.Views().Files().IsFocused()
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		// the cancelled push doesn't get in the way of trying again
+		t.Views().Files().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("password").
+			Confirm()
+
+		t.Views().Status().Content(Contains("✓ repo → master"))
+
+		assertSuccessfullyPushed(t)
+	},
+})
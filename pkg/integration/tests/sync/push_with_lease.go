@@ -0,0 +1,38 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithLease = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force push with lease from the push menu, showing the git command in the command log",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↑1↓1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.PushMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("force push with lease").Contains("git push --force-with-lease").DoesNotContain("--force-if-includes")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("✓ repo → master"))
+
+		t.Views().Extras().
+			Content(Contains("git push --force-with-lease").DoesNotContain("--force-if-includes"))
+	},
+})
@@ -33,16 +33,16 @@ var RenameBranchAndPull = NewIntegrationTest(NewIntegrationTestArgs{
 			).
 			Press(keys.Branches.RenameBranch).
 			Tap(func() {
-				t.ExpectPopup().Confirmation().
-					Title(Equals("rename branch")).
-					Content(Equals("This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?")).
-					Confirm()
-
 				t.ExpectPopup().Prompt().
 					Title(Contains("Enter new branch name")).
 					InitialText(Equals("master")).
 					Type("-local").
 					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Rename on remote")).
+					Content(Equals("Also rename 'origin/master' to 'origin/master-local' on the remote?")).
+					Cancel()
 			}).
 			Press(keys.Universal.Pull)
 
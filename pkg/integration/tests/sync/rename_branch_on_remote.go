@@ -0,0 +1,65 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RenameBranchOnRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rename a branch that has an upstream, and rename it on the remote too",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+		shell.SetBranchUpstream("feature", "origin/feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.NextScreenMode). // we need to enlargen the window to see the upstream
+			Lines(
+				Contains("master"),
+				Contains("feature").Contains("origin feature"),
+			).
+			NavigateToLine(Contains("feature")).
+			Press(keys.Branches.RenameBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Contains("Enter new branch name")).
+					InitialText(Equals("feature")).
+					Clear().
+					Type("renamed").
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Rename on remote")).
+					Content(Equals("Also rename 'origin/feature' to 'origin/renamed' on the remote?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("renamed").Contains("✓").Contains("origin renamed").IsSelected(),
+			).
+			Press(keys.Universal.PrevScreenMode)
+
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin"),
+			).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("renamed"),
+			)
+	},
+})
@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RenameBranchOnRemoteFails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rename a branch on the remote too, where deleting the old remote branch fails",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		// the remote's HEAD points at master, so it refuses to delete it
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.NextScreenMode). // we need to enlargen the window to see the upstream
+			Lines(
+				Contains("master").Contains("origin master").IsSelected(),
+			).
+			Press(keys.Branches.RenameBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Contains("Enter new branch name")).
+					Clear().
+					Type("main").
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Rename on remote")).
+					Content(Equals("Also rename 'origin/master' to 'origin/main' on the remote?")).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("The branch was renamed locally, but renaming it on the remote failed")).
+					Confirm()
+			}).
+			// the new name was pushed before deleting the old one failed
+			Lines(
+				Contains("main").Contains("origin main").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,62 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditMessage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit the message of an annotated tag that also exists on a remote, and force push it there",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("lightweight-tag", "HEAD")
+		shell.EmptyCommit("second commit")
+		shell.CreateAnnotatedTag("annotated-tag", "old message", "HEAD")
+		shell.CloneIntoRemote("origin")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("annotated-tag").Contains("old message").IsSelected(),
+				Contains("lightweight-tag").Contains("(lightweight) initial commit"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("Tagger:").Contains("old message").Contains("second commit"))
+			}).
+			Press(keys.Branches.EditTagMessage).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Message for tag 'annotated-tag':")).
+					InitialText(Equals("old message")).
+					Clear().
+					Type("new message").
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Force push tag")).
+					Content(Equals("Tag 'annotated-tag' also exists on origin. Force push the edited tag there?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("annotated-tag").Contains("new message").IsSelected(),
+				Contains("lightweight-tag"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("new message").Contains("second commit"))
+			}).
+			NavigateToLine(Contains("lightweight-tag")).
+			Press(keys.Branches.EditTagMessage).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("Lightweight tags have no message of their own")).
+					Confirm()
+			})
+	},
+})
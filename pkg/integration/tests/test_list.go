@@ -18,6 +18,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/reflog"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/remote"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/staging"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/stash"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/submodule"
@@ -29,65 +30,121 @@ import (
 
 var tests = []*components.IntegrationTest{
 	bisect.Basic,
+	bisect.CancelRun,
 	bisect.FromOtherBranch,
+	bisect.RunCommand,
+	branch.CheckoutBranchInOtherWorktree,
 	branch.CheckoutByName,
+	branch.CheckoutWithDetachedCommits,
+	branch.CheckoutWithDetachedCommitsAlreadyOnBranch,
 	branch.CreateTag,
+	branch.CreateWorktreeFromRemoteBranch,
 	branch.Delete,
 	branch.DetachedHead,
+	branch.OpenPullRequest,
 	branch.OpenWithCliArg,
 	branch.Rebase,
 	branch.RebaseAndDrop,
 	branch.RebaseDoesNotAutosquash,
+	branch.RebaseOntoTypedRef,
 	branch.Reset,
 	branch.ResetUpstream,
 	branch.SetUpstream,
+	branch.ShowDivergenceFromBaseBranch,
+	branch.ShowPullRequestStatus,
+	branch.SquashMerge,
+	branch.SquashMergeNoChanges,
 	branch.Suggestions,
 	cherry_pick.CherryPick,
 	cherry_pick.CherryPickConflicts,
+	cherry_pick.CherryPickRange,
+	commit.AbsorbStagedChanges,
+	commit.AddTrailers,
+	commit.AddTrailersToEmptyMessage,
+	commit.ChangeAuthorAndDate,
 	commit.Commit,
+	commit.CommitMessageHistory,
+	commit.CommitMessageWarnings,
 	commit.CommitMultiline,
+	commit.CommitWithTemplate,
+	commit.CommitWithTemplateGlob,
+	commit.CopyFileUrl,
+	commit.CreateFixupCommitsByFile,
 	commit.CreateTag,
+	commit.DiscardOldDirectoryChange,
 	commit.DiscardOldFileChange,
+	commit.DropMarkedCommits,
+	commit.LoadCommitsInChunks,
+	commit.MoveCommitsToBranch,
+	commit.MoveCommitsToBranchConflict,
+	commit.NavigateWhileSearchingContents,
 	commit.NewBranch,
 	commit.ResetAuthor,
 	commit.Revert,
 	commit.RevertMerge,
+	commit.RevertWithConflict,
 	commit.Search,
+	commit.SearchContents,
+	commit.SearchMultiByte,
 	commit.SetAuthor,
+	commit.ShowAllBranches,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
 	commit.Unstaged,
+	config.ReloadConfig,
 	config.RemoteNamedStar,
+	config.RepoConfig,
 	conflicts.Filter,
+	conflicts.PickAllHunksBottomFirst,
 	conflicts.ResolveExternally,
 	conflicts.ResolveMultipleFiles,
 	conflicts.UndoChooseHunk,
 	custom_commands.Basic,
+	custom_commands.ChainedPrompts,
+	custom_commands.EnabledIf,
 	custom_commands.FormPrompts,
+	custom_commands.KeySequence,
 	custom_commands.MenuFromCommand,
+	custom_commands.MenuFromCommandColumns,
 	custom_commands.MenuFromCommandsOutput,
 	custom_commands.MultiplePrompts,
+	custom_commands.ShowOutput,
+	diff.CompareCommits,
 	diff.Diff,
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
 	diff.IgnoreWhitespace,
+	file.Blame,
+	file.CollapseAndExpandAll,
 	file.DirWithUntrackedFile,
 	file.DiscardChanges,
+	file.DiscardPickedUntrackedFiles,
 	file.DiscardStagedChanges,
+	file.FileHistory,
 	file.Gitignore,
+	file.GitignorePattern,
+	file.OpenDiffTool,
 	file.RememberCommitMessageAfterFail,
+	file.SkipWorktree,
+	file.UntrackFile,
 	filter_by_path.CliArg,
+	filter_by_path.FilterByAuthor,
+	filter_by_path.MultiplePaths,
 	filter_by_path.SelectFile,
 	filter_by_path.TypeFile,
+	interactive_rebase.AddExecAndBreak,
+	interactive_rebase.AmendCommitInMiddle,
 	interactive_rebase.AmendFirstCommit,
 	interactive_rebase.AmendMerge,
+	interactive_rebase.AmendPushedCommit,
 	interactive_rebase.EditFirstCommit,
 	interactive_rebase.FixupFirstCommit,
 	interactive_rebase.FixupSecondCommit,
 	interactive_rebase.Move,
 	interactive_rebase.MoveInRebase,
 	interactive_rebase.Rebase,
+	interactive_rebase.RebaseMergesTodo,
 	interactive_rebase.RewordFirstCommit,
 	interactive_rebase.RewordLastCommit,
 	interactive_rebase.SquashDownFirstCommit,
@@ -95,53 +152,96 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.SquashFixupsAboveFirstCommit,
 	interactive_rebase.SwapInRebaseWithConflict,
 	interactive_rebase.SwapWithConflict,
+	misc.CommandHistory,
 	misc.ConfirmOnQuit,
 	misc.InitialOpen,
+	misc.QuitWhileRebasing,
+	misc.RecentReposPicker,
+	patch_building.AddFromMultipleCommits,
 	patch_building.Apply,
 	patch_building.ApplyInReverse,
 	patch_building.ApplyInReverseWithConflict,
+	patch_building.ApplyToWorkingTree,
+	patch_building.ApplyToWorkingTreeWithConflict,
 	patch_building.CopyPatchToClipboard,
+	patch_building.EditLine,
 	patch_building.MoveToIndex,
+	patch_building.MoveToIndexFromMultipleCommits,
 	patch_building.MoveToIndexPartial,
 	patch_building.MoveToIndexWithConflict,
 	patch_building.MoveToNewCommit,
+	patch_building.MoveToNewCommitBefore,
+	patch_building.PatchSummary,
 	patch_building.RemoveFromCommit,
 	patch_building.ResetWithEscape,
+	patch_building.SaveAsStashEntry,
+	patch_building.SavePatchToFile,
 	patch_building.SelectAllFiles,
 	patch_building.SpecificSelection,
-	patch_building.StartNewPatch,
 	reflog.Checkout,
 	reflog.CherryPick,
 	reflog.Patch,
 	reflog.Reset,
+	reflog.RestoreToEntry,
+	remote.EditUrls,
+	remote.FetchAllRemotes,
+	staging.CollapseHunks,
+	staging.CommitSelectedLines,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
+	staging.DiscardLines,
+	staging.DiscardLinesNoLongerMatching,
+	staging.GoToLine,
 	staging.Search,
+	staging.SearchAfterStaging,
+	staging.SplitHunk,
+	staging.StageHunkOfRenamedFile,
+	staging.StageHunkSkippingWhitespace,
 	staging.StageHunks,
 	staging.StageLines,
+	staging.StageLinesOfDeletedFile,
+	staging.StageLinesOfUntrackedBinaryFile,
+	staging.StageLinesOfUntrackedFile,
+	staging.StageLinesSideBySide,
+	staging.StageLinesWithModeChange,
+	staging.StageLinesWithTenContext,
+	staging.StageLinesWithZeroContext,
+	staging.StageMarkedLines,
 	staging.StageRanges,
+	staging.StashSelectedLines,
+	staging.ViewCombinedDiffOfConflictedFile,
 	stash.Apply,
+	stash.ApplyOntoBranch,
+	stash.ApplyOntoBranchCheckoutFails,
 	stash.ApplyPatch,
 	stash.CreateBranch,
 	stash.Drop,
 	stash.Pop,
 	stash.Rename,
+	stash.ShowFilesAfterDropping,
 	stash.Stash,
 	stash.StashAll,
 	stash.StashAndKeepIndex,
+	stash.StashBranch,
 	stash.StashIncludingUntrackedFiles,
+	stash.StashSelectedPath,
 	stash.StashStaged,
 	stash.StashUnstaged,
+	stash.ViewUntrackedFilesOfStash,
 	submodule.Add,
 	submodule.Enter,
+	submodule.Nested,
 	submodule.Remove,
 	submodule.Reset,
+	submodule.UpdateToTrackedBranch,
 	sync.FetchPrune,
 	sync.ForcePush,
 	sync.ForcePushMultipleMatching,
 	sync.ForcePushMultipleUpstream,
 	sync.Pull,
 	sync.PullAndSetUpstream,
+	sync.PullMenuFastForwardOnly,
+	sync.PullMenuMerge,
 	sync.PullMerge,
 	sync.PullMergeConflict,
 	sync.PullRebase,
@@ -154,13 +254,26 @@ var tests = []*components.IntegrationTest{
 	sync.PushFollowTags,
 	sync.PushNoFollowTags,
 	sync.PushTag,
+	sync.PushToAllRemotes,
+	sync.PushToAllRemotesNotConfigured,
+	sync.PushToAllRemotesWithPushConfig,
 	sync.PushWithCredentialPrompt,
+	sync.PushWithCredentialPromptCancelled,
+	sync.PushWithLease,
 	sync.RenameBranchAndPull,
+	sync.RenameBranchOnRemote,
+	sync.RenameBranchOnRemoteFails,
 	tag.Checkout,
 	tag.CrudAnnotated,
 	tag.CrudLightweight,
+	tag.EditMessage,
 	tag.Reset,
+	ui.CommandPalette,
 	ui.DoublePopup,
+	ui.PanelLayout,
+	ui.StatusDashboard,
+	ui.StatusSpeedups,
+	ui.StatusSpeedupsWithFsMonitorHook,
 	ui.SwitchTabFromMenu,
 	undo.UndoCheckoutAndDrop,
 	undo.UndoDrop,
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandPalette = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search for an action from another panel with the command palette and run it",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Search actions")).
+			Type("checkout file commit files").
+			SuggestionTopLines(
+				Contains("checkout file (Commit files)"),
+			).
+			Confirm()
+
+		// the commit files panel is only reachable via a commit
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("only available once the")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Search actions")).
+			Type("new branch local").
+			SuggestionTopLines(
+				Contains("new branch (Local Branches)"),
+			).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("New Branch Name")).
+			Type("my-branch").
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("my-branch").IsSelected(),
+				Contains("master"),
+			)
+	},
+})
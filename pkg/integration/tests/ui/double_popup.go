@@ -23,9 +23,9 @@ var DoublePopup = NewIntegrationTest(NewIntegrationTestArgs{
 			Title(Contains("Error")).
 			Content(Contains("You have already checked out this branch"))
 
-		t.GlobalPress(keys.Universal.OpenRecentRepos)
+		t.GlobalPress(keys.Universal.DiffingMenu)
 
-		t.ExpectPopup().Menu().Title(Contains("recent repositories")).Cancel()
+		t.ExpectPopup().Menu().Title(Equals("Diffing")).Cancel()
 
 		t.Views().Branches().IsFocused()
 
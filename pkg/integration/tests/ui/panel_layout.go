@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PanelLayout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reorder and hide side panels, revealing a hidden panel while it's focused",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.Panels.Order = []string{"status", "branches", "files"}
+		config.UserConfig.Gui.Panels.Hidden = []string{"stash"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			IsInvisible()
+
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.NextBlock)
+
+		// the hidden stash panel is skipped
+		t.Views().Commits().
+			IsFocused().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().Status().
+			IsFocused()
+
+		t.Views().Stash().
+			Focus().
+			IsVisible().
+			Press(keys.Universal.PrevBlock)
+
+		t.Views().Commits().
+			IsFocused()
+
+		t.Views().Stash().
+			IsInvisible()
+	},
+})
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StatusDashboard = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a summary of the repo when the status panel is focused and jump to the panel of a selected line",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.StatusPanelView = "dashboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file", "content")
+		shell.Stash("my stash")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Content(Contains("Stashes: 1")).
+			Content(Contains("Worktrees: 1")).
+			Content(Contains("Files with merge conflicts: 0")).
+			Content(Contains("In progress: nothing")).
+			Content(Contains("Last fetch: never"))
+
+		t.Views().Status().
+			Press(keys.Universal.NextItem).
+			Press(keys.Universal.PrevItem).
+			PressPrimaryAction()
+
+		t.Views().Stash().
+			IsFocused().
+			Lines(
+				Contains("my stash"),
+			)
+	},
+})
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StatusSpeedups = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Turn fsmonitor and the untracked cache on and off from the status panel, seeing their state in the dashboard",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.StatusPanelView = "dashboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Content(Contains("fsmonitor: off, untracked cache: off"))
+
+		t.Views().Status().
+			Press(keys.Status.StatusSpeedups)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Speed up git status")).
+			Select(Contains("enable fsmonitor")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("fsmonitor: on, untracked cache: off"))
+
+		t.FileSystem().FileContent(".git/config", Contains("fsmonitor = true"))
+
+		t.Views().Status().
+			Press(keys.Status.StatusSpeedups)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Speed up git status")).
+			Select(Contains("enable the untracked cache")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("fsmonitor: on, untracked cache: on"))
+
+		t.FileSystem().FileContent(".git/config", Contains("untrackedCache = true"))
+
+		t.Views().Status().
+			Press(keys.Status.StatusSpeedups)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Speed up git status")).
+			Select(Contains("disable fsmonitor")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("fsmonitor: off, untracked cache: on"))
+
+		t.FileSystem().FileContent(".git/config", DoesNotContain("fsmonitor"))
+	},
+})
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StatusSpeedupsWithFsMonitorHook = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "An fsmonitor hook counts as fsmonitor being on, and can't be swapped for the built-in monitor from the status panel",
+	ExtraCmdArgs: "",
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Gui.StatusPanelView = "dashboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		// a hook that fails makes git look at every file, as if there were none
+		shell.CreateFile(".git/hooks/query-watchman", "#!/bin/sh\nexit 1\n")
+		shell.RunShellCommand("chmod +x .git/hooks/query-watchman")
+		shell.SetConfig("core.fsmonitor", ".git/hooks/query-watchman")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Content(Contains("fsmonitor: on"))
+
+		t.Views().Status().
+			Press(keys.Status.StatusSpeedups)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Speed up git status")).
+			Select(Contains("disable fsmonitor")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("fsmonitor is using the hook '.git/hooks/query-watchman', which lazygit leaves alone")).
+			Confirm()
+
+		t.FileSystem().FileContent(".git/config", Contains("fsmonitor = .git/hooks/query-watchman"))
+	},
+})
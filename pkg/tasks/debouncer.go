@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// a Debouncer collapses requests to run something that come in quick
+// succession into a single run. A run happens once the delay has passed since
+// the first of the requests, and any requests that come in while it's running
+// just mean that it needs to run again afterwards, rather than each being
+// queued up. For example, if a build tool writes hundreds of files we'll get a
+// request to refresh the files for each of them, but only want to run git
+// status once or twice.
+type Debouncer struct {
+	delay time.Duration
+	run   func()
+	// calls f after d has passed, without blocking. Can be swapped out in tests
+	afterFunc func(d time.Duration, f func())
+
+	mutex deadlock.Mutex
+	// a run has been scheduled but hasn't started yet
+	scheduled bool
+	running   bool
+	// we were asked to run while we were already running
+	rerunNeeded bool
+}
+
+func NewDebouncer(delay time.Duration, run func()) *Debouncer {
+	return &Debouncer{
+		delay: delay,
+		run:   run,
+		afterFunc: func(d time.Duration, f func()) {
+			time.AfterFunc(d, func() { utils.Safe(f) })
+		},
+		mutex: deadlock.Mutex{},
+	}
+}
+
+// Request asks for a run, which will happen after the delay unless one is
+// already coming up
+func (self *Debouncer) Request() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.running {
+		self.rerunNeeded = true
+		return
+	}
+
+	self.schedule()
+}
+
+// expects the mutex to be held
+func (self *Debouncer) schedule() {
+	if self.scheduled {
+		return
+	}
+
+	self.scheduled = true
+	self.afterFunc(self.delay, self.fire)
+}
+
+func (self *Debouncer) fire() {
+	self.mutex.Lock()
+	self.scheduled = false
+	self.running = true
+	self.mutex.Unlock()
+
+	self.run()
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.running = false
+	if self.rerunNeeded {
+		self.rerunNeeded = false
+		self.schedule()
+	}
+}
@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTimer struct {
+	at time.Duration
+	f  func()
+}
+
+// fakeClock only moves when we tell it to, firing whatever timers are due
+type fakeClock struct {
+	now    time.Duration
+	timers []fakeTimer
+}
+
+func (self *fakeClock) afterFunc(d time.Duration, f func()) {
+	self.timers = append(self.timers, fakeTimer{at: self.now + d, f: f})
+}
+
+func (self *fakeClock) advance(d time.Duration) {
+	self.now += d
+	for {
+		dueIdx := -1
+		for i, timer := range self.timers {
+			if timer.at <= self.now {
+				dueIdx = i
+				break
+			}
+		}
+		if dueIdx == -1 {
+			return
+		}
+		timer := self.timers[dueIdx]
+		self.timers = append(self.timers[:dueIdx], self.timers[dueIdx+1:]...)
+		timer.f()
+	}
+}
+
+func TestDebouncer(t *testing.T) {
+	type scenario struct {
+		testName string
+		// makes requests over time
+		test func(debouncer *Debouncer, clock *fakeClock)
+		// called from within each run
+		duringRun   func(debouncer *Debouncer, runCount int)
+		expectedRun int
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "no requests",
+			test:        func(debouncer *Debouncer, clock *fakeClock) { clock.advance(time.Second) },
+			expectedRun: 0,
+		},
+		{
+			testName: "doesn't run before the delay has passed",
+			test: func(debouncer *Debouncer, clock *fakeClock) {
+				debouncer.Request()
+				clock.advance(199 * time.Millisecond)
+			},
+			expectedRun: 0,
+		},
+		{
+			testName: "runs once the delay has passed",
+			test: func(debouncer *Debouncer, clock *fakeClock) {
+				debouncer.Request()
+				clock.advance(200 * time.Millisecond)
+			},
+			expectedRun: 1,
+		},
+		{
+			testName: "requests within the delay collapse into one run",
+			test: func(debouncer *Debouncer, clock *fakeClock) {
+				for i := 0; i < 100; i++ {
+					debouncer.Request()
+					clock.advance(time.Millisecond)
+				}
+				clock.advance(time.Second)
+			},
+			expectedRun: 1,
+		},
+		{
+			testName: "requests after a run has finished get their own run",
+			test: func(debouncer *Debouncer, clock *fakeClock) {
+				debouncer.Request()
+				clock.advance(time.Second)
+				debouncer.Request()
+				clock.advance(time.Second)
+			},
+			expectedRun: 2,
+		},
+		{
+			testName: "requests during a run lead to one more run afterwards",
+			test: func(debouncer *Debouncer, clock *fakeClock) {
+				debouncer.Request()
+				clock.advance(time.Second)
+				clock.advance(time.Second)
+			},
+			duringRun: func(debouncer *Debouncer, runCount int) {
+				if runCount == 1 {
+					for i := 0; i < 100; i++ {
+						debouncer.Request()
+					}
+				}
+			},
+			expectedRun: 2,
+		},
+		{
+			testName: "the run after a run isn't until the delay has passed again",
+			test: func(debouncer *Debouncer, clock *fakeClock) {
+				debouncer.Request()
+				clock.advance(200 * time.Millisecond)
+				clock.advance(199 * time.Millisecond)
+			},
+			duringRun: func(debouncer *Debouncer, runCount int) {
+				debouncer.Request()
+			},
+			expectedRun: 1,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			clock := &fakeClock{}
+			runCount := 0
+			var debouncer *Debouncer
+			debouncer = NewDebouncer(200*time.Millisecond, func() {
+				runCount++
+				if s.duringRun != nil {
+					s.duringRun(debouncer, runCount)
+				}
+			})
+			debouncer.afterFunc = clock.afterFunc
+
+			s.test(debouncer, clock)
+
+			assert.Equal(t, s.expectedRun, runCount)
+		})
+	}
+}
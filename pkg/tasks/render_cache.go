@@ -0,0 +1,95 @@
+package tasks
+
+import (
+	"container/list"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// a RenderCache holds what commands rendered to a view, so that when we show
+// the same thing again (e.g. going back to a commit we were just looking at)
+// we don't have to run the command and wait for its output again. Once it's
+// full, the least recently used entries make way for new ones.
+type RenderCache struct {
+	size    int
+	entries map[string]*list.Element
+	// most recently used at the front
+	order *list.List
+	mutex deadlock.Mutex
+}
+
+type renderCacheEntry struct {
+	key     string
+	content string
+}
+
+// a size of 0 means we don't cache anything
+func NewRenderCache(size int) *RenderCache {
+	return &RenderCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		mutex:   deadlock.Mutex{},
+	}
+}
+
+func (self *RenderCache) Get(key string) (string, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	element, ok := self.entries[key]
+	if !ok {
+		return "", false
+	}
+
+	self.order.MoveToFront(element)
+	return element.Value.(*renderCacheEntry).content, true
+}
+
+func (self *RenderCache) Set(key string, content string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.size <= 0 {
+		return
+	}
+
+	if element, ok := self.entries[key]; ok {
+		element.Value.(*renderCacheEntry).content = content
+		self.order.MoveToFront(element)
+		return
+	}
+
+	self.entries[key] = self.order.PushFront(&renderCacheEntry{key: key, content: content})
+	self.evict()
+}
+
+// SetSize is for when the config changes, dropping entries if we now have too
+// many
+func (self *RenderCache) SetSize(size int) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.size = size
+	self.evict()
+}
+
+// expects the mutex to be held
+func (self *RenderCache) evict() {
+	for self.order.Len() > utils.Max(self.size, 0) {
+		oldest := self.order.Back()
+		self.order.Remove(oldest)
+		delete(self.entries, oldest.Value.(*renderCacheEntry).key)
+	}
+}
+
+// Clear is for when what's cached may be out of date, e.g. because a stash
+// entry we rendered by its index is now a different one
+func (self *RenderCache) Clear() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries = map[string]*list.Element{}
+	self.order.Init()
+}
@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCache(t *testing.T) {
+	type scenario struct {
+		testName string
+		size     int
+		// calls Set and Get on the cache
+		test func(cache *RenderCache)
+		// what Get should return for each key afterwards, where a missing
+		// entry is ""
+		expected map[string]string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "empty",
+			size:     2,
+			test:     func(cache *RenderCache) {},
+			expected: map[string]string{"a": ""},
+		},
+		{
+			testName: "keeps what it's given",
+			size:     2,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+				cache.Set("b", "content b")
+			},
+			expected: map[string]string{"a": "content a", "b": "content b"},
+		},
+		{
+			testName: "drops the least recently set entry when full",
+			size:     2,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+				cache.Set("b", "content b")
+				cache.Set("c", "content c")
+			},
+			expected: map[string]string{"a": "", "b": "content b", "c": "content c"},
+		},
+		{
+			testName: "getting an entry counts as using it",
+			size:     2,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+				cache.Set("b", "content b")
+				cache.Get("a")
+				cache.Set("c", "content c")
+			},
+			expected: map[string]string{"a": "content a", "b": "", "c": "content c"},
+		},
+		{
+			testName: "setting an existing entry replaces it",
+			size:     2,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+				cache.Set("b", "content b")
+				cache.Set("a", "new content a")
+				cache.Set("c", "content c")
+			},
+			expected: map[string]string{"a": "new content a", "b": "", "c": "content c"},
+		},
+		{
+			testName: "clearing",
+			size:     2,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+				cache.Clear()
+				cache.Set("b", "content b")
+			},
+			expected: map[string]string{"a": "", "b": "content b"},
+		},
+		{
+			testName: "shrinking",
+			size:     3,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+				cache.Set("b", "content b")
+				cache.Set("c", "content c")
+				cache.SetSize(1)
+			},
+			expected: map[string]string{"a": "", "b": "", "c": "content c"},
+		},
+		{
+			testName: "size of zero",
+			size:     0,
+			test: func(cache *RenderCache) {
+				cache.Set("a", "content a")
+			},
+			expected: map[string]string{"a": ""},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			cache := NewRenderCache(s.size)
+			s.test(cache)
+
+			for key, expectedContent := range s.expected {
+				content, ok := cache.Get(key)
+				assert.Equal(t, expectedContent != "", ok, key)
+				assert.Equal(t, expectedContent, content, key)
+			}
+		})
+	}
+}
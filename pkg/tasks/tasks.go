@@ -25,6 +25,16 @@ import (
 
 const THROTTLE_TIME = time.Millisecond * 30
 
+// for tasks whose output we cache, once the user has stayed on an item for
+// READ_AHEAD_DELAY we keep reading up to this many lines in the background, so
+// that we get to the end of most outputs (and can cache them) without the user
+// scrolling there. We wait so that flicking through items doesn't read ahead
+// for each of them.
+const (
+	CACHEABLE_LINES_TO_READ = 5000
+	READ_AHEAD_DELAY        = time.Millisecond * 500
+)
+
 // we use this to check if the system is under stress right now. Hopefully this makes sense on other machines
 const COMMAND_START_THRESHOLD = time.Millisecond * 10
 
@@ -84,8 +94,12 @@ func (self *ViewBufferManager) ReadLines(n int) {
 	})
 }
 
-// note: onDone may be called twice
-func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead int, onDone func()) func(chan struct{}) error {
+// note: onDone may be called twice. onComplete, if given, is called with
+// everything we wrote if we got to the end of the command's output without being
+// stopped. If the task isn't stopped within READ_AHEAD_DELAY we read ahead in
+// the background to get there, but if the output is longer than
+// CACHEABLE_LINES_TO_READ that won't happen until the user scrolls to the end.
+func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead int, onDone func(), onComplete func(output string)) func(chan struct{}) error {
 	return func(stop chan struct{}) error {
 		var once sync.Once
 		var onDoneWrapper func()
@@ -95,7 +109,13 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 
 		if self.throttle {
 			self.Log.Info("throttling task")
-			time.Sleep(THROTTLE_TIME)
+			// if another task comes along while we wait, we never start the
+			// command at all
+			select {
+			case <-stop:
+				return nil
+			case <-time.After(THROTTLE_TIME):
+			}
 		}
 
 		select {
@@ -139,6 +159,14 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 
 		loaded := false
 
+		var output strings.Builder
+		write := func(content []byte) {
+			_, _ = self.writer.Write(content)
+			if onComplete != nil {
+				output.Write(content)
+			}
+		}
+
 		go utils.Safe(func() {
 			ticker := time.NewTicker(time.Millisecond * 200)
 			defer ticker.Stop()
@@ -175,7 +203,7 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 						if !loaded {
 							self.beforeStart()
 							if prefix != "" {
-								_, _ = self.writer.Write([]byte(prefix))
+								write([]byte(prefix))
 							}
 							loaded = true
 						}
@@ -185,9 +213,19 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 							// if we're here then there's nothing left to scan from the source
 							// so we're at the EOF and can flush the stale content
 							self.onEndOfInput()
+
+							// we only kill the command after being stopped, so if
+							// we haven't been stopped, this really is the end
+							select {
+							case <-stop:
+							default:
+								if onComplete != nil {
+									onComplete(output.String())
+								}
+							}
 							break outer
 						}
-						_, _ = self.writer.Write(append(scanner.Bytes(), '\n'))
+						write(append(scanner.Bytes(), '\n'))
 					}
 					self.refreshView()
 				}
@@ -211,6 +249,16 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 		})
 
 		self.readLines <- linesToRead
+		if onComplete != nil {
+			readLines := self.readLines
+			go utils.Safe(func() {
+				select {
+				case <-stop:
+				case <-time.After(READ_AHEAD_DELAY):
+					readLines <- CACHEABLE_LINES_TO_READ
+				}
+			})
+		}
 
 		<-done
 
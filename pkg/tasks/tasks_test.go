@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os/exec"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -45,7 +46,11 @@ func TestNewCmdTaskInstantStop(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", 20, onDone)
+	onComplete := func(output string) {
+		t.Errorf("expected onComplete not to be called, because we were stopped")
+	}
+
+	fn := manager.NewCmdTask(start, "prefix\n", 20, onDone, onComplete)
 
 	_ = fn(stop)
 
@@ -99,7 +104,12 @@ func TestNewCmdTask(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", 20, onDone)
+	completedOutput := ""
+	onComplete := func(output string) {
+		completedOutput = output
+	}
+
+	fn := manager.NewCmdTask(start, "prefix\n", 20, onDone, onComplete)
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -133,4 +143,89 @@ func TestNewCmdTask(t *testing.T) {
 	if actualContent != expectedContent {
 		t.Errorf("expected writer to receive the following content: \n%s\n. But instead it received: %s", expectedContent, actualContent)
 	}
+
+	if completedOutput != expectedContent {
+		t.Errorf("expected onComplete to receive the following content: \n%s\n. But instead it received: %s", expectedContent, completedOutput)
+	}
+}
+
+func TestNewCmdTaskReadsAheadWhenCaching(t *testing.T) {
+	writer := bytes.NewBuffer(nil)
+	noop := func() {}
+
+	manager := NewViewBufferManager(
+		utils.NewDummyLog(),
+		writer,
+		noop,
+		noop,
+		noop,
+		noop,
+	)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	content := strings.Repeat("line\n", 50)
+	reader := bytes.NewBufferString(content)
+	start := func() (*exec.Cmd, io.Reader) {
+		// not actually starting this because it's not necessary
+		cmd := secureexec.Command("blah blah")
+
+		return cmd, reader
+	}
+
+	completedOutput := ""
+	onComplete := func(output string) {
+		completedOutput = output
+	}
+
+	// we only ask for the first 5 lines, but we should still get to the end
+	fn := manager.NewCmdTask(start, "", 5, nil, onComplete)
+	_ = fn(stop)
+
+	if completedOutput != content {
+		t.Errorf("expected onComplete to receive the following content: \n%s\n. But instead it received: %s", content, completedOutput)
+	}
+}
+
+func TestNewCmdTaskDoesNotReadAheadWhenStoppedEarly(t *testing.T) {
+	writer := bytes.NewBuffer(nil)
+	noop := func() {}
+
+	manager := NewViewBufferManager(
+		utils.NewDummyLog(),
+		writer,
+		noop,
+		noop,
+		noop,
+		noop,
+	)
+
+	stop := make(chan struct{})
+	reader := bytes.NewBufferString(strings.Repeat("line\n", 50))
+	start := func() (*exec.Cmd, io.Reader) {
+		// not actually starting this because it's not necessary
+		cmd := secureexec.Command("blah blah")
+
+		return cmd, reader
+	}
+
+	onComplete := func(output string) {
+		t.Errorf("expected onComplete not to be called, because we were stopped before reading ahead")
+	}
+
+	fn := manager.NewCmdTask(start, "", 5, nil, onComplete)
+
+	go func() {
+		time.Sleep(READ_AHEAD_DELAY / 5)
+		close(stop)
+	}()
+
+	_ = fn(stop)
+
+	// give a read ahead the chance to happen if it's going to
+	time.Sleep(READ_AHEAD_DELAY)
+
+	if strings.Count(writer.String(), "\n") != 5 {
+		t.Errorf("expected only the first 5 lines to be read, but got: %s", writer.String())
+	}
 }
@@ -19,3 +19,18 @@ func FuzzySearch(needle string, haystack []string) []string {
 		return match.Str
 	})
 }
+
+// FuzzySearchItems is like FuzzySearch but returns the matching items themselves,
+// searching against the text returned by getText for each item
+func FuzzySearchItems[T any](needle string, items []T, getText func(T) string) []T {
+	if needle == "" {
+		return []T{}
+	}
+
+	matches := fuzzy.Find(needle, slices.Map(items, getText))
+	sort.Sort(matches)
+
+	return slices.Map(matches, func(match fuzzy.Match) T {
+		return items[match.Index]
+	})
+}
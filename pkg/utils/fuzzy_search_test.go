@@ -51,3 +51,16 @@ func TestFuzzySearch(t *testing.T) {
 		assert.EqualValues(t, s.expected, FuzzySearch(s.needle, s.haystack))
 	}
 }
+
+func TestFuzzySearchItems(t *testing.T) {
+	type item struct {
+		id   int
+		name string
+	}
+
+	items := []item{{1, "my_branch"}, {2, "mybranch"}, {3, "branch"}}
+	getText := func(i item) string { return i.name }
+
+	assert.EqualValues(t, []item{}, FuzzySearchItems("", items, getText))
+	assert.EqualValues(t, []item{{2, "mybranch"}, {1, "my_branch"}}, FuzzySearchItems("mybranch", items, getText))
+}
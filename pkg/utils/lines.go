@@ -1,6 +1,10 @@
 package utils
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
 
 // SplitLines takes a multiline string and splits it on newlines
 // currently we are also stripping \r's which may have adverse effects for
@@ -43,3 +47,30 @@ func EscapeSpecialChars(str string) string {
 		"\v", "\\v",
 	).Replace(str)
 }
+
+// WrapLines breaks each line of the given string at spaces so that no line is
+// wider than the given width. Words that are wider than the width on their own
+// are left intact.
+func WrapLines(str string, width int) string {
+	lines := strings.Split(str, "\n")
+	wrappedLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		wrappedLines = append(wrappedLines, wrapLine(line, width)...)
+	}
+	return strings.Join(wrappedLines, "\n")
+}
+
+func wrapLine(line string, width int) []string {
+	words := strings.Split(line, " ")
+	wrappedLines := []string{}
+	current := words[0]
+	for _, word := range words[1:] {
+		if runewidth.StringWidth(current)+1+runewidth.StringWidth(word) > width {
+			wrappedLines = append(wrappedLines, current)
+			current = word
+		} else {
+			current += " " + word
+		}
+	}
+	return append(wrappedLines, current)
+}
@@ -100,3 +100,38 @@ func TestNormalizeLinefeeds(t *testing.T) {
 		assert.EqualValues(t, string(s.expected), NormalizeLinefeeds(string(s.byteArray)))
 	}
 }
+
+func TestWrapLines(t *testing.T) {
+	type scenario struct {
+		str      string
+		width    int
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			"",
+			10,
+			"",
+		},
+		{
+			"short line",
+			10,
+			"short line",
+		},
+		{
+			"a somewhat longer line",
+			10,
+			"a somewhat\nlonger\nline",
+		},
+		{
+			"first line\n\nan unbreakablewordhere",
+			10,
+			"first line\n\nan\nunbreakablewordhere",
+		},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, WrapLines(s.str, s.width))
+	}
+}